@@ -2,13 +2,18 @@ package accountservice
 
 import (
 	"context"
+	"math"
+	"sort"
 	"strconv"
 	"time"
 
 	com "github.com/aureontu/MRWebServer/mr_services/common"
 	"github.com/aureontu/MRWebServer/mr_services/mpb"
 	"github.com/aureontu/MRWebServer/mr_services/mpberr"
+	"github.com/aureontu/MRWebServer/mr_services/util"
+	"github.com/go-redis/redis/v8"
 	"github.com/oldjon/gutil/gdb"
+	gmarshaller "github.com/oldjon/gutil/marshaller"
 	grmux "github.com/oldjon/gutil/redismutex"
 	"go.uber.org/zap"
 )
@@ -18,17 +23,53 @@ type accountDAO struct {
 	rMux   *grmux.RedisMutex
 	accDB  *gdb.DB
 	tmpDB  *gdb.DB
+
+	// accScript run the scripts on the account redis, the objects are marshalled by accMarshaller like accDB does
+	accScript     redis.UniversalClient
+	accMarshaller gmarshaller.Marshaller
+	// tmpScript run the scripts on the tmp redis
+	tmpScript redis.UniversalClient
 }
 
-func newAccountDAO(logger *zap.Logger, rMux *grmux.RedisMutex, accRedis gdb.RedisClient, tmpRedis gdb.RedisClient) *accountDAO {
+func newAccountDAO(logger *zap.Logger, rMux *grmux.RedisMutex, accRedis gdb.RedisClient, tmpRedis gdb.RedisClient,
+	accScript redis.UniversalClient, accMarshaller gmarshaller.Marshaller, tmpScript redis.UniversalClient) *accountDAO {
 	return &accountDAO{
-		logger: logger,
-		rMux:   rMux,
-		accDB:  gdb.NewDB(accRedis),
-		tmpDB:  gdb.NewDB(tmpRedis),
+		logger:        logger,
+		rMux:          rMux,
+		accDB:         gdb.NewDB(accRedis),
+		tmpDB:         gdb.NewDB(tmpRedis),
+		accScript:     accScript,
+		accMarshaller: accMarshaller,
+		tmpScript:     tmpScript,
 	}
 }
 
+// createWalletAccountScript write a new account and the index of its wallet at once.
+// KEYS[1] the wallet index, KEYS[2] the account; ARGV[1] the index value, ARGV[2] the account value.
+// Nothing is written and 0 is returned if the wallet is indexed already.
+// Both keys must be on one node, the account redis runs in single mode.
+var createWalletAccountScript = redis.NewScript(`
+if redis.call("EXISTS", KEYS[1]) == 1 then
+	return 0
+end
+if redis.call("EXISTS", KEYS[2]) == 1 then
+	return redis.error_reply("account exists")
+end
+redis.call("SET", KEYS[2], ARGV[2])
+redis.call("SET", KEYS[1], ARGV[1])
+return 1
+`)
+
+// incrExpireScript increase the counter at KEYS[1] and set its ttl to ARGV[1] seconds when it is created,
+// so a counter never lives without a ttl
+var incrExpireScript = redis.NewScript(`
+local n = redis.call("INCR", KEYS[1])
+if n == 1 then
+	redis.call("EXPIRE", KEYS[1], ARGV[1])
+end
+return n
+`)
+
 func (dao *accountDAO) getAccountInfo(ctx context.Context, userId uint64) (*mpb.DBAccountInfo, error) {
 	key := com.AccountKey(userId)
 	dbAccount := &mpb.DBAccountInfo{}
@@ -39,9 +80,44 @@ func (dao *accountDAO) getAccountInfo(ctx context.Context, userId uint64) (*mpb.
 		dao.logger.Error("getAccountInfo GetObject failed", zap.String("key", key), zap.Error(err))
 		return nil, mpberr.ErrDB
 	}
+	if dbAccount.MergedInto != 0 {
+		return nil, mpberr.ErrAccountNotExist
+	}
+	return dbAccount, nil
+}
+
+// getAccountRecord return the stored account of the user id, including the tombstone of a merged account.
+// ErrAccountNotExist is returned only if nothing is stored.
+func (dao *accountDAO) getAccountRecord(ctx context.Context, userId uint64) (*mpb.DBAccountInfo, error) {
+	key := com.AccountKey(userId)
+	dbAccount := &mpb.DBAccountInfo{}
+	err := dao.accDB.GetObject(ctx, key, dbAccount)
+	if dao.accDB.IsErrNil(err) {
+		return nil, mpberr.ErrAccountNotExist
+	} else if err != nil {
+		dao.logger.Error("getAccountRecord GetObject failed", zap.String("key", key), zap.Error(err))
+		return nil, mpberr.ErrDB
+	}
 	return dbAccount, nil
 }
 
+// resolveAccount return the account of the user id read from an index, following the merges of the account.
+// An index may still point to a merged account while the merge moves it.
+func (dao *accountDAO) resolveAccount(ctx context.Context, userId uint64) (*mpb.DBAccountInfo, error) {
+	for i := 0; i < com.AccountMergeMaxHops; i++ {
+		dbAcc, err := dao.getAccountRecord(ctx, userId)
+		if err != nil {
+			return nil, err
+		}
+		if dbAcc.MergedInto == 0 {
+			return dbAcc, nil
+		}
+		userId = dbAcc.MergedInto
+	}
+	dao.logger.Error("resolveAccount too many merges", zap.Uint64("user_id", userId))
+	return nil, mpberr.ErrAccountNotExist
+}
+
 func (dao *accountDAO) getUserIdByAccount(ctx context.Context, account string) (uint64, error) {
 	key := com.AccountUIDKey(account)
 	userId, err := gdb.ToUint64(dao.accDB.Get(ctx, key))
@@ -63,15 +139,23 @@ func (dao *accountDAO) getAccountInfoByAccount(ctx context.Context, account stri
 		dao.logger.Error("getUserIdByAccount Get failed", zap.String("key", key), zap.Error(err))
 		return nil, mpberr.ErrDB
 	}
-	return dao.getAccountInfo(ctx, userId)
+	return dao.resolveAccount(ctx, userId)
 }
 
-func (dao *accountDAO) saveNonce(ctx context.Context, nonce string) error {
-	return dao.tmpDB.SetEX(ctx, com.NonceKey(nonce), 1, com.Dur10Mins)
+// saveNonce save the nonce for the wallet, it can only be used to sign in with that wallet
+func (dao *accountDAO) saveNonce(ctx context.Context, nonce, walletAddr string) (bool, error) {
+	key := com.NonceKey(walletAddr, nonce)
+	ok, err := dao.tmpDB.SetEXNX(ctx, key, 1, com.NonceExpireDuration)
+	if err != nil {
+		dao.logger.Error("saveNonce SetEXNX failed", zap.String("key", key), zap.Error(err))
+		return false, mpberr.ErrDB
+	}
+	return ok, nil
 }
 
-func (dao *accountDAO) checkNonce(ctx context.Context, nonce string) (bool, error) {
-	key := com.NonceKey(nonce)
+// checkNonce consume the nonce, false if the nonce was not issued to the wallet or has expired
+func (dao *accountDAO) checkNonce(ctx context.Context, nonce, walletAddr string) (bool, error) {
+	key := com.NonceKey(walletAddr, nonce)
 	n, err := dao.tmpDB.Del(ctx, key)
 	if err != nil {
 		dao.logger.Error("checkNonce Del failed", zap.String("key", key), zap.Error(err))
@@ -80,357 +164,3352 @@ func (dao *accountDAO) checkNonce(ctx context.Context, nonce string) (bool, erro
 	return n == 1, nil
 }
 
-func (dao *accountDAO) getAccountByWallet(ctx context.Context, aptosAccAddr string, pubKey []byte) (*mpb.DBAccountInfo, error) {
+func (dao *accountDAO) getWalletAcc(ctx context.Context, aptosAccAddr string) (*mpb.DBWalletAcc, error) {
 	wa := &mpb.DBWalletAcc{}
 	key := com.WalletAccKey(aptosAccAddr)
 	err := dao.accDB.GetObject(ctx, key, wa)
 	if err != nil && !dao.accDB.IsErrNil(err) {
-		dao.logger.Error("getAccountByWallet GetObject failed", zap.String("key", key), zap.Error(err))
+		dao.logger.Error("getWalletAcc GetObject failed", zap.String("key", key), zap.Error(err))
 		return nil, mpberr.ErrDB
 	}
-	if wa == nil || wa.UserId == 0 {
-		// init wallet acc
-		userId, err := gdb.ToUint64(dao.accDB.Incr(ctx, com.UserIdIndexKey()))
+	return wa, nil
+}
+
+// getAccountByWallet return the account of the wallet and whether it is created by this call, the account is
+// created on the first login of the wallet.
+// Creation is serialized by the wallet lock, and the account and the wallet index are written by one script, so a
+// crash can not leave one without the other.
+func (dao *accountDAO) getAccountByWallet(ctx context.Context, aptosAccAddr string, pubKey []byte) (
+	*mpb.DBAccountInfo, bool, error) {
+	wa, err := dao.getWalletAcc(ctx, aptosAccAddr)
+	if err != nil {
+		return nil, false, err
+	}
+	if wa.UserId != 0 {
+		dbAcc, err := dao.resolveAccount(ctx, wa.UserId)
+		if err != mpberr.ErrAccountNotExist {
+			return dbAcc, false, err
+		}
+	}
+
+	key := com.WalletAccKey(aptosAccAddr)
+	var dbAcc *mpb.DBAccountInfo
+	var created bool
+	err = dao.rMux.Safely(ctx, key, func() error {
+		// check again in lock, a parallel login of the wallet may have created the account
+		wa, err := dao.getWalletAcc(ctx, aptosAccAddr)
 		if err != nil {
-			dao.logger.Error("getAccountByWallet GetObject failed", zap.String("key", key), zap.Error(err))
-			return nil, mpberr.ErrDB
+			return err
 		}
-		wa = &mpb.DBWalletAcc{
-			UserId: userId,
+		if wa.UserId != 0 {
+			dbAcc, err = dao.resolveAccount(ctx, wa.UserId)
+			if err != mpberr.ErrAccountNotExist {
+				return err
+			}
+			// only an index written before the creation script can point to nothing, rebuild the account with the
+			// indexed user id if the key is really absent, never over a tombstone
+			_, err = dao.getAccountRecord(ctx, wa.UserId)
+			if err != mpberr.ErrAccountNotExist {
+				if err == nil {
+					dao.logger.Error("getAccountByWallet merge chain broken", zap.String("key", key),
+						zap.Uint64("user_id", wa.UserId))
+					return mpberr.ErrAccountNotExist
+				}
+				return err
+			}
+			dao.logger.Warn("getAccountByWallet rebuild lost account", zap.String("key", key),
+				zap.Uint64("user_id", wa.UserId))
+			dbAcc = newWalletAccount(wa.UserId, aptosAccAddr, pubKey)
+			return dao.rebuildAccount(ctx, dbAcc)
 		}
-		err = dao.accDB.SetObject(ctx, key, wa)
+
+		userId, err := gdb.ToUint64(dao.accDB.Incr(ctx, com.UserIdIndexKey()))
 		if err != nil {
-			dao.logger.Error("getAccountByWallet GetObject failed", zap.String("key", key), zap.Error(err))
-			return nil, mpberr.ErrDB
-		}
-		nickname := "MR" + strconv.Itoa(int(userId))
-		dbAcc := &mpb.DBAccountInfo{
-			UserId:       userId,
-			AptosAccAddr: aptosAccAddr,
-			PublicKey:    pubKey,
-			Nickname:     nickname,
-			Icon:         "0",
-			RegisterTime: time.Now().Unix(),
+			dao.logger.Error("getAccountByWallet Incr failed", zap.String("key", com.UserIdIndexKey()),
+				zap.Error(err))
+			return mpberr.ErrDB
 		}
-		err = dao.accDB.SetObject(ctx, com.AccountKey(userId), dbAcc)
+		dbAcc = newWalletAccount(userId, aptosAccAddr, pubKey)
+		created, err = dao.createWalletAccount(ctx, key, dbAcc)
 		if err != nil {
-			dao.logger.Error("getAccountByWallet SetObject failed",
-				zap.String("key", com.AccountKey(userId)), zap.Error(err))
-			return nil, mpberr.ErrDB
+			return err
 		}
-		return dbAcc, nil
+		if !created { // indexed out of the lock, the user id is skipped
+			return mpberr.ErrRepeatedRequest
+		}
+		return nil
+	})
+	if err != nil {
+		dao.logger.Error("getAccountByWallet Safely failed", zap.String("key", key), zap.Error(err))
+		return nil, false, err
 	}
-
-	return dao.getAccountInfo(ctx, wa.UserId)
+	return dbAcc, created, nil
 }
 
-func (dao *accountDAO) batchGetWalletAccs(ctx context.Context, addrs []string) ([]*mpb.DBWalletAcc, error) {
-	keys := make([]string, 0, len(addrs))
-	was := make([]*mpb.DBWalletAcc, 0, len(addrs))
-	for _, addr := range addrs {
-		keys = append(keys, com.WalletAccKey(addr))
-		was = append(was, &mpb.DBWalletAcc{})
-	}
-	err := dao.accDB.GetObjects(ctx, keys, was)
-	if err != nil {
-		dao.logger.Error("batchGetWalletAccs GetObjects failed",
-			zap.Any("addrs", addrs), zap.Error(err))
-		return nil, err
+func newWalletAccount(userId uint64, aptosAccAddr string, pubKey []byte) *mpb.DBAccountInfo {
+	return &mpb.DBAccountInfo{
+		UserId:       userId,
+		AptosAccAddr: aptosAccAddr,
+		PublicKey:    pubKey,
+		Nickname:     com.DefaultNicknamePrefix + strconv.Itoa(int(userId)),
+		Icon:         "0",
+		RegisterTime: time.Now().Unix(),
 	}
-	return was, err
 }
 
-func (dao *accountDAO) batchGetAccounts(ctx context.Context, userIds []uint64) ([]*mpb.DBAccountInfo, error) {
-	keys := make([]string, 0, len(userIds))
-	accs := make([]*mpb.DBAccountInfo, 0, len(userIds))
-	for _, uid := range userIds {
-		keys = append(keys, com.AccountKey(uid))
-		accs = append(accs, &mpb.DBAccountInfo{})
+// createWalletAccount write the account and the wallet index by createWalletAccountScript, false if the wallet is
+// indexed already
+func (dao *accountDAO) createWalletAccount(ctx context.Context, waKey string, dbAcc *mpb.DBAccountInfo) (bool,
+	error) {
+	accKey := com.AccountKey(dbAcc.UserId)
+	waBys, err := dao.accMarshaller.Marshal(&mpb.DBWalletAcc{UserId: dbAcc.UserId})
+	if err != nil {
+		dao.logger.Error("createWalletAccount Marshal failed", zap.String("key", waKey), zap.Error(err))
+		return false, mpberr.ErrUnknown
 	}
-	err := dao.accDB.GetObjects(ctx, keys, accs)
+	accBys, err := dao.accMarshaller.Marshal(dbAcc)
 	if err != nil {
-		dao.logger.Error("batchGetWalletAccs GetObjects failed",
-			zap.Any("user_ids", userIds), zap.Error(err))
-		return nil, err
+		dao.logger.Error("createWalletAccount Marshal failed", zap.String("key", accKey), zap.Error(err))
+		return false, mpberr.ErrUnknown
 	}
-	return accs, err
+	n, err := createWalletAccountScript.Run(ctx, dao.accScript, []string{waKey, accKey}, waBys, accBys).Int()
+	if err != nil {
+		dao.logger.Error("createWalletAccount Run failed", zap.String("key", waKey), zap.String("acc_key", accKey),
+			zap.Error(err))
+		return false, mpberr.ErrDB
+	}
+	return n == 1, nil
 }
 
-func (dao *accountDAO) saveToken(ctx context.Context, userId uint64, token, account, deviceId, device string) error {
-	key := com.TokenKey(token)
-	err := dao.tmpDB.SetObjectEX(ctx, key, &mpb.DBTokenInfo{
-		Account:  account,
-		Device:   device,
-		DeviceId: deviceId,
-	}, com.TokenExpireDuration)
+// rebuildAccount write the account only if its key is absent
+func (dao *accountDAO) rebuildAccount(ctx context.Context, dbAcc *mpb.DBAccountInfo) error {
+	key := com.AccountKey(dbAcc.UserId)
+	bys, err := dao.accMarshaller.Marshal(dbAcc)
 	if err != nil {
-		dao.logger.Error("save token failed", zap.String("key", key), zap.Error(err))
-		return mpberr.ErrDB
+		dao.logger.Error("rebuildAccount Marshal failed", zap.String("key", key), zap.Error(err))
+		return mpberr.ErrUnknown
 	}
-	err = dao.tmpDB.Set(ctx, com.UIDTokenKey(userId), token)
+	ok, err := dao.accDB.SetNX(ctx, key, bys)
 	if err != nil {
-		dao.logger.Error("save token failed", zap.String("key", key), zap.Error(err))
+		dao.logger.Error("rebuildAccount SetNX failed", zap.String("key", key), zap.Error(err))
 		return mpberr.ErrDB
 	}
+	if !ok {
+		return mpberr.ErrRepeatedRequest
+	}
 	return nil
 }
 
-func (dao *accountDAO) checkEmailSendLimit(ctx context.Context, emailAddr string) (bool, error) {
-	now := time.Now()
-	date := now.Format("20060102")
-	key := com.EmailSendDailyLimitKey(emailAddr, date)
-	cnt, err := dao.tmpDB.IncrBy(ctx, key, 1)
-	if err != nil {
-		dao.logger.Error("checkEmailSendLimit IncrBy failed", zap.String("key", key), zap.Error(err))
-		return false, mpberr.ErrDB
+// repairAccounts check the accounts in [startUserId, startUserId+limit) against the index of their primary wallet.
+// A lost wallet index is pointed back to the account, and an account duplicated by concurrent first logins is
+// merged into the one the wallet index points to. The next user id to check is returned, 0 if all are checked.
+// It only fixes the accounts created before the creation script, so it is not scheduled and runs only by the GM.
+func (dao *accountDAO) repairAccounts(ctx context.Context, startUserId, limit uint64, dryRun bool) (
+	[]*mpb.AccountRepair, uint64, error) {
+	maxUserId, err := gdb.ToUint64(dao.accDB.Get(ctx, com.UserIdIndexKey()))
+	if err != nil && !dao.accDB.IsErrNil(err) {
+		dao.logger.Error("repairAccounts Get failed", zap.String("key", com.UserIdIndexKey()), zap.Error(err))
+		return nil, 0, mpberr.ErrDB
 	}
-	if cnt > com.EmailSendDailyLimit {
-		return false, nil
+	if startUserId == 0 {
+		startUserId = 1
 	}
-	_, err = dao.tmpDB.Expire(ctx, key, com.Dur1Day)
-	if err != nil {
-		dao.logger.Error("checkEmailSendLimit Expire failed", zap.String("key", key), zap.Error(err))
-		return false, err
+	endUserId := startUserId + limit - 1
+	if endUserId > maxUserId {
+		endUserId = maxUserId
 	}
-	return true, nil
-}
 
-func (dao *accountDAO) saveEmailBindCode(ctx context.Context, emailAddr, code string) error {
-	key := com.EmailBindCodeKey(emailAddr)
-	err := dao.tmpDB.SetEX(ctx, key, code, com.Dur10Mins)
-	if err != nil {
-		dao.logger.Error("saveEmailBindCode SetEX failed", zap.String("key", key), zap.Error(err))
-		return mpberr.ErrDB
+	var repairs []*mpb.AccountRepair
+	for start := startUserId; start <= endUserId; start += com.DBBatchNum100 {
+		userIds := make([]uint64, 0, com.DBBatchNum100)
+		for uid := start; uid <= endUserId && uid < start+com.DBBatchNum100; uid++ {
+			userIds = append(userIds, uid)
+		}
+		accs, err := dao.batchGetAccounts(ctx, userIds)
+		if err != nil {
+			return nil, 0, mpberr.ErrDB
+		}
+		for _, acc := range accs {
+			if acc == nil || acc.AptosAccAddr == "" || acc.MergedInto != 0 {
+				continue
+			}
+			repair, err := dao.repairAccountWallet(ctx, acc, dryRun)
+			if err != nil {
+				return nil, 0, err
+			}
+			if repair != nil {
+				repairs = append(repairs, repair)
+			}
+		}
 	}
-	return nil
+
+	if endUserId >= maxUserId {
+		return repairs, 0, nil
+	}
+	return repairs, endUserId + 1, nil
 }
 
-func (dao *accountDAO) checkEmailBindCode(ctx context.Context, emailAddr, code string) (bool, error) {
-	key := com.EmailBindCodeKey(emailAddr)
-	var ok bool
-	err := dao.rMux.Safely(ctx, key, func() error {
-		dbCode, err := dao.tmpDB.Get(ctx, key)
-		if err != nil && !dao.tmpDB.IsErrNil(err) {
-			dao.logger.Error("checkEmailBindCode Get failed", zap.String("key", key), zap.Error(err))
-			return mpberr.ErrDB
+func (dao *accountDAO) repairAccountWallet(ctx context.Context, acc *mpb.DBAccountInfo, dryRun bool) (
+	*mpb.AccountRepair, error) {
+	waKey := com.WalletAccKey(acc.AptosAccAddr)
+	repair := &mpb.AccountRepair{
+		UserId:     acc.UserId,
+		WalletAddr: acc.AptosAccAddr,
+	}
+	err := dao.rMux.Safely(ctx, waKey, func() error {
+		wa, err := dao.getWalletAcc(ctx, acc.AptosAccAddr)
+		if err != nil {
+			return err
 		}
-		if dbCode != code {
+		if wa.UserId == acc.UserId {
 			return nil
 		}
+		if wa.UserId != 0 {
+			live, err := dao.resolveAccount(ctx, wa.UserId)
+			if err != nil && err != mpberr.ErrAccountNotExist {
+				return err
+			}
+			if err == nil {
+				repair.LiveUserId = live.UserId
+			}
+		}
 
-		ok = true
-		_, err = dao.tmpDB.Del(ctx, key)
-		if err != nil {
-			dao.logger.Error("checkEmailBindCode Del failed", zap.String("key", key), zap.Error(err))
+		if repair.LiveUserId == 0 {
+			repair.Action = mpb.EAccountRepair_Action_AdoptWallet
+			if dryRun {
+				return nil
+			}
+			err = dao.accDB.SetObject(ctx, waKey, &mpb.DBWalletAcc{UserId: acc.UserId})
+			if err != nil {
+				dao.logger.Error("repairAccountWallet SetObject failed", zap.String("key", waKey), zap.Error(err))
+				return mpberr.ErrDB
+			}
+			return nil
+		}
+
+		// the account is a duplicate, it can be merged if nothing but the wallet was attached to it
+		uwKey := com.UIDWalletsKey(acc.UserId)
+		var fields []string
+		var wallets []*mpb.DBLinkedWallet
+		err = dao.accDB.HGetAllObjects(ctx, uwKey, &fields, &wallets)
+		if err != nil && !dao.accDB.IsErrNil(err) {
+			dao.logger.Error("repairAccountWallet HGetAllObjects failed", zap.String("key", uwKey), zap.Error(err))
 			return mpberr.ErrDB
 		}
-		return nil
+		for _, w := range wallets {
+			if w.WalletAddr != acc.AptosAccAddr {
+				repair.Action = mpb.EAccountRepair_Action_Conflict
+				return nil
+			}
+		}
+		if acc.Email != "" {
+			repair.Action = mpb.EAccountRepair_Action_Conflict
+			return nil
+		}
+		repair.Action = mpb.EAccountRepair_Action_MergeOrphan
+		if dryRun {
+			return nil
+		}
+		return dao.mergeOrphanAccount(ctx, acc.UserId, repair.LiveUserId)
 	})
 	if err != nil {
-		dao.logger.Error("checkEmailBindCode Safely failed", zap.String("key", key), zap.Error(err))
-		return false, err
+		dao.logger.Error("repairAccountWallet Safely failed", zap.String("key", waKey), zap.Error(err))
+		return nil, err
 	}
-	return ok, nil
+	if repair.Action == mpb.EAccountRepair_Action_None {
+		return nil, nil
+	}
+	return repair, nil
 }
 
-func (dao *accountDAO) bindEmail(ctx context.Context, userId uint64, acc, email string) (*mpb.DBAccountInfo, error) {
-	dbAcc := &mpb.DBAccountInfo{}
-	key1 := com.AccountKey(userId)
-	err := dao.rMux.Safely(ctx, key1, func() error {
-		err := dao.accDB.GetObject(ctx, key1, dbAcc)
+func (dao *accountDAO) mergeOrphanAccount(ctx context.Context, userId, liveUserId uint64) error {
+	key := com.AccountKey(userId)
+	err := dao.rMux.Safely(ctx, key, func() error {
+		acc := &mpb.DBAccountInfo{}
+		err := dao.accDB.GetObject(ctx, key, acc)
 		if err != nil {
-			dao.logger.Error("bindEmail GetObject failed", zap.String("key", key1), zap.Error(err))
+			dao.logger.Error("mergeOrphanAccount GetObject failed", zap.String("key", key), zap.Error(err))
 			return mpberr.ErrDB
 		}
-		if dbAcc.Email != "" {
-			return mpberr.ErrAccBoundEmail
+		acc.MergedInto = liveUserId
+		acc.AptosAccAddr = ""
+		acc.PublicKey = nil
+		err = dao.accDB.SetObject(ctx, key, acc)
+		if err != nil {
+			dao.logger.Error("mergeOrphanAccount SetObject failed", zap.String("key", key), zap.Error(err))
+			return mpberr.ErrDB
 		}
-		dbAcc.Email = email
-		dbAcc.Account = acc
-		err = dao.accDB.SetObject(ctx, key1, dbAcc)
+		_, err = dao.accDB.Del(ctx, com.UIDWalletsKey(userId))
 		if err != nil {
-			dao.logger.Error("bindEmail SetObject failed", zap.String("key", key1), zap.Error(err))
+			dao.logger.Error("mergeOrphanAccount Del failed", zap.String("key", com.UIDWalletsKey(userId)),
+				zap.Error(err))
 			return mpberr.ErrDB
 		}
 		return nil
 	})
 	if err != nil {
-		dao.logger.Error("bindEmail Safely failed", zap.String("key", key1), zap.Error(err))
-		return nil, err
-	}
-
-	key2 := com.EmailAccKey(email)
-	err = dao.accDB.Set(ctx, key2, userId)
-	if err != nil {
-		dao.logger.Error("bindEmail Set failed", zap.String("key", key2), zap.Error(err))
-		return nil, mpberr.ErrDB
-	}
-	key3 := com.AccountUIDKey(dbAcc.Account)
-	err = dao.accDB.Set(ctx, key3, userId)
-	if err != nil {
-		dao.logger.Error("bindEmail Set failed", zap.String("key", key3), zap.Error(err))
-		return nil, mpberr.ErrDB
+		dao.logger.Error("mergeOrphanAccount Safely failed", zap.String("key", key), zap.Error(err))
+		return err
 	}
-	return dbAcc, nil
+	return dao.delAllTokens(ctx, userId)
 }
 
-func (dao *accountDAO) changePassword(ctx context.Context, userId uint64, oldPassword, newPassword string) error {
-	key := com.AccountKey(userId)
+// registerAccount create an email account with a new user id
+func (dao *accountDAO) registerAccount(ctx context.Context, email, password, device, deviceId, os, region,
+	platform string) (*mpb.DBAccountInfo, error) {
+	key := com.EmailAccKey(email)
+	var dbAcc *mpb.DBAccountInfo
 	err := dao.rMux.Safely(ctx, key, func() error {
-		var dbAcc = &mpb.DBAccountInfo{}
-		err := dao.accDB.GetObject(ctx, key, dbAcc)
-		if dao.accDB.IsErrNil(err) {
-			return mpberr.ErrAccountNotExist
-		} else if err != nil {
-			dao.logger.Error("changePassword GetObject failed", zap.String("key", key), zap.Error(err))
+		ok, err := dao.accDB.Exists(ctx, key)
+		if err != nil {
+			dao.logger.Error("registerAccount Exists failed", zap.String("key", key), zap.Error(err))
 			return mpberr.ErrDB
 		}
-		if dbAcc.Password != "" && dbAcc.Password != oldPassword {
-			return mpberr.ErrPassword
+		if ok {
+			return mpberr.ErrEmailBound
 		}
-		dbAcc.Password = newPassword
-		err = dao.accDB.SetObject(ctx, key, dbAcc)
+		ok, err = dao.accDB.Exists(ctx, com.AccountUIDKey(email))
 		if err != nil {
-			dao.logger.Error("changePassword SetObject failed", zap.String("key", key), zap.Error(err))
+			dao.logger.Error("registerAccount Exists failed", zap.String("key", com.AccountUIDKey(email)),
+				zap.Error(err))
+			return mpberr.ErrDB
+		}
+		if ok {
+			return mpberr.ErrAccountExist
+		}
+
+		userId, err := gdb.ToUint64(dao.accDB.Incr(ctx, com.UserIdIndexKey()))
+		if err != nil {
+			dao.logger.Error("registerAccount Incr failed", zap.String("key", com.UserIdIndexKey()), zap.Error(err))
+			return mpberr.ErrDB
+		}
+		hashed, err := util.HashPassword(password)
+		if err != nil {
+			dao.logger.Error("registerAccount HashPassword failed", zap.Uint64("user_id", userId), zap.Error(err))
+			return mpberr.ErrUnknown
+		}
+		dbAcc = &mpb.DBAccountInfo{
+			Account:      email,
+			UserId:       userId,
+			DeviceId:     deviceId,
+			Device:       device,
+			Os:           os,
+			Region:       region,
+			Password:     hashed,
+			RegisterTime: time.Now().Unix(),
+			Email:        email,
+			Platform:     platform,
+			Nickname:     com.DefaultNicknamePrefix + strconv.Itoa(int(userId)),
+			Icon:         "0",
+		}
+		err = dao.accDB.SetObject(ctx, com.AccountKey(userId), dbAcc)
+		if err != nil {
+			dao.logger.Error("registerAccount SetObject failed", zap.String("key", com.AccountKey(userId)),
+				zap.Error(err))
+			return mpberr.ErrDB
+		}
+		err = dao.accDB.Set(ctx, key, userId)
+		if err != nil {
+			dao.logger.Error("registerAccount Set failed", zap.String("key", key), zap.Error(err))
+			return mpberr.ErrDB
+		}
+		err = dao.accDB.Set(ctx, com.AccountUIDKey(email), userId)
+		if err != nil {
+			dao.logger.Error("registerAccount Set failed", zap.String("key", com.AccountUIDKey(email)),
+				zap.Error(err))
 			return mpberr.ErrDB
 		}
 		return nil
 	})
 	if err != nil {
-		dao.logger.Error("changePassword Safely failed", zap.String("key", key), zap.Error(err))
-		return err
+		dao.logger.Error("registerAccount Safely failed", zap.String("key", key), zap.Error(err))
+		return nil, err
 	}
-	return nil
+	return dbAcc, nil
 }
 
-func (dao *accountDAO) checkEmailExist(ctx context.Context, emailAddr string) (bool, error) {
-	key := com.EmailAccKey(emailAddr)
-	ok, err := dao.accDB.Exists(ctx, key)
-	if err != nil {
-		dao.logger.Error("checkEmailExist Exists failed", zap.String("key", key), zap.Error(err))
-		return false, mpberr.ErrDB
+// getLinkedWallets return the wallets linked to the account, the primary wallet of accounts created before
+// multi-wallet is not in the wallets hash yet, it is written back here
+func (dao *accountDAO) getLinkedWallets(ctx context.Context, dbAcc *mpb.DBAccountInfo) ([]*mpb.DBLinkedWallet, error) {
+	key := com.UIDWalletsKey(dbAcc.UserId)
+	var fields []string
+	var wallets []*mpb.DBLinkedWallet
+	err := dao.accDB.HGetAllObjects(ctx, key, &fields, &wallets)
+	if err != nil && !dao.accDB.IsErrNil(err) {
+		dao.logger.Error("getLinkedWallets HGetAllObjects failed", zap.String("key", key), zap.Error(err))
+		return nil, mpberr.ErrDB
+	}
+	if dbAcc.AptosAccAddr == "" {
+		return wallets, nil
+	}
+	for _, w := range wallets {
+		if w.WalletAddr == dbAcc.AptosAccAddr {
+			return wallets, nil
+		}
 	}
-	return ok, nil
-}
 
-func (dao *accountDAO) saveEmailResetPasswordValidationCode(ctx context.Context, emailAddr, code string) error {
-	key := com.EmailResetPasswordValidationCodeKey(emailAddr)
-	err := dao.tmpDB.SetEX(ctx, key, code, com.Dur10Mins)
+	primary := &mpb.DBLinkedWallet{
+		WalletAddr: dbAcc.AptosAccAddr,
+		PublicKey:  dbAcc.PublicKey,
+		LinkTime:   dbAcc.RegisterTime,
+		Legacy:     true,
+	}
+	err = dao.accDB.HSetObjects(ctx, key, primary.WalletAddr, primary)
 	if err != nil {
-		dao.logger.Error("saveEmailBindCode SetEX failed", zap.String("key", key), zap.Error(err))
-		return mpberr.ErrDB
+		dao.logger.Error("getLinkedWallets HSetObjects failed", zap.String("key", key), zap.Error(err))
+		return nil, mpberr.ErrDB
 	}
-	return nil
+	return append(wallets, primary), nil
 }
 
-func (dao *accountDAO) checkEmailResetPasswordValidationCode(ctx context.Context, emailAddr, code string) (bool, error) {
-	key := com.EmailResetPasswordValidationCodeKey(emailAddr)
-	var ok bool
-	err := dao.rMux.Safely(ctx, key, func() error {
-		dbCode, err := dao.tmpDB.Get(ctx, key)
-		if err != nil && !dao.tmpDB.IsErrNil(err) {
-			dao.logger.Error("checkEmailResetPasswordValidationCode Get failed",
-				zap.String("key", key), zap.Error(err))
+// linkWallet link a new wallet to the account, it becomes the primary wallet if the account has none
+func (dao *accountDAO) linkWallet(ctx context.Context, userId uint64, aptosAccAddr string, pubKey []byte) (
+	*mpb.DBAccountInfo, []*mpb.DBLinkedWallet, error) {
+	waKey := com.WalletAccKey(aptosAccAddr)
+	accKey := com.AccountKey(userId)
+	dbAcc := &mpb.DBAccountInfo{}
+	var wallets []*mpb.DBLinkedWallet
+	err := dao.rMux.Safely(ctx, waKey, func() error {
+		wa := &mpb.DBWalletAcc{}
+		err := dao.accDB.GetObject(ctx, waKey, wa)
+		if err != nil && !dao.accDB.IsErrNil(err) {
+			dao.logger.Error("linkWallet GetObject failed", zap.String("key", waKey), zap.Error(err))
 			return mpberr.ErrDB
 		}
-		if dbCode != code {
+		if wa.UserId != 0 {
+			return mpberr.ErrWalletBound
+		}
+
+		err = dao.rMux.Safely(ctx, accKey, func() error {
+			err := dao.accDB.GetObject(ctx, accKey, dbAcc)
+			if dao.accDB.IsErrNil(err) {
+				return mpberr.ErrAccountNotExist
+			} else if err != nil {
+				dao.logger.Error("linkWallet GetObject failed", zap.String("key", accKey), zap.Error(err))
+				return mpberr.ErrDB
+			}
+			wallets, err = dao.getLinkedWallets(ctx, dbAcc)
+			if err != nil {
+				return err
+			}
+			if len(wallets) >= com.MaxLinkedWallets {
+				return mpberr.ErrWalletLimit
+			}
+
+			wallet := &mpb.DBLinkedWallet{
+				WalletAddr: aptosAccAddr,
+				PublicKey:  pubKey,
+				LinkTime:   time.Now().Unix(),
+			}
+			uwKey := com.UIDWalletsKey(userId)
+			err = dao.accDB.HSetObjects(ctx, uwKey, wallet.WalletAddr, wallet)
+			if err != nil {
+				dao.logger.Error("linkWallet HSetObjects failed", zap.String("key", uwKey), zap.Error(err))
+				return mpberr.ErrDB
+			}
+			wallets = append(wallets, wallet)
+
+			if dbAcc.AptosAccAddr != "" && !dbAcc.Guest {
+				return nil
+			}
+			// the first wallet of a guest account upgrades it, the device can not log in it any more
+			dbAcc.Guest = false
+			if dbAcc.AptosAccAddr == "" {
+				dbAcc.AptosAccAddr = aptosAccAddr
+				dbAcc.PublicKey = pubKey
+			}
+			err = dao.accDB.SetObject(ctx, accKey, dbAcc)
+			if err != nil {
+				dao.logger.Error("linkWallet SetObject failed", zap.String("key", accKey), zap.Error(err))
+				return mpberr.ErrDB
+			}
 			return nil
+		})
+		if err != nil {
+			return err
 		}
 
-		ok = true
-		_, err = dao.tmpDB.Del(ctx, key)
+		err = dao.accDB.SetObject(ctx, waKey, &mpb.DBWalletAcc{UserId: userId})
 		if err != nil {
-			dao.logger.Error("checkEmailResetPasswordValidationCode Del failed",
-				zap.String("key", key), zap.Error(err))
+			dao.logger.Error("linkWallet SetObject failed", zap.String("key", waKey), zap.Error(err))
 			return mpberr.ErrDB
 		}
 		return nil
 	})
 	if err != nil {
-		dao.logger.Error("checkEmailResetPasswordValidationCode Safely failed",
-			zap.String("key", key), zap.Error(err))
-		return false, err
+		dao.logger.Error("linkWallet Safely failed", zap.String("key", waKey), zap.Error(err))
+		return nil, nil, err
 	}
-	return ok, nil
+	return dbAcc, wallets, nil
 }
 
-func (dao *accountDAO) saveEmailResetPasswordNonce(ctx context.Context, emailAddr, nonce string) error {
+// unlinkWallet remove a wallet from the account, the earliest linked one of the rest becomes primary
+// if the primary wallet is removed. The last wallet of an account without email can not be removed.
+func (dao *accountDAO) unlinkWallet(ctx context.Context, userId uint64, aptosAccAddr string) (
+	dbAcc *mpb.DBAccountInfo, removed *mpb.DBLinkedWallet, rest []*mpb.DBLinkedWallet, err error) {
+	waKey := com.WalletAccKey(aptosAccAddr)
+	accKey := com.AccountKey(userId)
+	dbAcc = &mpb.DBAccountInfo{}
+	err = dao.rMux.Safely(ctx, waKey, func() error {
+		err := dao.rMux.Safely(ctx, accKey, func() error {
+			err := dao.accDB.GetObject(ctx, accKey, dbAcc)
+			if dao.accDB.IsErrNil(err) {
+				return mpberr.ErrAccountNotExist
+			} else if err != nil {
+				dao.logger.Error("unlinkWallet GetObject failed", zap.String("key", accKey), zap.Error(err))
+				return mpberr.ErrDB
+			}
+			wallets, err := dao.getLinkedWallets(ctx, dbAcc)
+			if err != nil {
+				return err
+			}
+			rest = make([]*mpb.DBLinkedWallet, 0, len(wallets))
+			for _, w := range wallets {
+				if w.WalletAddr == aptosAccAddr {
+					removed = w
+					continue
+				}
+				rest = append(rest, w)
+			}
+			if removed == nil {
+				return mpberr.ErrWalletNotLinked
+			}
+			if len(rest) == 0 && dbAcc.Email == "" {
+				return mpberr.ErrWalletLastLogin
+			}
+
+			uwKey := com.UIDWalletsKey(userId)
+			_, err = dao.accDB.HDel(ctx, uwKey, aptosAccAddr)
+			if err != nil {
+				dao.logger.Error("unlinkWallet HDel failed", zap.String("key", uwKey), zap.Error(err))
+				return mpberr.ErrDB
+			}
+
+			if dbAcc.AptosAccAddr != aptosAccAddr {
+				return nil
+			}
+			dbAcc.AptosAccAddr = ""
+			dbAcc.PublicKey = nil
+			sort.Slice(rest, func(i, j int) bool {
+				return rest[i].LinkTime < rest[j].LinkTime
+			})
+			if len(rest) > 0 {
+				dbAcc.AptosAccAddr = rest[0].WalletAddr
+				dbAcc.PublicKey = rest[0].PublicKey
+			}
+			err = dao.accDB.SetObject(ctx, accKey, dbAcc)
+			if err != nil {
+				dao.logger.Error("unlinkWallet SetObject failed", zap.String("key", accKey), zap.Error(err))
+				return mpberr.ErrDB
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		_, err = dao.accDB.Del(ctx, waKey)
+		if err != nil {
+			dao.logger.Error("unlinkWallet Del failed", zap.String("key", waKey), zap.Error(err))
+			return mpberr.ErrDB
+		}
+		return nil
+	})
+	if err != nil {
+		dao.logger.Error("unlinkWallet Safely failed", zap.String("key", waKey), zap.Error(err))
+		return nil, nil, nil, err
+	}
+	return dbAcc, removed, rest, nil
+}
+
+func (dao *accountDAO) setPrimaryWallet(ctx context.Context, userId uint64, aptosAccAddr string) (
+	*mpb.DBAccountInfo, []*mpb.DBLinkedWallet, error) {
+	key := com.AccountKey(userId)
+	dbAcc := &mpb.DBAccountInfo{}
+	var wallets []*mpb.DBLinkedWallet
+	err := dao.rMux.Safely(ctx, key, func() error {
+		err := dao.accDB.GetObject(ctx, key, dbAcc)
+		if dao.accDB.IsErrNil(err) {
+			return mpberr.ErrAccountNotExist
+		} else if err != nil {
+			dao.logger.Error("setPrimaryWallet GetObject failed", zap.String("key", key), zap.Error(err))
+			return mpberr.ErrDB
+		}
+		wallets, err = dao.getLinkedWallets(ctx, dbAcc)
+		if err != nil {
+			return err
+		}
+		var primary *mpb.DBLinkedWallet
+		for _, w := range wallets {
+			if w.WalletAddr == aptosAccAddr {
+				primary = w
+				break
+			}
+		}
+		if primary == nil {
+			return mpberr.ErrWalletNotLinked
+		}
+		if dbAcc.AptosAccAddr == aptosAccAddr {
+			return nil
+		}
+		dbAcc.AptosAccAddr = primary.WalletAddr
+		dbAcc.PublicKey = primary.PublicKey
+		err = dao.accDB.SetObject(ctx, key, dbAcc)
+		if err != nil {
+			dao.logger.Error("setPrimaryWallet SetObject failed", zap.String("key", key), zap.Error(err))
+			return mpberr.ErrDB
+		}
+		return nil
+	})
+	if err != nil {
+		dao.logger.Error("setPrimaryWallet Safely failed", zap.String("key", key), zap.Error(err))
+		return nil, nil, err
+	}
+	return dbAcc, wallets, nil
+}
+
+func (dao *accountDAO) batchGetWalletAccs(ctx context.Context, addrs []string) ([]*mpb.DBWalletAcc, error) {
+	keys := make([]string, 0, len(addrs))
+	was := make([]*mpb.DBWalletAcc, 0, len(addrs))
+	for _, addr := range addrs {
+		keys = append(keys, com.WalletAccKey(addr))
+		was = append(was, &mpb.DBWalletAcc{})
+	}
+	err := dao.accDB.GetObjects(ctx, keys, was)
+	if err != nil {
+		dao.logger.Error("batchGetWalletAccs GetObjects failed",
+			zap.Any("addrs", addrs), zap.Error(err))
+		return nil, err
+	}
+	return was, err
+}
+
+func (dao *accountDAO) batchGetAccounts(ctx context.Context, userIds []uint64) ([]*mpb.DBAccountInfo, error) {
+	keys := make([]string, 0, len(userIds))
+	accs := make([]*mpb.DBAccountInfo, 0, len(userIds))
+	for _, uid := range userIds {
+		keys = append(keys, com.AccountKey(uid))
+		accs = append(accs, &mpb.DBAccountInfo{})
+	}
+	err := dao.accDB.GetObjects(ctx, keys, accs)
+	if err != nil {
+		dao.logger.Error("batchGetWalletAccs GetObjects failed",
+			zap.Any("user_ids", userIds), zap.Error(err))
+		return nil, err
+	}
+	return accs, err
+}
+
+// saveToken save the token pair of the session and update the session record of the user
+func (dao *accountDAO) saveToken(ctx context.Context, account string, session *mpb.DBSession) error {
+	key := com.TokenKey(session.Token)
+	err := dao.tmpDB.SetObjectEX(ctx, key, &mpb.DBTokenInfo{
+		Account:      account,
+		Device:       session.Device,
+		DeviceId:     session.DeviceId,
+		UserId:       session.UserId,
+		RefreshToken: session.RefreshToken,
+		SessionId:    session.SessionId,
+	}, com.TokenExpireDuration)
+	if err != nil {
+		dao.logger.Error("save token failed", zap.String("key", key), zap.Error(err))
+		return mpberr.ErrDB
+	}
+	rKey := com.RefreshTokenKey(session.RefreshToken)
+	err = dao.tmpDB.SetObjectEX(ctx, rKey, &mpb.DBRefreshTokenInfo{
+		UserId:    session.UserId,
+		Account:   account,
+		Device:    session.Device,
+		DeviceId:  session.DeviceId,
+		Token:     session.Token,
+		SessionId: session.SessionId,
+	}, com.RefreshTokenExpireDuration)
+	if err != nil {
+		dao.logger.Error("save token failed", zap.String("key", rKey), zap.Error(err))
+		return mpberr.ErrDB
+	}
+	sKey := com.UIDSessionsKey(session.UserId)
+	err = dao.tmpDB.HSetObjects(ctx, sKey, session.SessionId, session)
+	if err != nil {
+		dao.logger.Error("save token failed", zap.String("key", sKey), zap.Error(err))
+		return mpberr.ErrDB
+	}
+	_, err = dao.tmpDB.Expire(ctx, sKey, com.RefreshTokenExpireDuration)
+	if err != nil {
+		dao.logger.Error("save token failed", zap.String("key", sKey), zap.Error(err))
+		return mpberr.ErrDB
+	}
+	return nil
+}
+
+// useRefreshToken consume a refresh token and revoke the access token issued with it
+func (dao *accountDAO) useRefreshToken(ctx context.Context, refreshToken string) (*mpb.DBRefreshTokenInfo, error) {
+	key := com.RefreshTokenKey(refreshToken)
+	rt := &mpb.DBRefreshTokenInfo{}
+	err := dao.rMux.Safely(ctx, key, func() error {
+		err := dao.tmpDB.GetObject(ctx, key, rt)
+		if dao.tmpDB.IsErrNil(err) {
+			return mpberr.ErrTokenVerify
+		} else if err != nil {
+			dao.logger.Error("useRefreshToken GetObject failed", zap.String("key", key), zap.Error(err))
+			return mpberr.ErrDB
+		}
+		_, err = dao.tmpDB.Del(ctx, key)
+		if err != nil {
+			dao.logger.Error("useRefreshToken Del failed", zap.String("key", key), zap.Error(err))
+			return mpberr.ErrDB
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	tKey := com.TokenKey(rt.Token)
+	_, err = dao.tmpDB.Del(ctx, tKey)
+	if err != nil {
+		dao.logger.Error("useRefreshToken Del failed", zap.String("key", tKey), zap.Error(err))
+		return nil, mpberr.ErrDB
+	}
+	return rt, nil
+}
+
+func (dao *accountDAO) getSession(ctx context.Context, userId uint64, sessionId string) (*mpb.DBSession, error) {
+	key := com.UIDSessionsKey(userId)
+	session := &mpb.DBSession{}
+	err := dao.tmpDB.HGetObject(ctx, key, sessionId, session)
+	if dao.tmpDB.IsErrNil(err) {
+		return nil, mpberr.ErrSessionNotExist
+	} else if err != nil {
+		dao.logger.Error("getSession HGetObject failed", zap.String("key", key), zap.String("session_id", sessionId),
+			zap.Error(err))
+		return nil, mpberr.ErrDB
+	}
+	return session, nil
+}
+
+// getSessions return the alive sessions of the user, expired ones are cleaned up
+func (dao *accountDAO) getSessions(ctx context.Context, userId uint64) ([]*mpb.DBSession, error) {
+	key := com.UIDSessionsKey(userId)
+	var fields []string
+	var sessions []*mpb.DBSession
+	err := dao.tmpDB.HGetAllObjects(ctx, key, &fields, &sessions)
+	if err != nil && !dao.tmpDB.IsErrNil(err) {
+		dao.logger.Error("getSessions HGetAllObjects failed", zap.String("key", key), zap.Error(err))
+		return nil, mpberr.ErrDB
+	}
+
+	now := time.Now().Unix()
+	alive := make([]*mpb.DBSession, 0, len(sessions))
+	var expired []string
+	for i, session := range sessions {
+		if session.ExpireTime <= now {
+			expired = append(expired, fields[i])
+			continue
+		}
+		alive = append(alive, session)
+	}
+	if len(expired) > 0 {
+		_, err = dao.tmpDB.HDel(ctx, key, expired...)
+		if err != nil {
+			dao.logger.Error("getSessions HDel failed", zap.String("key", key), zap.Strings("fields", expired),
+				zap.Error(err))
+		}
+	}
+
+	// the gateway records the use of the sessions apart from the session records
+	sKey := com.UIDSessionSeenKey(userId)
+	seen, err := dao.tmpDB.HGetAll(ctx, sKey)
+	if err != nil && !dao.tmpDB.IsErrNil(err) {
+		dao.logger.Error("getSessions HGetAll failed", zap.String("key", sKey), zap.Error(err))
+		return alive, nil
+	}
+	for _, session := range alive {
+		t, _ := strconv.ParseInt(seen[session.SessionId], 10, 64)
+		if t > session.LastSeenTime {
+			session.LastSeenTime = t
+		}
+	}
+	return alive, nil
+}
+
+// delToken revoke the session which the access token belongs to
+func (dao *accountDAO) delToken(ctx context.Context, userId uint64, token string) error {
+	key := com.TokenKey(token)
+	ti := &mpb.DBTokenInfo{}
+	err := dao.tmpDB.GetObject(ctx, key, ti)
+	if dao.tmpDB.IsErrNil(err) {
+		return nil
+	} else if err != nil {
+		dao.logger.Error("delToken GetObject failed", zap.String("key", key), zap.Error(err))
+		return mpberr.ErrDB
+	}
+	if ti.UserId != userId {
+		return mpberr.ErrTokenVerify
+	}
+	return dao.delSessions(ctx, userId, []*mpb.DBSession{{
+		SessionId:    ti.SessionId,
+		Token:        token,
+		RefreshToken: ti.RefreshToken,
+	}})
+}
+
+// delSession revoke a session of the user
+func (dao *accountDAO) delSession(ctx context.Context, userId uint64, sessionId string) error {
+	session, err := dao.getSession(ctx, userId, sessionId)
+	if err != nil {
+		return err
+	}
+	return dao.delSessions(ctx, userId, []*mpb.DBSession{session})
+}
+
+// delAllTokens revoke every session of the user
+func (dao *accountDAO) delAllTokens(ctx context.Context, userId uint64) error {
+	key := com.UIDSessionsKey(userId)
+	var fields []string
+	var sessions []*mpb.DBSession
+	err := dao.tmpDB.HGetAllObjects(ctx, key, &fields, &sessions)
+	if err != nil && !dao.tmpDB.IsErrNil(err) {
+		dao.logger.Error("delAllTokens HGetAllObjects failed", zap.String("key", key), zap.Error(err))
+		return mpberr.ErrDB
+	}
+	keys := make([]string, 0, 2*len(sessions)+1)
+	for _, session := range sessions {
+		keys = append(keys, com.TokenKey(session.Token), com.RefreshTokenKey(session.RefreshToken))
+	}
+	keys = append(keys, key, com.UIDSessionSeenKey(userId))
+	err = dao.tmpDB.BatchDel(ctx, keys)
+	if err != nil {
+		dao.logger.Error("delAllTokens BatchDel failed", zap.Uint64("user_id", userId), zap.Error(err))
+		return mpberr.ErrDB
+	}
+	return nil
+}
+
+// revokeAccessTokens revoke the access tokens of every session of the user, the sessions and refresh tokens are kept
+// so the clients refresh into tokens with the current claims
+func (dao *accountDAO) revokeAccessTokens(ctx context.Context, userId uint64) error {
+	key := com.UIDSessionsKey(userId)
+	var fields []string
+	var sessions []*mpb.DBSession
+	err := dao.tmpDB.HGetAllObjects(ctx, key, &fields, &sessions)
+	if dao.tmpDB.IsErrNil(err) || len(sessions) == 0 {
+		return nil
+	} else if err != nil {
+		dao.logger.Error("revokeAccessTokens HGetAllObjects failed", zap.String("key", key), zap.Error(err))
+		return mpberr.ErrDB
+	}
+	keys := make([]string, 0, len(sessions))
+	for _, session := range sessions {
+		keys = append(keys, com.TokenKey(session.Token))
+	}
+	err = dao.tmpDB.BatchDel(ctx, keys)
+	if err != nil {
+		dao.logger.Error("revokeAccessTokens BatchDel failed", zap.Uint64("user_id", userId), zap.Error(err))
+		return mpberr.ErrDB
+	}
+	return nil
+}
+
+func (dao *accountDAO) delSessions(ctx context.Context, userId uint64, sessions []*mpb.DBSession) error {
+	keys := make([]string, 0, 2*len(sessions))
+	fields := make([]string, 0, len(sessions))
+	for _, session := range sessions {
+		keys = append(keys, com.TokenKey(session.Token))
+		if session.RefreshToken != "" {
+			keys = append(keys, com.RefreshTokenKey(session.RefreshToken))
+		}
+		if session.SessionId != "" {
+			fields = append(fields, session.SessionId)
+		}
+	}
+	err := dao.tmpDB.BatchDel(ctx, keys)
+	if err != nil {
+		dao.logger.Error("delSessions BatchDel failed", zap.Strings("keys", keys), zap.Error(err))
+		return mpberr.ErrDB
+	}
+	if len(fields) == 0 {
+		return nil
+	}
+	sKey := com.UIDSessionsKey(userId)
+	_, err = dao.tmpDB.HDel(ctx, sKey, fields...)
+	if err != nil {
+		dao.logger.Error("delSessions HDel failed", zap.String("key", sKey), zap.Error(err))
+		return mpberr.ErrDB
+	}
+	return nil
+}
+
+func (dao *accountDAO) checkEmailSendLimit(ctx context.Context, emailAddr string) (bool, error) {
+	date := time.Now().Format("20060102")
+	return dao.checkDailySendLimit(ctx, com.EmailSendDailyLimitKey(emailAddr, date), com.EmailSendDailyLimit)
+}
+
+// checkSMSSendLimit count a send in the daily counters of the account, the ip and the phone number, false if any of
+// them is exceeded. The user id is 0 for the sends without login.
+func (dao *accountDAO) checkSMSSendLimit(ctx context.Context, userId uint64, ip, tel string) (bool, error) {
+	date := time.Now().Format("20060102")
+	if userId != 0 {
+		ok, err := dao.checkDailySendLimit(ctx, com.SMSSendUserLimitKey(userId, date), com.SMSSendUserDailyLimit)
+		if err != nil || !ok {
+			return ok, err
+		}
+	}
+	ok, err := dao.checkDailySendLimit(ctx, com.SMSSendIPLimitKey(ip, date), com.SMSSendIPDailyLimit)
+	if err != nil || !ok {
+		return ok, err
+	}
+	return dao.checkDailySendLimit(ctx, com.SMSSendDailyLimitKey(tel, date), com.SMSSendDailyLimit)
+}
+
+// checkDailySendLimit count a send in the daily counter of the key, false if the limit is exceeded
+func (dao *accountDAO) checkDailySendLimit(ctx context.Context, key string, limit int64) (bool, error) {
+	cnt, err := dao.tmpDB.IncrBy(ctx, key, 1)
+	if err != nil {
+		dao.logger.Error("checkDailySendLimit IncrBy failed", zap.String("key", key), zap.Error(err))
+		return false, mpberr.ErrDB
+	}
+	if cnt > limit {
+		return false, nil
+	}
+	_, err = dao.tmpDB.Expire(ctx, key, com.Dur1Day)
+	if err != nil {
+		dao.logger.Error("checkDailySendLimit Expire failed", zap.String("key", key), zap.Error(err))
+		return false, err
+	}
+	return true, nil
+}
+
+func (dao *accountDAO) saveEmailBindCode(ctx context.Context, emailAddr, code string) error {
+	key := com.EmailBindCodeKey(emailAddr)
+	err := dao.tmpDB.SetEX(ctx, key, code, com.Dur10Mins)
+	if err != nil {
+		dao.logger.Error("saveEmailBindCode SetEX failed", zap.String("key", key), zap.Error(err))
+		return mpberr.ErrDB
+	}
+	return nil
+}
+
+func (dao *accountDAO) checkEmailBindCode(ctx context.Context, emailAddr, code string) (bool, error) {
+	key := com.EmailBindCodeKey(emailAddr)
+	var ok bool
+	err := dao.rMux.Safely(ctx, key, func() error {
+		dbCode, err := dao.tmpDB.Get(ctx, key)
+		if err != nil && !dao.tmpDB.IsErrNil(err) {
+			dao.logger.Error("checkEmailBindCode Get failed", zap.String("key", key), zap.Error(err))
+			return mpberr.ErrDB
+		}
+		if dbCode == "" {
+			return nil
+		}
+		if dbCode != code {
+			return dao.addVCodeFailure(ctx, key)
+		}
+
+		ok = true
+		err = dao.tmpDB.BatchDel(ctx, []string{key, com.VCodeFailKey(key)})
+		if err != nil {
+			dao.logger.Error("checkEmailBindCode BatchDel failed", zap.String("key", key), zap.Error(err))
+			return mpberr.ErrDB
+		}
+		return nil
+	})
+	if err != nil {
+		dao.logger.Error("checkEmailBindCode Safely failed", zap.String("key", key), zap.Error(err))
+		return false, err
+	}
+	return ok, nil
+}
+
+func (dao *accountDAO) bindEmail(ctx context.Context, userId uint64, acc, email string) (*mpb.DBAccountInfo, error) {
+	dbAcc := &mpb.DBAccountInfo{}
+	key1 := com.AccountKey(userId)
+	err := dao.rMux.Safely(ctx, key1, func() error {
+		err := dao.accDB.GetObject(ctx, key1, dbAcc)
+		if err != nil {
+			dao.logger.Error("bindEmail GetObject failed", zap.String("key", key1), zap.Error(err))
+			return mpberr.ErrDB
+		}
+		if dbAcc.Email != "" {
+			return mpberr.ErrAccBoundEmail
+		}
+		dbAcc.Email = email
+		dbAcc.Account = acc
+		err = dao.accDB.SetObject(ctx, key1, dbAcc)
+		if err != nil {
+			dao.logger.Error("bindEmail SetObject failed", zap.String("key", key1), zap.Error(err))
+			return mpberr.ErrDB
+		}
+		return nil
+	})
+	if err != nil {
+		dao.logger.Error("bindEmail Safely failed", zap.String("key", key1), zap.Error(err))
+		return nil, err
+	}
+
+	key2 := com.EmailAccKey(email)
+	err = dao.accDB.Set(ctx, key2, userId)
+	if err != nil {
+		dao.logger.Error("bindEmail Set failed", zap.String("key", key2), zap.Error(err))
+		return nil, mpberr.ErrDB
+	}
+	key3 := com.AccountUIDKey(dbAcc.Account)
+	err = dao.accDB.Set(ctx, key3, userId)
+	if err != nil {
+		dao.logger.Error("bindEmail Set failed", zap.String("key", key3), zap.Error(err))
+		return nil, mpberr.ErrDB
+	}
+	return dbAcc, nil
+}
+
+func (dao *accountDAO) saveEmailChangeOldCode(ctx context.Context, userId uint64, code string) error {
+	key := com.EmailChangeOldCodeKey(userId)
+	err := dao.tmpDB.SetEX(ctx, key, code, com.Dur10Mins)
+	if err != nil {
+		dao.logger.Error("saveEmailChangeOldCode SetEX failed", zap.String("key", key), zap.Error(err))
+		return mpberr.ErrDB
+	}
+	return nil
+}
+
+func (dao *accountDAO) checkEmailChangeOldCode(ctx context.Context, userId uint64, code string) (bool, error) {
+	key := com.EmailChangeOldCodeKey(userId)
+	var ok bool
+	err := dao.rMux.Safely(ctx, key, func() error {
+		dbCode, err := dao.tmpDB.Get(ctx, key)
+		if err != nil && !dao.tmpDB.IsErrNil(err) {
+			dao.logger.Error("checkEmailChangeOldCode Get failed", zap.String("key", key), zap.Error(err))
+			return mpberr.ErrDB
+		}
+		if dbCode == "" {
+			return nil
+		}
+		if dbCode != code {
+			return dao.addVCodeFailure(ctx, key)
+		}
+
+		ok = true
+		err = dao.tmpDB.BatchDel(ctx, []string{key, com.VCodeFailKey(key)})
+		if err != nil {
+			dao.logger.Error("checkEmailChangeOldCode BatchDel failed", zap.String("key", key), zap.Error(err))
+			return mpberr.ErrDB
+		}
+		return nil
+	})
+	if err != nil {
+		dao.logger.Error("checkEmailChangeOldCode Safely failed", zap.String("key", key), zap.Error(err))
+		return false, err
+	}
+	return ok, nil
+}
+
+// changeEmail move the account to the new email, the email and account indexes of the old email are removed
+// so that it can be used by others
+func (dao *accountDAO) changeEmail(ctx context.Context, userId uint64, email string) (*mpb.DBAccountInfo, error) {
+	emailKey := com.EmailAccKey(email)
+	accKey := com.AccountKey(userId)
+	dbAcc := &mpb.DBAccountInfo{}
+	err := dao.rMux.Safely(ctx, emailKey, func() error {
+		return dao.rMux.Safely(ctx, accKey, func() error {
+			ok, err := dao.accDB.Exists(ctx, emailKey)
+			if err != nil {
+				dao.logger.Error("changeEmail Exists failed", zap.String("key", emailKey), zap.Error(err))
+				return mpberr.ErrDB
+			}
+			if ok {
+				return mpberr.ErrEmailBound
+			}
+			err = dao.accDB.GetObject(ctx, accKey, dbAcc)
+			if err != nil {
+				dao.logger.Error("changeEmail GetObject failed", zap.String("key", accKey), zap.Error(err))
+				return mpberr.ErrDB
+			}
+			if dbAcc.Email == "" {
+				return mpberr.ErrEmailNotExist
+			}
+			oldEmail := dbAcc.Email
+			// the account name is the email for email accounts, it moves together with the email
+			moveAccount := dbAcc.Account == oldEmail
+			if moveAccount {
+				ok, err = dao.accDB.Exists(ctx, com.AccountUIDKey(email))
+				if err != nil {
+					dao.logger.Error("changeEmail Exists failed", zap.String("key", com.AccountUIDKey(email)),
+						zap.Error(err))
+					return mpberr.ErrDB
+				}
+				if ok {
+					return mpberr.ErrAccountExist
+				}
+			}
+
+			err = dao.accDB.Set(ctx, emailKey, userId)
+			if err != nil {
+				dao.logger.Error("changeEmail Set failed", zap.String("key", emailKey), zap.Error(err))
+				return mpberr.ErrDB
+			}
+			if moveAccount {
+				err = dao.accDB.Set(ctx, com.AccountUIDKey(email), userId)
+				if err != nil {
+					dao.logger.Error("changeEmail Set failed", zap.String("key", com.AccountUIDKey(email)),
+						zap.Error(err))
+					return mpberr.ErrDB
+				}
+				dbAcc.Account = email
+			}
+			dbAcc.Email = email
+			err = dao.accDB.SetObject(ctx, accKey, dbAcc)
+			if err != nil {
+				dao.logger.Error("changeEmail SetObject failed", zap.String("key", accKey), zap.Error(err))
+				return mpberr.ErrDB
+			}
+
+			delKeys := []string{com.EmailAccKey(oldEmail)}
+			if moveAccount {
+				delKeys = append(delKeys, com.AccountUIDKey(oldEmail))
+			}
+			err = dao.accDB.BatchDel(ctx, delKeys)
+			if err != nil {
+				dao.logger.Error("changeEmail BatchDel failed", zap.Strings("keys", delKeys), zap.Error(err))
+				return mpberr.ErrDB
+			}
+			return nil
+		})
+	})
+	if err != nil {
+		dao.logger.Error("changeEmail Safely failed", zap.Uint64("user_id", userId), zap.String("email", email),
+			zap.Error(err))
+		return nil, err
+	}
+	return dbAcc, nil
+}
+
+func (dao *accountDAO) changePassword(ctx context.Context, userId uint64, oldPassword, newPassword string) error {
+	key := com.AccountKey(userId)
+	err := dao.rMux.Safely(ctx, key, func() error {
+		var dbAcc = &mpb.DBAccountInfo{}
+		err := dao.accDB.GetObject(ctx, key, dbAcc)
+		if dao.accDB.IsErrNil(err) {
+			return mpberr.ErrAccountNotExist
+		} else if err != nil {
+			dao.logger.Error("changePassword GetObject failed", zap.String("key", key), zap.Error(err))
+			return mpberr.ErrDB
+		}
+		if dbAcc.Password != "" {
+			if ok, _ := util.VerifyPassword(dbAcc.Password, oldPassword); !ok {
+				return mpberr.ErrPassword
+			}
+		}
+		dbAcc.Password, err = util.HashPassword(newPassword)
+		if err != nil {
+			dao.logger.Error("changePassword HashPassword failed", zap.Uint64("user_id", userId), zap.Error(err))
+			return mpberr.ErrUnknown
+		}
+		err = dao.accDB.SetObject(ctx, key, dbAcc)
+		if err != nil {
+			dao.logger.Error("changePassword SetObject failed", zap.String("key", key), zap.Error(err))
+			return mpberr.ErrDB
+		}
+		return nil
+	})
+	if err != nil {
+		dao.logger.Error("changePassword Safely failed", zap.String("key", key), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+func (dao *accountDAO) checkEmailExist(ctx context.Context, emailAddr string) (bool, error) {
+	key := com.EmailAccKey(emailAddr)
+	ok, err := dao.accDB.Exists(ctx, key)
+	if err != nil {
+		dao.logger.Error("checkEmailExist Exists failed", zap.String("key", key), zap.Error(err))
+		return false, mpberr.ErrDB
+	}
+	return ok, nil
+}
+
+func (dao *accountDAO) saveEmailResetPasswordValidationCode(ctx context.Context, emailAddr, code string) error {
+	key := com.EmailResetPasswordValidationCodeKey(emailAddr)
+	err := dao.tmpDB.SetEX(ctx, key, code, com.Dur10Mins)
+	if err != nil {
+		dao.logger.Error("saveEmailBindCode SetEX failed", zap.String("key", key), zap.Error(err))
+		return mpberr.ErrDB
+	}
+	return nil
+}
+
+func (dao *accountDAO) checkEmailResetPasswordValidationCode(ctx context.Context, emailAddr, code string) (bool, error) {
+	key := com.EmailResetPasswordValidationCodeKey(emailAddr)
+	var ok bool
+	err := dao.rMux.Safely(ctx, key, func() error {
+		dbCode, err := dao.tmpDB.Get(ctx, key)
+		if err != nil && !dao.tmpDB.IsErrNil(err) {
+			dao.logger.Error("checkEmailResetPasswordValidationCode Get failed",
+				zap.String("key", key), zap.Error(err))
+			return mpberr.ErrDB
+		}
+		if dbCode == "" {
+			return nil
+		}
+		if dbCode != code {
+			return dao.addVCodeFailure(ctx, key)
+		}
+
+		ok = true
+		err = dao.tmpDB.BatchDel(ctx, []string{key, com.VCodeFailKey(key)})
+		if err != nil {
+			dao.logger.Error("checkEmailResetPasswordValidationCode BatchDel failed",
+				zap.String("key", key), zap.Error(err))
+			return mpberr.ErrDB
+		}
+		return nil
+	})
+	if err != nil {
+		dao.logger.Error("checkEmailResetPasswordValidationCode Safely failed",
+			zap.String("key", key), zap.Error(err))
+		return false, err
+	}
+	return ok, nil
+}
+
+func (dao *accountDAO) saveEmailResetPasswordNonce(ctx context.Context, emailAddr, nonce string) error {
 	key := com.EmailResetPasswordNonceKey(emailAddr)
 	err := dao.tmpDB.SetEX(ctx, key, nonce, com.Dur10Mins)
 	if err != nil {
-		dao.logger.Error("saveEmailBindCode SetEX failed", zap.String("key", key), zap.Error(err))
+		dao.logger.Error("saveEmailBindCode SetEX failed", zap.String("key", key), zap.Error(err))
+		return mpberr.ErrDB
+	}
+	return nil
+}
+
+func (dao *accountDAO) checkEmailResetPasswordNonce(ctx context.Context, emailAddr, nonce string) (bool, error) {
+	key := com.EmailResetPasswordNonceKey(emailAddr)
+	var ok bool
+	err := dao.rMux.Safely(ctx, key, func() error {
+		dbNonce, err := dao.tmpDB.Get(ctx, key)
+		if err != nil && !dao.tmpDB.IsErrNil(err) {
+			dao.logger.Error("checkEmailResetPasswordNonce Get failed",
+				zap.String("key", key), zap.Error(err))
+			return mpberr.ErrDB
+		}
+		if dbNonce == "" {
+			return nil
+		}
+		if dbNonce != nonce {
+			return dao.addVCodeFailure(ctx, key)
+		}
+
+		ok = true
+		err = dao.tmpDB.BatchDel(ctx, []string{key, com.VCodeFailKey(key)})
+		if err != nil {
+			dao.logger.Error("checkEmailResetPasswordNonce BatchDel failed",
+				zap.String("key", key), zap.Error(err))
+			return mpberr.ErrDB
+		}
+		return nil
+	})
+	if err != nil {
+		dao.logger.Error("checkEmailResetPasswordNonce Safely failed",
+			zap.String("key", key), zap.Error(err))
+		return false, err
+	}
+	return ok, nil
+}
+
+func (dao *accountDAO) getUserIdByEmail(ctx context.Context, email string) (uint64, error) {
+	key := com.EmailAccKey(email)
+	userId, err := gdb.ToUint64(dao.accDB.Get(ctx, key))
+
+	if dao.accDB.IsErrNil(err) {
+		return 0, mpberr.ErrEmailNotExist
+	} else if err != nil {
+		dao.logger.Error("getUserIdByEmail SetObject failed", zap.String("key", key), zap.Error(err))
+		return 0, mpberr.ErrDB
+	}
+	return userId, nil
+}
+
+func (dao *accountDAO) checkTelExist(ctx context.Context, tel string) (bool, error) {
+	key := com.TelAccKey(tel)
+	ok, err := dao.accDB.Exists(ctx, key)
+	if err != nil {
+		dao.logger.Error("checkTelExist Exists failed", zap.String("key", key), zap.Error(err))
+		return false, mpberr.ErrDB
+	}
+	return ok, nil
+}
+
+func (dao *accountDAO) getUserIdByTel(ctx context.Context, tel string) (uint64, error) {
+	key := com.TelAccKey(tel)
+	userId, err := gdb.ToUint64(dao.accDB.Get(ctx, key))
+	if dao.accDB.IsErrNil(err) {
+		return 0, mpberr.ErrPhoneNotExist
+	} else if err != nil {
+		dao.logger.Error("getUserIdByTel Get failed", zap.String("key", key), zap.Error(err))
+		return 0, mpberr.ErrDB
+	}
+	return userId, nil
+}
+
+// saveSMSCode save the code sent to the phone, key is TelBindCodeKey or TelResetPasswordValidationCodeKey
+func (dao *accountDAO) saveSMSCode(ctx context.Context, key, code string) error {
+	err := dao.tmpDB.SetEX(ctx, key, code, com.Dur10Mins)
+	if err != nil {
+		dao.logger.Error("saveSMSCode SetEX failed", zap.String("key", key), zap.Error(err))
+		return mpberr.ErrDB
+	}
+	return nil
+}
+
+// checkSMSCode check and consume the code sent to the phone, the code is dropped after too many wrong guesses
+func (dao *accountDAO) checkSMSCode(ctx context.Context, key, code string) (bool, error) {
+	var ok bool
+	err := dao.rMux.Safely(ctx, key, func() error {
+		dbCode, err := dao.tmpDB.Get(ctx, key)
+		if err != nil && !dao.tmpDB.IsErrNil(err) {
+			dao.logger.Error("checkSMSCode Get failed", zap.String("key", key), zap.Error(err))
+			return mpberr.ErrDB
+		}
+		if dbCode == "" {
+			return nil
+		}
+		if dbCode != code {
+			return dao.addVCodeFailure(ctx, key)
+		}
+
+		ok = true
+		err = dao.tmpDB.BatchDel(ctx, []string{key, com.VCodeFailKey(key)})
+		if err != nil {
+			dao.logger.Error("checkSMSCode BatchDel failed", zap.String("key", key), zap.Error(err))
+			return mpberr.ErrDB
+		}
+		return nil
+	})
+	if err != nil {
+		dao.logger.Error("checkSMSCode Safely failed", zap.String("key", key), zap.Error(err))
+		return false, err
+	}
+	return ok, nil
+}
+
+// bindTel bind the phone number to the account, an account holds one number and a number belongs to one account
+func (dao *accountDAO) bindTel(ctx context.Context, userId uint64, tel string) (*mpb.DBAccountInfo, error) {
+	telKey := com.TelAccKey(tel)
+	accKey := com.AccountKey(userId)
+	dbAcc := &mpb.DBAccountInfo{}
+	err := dao.rMux.Safely(ctx, telKey, func() error {
+		return dao.rMux.Safely(ctx, accKey, func() error {
+			ok, err := dao.accDB.Exists(ctx, telKey)
+			if err != nil {
+				dao.logger.Error("bindTel Exists failed", zap.String("key", telKey), zap.Error(err))
+				return mpberr.ErrDB
+			}
+			if ok {
+				return mpberr.ErrPhoneBound
+			}
+			err = dao.accDB.GetObject(ctx, accKey, dbAcc)
+			if dao.accDB.IsErrNil(err) {
+				return mpberr.ErrAccountNotExist
+			} else if err != nil {
+				dao.logger.Error("bindTel GetObject failed", zap.String("key", accKey), zap.Error(err))
+				return mpberr.ErrDB
+			}
+			if dbAcc.Tel != "" {
+				return mpberr.ErrAccBoundPhone
+			}
+
+			err = dao.accDB.Set(ctx, telKey, userId)
+			if err != nil {
+				dao.logger.Error("bindTel Set failed", zap.String("key", telKey), zap.Error(err))
+				return mpberr.ErrDB
+			}
+			dbAcc.Tel = tel
+			err = dao.accDB.SetObject(ctx, accKey, dbAcc)
+			if err != nil {
+				dao.logger.Error("bindTel SetObject failed", zap.String("key", accKey), zap.Error(err))
+				return mpberr.ErrDB
+			}
+			return nil
+		})
+	})
+	if err != nil {
+		dao.logger.Error("bindTel Safely failed", zap.String("key", accKey), zap.Error(err))
+		return nil, err
+	}
+	return dbAcc, nil
+}
+
+func (dao *accountDAO) resetPassword(ctx context.Context, userId uint64, password string) error {
+	key := com.AccountKey(userId)
+	err := dao.rMux.Safely(ctx, key, func() error {
+		var dbAcc = &mpb.DBAccountInfo{}
+		err := dao.accDB.GetObject(ctx, key, dbAcc)
+		if dao.accDB.IsErrNil(err) {
+			return mpberr.ErrAccountNotExist
+		} else if err != nil {
+			dao.logger.Error("resetPassword GetObject failed", zap.String("key", key), zap.Error(err))
+			return mpberr.ErrDB
+		}
+
+		dbAcc.Password, err = util.HashPassword(password)
+		if err != nil {
+			dao.logger.Error("resetPassword HashPassword failed", zap.Uint64("user_id", userId), zap.Error(err))
+			return mpberr.ErrUnknown
+		}
+		err = dao.accDB.SetObject(ctx, key, dbAcc)
+		if err != nil {
+			dao.logger.Error("resetPassword SetObject failed", zap.String("key", key), zap.Error(err))
+			return mpberr.ErrDB
+		}
+		return nil
+	})
+	if err != nil {
+		dao.logger.Error("resetPassword Safely failed", zap.String("key", key), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+// rehashPassword replace the stored password with a fresh hash, it only writes if the stored value is unchanged
+func (dao *accountDAO) rehashPassword(ctx context.Context, userId uint64, oldStored, password string) error {
+	key := com.AccountKey(userId)
+	err := dao.rMux.Safely(ctx, key, func() error {
+		var dbAcc = &mpb.DBAccountInfo{}
+		err := dao.accDB.GetObject(ctx, key, dbAcc)
+		if dao.accDB.IsErrNil(err) {
+			return mpberr.ErrAccountNotExist
+		} else if err != nil {
+			dao.logger.Error("rehashPassword GetObject failed", zap.String("key", key), zap.Error(err))
+			return mpberr.ErrDB
+		}
+		if dbAcc.Password != oldStored { // changed by someone else
+			return nil
+		}
+
+		dbAcc.Password, err = util.HashPassword(password)
+		if err != nil {
+			dao.logger.Error("rehashPassword HashPassword failed", zap.Uint64("user_id", userId), zap.Error(err))
+			return mpberr.ErrUnknown
+		}
+		err = dao.accDB.SetObject(ctx, key, dbAcc)
+		if err != nil {
+			dao.logger.Error("rehashPassword SetObject failed", zap.String("key", key), zap.Error(err))
+			return mpberr.ErrDB
+		}
+		return nil
+	})
+	if err != nil {
+		dao.logger.Error("rehashPassword Safely failed", zap.String("key", key), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+func (dao *accountDAO) savePendingTOTPSecret(ctx context.Context, userId uint64, secret string) error {
+	key := com.TOTPPendingKey(userId)
+	err := dao.tmpDB.SetEX(ctx, key, secret, com.TOTPEnrollExpireDuration)
+	if err != nil {
+		dao.logger.Error("savePendingTOTPSecret SetEX failed", zap.String("key", key), zap.Error(err))
+		return mpberr.ErrDB
+	}
+	return nil
+}
+
+func (dao *accountDAO) getPendingTOTPSecret(ctx context.Context, userId uint64) (string, error) {
+	key := com.TOTPPendingKey(userId)
+	secret, err := gdb.ToString(dao.tmpDB.Get(ctx, key))
+	if dao.tmpDB.IsErrNil(err) {
+		return "", mpberr.ErrTOTPNotEnabled
+	} else if err != nil {
+		dao.logger.Error("getPendingTOTPSecret Get failed", zap.String("key", key), zap.Error(err))
+		return "", mpberr.ErrDB
+	}
+	return secret, nil
+}
+
+// enableTOTP turn on totp with the confirmed secret and the hashed recovery codes
+func (dao *accountDAO) enableTOTP(ctx context.Context, userId uint64, secret string, recoveryCodes []string) error {
+	key := com.AccountKey(userId)
+	err := dao.rMux.Safely(ctx, key, func() error {
+		var dbAcc = &mpb.DBAccountInfo{}
+		err := dao.accDB.GetObject(ctx, key, dbAcc)
+		if dao.accDB.IsErrNil(err) {
+			return mpberr.ErrAccountNotExist
+		} else if err != nil {
+			dao.logger.Error("enableTOTP GetObject failed", zap.String("key", key), zap.Error(err))
+			return mpberr.ErrDB
+		}
+		if dbAcc.TotpEnabled {
+			return mpberr.ErrTOTPEnabled
+		}
+		dbAcc.TotpSecret = secret
+		dbAcc.TotpEnabled = true
+		dbAcc.TotpRecoveryCodes = recoveryCodes
+		err = dao.accDB.SetObject(ctx, key, dbAcc)
+		if err != nil {
+			dao.logger.Error("enableTOTP SetObject failed", zap.String("key", key), zap.Error(err))
+			return mpberr.ErrDB
+		}
+		return nil
+	})
+	if err != nil {
+		dao.logger.Error("enableTOTP Safely failed", zap.String("key", key), zap.Error(err))
+		return err
+	}
+
+	pKey := com.TOTPPendingKey(userId)
+	_, err = dao.tmpDB.Del(ctx, pKey)
+	if err != nil {
+		dao.logger.Error("enableTOTP Del failed", zap.String("key", pKey), zap.Error(err))
+	}
+	return nil
+}
+
+func (dao *accountDAO) disableTOTP(ctx context.Context, userId uint64) error {
+	key := com.AccountKey(userId)
+	err := dao.rMux.Safely(ctx, key, func() error {
+		var dbAcc = &mpb.DBAccountInfo{}
+		err := dao.accDB.GetObject(ctx, key, dbAcc)
+		if dao.accDB.IsErrNil(err) {
+			return mpberr.ErrAccountNotExist
+		} else if err != nil {
+			dao.logger.Error("disableTOTP GetObject failed", zap.String("key", key), zap.Error(err))
+			return mpberr.ErrDB
+		}
+		dbAcc.TotpSecret = ""
+		dbAcc.TotpEnabled = false
+		dbAcc.TotpRecoveryCodes = nil
+		err = dao.accDB.SetObject(ctx, key, dbAcc)
+		if err != nil {
+			dao.logger.Error("disableTOTP SetObject failed", zap.String("key", key), zap.Error(err))
+			return mpberr.ErrDB
+		}
+		return nil
+	})
+	if err != nil {
+		dao.logger.Error("disableTOTP Safely failed", zap.String("key", key), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+// useTOTPRecoveryCode remove the hashed recovery code from the account, return false if it is not found
+func (dao *accountDAO) useTOTPRecoveryCode(ctx context.Context, userId uint64, hashedCode string) (bool, error) {
+	key := com.AccountKey(userId)
+	var found bool
+	err := dao.rMux.Safely(ctx, key, func() error {
+		var dbAcc = &mpb.DBAccountInfo{}
+		err := dao.accDB.GetObject(ctx, key, dbAcc)
+		if dao.accDB.IsErrNil(err) {
+			return mpberr.ErrAccountNotExist
+		} else if err != nil {
+			dao.logger.Error("useTOTPRecoveryCode GetObject failed", zap.String("key", key), zap.Error(err))
+			return mpberr.ErrDB
+		}
+		for i, c := range dbAcc.TotpRecoveryCodes {
+			if c == hashedCode {
+				dbAcc.TotpRecoveryCodes = append(dbAcc.TotpRecoveryCodes[:i], dbAcc.TotpRecoveryCodes[i+1:]...)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil
+		}
+		err = dao.accDB.SetObject(ctx, key, dbAcc)
+		if err != nil {
+			dao.logger.Error("useTOTPRecoveryCode SetObject failed", zap.String("key", key), zap.Error(err))
+			return mpberr.ErrDB
+		}
+		return nil
+	})
+	if err != nil {
+		dao.logger.Error("useTOTPRecoveryCode Safely failed", zap.String("key", key), zap.Error(err))
+		return false, err
+	}
+	return found, nil
+}
+
+// useTOTPCounter mark the time step as used, return false if the code of this step has been used already
+func (dao *accountDAO) useTOTPCounter(ctx context.Context, userId uint64, counter uint64) (bool, error) {
+	key := com.TOTPUsedKey(userId, counter)
+	ok, err := dao.tmpDB.SetEXNX(ctx, key, 1, com.TOTPUsedExpireDuration)
+	if err != nil {
+		dao.logger.Error("useTOTPCounter SetEXNX failed", zap.String("key", key), zap.Error(err))
+		return false, mpberr.ErrDB
+	}
+	return ok, nil
+}
+
+func (dao *accountDAO) getTOTPFailures(ctx context.Context, userId uint64) (uint64, error) {
+	key := com.TOTPFailKey(userId)
+	cnt, err := gdb.ToUint64(dao.tmpDB.Get(ctx, key))
+	if dao.tmpDB.IsErrNil(err) {
+		return 0, nil
+	} else if err != nil {
+		dao.logger.Error("getTOTPFailures Get failed", zap.String("key", key), zap.Error(err))
+		return 0, mpberr.ErrDB
+	}
+	return cnt, nil
+}
+
+func (dao *accountDAO) addTOTPFailure(ctx context.Context, userId uint64) error {
+	key := com.TOTPFailKey(userId)
+	_, err := dao.incrWithExpire(ctx, key, com.TOTPFailExpireDuration)
+	return err
+}
+
+func (dao *accountDAO) clearTOTPFailures(ctx context.Context, userId uint64) {
+	key := com.TOTPFailKey(userId)
+	_, err := dao.tmpDB.Del(ctx, key)
+	if err != nil {
+		dao.logger.Error("clearTOTPFailures Del failed", zap.String("key", key), zap.Error(err))
+	}
+}
+
+func (dao *accountDAO) saveTOTPLoginTicket(ctx context.Context, ticket string, lt *mpb.DBTOTPLoginTicket) error {
+	key := com.TOTPLoginTicketKey(ticket)
+	err := dao.tmpDB.SetObjectEX(ctx, key, lt, com.TOTPLoginTicketExpireDuration)
+	if err != nil {
+		dao.logger.Error("saveTOTPLoginTicket SetObjectEX failed", zap.String("key", key), zap.Error(err))
+		return mpberr.ErrDB
+	}
+	return nil
+}
+
+func (dao *accountDAO) getTOTPLoginTicket(ctx context.Context, ticket string) (*mpb.DBTOTPLoginTicket, error) {
+	key := com.TOTPLoginTicketKey(ticket)
+	lt := &mpb.DBTOTPLoginTicket{}
+	err := dao.tmpDB.GetObject(ctx, key, lt)
+	if dao.tmpDB.IsErrNil(err) {
+		return nil, mpberr.ErrTokenVerify
+	} else if err != nil {
+		dao.logger.Error("getTOTPLoginTicket GetObject failed", zap.String("key", key), zap.Error(err))
+		return nil, mpberr.ErrDB
+	}
+	return lt, nil
+}
+
+// delTOTPLoginTicket delete the ticket, return false if it has been used by another request
+func (dao *accountDAO) delTOTPLoginTicket(ctx context.Context, ticket string) (bool, error) {
+	key := com.TOTPLoginTicketKey(ticket)
+	n, err := dao.tmpDB.Del(ctx, key)
+	if err != nil {
+		dao.logger.Error("delTOTPLoginTicket Del failed", zap.String("key", key), zap.Error(err))
+		return false, mpberr.ErrDB
+	}
+	return n == 1, nil
+}
+
+// requestAccountDeletion schedule the deletion of the account, an earlier request keeps its deletion time
+func (dao *accountDAO) requestAccountDeletion(ctx context.Context, userId uint64, deletionTime int64) (
+	*mpb.DBAccountInfo, error) {
+	key := com.AccountKey(userId)
+	var dbAcc = &mpb.DBAccountInfo{}
+	err := dao.rMux.Safely(ctx, key, func() error {
+		err := dao.accDB.GetObject(ctx, key, dbAcc)
+		if dao.accDB.IsErrNil(err) {
+			return mpberr.ErrAccountNotExist
+		} else if err != nil {
+			dao.logger.Error("requestAccountDeletion GetObject failed", zap.String("key", key), zap.Error(err))
+			return mpberr.ErrDB
+		}
+		if dbAcc.DeletionTime > 0 {
+			return nil
+		}
+		dbAcc.DeletionTime = deletionTime
+		err = dao.accDB.SetObject(ctx, key, dbAcc)
+		if err != nil {
+			dao.logger.Error("requestAccountDeletion SetObject failed", zap.String("key", key), zap.Error(err))
+			return mpberr.ErrDB
+		}
+		return nil
+	})
+	if err != nil {
+		dao.logger.Error("requestAccountDeletion Safely failed", zap.String("key", key), zap.Error(err))
+		return nil, err
+	}
+
+	dKey := com.AccountDeletionKey()
+	_, err = dao.accDB.ZAdd(ctx, dKey, dbAcc.DeletionTime, userId)
+	if err != nil {
+		dao.logger.Error("requestAccountDeletion ZAdd failed", zap.String("key", dKey), zap.Error(err))
+		return nil, mpberr.ErrDB
+	}
+	return dbAcc, nil
+}
+
+func (dao *accountDAO) cancelAccountDeletion(ctx context.Context, userId uint64) error {
+	key := com.AccountKey(userId)
+	err := dao.rMux.Safely(ctx, key, func() error {
+		var dbAcc = &mpb.DBAccountInfo{}
+		err := dao.accDB.GetObject(ctx, key, dbAcc)
+		if dao.accDB.IsErrNil(err) {
+			return mpberr.ErrAccountNotExist
+		} else if err != nil {
+			dao.logger.Error("cancelAccountDeletion GetObject failed", zap.String("key", key), zap.Error(err))
+			return mpberr.ErrDB
+		}
+		if dbAcc.DeletionTime == 0 {
+			return nil
+		}
+		dbAcc.DeletionTime = 0
+		err = dao.accDB.SetObject(ctx, key, dbAcc)
+		if err != nil {
+			dao.logger.Error("cancelAccountDeletion SetObject failed", zap.String("key", key), zap.Error(err))
+			return mpberr.ErrDB
+		}
+		return nil
+	})
+	if err != nil {
+		dao.logger.Error("cancelAccountDeletion Safely failed", zap.String("key", key), zap.Error(err))
+		return err
+	}
+	return dao.unscheduleAccountDeletion(ctx, userId)
+}
+
+func (dao *accountDAO) unscheduleAccountDeletion(ctx context.Context, userId uint64) error {
+	key := com.AccountDeletionKey()
+	_, err := dao.accDB.ZRem(ctx, key, userId)
+	if err != nil {
+		dao.logger.Error("unscheduleAccountDeletion ZRem failed", zap.String("key", key),
+			zap.Uint64("user_id", userId), zap.Error(err))
+		return mpberr.ErrDB
+	}
+	return nil
+}
+
+// getDueAccountDeletions return the users whose grace period is over
+func (dao *accountDAO) getDueAccountDeletions(ctx context.Context, now int64) ([]uint64, error) {
+	key := com.AccountDeletionKey()
+	userIds, err := gdb.ToUint64Slice(dao.accDB.ZRangeByScore(ctx, key, "1", strconv.FormatInt(now, 10)))
+	if err != nil {
+		dao.logger.Error("getDueAccountDeletions ZRangeByScore failed", zap.String("key", key), zap.Error(err))
+		return nil, mpberr.ErrDB
+	}
+	return userIds, nil
+}
+
+// lockAccountDeletionJob make sure only one account service instance runs the deletion job in an interval
+func (dao *accountDAO) lockAccountDeletionJob(ctx context.Context) (bool, error) {
+	key := com.AccountDeletionJobKey()
+	ok, err := dao.tmpDB.SetEXNX(ctx, key, 1, com.AccountDeletionJobInterval)
+	if err != nil {
+		dao.logger.Error("lockAccountDeletionJob SetEXNX failed", zap.String("key", key), zap.Error(err))
+		return false, mpberr.ErrDB
+	}
+	return ok, nil
+}
+
+// deleteAccount erase the account and every index pointing to it.
+// The account key is deleted after the indices and the schedule entry at last, so a failed run is retried as a whole.
+// Wallet indices are only removed when they still point to this user, the wallet may have been relinked.
+func (dao *accountDAO) deleteAccount(ctx context.Context, dbAcc *mpb.DBAccountInfo, wallets []*mpb.DBLinkedWallet) error {
+	userId := dbAcc.UserId
+	addrs := make([]string, 0, len(wallets))
+	for _, w := range wallets {
+		addrs = append(addrs, w.WalletAddr)
+	}
+	keys := make([]string, 0, len(addrs)+4)
+	if len(addrs) > 0 {
+		walletAccs, err := dao.batchGetWalletAccs(ctx, addrs)
+		if err != nil {
+			return mpberr.ErrDB
+		}
+		for i, wa := range walletAccs {
+			if wa != nil && wa.UserId == userId {
+				keys = append(keys, com.WalletAccKey(addrs[i]))
+			}
+		}
+	}
+	keys = append(keys, com.UIDWalletsKey(userId), com.LoginInfoKey(userId), com.ReferralsKey(userId),
+		com.AccountMergeLogKey(userId))
+	if dbAcc.InviteCode != "" {
+		keys = append(keys, com.InviteCodeKey(dbAcc.InviteCode))
+	}
+	for _, link := range dbAcc.PlatformLinks {
+		pa, err := dao.getPlatformAcc(ctx, link.Provider, link.Subject)
+		if err != nil {
+			return err
+		}
+		if pa.UserId == userId {
+			keys = append(keys, com.PlatformAccKey(link.Provider, link.Subject))
+		}
+	}
+	if dbAcc.Email != "" {
+		keys = append(keys, com.EmailAccKey(dbAcc.Email))
+	}
+	if dbAcc.Tel != "" {
+		keys = append(keys, com.TelAccKey(dbAcc.Tel))
+	}
+	if dbAcc.Account != "" {
+		keys = append(keys, com.AccountUIDKey(dbAcc.Account))
+	}
+	err := dao.accDB.BatchDel(ctx, keys)
+	if err != nil {
+		dao.logger.Error("deleteAccount BatchDel failed", zap.Uint64("user_id", userId), zap.Error(err))
+		return mpberr.ErrDB
+	}
+
+	err = dao.delAllTokens(ctx, userId)
+	if err != nil {
+		return err
+	}
+	tmpKeys := []string{com.TOTPPendingKey(userId), com.TOTPFailKey(userId), com.EmailChangeOldCodeKey(userId),
+		com.BanKey(userId)}
+	if dbAcc.Email != "" {
+		tmpKeys = append(tmpKeys, com.EmailBindCodeKey(dbAcc.Email),
+			com.EmailResetPasswordValidationCodeKey(dbAcc.Email), com.EmailResetPasswordNonceKey(dbAcc.Email))
+	}
+	if dbAcc.Tel != "" {
+		tmpKeys = append(tmpKeys, com.TelBindCodeKey(dbAcc.Tel), com.TelResetPasswordValidationCodeKey(dbAcc.Tel))
+	}
+	err = dao.tmpDB.BatchDel(ctx, tmpKeys)
+	if err != nil {
+		dao.logger.Error("deleteAccount tmp BatchDel failed", zap.Uint64("user_id", userId), zap.Error(err))
+		return mpberr.ErrDB
+	}
+	_, err = dao.accDB.ZRem(ctx, com.ReferrersKey(), userId)
+	if err != nil {
+		dao.logger.Error("deleteAccount ZRem failed", zap.String("key", com.ReferrersKey()), zap.Error(err))
+		return mpberr.ErrDB
+	}
+	if dbAcc.ReferrerId != 0 {
+		err = dao.removeReferral(ctx, dbAcc.ReferrerId, userId)
+		if err != nil {
+			return err
+		}
+	}
+	// the ban audit trail is kept for the operators
+	for _, rec := range []*mpb.DBBanRecord{dbAcc.Ban, dbAcc.Mute} {
+		if rec == nil {
+			continue
+		}
+		bKey := com.BansKey(rec.Type)
+		_, err = dao.accDB.ZRem(ctx, bKey, userId)
+		if err != nil {
+			dao.logger.Error("deleteAccount ZRem failed", zap.String("key", bKey), zap.Error(err))
+			return mpberr.ErrDB
+		}
+	}
+
+	if dbAcc.Nickname != "" {
+		dao.releaseNickname(ctx, userId, dbAcc.Nickname)
+	}
+	if dbAcc.DeviceId != "" {
+		err = dao.removeDeviceAccount(ctx, dbAcc.DeviceId, userId)
+		if err != nil {
+			return err
+		}
+	}
+
+	key := com.AccountKey(userId)
+	_, err = dao.accDB.Del(ctx, key)
+	if err != nil {
+		dao.logger.Error("deleteAccount Del failed", zap.String("key", key), zap.Error(err))
+		return mpberr.ErrDB
+	}
+	return dao.unscheduleAccountDeletion(ctx, userId)
+}
+
+// updateProfile change the nickname and the icon of the account, an empty value keeps the current one.
+// The nickname index is taken before the account is updated and released on failure, so two accounts never
+// share a nickname. The index of the old nickname is removed at last.
+func (dao *accountDAO) updateProfile(ctx context.Context, userId uint64, nickname, icon string) (
+	*mpb.DBAccountInfo, error) {
+	if nickname == "" {
+		dbAcc, _, err := dao.updateAccountProfile(ctx, userId, "", icon)
+		return dbAcc, err
+	}
+
+	key := com.NicknameKey(nickname)
+	var dbAcc *mpb.DBAccountInfo
+	var oldNickname string
+	err := dao.rMux.Safely(ctx, key, func() error {
+		owner, err := gdb.ToUint64(dao.accDB.Get(ctx, key))
+		if err != nil && !dao.accDB.IsErrNil(err) {
+			dao.logger.Error("updateProfile Get failed", zap.String("key", key), zap.Error(err))
+			return mpberr.ErrDB
+		}
+		if owner != 0 && owner != userId {
+			return mpberr.ErrNicknameExist
+		}
+		if owner == 0 {
+			err = dao.accDB.Set(ctx, key, userId)
+			if err != nil {
+				dao.logger.Error("updateProfile Set failed", zap.String("key", key), zap.Error(err))
+				return mpberr.ErrDB
+			}
+		}
+
+		dbAcc, oldNickname, err = dao.updateAccountProfile(ctx, userId, nickname, icon)
+		if err != nil && owner == 0 {
+			_, dErr := dao.accDB.Del(ctx, key)
+			if dErr != nil {
+				dao.logger.Error("updateProfile Del failed", zap.String("key", key), zap.Error(dErr))
+			}
+		}
+		return err
+	})
+	if err != nil {
+		dao.logger.Error("updateProfile Safely failed", zap.String("key", key), zap.Error(err))
+		return nil, err
+	}
+
+	if com.NicknameKey(oldNickname) != key {
+		dao.releaseNickname(ctx, userId, oldNickname)
+	}
+	return dbAcc, nil
+}
+
+func (dao *accountDAO) updateAccountProfile(ctx context.Context, userId uint64, nickname, icon string) (
+	*mpb.DBAccountInfo, string, error) {
+	key := com.AccountKey(userId)
+	var dbAcc = &mpb.DBAccountInfo{}
+	var oldNickname string
+	err := dao.rMux.Safely(ctx, key, func() error {
+		err := dao.accDB.GetObject(ctx, key, dbAcc)
+		if dao.accDB.IsErrNil(err) {
+			return mpberr.ErrAccountNotExist
+		} else if err != nil {
+			dao.logger.Error("updateAccountProfile GetObject failed", zap.String("key", key), zap.Error(err))
+			return mpberr.ErrDB
+		}
+
+		now := time.Now()
+		if nickname != "" {
+			if now.Before(time.Unix(dbAcc.NicknameUpdateTime, 0).Add(com.NicknameChangeCooldown)) {
+				return mpberr.ErrProfileCooldown
+			}
+			oldNickname = dbAcc.Nickname
+			dbAcc.Nickname = nickname
+			dbAcc.NicknameUpdateTime = now.Unix()
+		}
+		if icon != "" {
+			if now.Before(time.Unix(dbAcc.IconUpdateTime, 0).Add(com.IconChangeCooldown)) {
+				return mpberr.ErrProfileCooldown
+			}
+			dbAcc.Icon = icon
+			dbAcc.IconUpdateTime = now.Unix()
+		}
+
+		err = dao.accDB.SetObject(ctx, key, dbAcc)
+		if err != nil {
+			dao.logger.Error("updateAccountProfile SetObject failed", zap.String("key", key), zap.Error(err))
+			return mpberr.ErrDB
+		}
+		return nil
+	})
+	if err != nil {
+		dao.logger.Error("updateAccountProfile Safely failed", zap.String("key", key), zap.Error(err))
+		return nil, "", err
+	}
+	return dbAcc, oldNickname, nil
+}
+
+// releaseNickname remove the nickname index if it still belongs to the user
+func (dao *accountDAO) releaseNickname(ctx context.Context, userId uint64, nickname string) {
+	key := com.NicknameKey(nickname)
+	err := dao.rMux.Safely(ctx, key, func() error {
+		owner, err := gdb.ToUint64(dao.accDB.Get(ctx, key))
+		if dao.accDB.IsErrNil(err) {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		if owner != userId {
+			return nil
+		}
+		_, err = dao.accDB.Del(ctx, key)
+		return err
+	})
+	if err != nil {
+		dao.logger.Error("releaseNickname failed", zap.String("key", key), zap.Error(err))
+	}
+}
+
+// getGuestAccount return the last guest account of the device which is not upgraded yet, a guest account is
+// created if there is none or newAccount is set. A device can create at most MaxGuestsPerDevice guest accounts,
+// upgraded ones are still counted.
+func (dao *accountDAO) getGuestAccount(ctx context.Context, deviceId, device, os, region, platform string,
+	newAccount bool) (*mpb.DBAccountInfo, error) {
+	key := com.DeviceAccountsKey(deviceId)
+	var dbAcc *mpb.DBAccountInfo
+	err := dao.rMux.Safely(ctx, key, func() error {
+		devAccs := &mpb.DBDeviceAccounts{}
+		err := dao.accDB.GetObject(ctx, key, devAccs)
+		if err != nil && !dao.accDB.IsErrNil(err) {
+			dao.logger.Error("getGuestAccount GetObject failed", zap.String("key", key), zap.Error(err))
+			return mpberr.ErrDB
+		}
+
+		if !newAccount && len(devAccs.UserIds) > 0 {
+			accs, err := dao.batchGetAccounts(ctx, devAccs.UserIds)
+			if err != nil {
+				return mpberr.ErrDB
+			}
+			for i := len(accs) - 1; i >= 0; i-- {
+				if accs[i] != nil && accs[i].Guest && accs[i].MergedInto == 0 {
+					dbAcc = accs[i]
+					return nil
+				}
+			}
+		}
+		if len(devAccs.UserIds) >= com.MaxGuestsPerDevice {
+			return mpberr.ErrGuestLimit
+		}
+
+		userId, err := gdb.ToUint64(dao.accDB.Incr(ctx, com.UserIdIndexKey()))
+		if err != nil {
+			dao.logger.Error("getGuestAccount Incr failed", zap.String("key", com.UserIdIndexKey()), zap.Error(err))
+			return mpberr.ErrDB
+		}
+		dbAcc = &mpb.DBAccountInfo{
+			UserId:       userId,
+			DeviceId:     deviceId,
+			Device:       device,
+			Os:           os,
+			Region:       region,
+			Guest:        true,
+			RegisterTime: time.Now().Unix(),
+			Platform:     platform,
+			Nickname:     com.DefaultNicknamePrefix + strconv.Itoa(int(userId)),
+			Icon:         "0",
+		}
+		err = dao.accDB.SetObject(ctx, com.AccountKey(userId), dbAcc)
+		if err != nil {
+			dao.logger.Error("getGuestAccount SetObject failed", zap.String("key", com.AccountKey(userId)),
+				zap.Error(err))
+			return mpberr.ErrDB
+		}
+		devAccs.UserIds = append(devAccs.UserIds, userId)
+		err = dao.accDB.SetObject(ctx, key, devAccs)
+		if err != nil {
+			dao.logger.Error("getGuestAccount SetObject failed", zap.String("key", key), zap.Error(err))
+			return mpberr.ErrDB
+		}
+		return nil
+	})
+	if err != nil {
+		dao.logger.Error("getGuestAccount Safely failed", zap.String("key", key), zap.Error(err))
+		return nil, err
+	}
+	return dbAcc, nil
+}
+
+// upgradeGuestByEmail turn the guest account into an email account, the user id and progress are kept
+func (dao *accountDAO) upgradeGuestByEmail(ctx context.Context, userId uint64, email, password string) (
+	*mpb.DBAccountInfo, error) {
+	key := com.EmailAccKey(email)
+	accKey := com.AccountKey(userId)
+	dbAcc := &mpb.DBAccountInfo{}
+	err := dao.rMux.Safely(ctx, key, func() error {
+		ok, err := dao.accDB.Exists(ctx, key)
+		if err != nil {
+			dao.logger.Error("upgradeGuestByEmail Exists failed", zap.String("key", key), zap.Error(err))
+			return mpberr.ErrDB
+		}
+		if ok {
+			return mpberr.ErrEmailBound
+		}
+		ok, err = dao.accDB.Exists(ctx, com.AccountUIDKey(email))
+		if err != nil {
+			dao.logger.Error("upgradeGuestByEmail Exists failed", zap.String("key", com.AccountUIDKey(email)),
+				zap.Error(err))
+			return mpberr.ErrDB
+		}
+		if ok {
+			return mpberr.ErrAccountExist
+		}
+		hashed, err := util.HashPassword(password)
+		if err != nil {
+			dao.logger.Error("upgradeGuestByEmail HashPassword failed", zap.Uint64("user_id", userId), zap.Error(err))
+			return mpberr.ErrUnknown
+		}
+
+		err = dao.rMux.Safely(ctx, accKey, func() error {
+			err := dao.accDB.GetObject(ctx, accKey, dbAcc)
+			if dao.accDB.IsErrNil(err) {
+				return mpberr.ErrAccountNotExist
+			} else if err != nil {
+				dao.logger.Error("upgradeGuestByEmail GetObject failed", zap.String("key", accKey), zap.Error(err))
+				return mpberr.ErrDB
+			}
+			if !dbAcc.Guest {
+				return mpberr.ErrNotGuest
+			}
+			dbAcc.Guest = false
+			dbAcc.Account = email
+			dbAcc.Email = email
+			dbAcc.Password = hashed
+			err = dao.accDB.SetObject(ctx, accKey, dbAcc)
+			if err != nil {
+				dao.logger.Error("upgradeGuestByEmail SetObject failed", zap.String("key", accKey), zap.Error(err))
+				return mpberr.ErrDB
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		err = dao.accDB.Set(ctx, key, userId)
+		if err != nil {
+			dao.logger.Error("upgradeGuestByEmail Set failed", zap.String("key", key), zap.Error(err))
+			return mpberr.ErrDB
+		}
+		err = dao.accDB.Set(ctx, com.AccountUIDKey(email), userId)
+		if err != nil {
+			dao.logger.Error("upgradeGuestByEmail Set failed", zap.String("key", com.AccountUIDKey(email)),
+				zap.Error(err))
+			return mpberr.ErrDB
+		}
+		return nil
+	})
+	if err != nil {
+		dao.logger.Error("upgradeGuestByEmail Safely failed", zap.String("key", key), zap.Error(err))
+		return nil, err
+	}
+	return dbAcc, nil
+}
+
+// removeDeviceAccount drop the deleted account from the guest accounts of the device
+func (dao *accountDAO) removeDeviceAccount(ctx context.Context, deviceId string, userId uint64) error {
+	key := com.DeviceAccountsKey(deviceId)
+	err := dao.rMux.Safely(ctx, key, func() error {
+		devAccs := &mpb.DBDeviceAccounts{}
+		err := dao.accDB.GetObject(ctx, key, devAccs)
+		if dao.accDB.IsErrNil(err) {
+			return nil
+		} else if err != nil {
+			dao.logger.Error("removeDeviceAccount GetObject failed", zap.String("key", key), zap.Error(err))
+			return mpberr.ErrDB
+		}
+		for i, uid := range devAccs.UserIds {
+			if uid != userId {
+				continue
+			}
+			devAccs.UserIds = append(devAccs.UserIds[:i], devAccs.UserIds[i+1:]...)
+			if len(devAccs.UserIds) == 0 {
+				_, err = dao.accDB.Del(ctx, key)
+			} else {
+				err = dao.accDB.SetObject(ctx, key, devAccs)
+			}
+			if err != nil {
+				dao.logger.Error("removeDeviceAccount update failed", zap.String("key", key), zap.Error(err))
+				return mpberr.ErrDB
+			}
+			return nil
+		}
+		return nil
+	})
+	if err != nil {
+		dao.logger.Error("removeDeviceAccount Safely failed", zap.String("key", key), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+// getLoginLock return the unlock time of the login target, 0 if it is not locked
+func (dao *accountDAO) getLoginLock(ctx context.Context, target string) (int64, error) {
+	key := com.LoginLockKey(target)
+	unlockTime, err := gdb.ToUint64(dao.tmpDB.Get(ctx, key))
+	if dao.tmpDB.IsErrNil(err) {
+		return 0, nil
+	} else if err != nil {
+		dao.logger.Error("getLoginLock Get failed", zap.String("key", key), zap.Error(err))
+		return 0, mpberr.ErrDB
+	}
+	return int64(unlockTime), nil
+}
+
+// incrWithExpire increase the counter at key by incrExpireScript, the ttl is set only when the counter is created
+func (dao *accountDAO) incrWithExpire(ctx context.Context, key string, ttl time.Duration) (int64, error) {
+	cnt, err := incrExpireScript.Run(ctx, dao.tmpScript, []string{key}, int64(ttl/time.Second)).Int64()
+	if err != nil {
+		dao.logger.Error("incrWithExpire Run failed", zap.String("key", key), zap.Error(err))
+		return 0, mpberr.ErrDB
+	}
+	return cnt, nil
+}
+
+// addLoginFailure count a failure of the login target. Once the failures exceed freeFailures the target is locked,
+// the lock duration doubles on every further failure up to maxLock.
+func (dao *accountDAO) addLoginFailure(ctx context.Context, target string, freeFailures int64,
+	maxLock time.Duration) error {
+	key := com.LoginFailKey(target)
+	cnt, err := dao.incrWithExpire(ctx, key, com.LoginFailExpireDuration)
+	if err != nil {
+		return err
+	}
+	if cnt <= freeFailures {
+		return nil
+	}
+
+	dur := maxLock
+	if n := cnt - freeFailures - 1; n < 20 && com.LoginLockBaseDuration<<n < dur { // 1m<<20 outlasts any max, no overflow
+		dur = com.LoginLockBaseDuration << n
+	}
+	unlockTime := time.Now().Add(dur).Unix()
+	lKey := com.LoginLockKey(target)
+	err = dao.tmpDB.SetEX(ctx, lKey, unlockTime, dur)
+	if err != nil {
+		dao.logger.Error("addLoginFailure SetEX failed", zap.String("key", lKey), zap.Error(err))
+		return mpberr.ErrDB
+	}
+	_, err = dao.tmpDB.ZAdd(ctx, com.LoginLocksKey(), unlockTime, target)
+	if err != nil {
+		dao.logger.Error("addLoginFailure ZAdd failed", zap.String("key", com.LoginLocksKey()), zap.Error(err))
+		return mpberr.ErrDB
+	}
+	return nil
+}
+
+// clearLoginFailures reset the failures of the login target and unlock it
+func (dao *accountDAO) clearLoginFailures(ctx context.Context, target string) error {
+	err := dao.tmpDB.BatchDel(ctx, []string{com.LoginFailKey(target), com.LoginLockKey(target)})
+	if err != nil {
+		dao.logger.Error("clearLoginFailures BatchDel failed", zap.String("target", target), zap.Error(err))
+		return mpberr.ErrDB
+	}
+	_, err = dao.tmpDB.ZRem(ctx, com.LoginLocksKey(), target)
+	if err != nil {
+		dao.logger.Error("clearLoginFailures ZRem failed", zap.String("key", com.LoginLocksKey()), zap.Error(err))
+		return mpberr.ErrDB
+	}
+	return nil
+}
+
+func (dao *accountDAO) getLoginFailures(ctx context.Context, target string) (uint64, error) {
+	key := com.LoginFailKey(target)
+	cnt, err := gdb.ToUint64(dao.tmpDB.Get(ctx, key))
+	if dao.tmpDB.IsErrNil(err) {
+		return 0, nil
+	} else if err != nil {
+		dao.logger.Error("getLoginFailures Get failed", zap.String("key", key), zap.Error(err))
+		return 0, mpberr.ErrDB
+	}
+	return cnt, nil
+}
+
+// getLockedLoginTargets return the targets locked now, expired locks are dropped from the list
+func (dao *accountDAO) getLockedLoginTargets(ctx context.Context, now int64) ([]string, error) {
+	key := com.LoginLocksKey()
+	_, err := dao.tmpDB.ZRemRangeByScore(ctx, key, "-inf", strconv.FormatInt(now, 10))
+	if err != nil {
+		dao.logger.Error("getLockedLoginTargets ZRemRangeByScore failed", zap.String("key", key), zap.Error(err))
+		return nil, mpberr.ErrDB
+	}
+	targets, err := dao.tmpDB.ZRange(ctx, key, 0, -1)
+	if err != nil {
+		dao.logger.Error("getLockedLoginTargets ZRange failed", zap.String("key", key), zap.Error(err))
+		return nil, mpberr.ErrDB
+	}
+	return targets, nil
+}
+
+// addVCodeFailure count a wrong guess of the verification code stored at codeKey,
+// the code is invalidated after VCodeMaxFailures wrong guesses. A resent code keeps the counter, so resending does
+// not grant more guesses.
+func (dao *accountDAO) addVCodeFailure(ctx context.Context, codeKey string) error {
+	key := com.VCodeFailKey(codeKey)
+	cnt, err := dao.incrWithExpire(ctx, key, com.Dur10Mins)
+	if err != nil {
+		return err
+	}
+	if cnt < com.VCodeMaxFailures {
+		return nil
+	}
+	err = dao.tmpDB.BatchDel(ctx, []string{codeKey, key})
+	if err != nil {
+		dao.logger.Error("addVCodeFailure BatchDel failed", zap.String("key", codeKey), zap.Error(err))
+		return mpberr.ErrDB
+	}
+	return nil
+}
+
+// addLoginRecord append the login to the history of the user, only the latest MaxLoginHistory ones are kept.
+// The history before this login is returned.
+func (dao *accountDAO) addLoginRecord(ctx context.Context, userId uint64, record *mpb.DBLoginRecord) (
+	[]*mpb.DBLoginRecord, error) {
+	key := com.LoginInfoKey(userId)
+	var prev []*mpb.DBLoginRecord
+	err := dao.rMux.Safely(ctx, key, func() error {
+		history := &mpb.DBLoginHistory{}
+		err := dao.accDB.GetObject(ctx, key, history)
+		if err != nil && !dao.accDB.IsErrNil(err) {
+			dao.logger.Error("addLoginRecord GetObject failed", zap.String("key", key), zap.Error(err))
+			return mpberr.ErrDB
+		}
+		prev = history.Records
+
+		records := make([]*mpb.DBLoginRecord, 0, len(prev)+1)
+		if len(prev) >= com.MaxLoginHistory {
+			records = append(records, prev[len(prev)-com.MaxLoginHistory+1:]...)
+		} else {
+			records = append(records, prev...)
+		}
+		err = dao.accDB.SetObject(ctx, key, &mpb.DBLoginHistory{Records: append(records, record)})
+		if err != nil {
+			dao.logger.Error("addLoginRecord SetObject failed", zap.String("key", key), zap.Error(err))
+			return mpberr.ErrDB
+		}
+		return nil
+	})
+	if err != nil {
+		dao.logger.Error("addLoginRecord Safely failed", zap.String("key", key), zap.Error(err))
+		return nil, err
+	}
+	return prev, nil
+}
+
+func (dao *accountDAO) getLoginHistory(ctx context.Context, userId uint64) ([]*mpb.DBLoginRecord, error) {
+	key := com.LoginInfoKey(userId)
+	history := &mpb.DBLoginHistory{}
+	err := dao.accDB.GetObject(ctx, key, history)
+	if err != nil && !dao.accDB.IsErrNil(err) {
+		dao.logger.Error("getLoginHistory GetObject failed", zap.String("key", key), zap.Error(err))
+		return nil, mpberr.ErrDB
+	}
+	return history.Records, nil
+}
+
+// banAccount put the ban record on the account, a ban or a suspension replaces the current one.
+// The account is updated first so new logins are refused at once, then the user is marked banned for the gateways
+// and every session is revoked.
+func (dao *accountDAO) banAccount(ctx context.Context, userId uint64, rec *mpb.DBBanRecord) error {
+	key := com.AccountKey(userId)
+	var oldType uint32
+	err := dao.rMux.Safely(ctx, key, func() error {
+		dbAcc := &mpb.DBAccountInfo{}
+		err := dao.accDB.GetObject(ctx, key, dbAcc)
+		if dao.accDB.IsErrNil(err) {
+			return mpberr.ErrAccountNotExist
+		} else if err != nil {
+			dao.logger.Error("banAccount GetObject failed", zap.String("key", key), zap.Error(err))
+			return mpberr.ErrDB
+		}
+
+		if rec.Type == uint32(mpb.EBan_Type_Mute) {
+			dbAcc.Mute = rec
+		} else {
+			if dbAcc.Ban != nil {
+				oldType = dbAcc.Ban.Type
+			}
+			dbAcc.Ban = rec
+		}
+		err = dao.accDB.SetObject(ctx, key, dbAcc)
+		if err != nil {
+			dao.logger.Error("banAccount SetObject failed", zap.String("key", key), zap.Error(err))
+			return mpberr.ErrDB
+		}
+		return nil
+	})
+	if err != nil {
+		dao.logger.Error("banAccount Safely failed", zap.String("key", key), zap.Error(err))
+		return err
+	}
+
+	if oldType != 0 && oldType != rec.Type {
+		bKey := com.BansKey(oldType)
+		_, err = dao.accDB.ZRem(ctx, bKey, userId)
+		if err != nil {
+			dao.logger.Error("banAccount ZRem failed", zap.String("key", bKey), zap.Error(err))
+			return mpberr.ErrDB
+		}
+	}
+	score := rec.ExpireTime
+	if score == 0 {
+		score = math.MaxInt64
+	}
+	bKey := com.BansKey(rec.Type)
+	_, err = dao.accDB.ZAdd(ctx, bKey, score, userId)
+	if err != nil {
+		dao.logger.Error("banAccount ZAdd failed", zap.String("key", bKey), zap.Error(err))
+		return mpberr.ErrDB
+	}
+	if rec.Type == uint32(mpb.EBan_Type_Mute) {
+		return nil
+	}
+
+	bKey = com.BanKey(userId)
+	if rec.ExpireTime == 0 {
+		err = dao.tmpDB.Set(ctx, bKey, rec.ExpireTime)
+	} else {
+		err = dao.tmpDB.SetEX(ctx, bKey, rec.ExpireTime, time.Until(time.Unix(rec.ExpireTime, 0)))
+	}
+	if err != nil {
+		dao.logger.Error("banAccount Set failed", zap.String("key", bKey), zap.Error(err))
+		return mpberr.ErrDB
+	}
+	return dao.delAllTokens(ctx, userId)
+}
+
+// unbanAccount lift the login ban or the mute of the account, return the lifted record, nil if there is none
+func (dao *accountDAO) unbanAccount(ctx context.Context, userId uint64, mute bool) (*mpb.DBBanRecord, error) {
+	key := com.AccountKey(userId)
+	var rec *mpb.DBBanRecord
+	err := dao.rMux.Safely(ctx, key, func() error {
+		dbAcc := &mpb.DBAccountInfo{}
+		err := dao.accDB.GetObject(ctx, key, dbAcc)
+		if dao.accDB.IsErrNil(err) {
+			return mpberr.ErrAccountNotExist
+		} else if err != nil {
+			dao.logger.Error("unbanAccount GetObject failed", zap.String("key", key), zap.Error(err))
+			return mpberr.ErrDB
+		}
+
+		if mute {
+			rec, dbAcc.Mute = dbAcc.Mute, nil
+		} else {
+			rec, dbAcc.Ban = dbAcc.Ban, nil
+		}
+		if rec == nil {
+			return nil
+		}
+		err = dao.accDB.SetObject(ctx, key, dbAcc)
+		if err != nil {
+			dao.logger.Error("unbanAccount SetObject failed", zap.String("key", key), zap.Error(err))
+			return mpberr.ErrDB
+		}
+		return nil
+	})
+	if err != nil {
+		dao.logger.Error("unbanAccount Safely failed", zap.String("key", key), zap.Error(err))
+		return nil, err
+	}
+	if rec == nil {
+		return nil, nil
+	}
+
+	bKey := com.BansKey(rec.Type)
+	_, err = dao.accDB.ZRem(ctx, bKey, userId)
+	if err != nil {
+		dao.logger.Error("unbanAccount ZRem failed", zap.String("key", bKey), zap.Error(err))
+		return nil, mpberr.ErrDB
+	}
+	if !mute {
+		bKey = com.BanKey(userId)
+		_, err = dao.tmpDB.Del(ctx, bKey)
+		if err != nil {
+			dao.logger.Error("unbanAccount Del failed", zap.String("key", bKey), zap.Error(err))
+			return nil, mpberr.ErrDB
+		}
+	}
+	return rec, nil
+}
+
+// getBannedUserIds return the users banned with the ban type, expired bans are removed
+func (dao *accountDAO) getBannedUserIds(ctx context.Context, banType uint32, now int64) ([]uint64, error) {
+	key := com.BansKey(banType)
+	_, err := dao.accDB.ZRemRangeByScore(ctx, key, "-inf", strconv.FormatInt(now, 10))
+	if err != nil {
+		dao.logger.Error("getBannedUserIds ZRemRangeByScore failed", zap.String("key", key), zap.Error(err))
+		return nil, mpberr.ErrDB
+	}
+	userIds, err := gdb.ToUint64Slice(dao.accDB.ZRange(ctx, key, 0, -1))
+	if err != nil {
+		dao.logger.Error("getBannedUserIds ZRange failed", zap.String("key", key), zap.Error(err))
+		return nil, mpberr.ErrDB
+	}
+	return userIds, nil
+}
+
+// addBanAuditLog append the log to the ban audit trail of the user, only the latest MaxBanAuditLogs are kept
+func (dao *accountDAO) addBanAuditLog(ctx context.Context, userId uint64, log *mpb.DBBanAuditLog) error {
+	key := com.BanLogKey(userId)
+	err := dao.rMux.Safely(ctx, key, func() error {
+		logs := &mpb.DBBanAuditLogs{}
+		err := dao.accDB.GetObject(ctx, key, logs)
+		if err != nil && !dao.accDB.IsErrNil(err) {
+			dao.logger.Error("addBanAuditLog GetObject failed", zap.String("key", key), zap.Error(err))
+			return mpberr.ErrDB
+		}
+		if len(logs.Logs) >= com.MaxBanAuditLogs {
+			logs.Logs = logs.Logs[len(logs.Logs)-com.MaxBanAuditLogs+1:]
+		}
+		logs.Logs = append(logs.Logs, log)
+		err = dao.accDB.SetObject(ctx, key, logs)
+		if err != nil {
+			dao.logger.Error("addBanAuditLog SetObject failed", zap.String("key", key), zap.Error(err))
+			return mpberr.ErrDB
+		}
+		return nil
+	})
+	if err != nil {
+		dao.logger.Error("addBanAuditLog Safely failed", zap.String("key", key), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+func (dao *accountDAO) getBanAuditLogs(ctx context.Context, userId uint64) ([]*mpb.DBBanAuditLog, error) {
+	key := com.BanLogKey(userId)
+	logs := &mpb.DBBanAuditLogs{}
+	err := dao.accDB.GetObject(ctx, key, logs)
+	if err != nil && !dao.accDB.IsErrNil(err) {
+		dao.logger.Error("getBanAuditLogs GetObject failed", zap.String("key", key), zap.Error(err))
+		return nil, mpberr.ErrDB
+	}
+	return logs.Logs, nil
+}
+
+// getOrCreateInviteCode return the invite code of the user, the code is generated on the first call
+func (dao *accountDAO) getOrCreateInviteCode(ctx context.Context, userId uint64) (string, error) {
+	key := com.AccountKey(userId)
+	var code string
+	err := dao.rMux.Safely(ctx, key, func() error {
+		dbAcc := &mpb.DBAccountInfo{}
+		err := dao.accDB.GetObject(ctx, key, dbAcc)
+		if dao.accDB.IsErrNil(err) {
+			return mpberr.ErrAccountNotExist
+		} else if err != nil {
+			dao.logger.Error("getOrCreateInviteCode GetObject failed", zap.String("key", key), zap.Error(err))
+			return mpberr.ErrDB
+		}
+		if dbAcc.InviteCode != "" {
+			code = dbAcc.InviteCode
+			return nil
+		}
+
+		// retry on the rare collision of random codes
+		for i := 0; i < 5 && code == ""; i++ {
+			c, err := util.GenerateInviteCode(com.InviteCodeLen)
+			if err != nil {
+				dao.logger.Error("getOrCreateInviteCode GenerateInviteCode failed", zap.Error(err))
+				return mpberr.ErrUnknown
+			}
+			cKey := com.InviteCodeKey(c)
+			ok, err := dao.accDB.SetNX(ctx, cKey, userId)
+			if err != nil {
+				dao.logger.Error("getOrCreateInviteCode SetNX failed", zap.String("key", cKey), zap.Error(err))
+				return mpberr.ErrDB
+			}
+			if ok {
+				code = c
+			}
+		}
+		if code == "" {
+			dao.logger.Error("getOrCreateInviteCode no free code", zap.Uint64("user_id", userId))
+			return mpberr.ErrUnknown
+		}
+
+		dbAcc.InviteCode = code
+		err = dao.accDB.SetObject(ctx, key, dbAcc)
+		if err != nil {
+			dao.logger.Error("getOrCreateInviteCode SetObject failed", zap.String("key", key), zap.Error(err))
+			_, _ = dao.accDB.Del(ctx, com.InviteCodeKey(code))
+			return mpberr.ErrDB
+		}
+		return nil
+	})
+	if err != nil {
+		dao.logger.Error("getOrCreateInviteCode Safely failed", zap.String("key", key), zap.Error(err))
+		return "", err
+	}
+	return code, nil
+}
+
+func (dao *accountDAO) getUserIdByInviteCode(ctx context.Context, code string) (uint64, error) {
+	key := com.InviteCodeKey(code)
+	userId, err := gdb.ToUint64(dao.accDB.Get(ctx, key))
+	if dao.accDB.IsErrNil(err) {
+		return 0, mpberr.ErrReferrerCode
+	} else if err != nil {
+		dao.logger.Error("getUserIdByInviteCode Get failed", zap.String("key", key), zap.Error(err))
+		return 0, mpberr.ErrDB
+	}
+	return userId, nil
+}
+
+// claimReferralDevice mark the device is referred, return false if it has been referred before
+func (dao *accountDAO) claimReferralDevice(ctx context.Context, deviceId string, userId uint64) (bool, error) {
+	key := com.ReferralDeviceKey(deviceId)
+	ok, err := dao.accDB.SetNX(ctx, key, userId)
+	if err != nil {
+		dao.logger.Error("claimReferralDevice SetNX failed", zap.String("key", key), zap.Error(err))
+		return false, mpberr.ErrDB
+	}
+	return ok, nil
+}
+
+// addReferral store the referral under the referrer. An accepted referral is bound to the invitee, which can be
+// referred only once, and counted for the referrer; a rejected one is only kept for the records.
+func (dao *accountDAO) addReferral(ctx context.Context, referrerId uint64, ref *mpb.DBReferral) error {
+	if ref.RejectReason == uint32(mpb.EReferralReject_Reason_None) {
+		key := com.AccountKey(ref.UserId)
+		err := dao.rMux.Safely(ctx, key, func() error {
+			dbAcc := &mpb.DBAccountInfo{}
+			err := dao.accDB.GetObject(ctx, key, dbAcc)
+			if dao.accDB.IsErrNil(err) {
+				return mpberr.ErrAccountNotExist
+			} else if err != nil {
+				dao.logger.Error("addReferral GetObject failed", zap.String("key", key), zap.Error(err))
+				return mpberr.ErrDB
+			}
+			if dbAcc.ReferrerId != 0 {
+				return mpberr.ErrParam
+			}
+			dbAcc.ReferrerId = referrerId
+			err = dao.accDB.SetObject(ctx, key, dbAcc)
+			if err != nil {
+				dao.logger.Error("addReferral SetObject failed", zap.String("key", key), zap.Error(err))
+				return mpberr.ErrDB
+			}
+			return nil
+		})
+		if err != nil {
+			dao.logger.Error("addReferral Safely failed", zap.String("key", key), zap.Error(err))
+			return err
+		}
+	}
+
+	key := com.ReferralsKey(referrerId)
+	err := dao.accDB.HSetObjects(ctx, key, ref.UserId, ref)
+	if err != nil {
+		dao.logger.Error("addReferral HSetObjects failed", zap.String("key", key), zap.Error(err))
+		return mpberr.ErrDB
+	}
+	if ref.RejectReason != uint32(mpb.EReferralReject_Reason_None) {
+		return nil
+	}
+	_, err = dao.accDB.ZIncrBy(ctx, com.ReferrersKey(), 1, referrerId)
+	if err != nil {
+		dao.logger.Error("addReferral ZIncrBy failed", zap.String("key", com.ReferrersKey()), zap.Error(err))
+		return mpberr.ErrDB
+	}
+	return nil
+}
+
+// removeReferral drop the referral of the invitee from the referrer, the referrer loses the count if it was accepted.
+// It can be called again, only the call dropping the record changes the count.
+func (dao *accountDAO) removeReferral(ctx context.Context, referrerId, userId uint64) error {
+	key := com.ReferralsKey(referrerId)
+	field := strconv.FormatUint(userId, 10)
+	ref := &mpb.DBReferral{}
+	err := dao.accDB.HGetObject(ctx, key, field, ref)
+	if dao.accDB.IsErrNil(err) {
+		return nil
+	} else if err != nil {
+		dao.logger.Error("removeReferral HGetObject failed", zap.String("key", key), zap.Error(err))
+		return mpberr.ErrDB
+	}
+	n, err := dao.accDB.HDel(ctx, key, field)
+	if err != nil {
+		dao.logger.Error("removeReferral HDel failed", zap.String("key", key), zap.Error(err))
+		return mpberr.ErrDB
+	}
+	if n == 0 || ref.RejectReason != uint32(mpb.EReferralReject_Reason_None) {
+		return nil
+	}
+	_, err = dao.accDB.ZIncrBy(ctx, com.ReferrersKey(), -1, referrerId)
+	if err != nil {
+		dao.logger.Error("removeReferral ZIncrBy failed", zap.String("key", com.ReferrersKey()), zap.Error(err))
 		return mpberr.ErrDB
 	}
 	return nil
 }
 
-func (dao *accountDAO) checkEmailResetPasswordNonce(ctx context.Context, emailAddr, nonce string) (bool, error) {
-	key := com.EmailResetPasswordNonceKey(emailAddr)
-	var ok bool
-	err := dao.rMux.Safely(ctx, key, func() error {
-		dbNonce, err := dao.tmpDB.Get(ctx, key)
-		if err != nil && !dao.tmpDB.IsErrNil(err) {
-			dao.logger.Error("checkEmailResetPasswordNonce Get failed",
-				zap.String("key", key), zap.Error(err))
+// moveInviteeReferral hand the referral of the merged invitee to the kept account if it inherits the referrer,
+// otherwise the referral is removed. It can be called again.
+func (dao *accountDAO) moveInviteeReferral(ctx context.Context, dbAcc *mpb.DBAccountInfo, mergedUserId,
+	referrerId uint64) error {
+	if referrerId == 0 {
+		return nil
+	}
+	key := com.ReferralsKey(referrerId)
+	if dbAcc.ReferrerId == referrerId {
+		field := strconv.FormatUint(mergedUserId, 10)
+		ref := &mpb.DBReferral{}
+		err := dao.accDB.HGetObject(ctx, key, field, ref)
+		if dao.accDB.IsErrNil(err) {
+			return nil
+		} else if err != nil {
+			dao.logger.Error("moveInviteeReferral HGetObject failed", zap.String("key", key), zap.Error(err))
 			return mpberr.ErrDB
 		}
-		if dbNonce != nonce {
+		kept := &mpb.DBReferral{}
+		err = dao.accDB.HGetObject(ctx, key, strconv.FormatUint(dbAcc.UserId, 10), kept)
+		if dao.accDB.IsErrNil(err) {
+			// the kept account inherited the referrer from the merged one, the count stays
+			ref.UserId = dbAcc.UserId
+			err = dao.accDB.HSetObjects(ctx, key, dbAcc.UserId, ref)
+			if err != nil {
+				dao.logger.Error("moveInviteeReferral HSetObjects failed", zap.String("key", key), zap.Error(err))
+				return mpberr.ErrDB
+			}
+			_, err = dao.accDB.HDel(ctx, key, field)
+			if err != nil {
+				dao.logger.Error("moveInviteeReferral HDel failed", zap.String("key", key), zap.Error(err))
+				return mpberr.ErrDB
+			}
+			return nil
+		} else if err != nil {
+			dao.logger.Error("moveInviteeReferral HGetObject failed", zap.String("key", key), zap.Error(err))
+			return mpberr.ErrDB
+		}
+		if kept.ReferralTime == ref.ReferralTime && kept.DeviceId == ref.DeviceId {
+			// moved by a former call which failed before dropping the record of the merged invitee
+			_, err = dao.accDB.HDel(ctx, key, field)
+			if err != nil {
+				dao.logger.Error("moveInviteeReferral HDel failed", zap.String("key", key), zap.Error(err))
+				return mpberr.ErrDB
+			}
 			return nil
 		}
+	}
+	return dao.removeReferral(ctx, referrerId, mergedUserId)
+}
 
-		ok = true
-		_, err = dao.tmpDB.Del(ctx, key)
+func (dao *accountDAO) getReferrals(ctx context.Context, referrerId uint64) ([]*mpb.DBReferral, error) {
+	key := com.ReferralsKey(referrerId)
+	var fields []string
+	var refs []*mpb.DBReferral
+	err := dao.accDB.HGetAllObjects(ctx, key, &fields, &refs)
+	if err != nil && !dao.accDB.IsErrNil(err) {
+		dao.logger.Error("getReferrals HGetAllObjects failed", zap.String("key", key), zap.Error(err))
+		return nil, mpberr.ErrDB
+	}
+	return refs, nil
+}
+
+// getTopReferrers return the user ids and the accepted referral counts of the top referrers
+func (dao *accountDAO) getTopReferrers(ctx context.Context, limit uint32) ([]uint64, []uint64, error) {
+	key := com.ReferrersKey()
+	vals, err := gdb.ToUint64Slice(dao.accDB.ZRevRangeWithScores(ctx, key, 0, int64(limit)-1))
+	if err != nil && !dao.accDB.IsErrNil(err) {
+		dao.logger.Error("getTopReferrers ZRevRangeWithScores failed", zap.String("key", key), zap.Error(err))
+		return nil, nil, mpberr.ErrDB
+	}
+	userIds := make([]uint64, 0, len(vals)/2)
+	counts := make([]uint64, 0, len(vals)/2)
+	for i := 0; i+1 < len(vals); i += 2 {
+		userIds = append(userIds, vals[i])
+		counts = append(counts, vals[i+1])
+	}
+	return userIds, counts, nil
+}
+
+func (dao *accountDAO) getPlatformAcc(ctx context.Context, provider, subject string) (*mpb.DBPlatformAcc, error) {
+	pa := &mpb.DBPlatformAcc{}
+	key := com.PlatformAccKey(provider, subject)
+	err := dao.accDB.GetObject(ctx, key, pa)
+	if err != nil && !dao.accDB.IsErrNil(err) {
+		dao.logger.Error("getPlatformAcc GetObject failed", zap.String("key", key), zap.Error(err))
+		return nil, mpberr.ErrDB
+	}
+	return pa, nil
+}
+
+// getAccountByPlatform return the account of the platform identity and whether it is created by this call.
+// Like getAccountByWallet, creation is serialized by the lock of the platform index and the account is written
+// before the index.
+func (dao *accountDAO) getAccountByPlatform(ctx context.Context, provider string, identity *PlatformIdentity,
+	device, deviceId, os, region, platform string) (*mpb.DBAccountInfo, bool, error) {
+	key := com.PlatformAccKey(provider, identity.Subject)
+	var dbAcc *mpb.DBAccountInfo
+	var created bool
+	err := dao.rMux.Safely(ctx, key, func() error {
+		pa, err := dao.getPlatformAcc(ctx, provider, identity.Subject)
 		if err != nil {
-			dao.logger.Error("checkEmailResetPasswordNonce Del failed",
-				zap.String("key", key), zap.Error(err))
+			return err
+		}
+		if pa.UserId != 0 {
+			dbAcc, err = dao.resolveAccount(ctx, pa.UserId)
+			if err != mpberr.ErrAccountNotExist {
+				return err
+			}
+			// create the account again only if the indexed one is really absent, never over a tombstone
+			_, err = dao.getAccountRecord(ctx, pa.UserId)
+			if err == nil {
+				dao.logger.Error("getAccountByPlatform merge chain broken", zap.String("key", key),
+					zap.Uint64("user_id", pa.UserId))
+				return mpberr.ErrAccountNotExist
+			} else if err != mpberr.ErrAccountNotExist {
+				return err
+			}
+		}
+
+		userId, err := gdb.ToUint64(dao.accDB.Incr(ctx, com.UserIdIndexKey()))
+		if err != nil {
+			dao.logger.Error("getAccountByPlatform Incr failed", zap.String("key", com.UserIdIndexKey()),
+				zap.Error(err))
+			return mpberr.ErrDB
+		}
+		now := time.Now().Unix()
+		dbAcc = &mpb.DBAccountInfo{
+			UserId:       userId,
+			DeviceId:     deviceId,
+			Device:       device,
+			Os:           os,
+			Region:       region,
+			Platform:     platform,
+			Nickname:     com.DefaultNicknamePrefix + strconv.Itoa(int(userId)),
+			Icon:         "0",
+			RegisterTime: now,
+			PlatformLinks: []*mpb.DBPlatformLink{{
+				Provider: provider,
+				Subject:  identity.Subject,
+				Email:    identity.verifiedEmail(),
+				LinkTime: now,
+			}},
+		}
+		err = dao.accDB.SetObject(ctx, com.AccountKey(userId), dbAcc)
+		if err != nil {
+			dao.logger.Error("getAccountByPlatform SetObject failed", zap.String("key", com.AccountKey(userId)),
+				zap.Error(err))
+			return mpberr.ErrDB
+		}
+		err = dao.accDB.SetObject(ctx, key, &mpb.DBPlatformAcc{UserId: userId})
+		if err != nil {
+			dao.logger.Error("getAccountByPlatform SetObject failed", zap.String("key", key), zap.Error(err))
 			return mpberr.ErrDB
 		}
+		created = true
 		return nil
 	})
 	if err != nil {
-		dao.logger.Error("checkEmailResetPasswordNonce Safely failed",
-			zap.String("key", key), zap.Error(err))
-		return false, err
+		dao.logger.Error("getAccountByPlatform Safely failed", zap.String("key", key), zap.Error(err))
+		return nil, false, err
+	}
+	return dbAcc, created, nil
+}
+
+// usePlatformNonce mark the nonce of an ID token used until the token expires, false if it is used already
+func (dao *accountDAO) usePlatformNonce(ctx context.Context, provider, nonce string, expireTime time.Time) (bool,
+	error) {
+	key := com.PlatformNonceKey(provider, nonce)
+	ttl := time.Until(expireTime)
+	if ttl < time.Second {
+		ttl = time.Second
+	}
+	ok, err := dao.tmpDB.SetEXNX(ctx, key, 1, ttl)
+	if err != nil {
+		dao.logger.Error("usePlatformNonce SetEXNX failed", zap.String("key", key), zap.Error(err))
+		return false, mpberr.ErrDB
 	}
 	return ok, nil
 }
 
-func (dao *accountDAO) getUserIdByEmail(ctx context.Context, email string) (uint64, error) {
-	key := com.EmailAccKey(email)
-	userId, err := gdb.ToUint64(dao.accDB.Get(ctx, key))
+// linkPlatform link the platform identity to the user, a user links at most one identity of each provider.
+// The platform index lock is taken before the account lock.
+func (dao *accountDAO) linkPlatform(ctx context.Context, userId uint64, provider string,
+	identity *PlatformIdentity) (*mpb.DBAccountInfo, error) {
+	key := com.PlatformAccKey(provider, identity.Subject)
+	var dbAcc *mpb.DBAccountInfo
+	err := dao.rMux.Safely(ctx, key, func() error {
+		pa, err := dao.getPlatformAcc(ctx, provider, identity.Subject)
+		if err != nil {
+			return err
+		}
+		if pa.UserId == userId {
+			dbAcc, err = dao.getAccountInfo(ctx, userId)
+			return err
+		}
+		if pa.UserId != 0 {
+			owner, err := dao.resolveAccount(ctx, pa.UserId)
+			if err == nil && owner.UserId == userId {
+				dbAcc = owner
+				return nil
+			}
+			if err != mpberr.ErrAccountNotExist {
+				if err == nil {
+					err = mpberr.ErrPlatformLinked
+				}
+				return err
+			}
+		}
 
-	if dao.accDB.IsErrNil(err) {
-		return 0, mpberr.ErrEmailNotExist
-	} else if err != nil {
-		dao.logger.Error("getUserIdByEmail SetObject failed", zap.String("key", key), zap.Error(err))
-		return 0, mpberr.ErrDB
+		aKey := com.AccountKey(userId)
+		err = dao.rMux.Safely(ctx, aKey, func() error {
+			dbAcc = &mpb.DBAccountInfo{}
+			err := dao.accDB.GetObject(ctx, aKey, dbAcc)
+			if dao.accDB.IsErrNil(err) {
+				return mpberr.ErrAccountNotExist
+			} else if err != nil {
+				dao.logger.Error("linkPlatform GetObject failed", zap.String("key", aKey), zap.Error(err))
+				return mpberr.ErrDB
+			}
+			for _, link := range dbAcc.PlatformLinks {
+				if link.Provider == provider {
+					return mpberr.ErrPlatformLinked
+				}
+			}
+			dbAcc.PlatformLinks = append(dbAcc.PlatformLinks, &mpb.DBPlatformLink{
+				Provider: provider,
+				Subject:  identity.Subject,
+				Email:    identity.verifiedEmail(),
+				LinkTime: time.Now().Unix(),
+			})
+			err = dao.accDB.SetObject(ctx, aKey, dbAcc)
+			if err != nil {
+				dao.logger.Error("linkPlatform SetObject failed", zap.String("key", aKey), zap.Error(err))
+				return mpberr.ErrDB
+			}
+			return nil
+		})
+		if err != nil {
+			dao.logger.Error("linkPlatform Safely failed", zap.String("key", aKey), zap.Error(err))
+			return err
+		}
+
+		err = dao.accDB.SetObject(ctx, key, &mpb.DBPlatformAcc{UserId: userId})
+		if err != nil {
+			dao.logger.Error("linkPlatform SetObject failed", zap.String("key", key), zap.Error(err))
+			return mpberr.ErrDB
+		}
+		return nil
+	})
+	if err != nil {
+		dao.logger.Error("linkPlatform Safely failed", zap.String("key", key), zap.Error(err))
+		return nil, err
 	}
-	return userId, nil
+	return dbAcc, nil
 }
 
-func (dao *accountDAO) resetPassword(ctx context.Context, userId uint64, password string) error {
+// checkAccountsMergeable the merged account must not hold anything the kept account can hold only one of
+func checkAccountsMergeable(dbAcc, merged *mpb.DBAccountInfo, now int64) error {
+	if banActive(dbAcc.Ban, now) || banActive(merged.Ban, now) {
+		return mpberr.ErrAccountBanned
+	}
+	if dbAcc.Guest || dbAcc.DeletionTime != 0 || merged.DeletionTime != 0 {
+		return mpberr.ErrAccountMergeConflict
+	}
+	// an item held by both is moved already by a merge which failed halfway
+	if (dbAcc.Email != "" && merged.Email != "" && dbAcc.Email != merged.Email) ||
+		(dbAcc.Account != "" && merged.Account != "" && dbAcc.Account != merged.Account) ||
+		(dbAcc.Tel != "" && merged.Tel != "" && dbAcc.Tel != merged.Tel) {
+		return mpberr.ErrAccountMergeConflict
+	}
+	for _, link := range merged.PlatformLinks {
+		for _, l := range dbAcc.PlatformLinks {
+			if l.Provider == link.Provider && l.Subject != link.Subject {
+				return mpberr.ErrAccountMergeConflict
+			}
+		}
+	}
+	return nil
+}
+
+// mergedIndexKeys return the keys of the indices pointing to the merged account, sorted to be locked in order
+func mergedIndexKeys(merged *mpb.DBAccountInfo, wallets []*mpb.DBLinkedWallet) []string {
+	keys := make([]string, 0, len(wallets)+len(merged.PlatformLinks)+4)
+	for _, w := range wallets {
+		keys = append(keys, com.WalletAccKey(w.WalletAddr))
+	}
+	if merged.Email != "" {
+		keys = append(keys, com.EmailAccKey(merged.Email))
+	}
+	if merged.Account != "" {
+		keys = append(keys, com.AccountUIDKey(merged.Account))
+	}
+	if merged.Tel != "" {
+		keys = append(keys, com.TelAccKey(merged.Tel))
+	}
+	for _, link := range merged.PlatformLinks {
+		keys = append(keys, com.PlatformAccKey(link.Provider, link.Subject))
+	}
+	if merged.InviteCode != "" {
+		keys = append(keys, com.InviteCodeKey(merged.InviteCode))
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func equalKeys(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// safelyAll run f in the locks of all keys, the keys are locked in the given order
+func (dao *accountDAO) safelyAll(ctx context.Context, keys []string, f func() error) error {
+	if len(keys) == 0 {
+		return f()
+	}
+	return dao.rMux.Safely(ctx, keys[0], func() error {
+		return dao.safelyAll(ctx, keys[1:], f)
+	})
+}
+
+// mergeAccounts merge the account of mergedUserId into the account of userId. The wallets, the email, the phone
+// number, the platform identities and the invite code of the merged account are moved with their indices, then it
+// is tombstoned. Like the other paths the index locks are taken before the account locks: the indices of the merged
+// account are read first and checked again in the account locks, and the account locks are taken in user id order.
+// The moved items are filled in the log.
+// Every step can be repeated, so a merge which failed halfway is finished by calling it again, and merging an
+// account already merged into the user runs the steps after the move again and returns the stored log.
+func (dao *accountDAO) mergeAccounts(ctx context.Context, userId, mergedUserId uint64, log *mpb.DBAccountMergeLog) (
+	*mpb.DBAccountInfo, *mpb.DBAccountMergeLog, error) {
+	merged, err := dao.getAccountRecord(ctx, mergedUserId)
+	if err != nil {
+		return nil, nil, err
+	}
+	mergedWallets, err := dao.getLinkedWallets(ctx, merged)
+	if err != nil {
+		return nil, nil, err
+	}
+	indexKeys := mergedIndexKeys(merged, mergedWallets)
+
+	firstKey, secondKey := com.AccountKey(userId), com.AccountKey(mergedUserId)
+	if mergedUserId < userId {
+		firstKey, secondKey = secondKey, firstKey
+	}
+	var dbAcc *mpb.DBAccountInfo
+	done := false
+	err = dao.safelyAll(ctx, indexKeys, func() error {
+		return dao.rMux.Safely(ctx, firstKey, func() error {
+			return dao.rMux.Safely(ctx, secondKey, func() error {
+				var err error
+				dbAcc, err = dao.getAccountInfo(ctx, userId)
+				if err != nil {
+					return err
+				}
+				merged, err = dao.getAccountRecord(ctx, mergedUserId)
+				if err != nil {
+					return err
+				}
+				if merged.MergedInto == userId {
+					done = true
+					return nil
+				}
+				if merged.MergedInto != 0 {
+					return mpberr.ErrAccountNotExist
+				}
+				err = checkAccountsMergeable(dbAcc, merged, time.Now().Unix())
+				if err != nil {
+					return err
+				}
+
+				wallets, err := dao.getLinkedWallets(ctx, dbAcc)
+				if err != nil {
+					return err
+				}
+				mergedWallets, err = dao.getLinkedWallets(ctx, merged)
+				if err != nil {
+					return err
+				}
+				if !equalKeys(mergedIndexKeys(merged, mergedWallets), indexKeys) {
+					// the merged account changed out of the index locks, the merge can be tried again
+					return mpberr.ErrRepeatedRequest
+				}
+				if countNewWallets(wallets, mergedWallets)+len(wallets) > com.MaxLinkedWallets {
+					return mpberr.ErrWalletLimit
+				}
+				return dao.moveAccountItems(ctx, dbAcc, merged, mergedWallets, log)
+			})
+		})
+	})
+	if err != nil {
+		dao.logger.Error("mergeAccounts Safely failed", zap.Uint64("user_id", userId),
+			zap.Uint64("merged_user_id", mergedUserId), zap.Error(err))
+		return nil, nil, err
+	}
+	if done {
+		stored, err := dao.getAccountMergeLog(ctx, userId, mergedUserId)
+		if err != nil {
+			return nil, nil, err
+		}
+		if stored != nil {
+			log = stored
+		}
+	}
+
+	err = dao.delAllTokens(ctx, mergedUserId)
+	if err != nil {
+		return nil, nil, err
+	}
+	err = dao.moveReferrals(ctx, userId, mergedUserId)
+	if err != nil {
+		return nil, nil, err
+	}
+	err = dao.moveInviteeReferral(ctx, dbAcc, mergedUserId, merged.ReferrerId)
+	if err != nil {
+		return nil, nil, err
+	}
+	if merged.Nickname != "" {
+		dao.releaseNickname(ctx, mergedUserId, merged.Nickname)
+	}
+	if merged.DeviceId != "" {
+		err = dao.removeDeviceAccount(ctx, merged.DeviceId, mergedUserId)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	return dbAcc, log, nil
+}
+
+// countNewWallets count the merged wallets not linked to the kept account yet
+func countNewWallets(wallets, mergedWallets []*mpb.DBLinkedWallet) int {
+	n := 0
+	for _, mw := range mergedWallets {
+		found := false
+		for _, w := range wallets {
+			if w.WalletAddr == mw.WalletAddr {
+				found = true
+				break
+			}
+		}
+		if !found {
+			n++
+		}
+	}
+	return n
+}
+
+// moveAccountItems move the items of the merged account to dbAcc and tombstone the merged account,
+// it is called in the index locks of the merged account and the locks of both accounts.
+// Redis gives no transaction over the writes, so they are ordered to leave a state a retry can finish: the kept
+// account is written with the items first, then the indices are re-pointed, then the merge log is saved and the
+// merged account is tombstoned at last. Until the tombstone the merged account still owns its items, the
+// indices already moved lead to the kept account which holds the same items, and checkAccountsMergeable lets the
+// retry through.
+func (dao *accountDAO) moveAccountItems(ctx context.Context, dbAcc, merged *mpb.DBAccountInfo,
+	mergedWallets []*mpb.DBLinkedWallet, log *mpb.DBAccountMergeLog) error {
+	userId := dbAcc.UserId
+	uwKey := com.UIDWalletsKey(userId)
+	for _, w := range mergedWallets {
+		err := dao.accDB.HSetObjects(ctx, uwKey, w.WalletAddr, w)
+		if err != nil {
+			dao.logger.Error("moveAccountItems HSetObjects failed", zap.String("key", uwKey), zap.Error(err))
+			return mpberr.ErrDB
+		}
+		log.WalletAddrs = append(log.WalletAddrs, w.WalletAddr)
+	}
+	if dbAcc.AptosAccAddr == "" {
+		dbAcc.AptosAccAddr = merged.AptosAccAddr
+		dbAcc.PublicKey = merged.PublicKey
+	}
+	if merged.Email != "" {
+		dbAcc.Email = merged.Email
+		log.Email = merged.Email
+	}
+	if merged.Account != "" {
+		dbAcc.Account = merged.Account
+	}
+	if merged.Tel != "" {
+		dbAcc.Tel = merged.Tel
+	}
+	if dbAcc.Password == "" {
+		dbAcc.Password = merged.Password
+	}
+	if !dbAcc.TotpEnabled && merged.TotpEnabled {
+		dbAcc.TotpSecret = merged.TotpSecret
+		dbAcc.TotpEnabled = true
+		dbAcc.TotpRecoveryCodes = merged.TotpRecoveryCodes
+	}
+	for _, link := range merged.PlatformLinks {
+		if !hasPlatformLink(dbAcc, link) {
+			dbAcc.PlatformLinks = append(dbAcc.PlatformLinks, link)
+		}
+		log.Platforms = append(log.Platforms, link.Provider)
+	}
+	// the invite code shared by the merged account keeps working for the kept one
+	if dbAcc.InviteCode == "" {
+		dbAcc.InviteCode = merged.InviteCode
+	}
+	if dbAcc.ReferrerId == 0 && merged.ReferrerId != userId {
+		dbAcc.ReferrerId = merged.ReferrerId
+	}
+	// the mute of the merged account follows the user, or merging into a clean account would lift it
+	if banActive(merged.Mute, time.Now().Unix()) && !banOutlasts(dbAcc.Mute, merged.Mute, time.Now().Unix()) {
+		dbAcc.Mute = merged.Mute
+		score := merged.Mute.ExpireTime
+		if score == 0 {
+			score = math.MaxInt64
+		}
+		bKey := com.BansKey(uint32(mpb.EBan_Type_Mute))
+		_, err := dao.accDB.ZAdd(ctx, bKey, score, userId)
+		if err != nil {
+			dao.logger.Error("moveAccountItems ZAdd failed", zap.String("key", bKey), zap.Error(err))
+			return mpberr.ErrDB
+		}
+	}
 	key := com.AccountKey(userId)
-	err := dao.rMux.Safely(ctx, key, func() error {
-		var dbAcc = &mpb.DBAccountInfo{}
-		err := dao.accDB.GetObject(ctx, key, dbAcc)
-		if dao.accDB.IsErrNil(err) {
-			return mpberr.ErrAccountNotExist
-		} else if err != nil {
-			dao.logger.Error("resetPassword GetObject failed", zap.String("key", key), zap.Error(err))
+	err := dao.accDB.SetObject(ctx, key, dbAcc)
+	if err != nil {
+		dao.logger.Error("moveAccountItems SetObject failed", zap.String("key", key), zap.Error(err))
+		return mpberr.ErrDB
+	}
+
+	for _, w := range mergedWallets {
+		waKey := com.WalletAccKey(w.WalletAddr)
+		err = dao.accDB.SetObject(ctx, waKey, &mpb.DBWalletAcc{UserId: userId})
+		if err != nil {
+			dao.logger.Error("moveAccountItems SetObject failed", zap.String("key", waKey), zap.Error(err))
+			return mpberr.ErrDB
+		}
+	}
+	idxKeys := make([]string, 0, 4)
+	if merged.Email != "" {
+		idxKeys = append(idxKeys, com.EmailAccKey(merged.Email))
+	}
+	if merged.Account != "" {
+		idxKeys = append(idxKeys, com.AccountUIDKey(merged.Account))
+	}
+	if merged.Tel != "" {
+		idxKeys = append(idxKeys, com.TelAccKey(merged.Tel))
+	}
+	if merged.InviteCode != "" {
+		idxKeys = append(idxKeys, com.InviteCodeKey(merged.InviteCode))
+	}
+	for _, k := range idxKeys {
+		err = dao.accDB.Set(ctx, k, userId)
+		if err != nil {
+			dao.logger.Error("moveAccountItems Set failed", zap.String("key", k), zap.Error(err))
+			return mpberr.ErrDB
+		}
+	}
+	for _, link := range merged.PlatformLinks {
+		pKey := com.PlatformAccKey(link.Provider, link.Subject)
+		err = dao.accDB.SetObject(ctx, pKey, &mpb.DBPlatformAcc{UserId: userId})
+		if err != nil {
+			dao.logger.Error("moveAccountItems SetObject failed", zap.String("key", pKey), zap.Error(err))
 			return mpberr.ErrDB
 		}
+	}
 
-		dbAcc.Password = password
-		err = dao.accDB.SetObject(ctx, key, dbAcc)
+	// the log is saved before the tombstone, so a merge found done has its log
+	err = dao.addAccountMergeLog(ctx, userId, log)
+	if err != nil {
+		return err
+	}
+
+	merged.MergedInto = userId
+	merged.AptosAccAddr = ""
+	merged.PublicKey = nil
+	merged.Email = ""
+	merged.Tel = ""
+	merged.Account = ""
+	merged.TotpSecret = ""
+	merged.TotpEnabled = false
+	merged.TotpRecoveryCodes = nil
+	merged.PlatformLinks = nil
+	merged.InviteCode = ""
+	key = com.AccountKey(merged.UserId)
+	err = dao.accDB.SetObject(ctx, key, merged)
+	if err != nil {
+		dao.logger.Error("moveAccountItems SetObject failed", zap.String("key", key), zap.Error(err))
+		return mpberr.ErrDB
+	}
+	_, err = dao.accDB.Del(ctx, com.UIDWalletsKey(merged.UserId))
+	if err != nil {
+		dao.logger.Error("moveAccountItems Del failed", zap.String("key", com.UIDWalletsKey(merged.UserId)),
+			zap.Error(err))
+		return mpberr.ErrDB
+	}
+	return nil
+}
+
+func hasPlatformLink(dbAcc *mpb.DBAccountInfo, link *mpb.DBPlatformLink) bool {
+	for _, l := range dbAcc.PlatformLinks {
+		if l.Provider == link.Provider && l.Subject == link.Subject {
+			return true
+		}
+	}
+	return false
+}
+
+// moveReferrals move the referrals made by the merged account to the user. The count of the user is recounted
+// from the moved referrals instead of increased, so it can be called again.
+func (dao *accountDAO) moveReferrals(ctx context.Context, userId, mergedUserId uint64) error {
+	refs, err := dao.getReferrals(ctx, mergedUserId)
+	if err != nil {
+		return err
+	}
+	key := com.ReferralsKey(userId)
+	for _, ref := range refs {
+		err = dao.accDB.HSetObjects(ctx, key, ref.UserId, ref)
 		if err != nil {
-			dao.logger.Error("resetPassword SetObject failed", zap.String("key", key), zap.Error(err))
+			dao.logger.Error("moveReferrals HSetObjects failed", zap.String("key", key), zap.Error(err))
+			return mpberr.ErrDB
+		}
+	}
+	if len(refs) > 0 {
+		all, err := dao.getReferrals(ctx, userId)
+		if err != nil {
+			return err
+		}
+		accepted := 0
+		for _, ref := range all {
+			if ref.RejectReason == uint32(mpb.EReferralReject_Reason_None) {
+				accepted++
+			}
+		}
+		_, err = dao.accDB.ZAdd(ctx, com.ReferrersKey(), accepted, userId)
+		if err != nil {
+			dao.logger.Error("moveReferrals ZAdd failed", zap.String("key", com.ReferrersKey()), zap.Error(err))
+			return mpberr.ErrDB
+		}
+	}
+	_, err = dao.accDB.ZRem(ctx, com.ReferrersKey(), mergedUserId)
+	if err != nil {
+		dao.logger.Error("moveReferrals ZRem failed", zap.String("key", com.ReferrersKey()), zap.Error(err))
+		return mpberr.ErrDB
+	}
+	_, err = dao.accDB.Del(ctx, com.ReferralsKey(mergedUserId))
+	if err != nil {
+		dao.logger.Error("moveReferrals Del failed", zap.String("key", com.ReferralsKey(mergedUserId)),
+			zap.Error(err))
+		return mpberr.ErrDB
+	}
+	return nil
+}
+
+// addAccountMergeLog save the log of the merge, the log of a merged user id saved before is replaced
+func (dao *accountDAO) addAccountMergeLog(ctx context.Context, userId uint64, log *mpb.DBAccountMergeLog) error {
+	key := com.AccountMergeLogKey(userId)
+	err := dao.rMux.Safely(ctx, key, func() error {
+		logs := &mpb.DBAccountMergeLogs{}
+		err := dao.accDB.GetObject(ctx, key, logs)
+		if err != nil && !dao.accDB.IsErrNil(err) {
+			dao.logger.Error("addAccountMergeLog GetObject failed", zap.String("key", key), zap.Error(err))
+			return mpberr.ErrDB
+		}
+		for i, l := range logs.Logs {
+			if l.MergedUserId == log.MergedUserId {
+				logs.Logs = append(logs.Logs[:i], logs.Logs[i+1:]...)
+				break
+			}
+		}
+		if len(logs.Logs) >= com.MaxAccountMergeLogs {
+			logs.Logs = logs.Logs[len(logs.Logs)-com.MaxAccountMergeLogs+1:]
+		}
+		logs.Logs = append(logs.Logs, log)
+		err = dao.accDB.SetObject(ctx, key, logs)
+		if err != nil {
+			dao.logger.Error("addAccountMergeLog SetObject failed", zap.String("key", key), zap.Error(err))
 			return mpberr.ErrDB
 		}
 		return nil
 	})
 	if err != nil {
-		dao.logger.Error("resetPassword Safely failed", zap.String("key", key), zap.Error(err))
+		dao.logger.Error("addAccountMergeLog Safely failed", zap.String("key", key), zap.Error(err))
 		return err
 	}
 	return nil
 }
+
+// getAccountMergeLog return the log of merging mergedUserId into the user, nil if it is not kept
+func (dao *accountDAO) getAccountMergeLog(ctx context.Context, userId, mergedUserId uint64) (
+	*mpb.DBAccountMergeLog, error) {
+	key := com.AccountMergeLogKey(userId)
+	logs := &mpb.DBAccountMergeLogs{}
+	err := dao.accDB.GetObject(ctx, key, logs)
+	if err != nil && !dao.accDB.IsErrNil(err) {
+		dao.logger.Error("getAccountMergeLog GetObject failed", zap.String("key", key), zap.Error(err))
+		return nil, mpberr.ErrDB
+	}
+	for _, l := range logs.Logs {
+		if l.MergedUserId == mergedUserId {
+			return l, nil
+		}
+	}
+	return nil, nil
+}
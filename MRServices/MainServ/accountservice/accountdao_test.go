@@ -0,0 +1,444 @@
+package accountservice
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	com "github.com/aureontu/MRWebServer/mr_services/common"
+	"github.com/aureontu/MRWebServer/mr_services/mpb"
+	"github.com/aureontu/MRWebServer/mr_services/mpberr"
+	"github.com/aureontu/MRWebServer/mr_services/util"
+	"github.com/oldjon/gutil/env"
+	"github.com/oldjon/gutil/gdb"
+	gmarshaller "github.com/oldjon/gutil/marshaller"
+	grmux "github.com/oldjon/gutil/redismutex"
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
+)
+
+// testRedis a miniredis and the config of it like acc_redis or tmp_redis
+type testRedis struct {
+	server *miniredis.Miniredis
+	cfg    env.ModuleConfig
+}
+
+func newTestRedis(t *testing.T) *testRedis {
+	server := miniredis.RunT(t)
+	v := viper.New()
+	v.Set("mode", "single")
+	v.Set("addr", server.Addr())
+	return &testRedis{server: server, cfg: env.NewModuleConfig(v, nil)}
+}
+
+// newTestService create the service with the dao on miniredis the way NewAccountService does
+func newTestService(t *testing.T) (*AccountService, *testRedis, *testRedis) {
+	logger := zap.NewNop()
+	acc, tmp, mux := newTestRedis(t), newTestRedis(t), newTestRedis(t)
+	rMux, err := grmux.NewRedisMux(mux.cfg, nil, logger, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	accRedis, err := gdb.NewRedisClientByConfig(acc.cfg, gmarshaller.MarshallerTypeProtoBuf, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpRedis, err := gdb.NewRedisClientByConfig(tmp.cfg, gmarshaller.MarshallerTypeProtoBuf, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dao := newAccountDAO(logger, rMux, accRedis, tmpRedis,
+		util.NewRedisUniversalClient(acc.cfg),
+		util.NewRedisMarshaller(acc.cfg, gmarshaller.MarshallerTypeProtoBuf),
+		util.NewRedisUniversalClient(tmp.cfg))
+	return &AccountService{logger: logger, dao: dao}, acc, tmp
+}
+
+// loginAttempt the failures of the account from the ip
+type loginAttempt struct {
+	ip       string
+	failures int
+}
+
+// sprayAttempts fail the account n times from as many ips
+func sprayAttempts(n int) []loginAttempt {
+	attempts := make([]loginAttempt, n)
+	for i := range attempts {
+		attempts[i] = loginAttempt{ip: fmt.Sprintf("10.0.%d.%d", i/256, i%256), failures: 1}
+	}
+	return attempts
+}
+
+func TestLoginLocks(t *testing.T) {
+	const account = "a@b.io"
+	cases := []struct {
+		name     string
+		attempts []loginAttempt
+		account  string // checked with the ip
+		ip       string
+		locked   bool
+	}{
+		{name: "free failures", attempts: []loginAttempt{{ip: "1.1.1.1", failures: com.LoginFreeFailuresPerAccount}},
+			account: account, ip: "1.1.1.1"},
+		{name: "account from ip", attempts: []loginAttempt{{ip: "1.1.1.1", failures: com.LoginFreeFailuresPerAccount + 1}},
+			account: account, ip: "1.1.1.1", locked: true},
+		{name: "account from other ip", attempts: []loginAttempt{{ip: "1.1.1.1", failures: com.LoginFreeFailuresPerAccount + 1}},
+			account: account, ip: "2.2.2.2"},
+		{name: "other account from ip", attempts: []loginAttempt{{ip: "1.1.1.1", failures: com.LoginFreeFailuresPerAccount + 1}},
+			account: "c@d.io", ip: "1.1.1.1"},
+		{name: "ip", attempts: []loginAttempt{{ip: "1.1.1.1", failures: com.LoginFreeFailuresPerIP + 1}},
+			account: "c@d.io", ip: "1.1.1.1", locked: true},
+		{name: "account sprayed from many ips", attempts: sprayAttempts(com.LoginFreeFailuresAccount + 1),
+			account: account, ip: "2.2.2.2", locked: true},
+		{name: "account sprayed below threshold", attempts: sprayAttempts(com.LoginFreeFailuresAccount),
+			account: account, ip: "2.2.2.2"},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			svc, _, _ := newTestService(t)
+			ctx := context.Background()
+			for _, a := range c.attempts {
+				for i := 0; i < a.failures; i++ {
+					svc.addLoginFailure(ctx, account, a.ip)
+				}
+			}
+			err := svc.checkLoginLocked(ctx, c.account, c.ip)
+			if (err == mpberr.ErrLoginLocked) != c.locked || (err != nil && err != mpberr.ErrLoginLocked) {
+				t.Fatalf("checkLoginLocked = %v, want locked %v", err, c.locked)
+			}
+		})
+	}
+}
+
+func TestLoginLockDurations(t *testing.T) {
+	svc, _, tmp := newTestService(t)
+	ctx := context.Background()
+	target := loginLockTarget(mpb.ELoginLock_Type_Account, "a@b.io")
+	for i := 0; i < com.LoginFreeFailuresAccount+30; i++ {
+		svc.addLoginFailure(ctx, "a@b.io", "1.1.1.1")
+	}
+	ttl := tmp.server.TTL(com.LoginLockKey(target))
+	if ttl <= 0 || ttl > com.LoginAccountLockMaxDuration {
+		t.Fatalf("lock of the account %v, want at most %v", ttl, com.LoginAccountLockMaxDuration)
+	}
+	ttl = tmp.server.TTL(com.LoginLockKey(accountIPTarget("a@b.io", "1.1.1.1")))
+	if ttl != com.LoginLockMaxDuration {
+		t.Fatalf("lock of the account from the ip %v, want %v", ttl, com.LoginLockMaxDuration)
+	}
+
+	// a success clears the account from the ip only
+	svc.clearLoginFailures(ctx, "a@b.io", "1.1.1.1")
+	res, err := svc.AdminGetLoginLocks(ctx, &mpb.ReqAdminGetLoginLocks{
+		Type:   mpb.ELoginLock_Type_AccountIP,
+		Target: "A@b.io|1.1.1.1",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Locks) != 2 || res.Locks[0].Failures != 0 || res.Locks[0].UnlockTime != 0 ||
+		res.Locks[1].Type != mpb.ELoginLock_Type_Account || res.Locks[1].Target != "a@b.io" ||
+		res.Locks[1].Failures != uint64(com.LoginFreeFailuresAccount+30) ||
+		res.Locks[1].UnlockTime <= time.Now().Unix() {
+		t.Fatalf("locks = %v", res.Locks)
+	}
+}
+
+func TestDeletionProof(t *testing.T) {
+	password, other := strings.Repeat("a", com.PasswordLen), strings.Repeat("b", com.PasswordLen)
+	hashed, err := util.HashPassword(password)
+	if err != nil {
+		t.Fatal(err)
+	}
+	user := &mpb.DBAccountInfo{UserId: 1, Account: "a@b.io", Email: "a@b.io", Password: hashed, DeviceId: "d1"}
+	guest := &mpb.DBAccountInfo{UserId: 2, Account: "d2", DeviceId: "d2", Guest: true}
+	cases := []struct {
+		name  string
+		dbAcc *mpb.DBAccountInfo
+		req   *mpb.ReqRequestAccountDeletion
+		err   error
+	}{
+		{name: "password", dbAcc: user, req: &mpb.ReqRequestAccountDeletion{Password: password}},
+		{name: "wrong password", dbAcc: user, req: &mpb.ReqRequestAccountDeletion{Password: other},
+			err: mpberr.ErrPassword},
+		{name: "password of guest", dbAcc: guest, req: &mpb.ReqRequestAccountDeletion{Password: password},
+			err: mpberr.ErrPassword},
+		{name: "no proof", dbAcc: user, req: &mpb.ReqRequestAccountDeletion{}, err: mpberr.ErrParam},
+		{name: "device of guest", dbAcc: guest, req: &mpb.ReqRequestAccountDeletion{DeviceId: "d2"}},
+		{name: "other device of guest", dbAcc: guest, req: &mpb.ReqRequestAccountDeletion{DeviceId: "d1"},
+			err: mpberr.ErrParam},
+		{name: "device of registered account", dbAcc: user, req: &mpb.ReqRequestAccountDeletion{DeviceId: "d1"},
+			err: mpberr.ErrParam},
+		{name: "wallet not linked", dbAcc: user, req: &mpb.ReqRequestAccountDeletion{WalletAddr: "0x1"},
+			err: mpberr.ErrWalletNotLinked},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			svc, _, _ := newTestService(t)
+			c.req.UserId = c.dbAcc.UserId
+			c.req.RemoteIp = "1.1.1.1"
+			err := svc.checkDeletionProof(context.Background(), c.dbAcc, c.req)
+			if err != c.err {
+				t.Fatalf("err = %v, want %v", err, c.err)
+			}
+		})
+	}
+}
+
+func TestDeleteAccountKeys(t *testing.T) {
+	svc, acc, tmp := newTestService(t)
+	dao := svc.dao
+	ctx := context.Background()
+	password := strings.Repeat("a", com.PasswordLen)
+	dbAcc, err := dao.registerAccount(ctx, "a@b.io", password, "ios", "d1", "ios", "US", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	userId := dbAcc.UserId
+	other, _, err := dao.getAccountByWallet(ctx, "0xc1", []byte{1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, addr := range []string{"0xa1", "0xa2"} {
+		_, _, err = dao.linkWallet(ctx, userId, addr, []byte{1})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	_, err = dao.updateProfile(ctx, userId, "alice", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = dao.getOrCreateInviteCode(ctx, userId)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = dao.addLoginRecord(ctx, userId, &mpb.DBLoginRecord{LoginTime: time.Now().Unix(), RemoteIp: "1.1.1.1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = dao.saveToken(ctx, dbAcc.Account, &mpb.DBSession{UserId: userId, Token: "t", RefreshToken: "r",
+		SessionId: "s"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, _, err = dao.mergeAccounts(ctx, userId, other.UserId, &mpb.DBAccountMergeLog{MergedUserId: other.UserId})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = dao.requestAccountDeletion(ctx, userId, time.Now().Unix())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dbAcc, err = dao.getAccountInfo(ctx, userId)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wallets, err := dao.getLinkedWallets(ctx, dbAcc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = dao.deleteAccount(ctx, dbAcc, wallets)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// only the user id index and the tombstone of the merged account are left
+	want := map[string]bool{com.UserIdIndexKey(): true, com.AccountKey(other.UserId): true}
+	for _, key := range acc.server.Keys() {
+		if !want[key] {
+			t.Errorf("key %s left", key)
+		}
+	}
+	for _, key := range tmp.server.Keys() {
+		t.Errorf("tmp key %s left", key)
+	}
+}
+
+func TestMergeAccountsRetry(t *testing.T) {
+	cases := []struct {
+		name    string
+		partial bool // the items of the merged account were moved by a merge which failed before the tombstone
+	}{
+		{name: "clean"},
+		{name: "failed halfway", partial: true},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			svc, _, _ := newTestService(t)
+			dao := svc.dao
+			ctx := context.Background()
+			kept, _, err := dao.getAccountByWallet(ctx, "0xa1", []byte{1})
+			if err != nil {
+				t.Fatal(err)
+			}
+			merged, err := dao.registerAccount(ctx, "c@d.io", strings.Repeat("a", com.PasswordLen), "ios", "d2",
+				"ios", "US", "")
+			if err != nil {
+				t.Fatal(err)
+			}
+			_, mergedWallets, err := dao.linkWallet(ctx, merged.UserId, "0xc1", []byte{2})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if c.partial {
+				kept.Email, kept.Account = merged.Email, merged.Account
+				if err = dao.accDB.SetObject(ctx, com.AccountKey(kept.UserId), kept); err != nil {
+					t.Fatal(err)
+				}
+				if err = dao.accDB.HSetObjects(ctx, com.UIDWalletsKey(kept.UserId), "0xc1",
+					mergedWallets[0]); err != nil {
+					t.Fatal(err)
+				}
+				if err = dao.accDB.SetObject(ctx, com.WalletAccKey("0xc1"),
+					&mpb.DBWalletAcc{UserId: kept.UserId}); err != nil {
+					t.Fatal(err)
+				}
+				if err = dao.accDB.Set(ctx, com.EmailAccKey(merged.Email), kept.UserId); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			dbAcc, log, err := dao.mergeAccounts(ctx, kept.UserId, merged.UserId,
+				&mpb.DBAccountMergeLog{MergedUserId: merged.UserId, MergeTime: 1})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if dbAcc.Email != "c@d.io" || dbAcc.AptosAccAddr != "0xa1" || log.Email != "c@d.io" ||
+				len(log.WalletAddrs) != 1 || log.WalletAddrs[0] != "0xc1" {
+				t.Fatalf("account = %v, log = %v", dbAcc, log)
+			}
+			checkMerged(t, dao, kept.UserId, merged.UserId)
+
+			// merging again finishes the steps after the move and returns the stored log
+			_, log, err = dao.mergeAccounts(ctx, kept.UserId, merged.UserId,
+				&mpb.DBAccountMergeLog{MergedUserId: merged.UserId, MergeTime: 2})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if log.MergeTime != 1 || log.Email != "c@d.io" {
+				t.Fatalf("log = %v, want the stored one", log)
+			}
+			checkMerged(t, dao, kept.UserId, merged.UserId)
+		})
+	}
+}
+
+// checkMerged check the indices of the merged items lead to the kept account and the merged one is a tombstone
+func checkMerged(t *testing.T, dao *accountDAO, userId, mergedUserId uint64) {
+	t.Helper()
+	ctx := context.Background()
+	uid, err := dao.getUserIdByEmail(ctx, "c@d.io")
+	if err != nil || uid != userId {
+		t.Fatalf("email index = %d, %v", uid, err)
+	}
+	for _, addr := range []string{"0xa1", "0xc1"} {
+		wa, err := dao.getWalletAcc(ctx, addr)
+		if err != nil || wa.UserId != userId {
+			t.Fatalf("wallet index of %s = %v, %v", addr, wa, err)
+		}
+	}
+	dbAcc, err := dao.getAccountInfo(ctx, userId)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wallets, err := dao.getLinkedWallets(ctx, dbAcc)
+	if err != nil || len(wallets) != 2 {
+		t.Fatalf("wallets = %v, %v", wallets, err)
+	}
+	merged, err := dao.getAccountRecord(ctx, mergedUserId)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if merged.MergedInto != userId || merged.Email != "" || merged.Account != "" || merged.AptosAccAddr != "" {
+		t.Fatalf("merged account = %v", merged)
+	}
+	mergedWallets, err := dao.getLinkedWallets(ctx, merged)
+	if err != nil || len(mergedWallets) != 0 {
+		t.Fatalf("wallets of the merged account = %v, %v", mergedWallets, err)
+	}
+	log, err := dao.getAccountMergeLog(ctx, userId, mergedUserId)
+	if err != nil || log == nil {
+		t.Fatalf("merge log = %v, %v", log, err)
+	}
+}
+
+func TestLinkUnlinkWallet(t *testing.T) {
+	svc, _, _ := newTestService(t)
+	dao := svc.dao
+	ctx := context.Background()
+	withEmail, err := dao.registerAccount(ctx, "a@b.io", strings.Repeat("a", com.PasswordLen), "ios", "d1", "ios",
+		"US", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	walletOnly, _, err := dao.getAccountByWallet(ctx, "0xb1", []byte{1})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the steps run in order, primary is the wallet of the account after the step
+	steps := []struct {
+		name    string
+		unlink  bool
+		userId  uint64
+		addr    string
+		err     error
+		primary string
+	}{
+		{name: "first wallet becomes primary", userId: withEmail.UserId, addr: "0xa1", primary: "0xa1"},
+		{name: "second wallet", userId: withEmail.UserId, addr: "0xa2", primary: "0xa1"},
+		{name: "third wallet", userId: withEmail.UserId, addr: "0xa3", primary: "0xa1"},
+		{name: "linked again", userId: withEmail.UserId, addr: "0xa2", err: mpberr.ErrWalletBound, primary: "0xa1"},
+		{name: "linked to other account", userId: walletOnly.UserId, addr: "0xa1", err: mpberr.ErrWalletBound,
+			primary: "0xb1"},
+		{name: "not linked", unlink: true, userId: walletOnly.UserId, addr: "0xa1", err: mpberr.ErrWalletNotLinked,
+			primary: "0xb1"},
+		{name: "primary unlinked, the earliest linked one follows", unlink: true, userId: withEmail.UserId,
+			addr: "0xa1", primary: "0xa2"},
+		{name: "unlinked wallet linked to other account", userId: walletOnly.UserId, addr: "0xa1",
+			primary: "0xb1"},
+		{name: "other wallet unlinked", unlink: true, userId: withEmail.UserId, addr: "0xa3", primary: "0xa2"},
+		{name: "last wallet of account with email", unlink: true, userId: withEmail.UserId, addr: "0xa2"},
+		{name: "wallet unlinked", unlink: true, userId: walletOnly.UserId, addr: "0xb1", primary: "0xa1"},
+		{name: "last wallet of account without email", unlink: true, userId: walletOnly.UserId, addr: "0xa1",
+			err: mpberr.ErrWalletLastLogin, primary: "0xa1"},
+	}
+	for i, s := range steps {
+		if s.unlink {
+			_, _, _, err = dao.unlinkWallet(ctx, s.userId, s.addr)
+		} else {
+			_, _, err = dao.linkWallet(ctx, s.userId, s.addr, []byte{1})
+		}
+		if err != s.err {
+			t.Fatalf("%s: err = %v, want %v", s.name, err, s.err)
+		}
+		if !s.unlink && s.err == nil {
+			// the link times in seconds are too coarse to order the wallets, the step index orders them
+			err = dao.accDB.HSetObjects(ctx, com.UIDWalletsKey(s.userId), s.addr,
+				&mpb.DBLinkedWallet{WalletAddr: s.addr, PublicKey: []byte{1}, LinkTime: int64(i + 1)})
+			if err != nil {
+				t.Fatal(err)
+			}
+		}
+		dbAcc, err := dao.getAccountInfo(ctx, s.userId)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if dbAcc.AptosAccAddr != s.primary {
+			t.Fatalf("%s: primary = %q, want %q", s.name, dbAcc.AptosAccAddr, s.primary)
+		}
+		if s.err != nil {
+			continue
+		}
+		wa, err := dao.getWalletAcc(ctx, s.addr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if s.unlink != (wa.UserId == 0) || (!s.unlink && wa.UserId != s.userId) {
+			t.Fatalf("%s: index of the wallet = %d", s.name, wa.UserId)
+		}
+	}
+}
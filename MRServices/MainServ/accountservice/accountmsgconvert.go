@@ -1,17 +1,125 @@
 package accountservice
 
-import "github.com/aureontu/MRWebServer/mr_services/mpb"
+import (
+	"sort"
+	"time"
+
+	"github.com/aureontu/MRWebServer/mr_services/mpb"
+)
 
 func (svc *AccountService) DBAccountInfo2AccountInfo(in *mpb.DBAccountInfo) *mpb.AccountInfo {
 	if in == nil {
 		return nil
 	}
-	return &mpb.AccountInfo{
+	info := &mpb.AccountInfo{
 		Account:         in.Account,
 		UserId:          in.UserId,
 		Email:           in.Email,
+		Tel:             in.Tel,
 		Nickname:        in.Nickname,
 		Icon:            in.Icon,
 		AptosWalletAddr: in.AptosAccAddr,
+		TotpEnabled:     in.TotpEnabled,
+		DeletionTime:    in.DeletionTime,
+		Guest:           in.Guest,
+	}
+	for _, link := range in.PlatformLinks {
+		info.Platforms = append(info.Platforms, link.Provider)
+	}
+	if banActive(in.Mute, time.Now().Unix()) {
+		info.Mute = svc.DBBanRecord2BanRecord(in.UserId, in.Mute)
+	}
+	return info
+}
+
+// DBLinkedWallets2WalletInfos convert the linked wallets, sorted by link time
+func (svc *AccountService) DBLinkedWallets2WalletInfos(dbAcc *mpb.DBAccountInfo, in []*mpb.DBLinkedWallet) []*mpb.WalletInfo {
+	out := make([]*mpb.WalletInfo, 0, len(in))
+	for _, w := range in {
+		out = append(out, &mpb.WalletInfo{
+			WalletAddr: w.WalletAddr,
+			Primary:    w.WalletAddr == dbAcc.AptosAccAddr,
+			LinkTime:   w.LinkTime,
+		})
+	}
+	sort.Slice(out, func(i, j int) bool {
+		return out[i].LinkTime < out[j].LinkTime
+	})
+	return out
+}
+
+// DBLoginRecords2LoginRecords convert the login history, the latest login first
+func (svc *AccountService) DBLoginRecords2LoginRecords(in []*mpb.DBLoginRecord) []*mpb.LoginRecord {
+	out := make([]*mpb.LoginRecord, 0, len(in))
+	for i := len(in) - 1; i >= 0; i-- {
+		out = append(out, &mpb.LoginRecord{
+			LoginTime: in[i].LoginTime,
+			RemoteIp:  in[i].RemoteIp,
+			Region:    in[i].Region,
+			Method:    mpb.ELoginMethod_Method(in[i].Method),
+			Device:    in[i].Device,
+		})
+	}
+	return out
+}
+
+func (svc *AccountService) DBBanRecord2BanRecord(userId uint64, in *mpb.DBBanRecord) *mpb.BanRecord {
+	if in == nil {
+		return nil
+	}
+	return &mpb.BanRecord{
+		UserId:     userId,
+		Type:       mpb.EBan_Type(in.Type),
+		Reason:     in.Reason,
+		Operator:   in.Operator,
+		BanTime:    in.BanTime,
+		ExpireTime: in.ExpireTime,
+	}
+}
+
+// DBBanAuditLogs2BanAuditLogs convert the ban audit trail, the latest log first
+func (svc *AccountService) DBBanAuditLogs2BanAuditLogs(userId uint64, in []*mpb.DBBanAuditLog) []*mpb.BanAuditLog {
+	out := make([]*mpb.BanAuditLog, 0, len(in))
+	for i := len(in) - 1; i >= 0; i-- {
+		out = append(out, &mpb.BanAuditLog{
+			UserId:     userId,
+			Type:       mpb.EBan_Type(in[i].Type),
+			Unban:      in[i].Unban,
+			Reason:     in[i].Reason,
+			Operator:   in[i].Operator,
+			OpTime:     in[i].OpTime,
+			ExpireTime: in[i].ExpireTime,
+		})
+	}
+	return out
+}
+
+// DBReferrals2Referrals convert the referrals with the accounts of the invitees, dbAccs[i] is the account of in[i]
+func (svc *AccountService) DBReferrals2Referrals(in []*mpb.DBReferral, dbAccs []*mpb.DBAccountInfo) []*mpb.Referral {
+	out := make([]*mpb.Referral, 0, len(in))
+	for i, ref := range in {
+		r := &mpb.Referral{
+			UserId:       ref.UserId,
+			ReferralTime: ref.ReferralTime,
+		}
+		if i < len(dbAccs) && dbAccs[i] != nil {
+			r.Nickname = dbAccs[i].Nickname
+			r.Icon = dbAccs[i].Icon
+		}
+		out = append(out, r)
+	}
+	return out
+}
+
+func (svc *AccountService) DBAccountMergeLog2AccountMergeLog(userId uint64, in *mpb.DBAccountMergeLog) *mpb.AccountMergeLog {
+	return &mpb.AccountMergeLog{
+		UserId:       userId,
+		MergedUserId: in.MergedUserId,
+		Operator:     in.Operator,
+		Reason:       in.Reason,
+		MergeTime:    in.MergeTime,
+		WalletAddrs:  in.WalletAddrs,
+		Email:        in.Email,
+		Platforms:    in.Platforms,
 	}
 }
@@ -6,8 +6,17 @@ import (
 )
 
 type AccountResourceMgr struct {
+	wf *util.MultiLangWordFilter
 }
 
 func newAccountResourceMgr(logger *zap.Logger, sm *util.ServiceMetrics) (*AccountResourceMgr, error) {
-	return &AccountResourceMgr{}, nil
+	wf, err := util.GetMultiLangWordFilter(logger)
+	if err != nil {
+		return nil, err
+	}
+	return &AccountResourceMgr{wf: wf}, nil
+}
+
+func (rm *AccountResourceMgr) containsSensitiveWord(text string) bool {
+	return rm.wf.Contains(text)
 }
@@ -2,7 +2,13 @@ package accountservice
 
 import (
 	"context"
+	"encoding/json"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
+	"unicode"
+	"unicode/utf8"
 
 	com "github.com/aureontu/MRWebServer/mr_services/common"
 	"github.com/aureontu/MRWebServer/mr_services/mpb"
@@ -39,6 +45,9 @@ type AccountService struct {
 	sm              *util.ServiceMetrics
 	dao             *accountDAO
 	tcpMsgCoder     gprotocol.FrameCoder
+	siwaDomains     []string
+	aptosChainId    int64
+	idps            map[string]IdentityProvider // provider name -> identity provider
 }
 
 // NewAccountService create an accountservice entity
@@ -52,7 +61,7 @@ func NewAccountService(driver service.ModuleDriver) (gxgrpc.GRPCServer, error) {
 		kvm:             driver.Host().KVManager(),
 		sm:              util.NewServiceMetrics(driver),
 		signingMethod:   jwt.SigningMethodHS256,
-		signingDuration: 24 * 30 * time.Hour,
+		signingDuration: com.TokenExpireDuration,
 	}
 
 	dialer := gxgrpc.Dialer{
@@ -90,10 +99,19 @@ func NewAccountService(driver service.ModuleDriver) (gxgrpc.GRPCServer, error) {
 		return nil, err
 	}
 
-	svc.dao = newAccountDAO(svc.logger, redisMux, accRedis, tmpRedis)
+	svc.dao = newAccountDAO(svc.logger, redisMux, accRedis, tmpRedis,
+		util.NewRedisUniversalClient(svc.config.SubConfig("acc_redis")),
+		util.NewRedisMarshaller(svc.config.SubConfig("acc_redis"), svc.config.GetString("db_marshaller")),
+		util.NewRedisUniversalClient(svc.config.SubConfig("tmp_redis")))
 
 	svc.serverEnv = uint32(svc.config.GetInt64("server_env"))
 	svc.tcpMsgCoder = gprotocol.NewFrameCoder(svc.config.GetString("protocol_code"))
+	svc.siwaDomains = svc.config.GetStringSlice("siwa_domains")
+	svc.aptosChainId = svc.config.GetInt64("aptos_chain_id")
+	if len(svc.siwaDomains) == 0 || svc.aptosChainId == 0 {
+		return nil, errors.New("siwa_domains and aptos_chain_id must be configured to check the wallet messages")
+	}
+	svc.idps = newIdentityProviders(svc.logger, svc.config)
 
 	return svc, err
 }
@@ -110,6 +128,8 @@ func (svc *AccountService) Serve(ctx context.Context) error {
 	}
 	svc.signingKey = signingKey
 
+	util.SafeGo(func() { svc.runAccountDeletionJob(ctx) })
+
 	<-ctx.Done()
 	return ctx.Err()
 }
@@ -126,23 +146,59 @@ func (svc *AccountService) Name() string {
 	return svc.name
 }
 
-//func (svc *AccountService) RegisterAccount(ctx context.Context, req *mpb.ReqRegisterAccount) (*mpb.AccountInfo, error) {
-//	if req.DeviceId == "" {
-//		return nil, mpberr.ErrOk
-//	}
-//
-//	accInfo, err := svc.dao.registerAccount(ctx, req.Account, req.Password, req.Device, req.DeviceId, req.Os, req.Region)
-//	if err != nil {
-//		return nil, err
-//	}
-//
-//	svc.logger.Info("Register Account", zap.String("account", accInfo.Account), zap.Uint64("user_id", accInfo.UserId),
-//		zap.String("device", req.Device), zap.String("os", req.Os), zap.String("device_id", req.DeviceId),
-//		zap.String("client_version", req.ClientVersion), zap.String("region", req.Region),
-//		zap.String("ip", req.RemoteIp))
-//
-//	return accInfo, nil
-//}
+func (svc *AccountService) RegisterAccount(ctx context.Context, req *mpb.ReqRegisterAccount) (*mpb.ResRegisterAccount,
+	error) {
+	if req.Account == "" || len(req.Password) != com.PasswordLen {
+		return nil, mpberr.ErrParam
+	}
+
+	// the email must be verified by the code sent by GenerateAndSendEmailBindCode
+	ok, err := svc.dao.checkEmailBindCode(ctx, req.Account, req.Code)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, mpberr.ErrEmailBindCode
+	}
+
+	var referrerId uint64
+	if req.ReferrerCode != "" {
+		referrerId, err = svc.getReferrerId(ctx, req.ReferrerCode)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	dbAcc, err := svc.dao.registerAccount(ctx, req.Account, req.Password, req.Device, req.DeviceId, req.Os,
+		req.Region, req.Platform)
+	if err != nil {
+		return nil, err
+	}
+
+	svc.logger.Info("Register Account", zap.String("account", dbAcc.Account), zap.Uint64("user_id", dbAcc.UserId),
+		zap.String("device", req.Device), zap.String("os", req.Os), zap.String("device_id", req.DeviceId),
+		zap.String("client_version", req.ClientVersion), zap.String("region", req.Region),
+		zap.String("ip", req.RemoteIp))
+
+	session := &mpb.DBSession{
+		Device:   req.Device,
+		DeviceId: req.DeviceId,
+		RemoteIp: req.RemoteIp,
+		Region:   req.Region,
+	}
+	if referrerId != 0 {
+		svc.applyReferral(ctx, referrerId, dbAcc, session)
+	}
+
+	res := &mpb.ResRegisterAccount{
+		Account: svc.DBAccountInfo2AccountInfo(dbAcc),
+	}
+	res.Token, res.RefreshToken, err = svc.issueToken(ctx, dbAcc, session)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
 
 func (svc *AccountService) LoginByPassword(ctx context.Context, req *mpb.ReqLoginByPassword) (*mpb.ResLoginByPassword,
 	error) {
@@ -150,31 +206,109 @@ func (svc *AccountService) LoginByPassword(ctx context.Context, req *mpb.ReqLogi
 		return nil, mpberr.ErrParam
 	}
 
+	err := svc.checkLoginLocked(ctx, req.Account, req.RemoteIp)
+	if err != nil {
+		return nil, err
+	}
+
 	dbAcc, err := svc.dao.getAccountInfoByAccount(ctx, req.Account)
+	if err == mpberr.ErrAccountNotExist {
+		svc.addLoginFailure(ctx, req.Account, req.RemoteIp)
+	}
 	if err != nil {
 		return nil, err
 	}
 
-	if dbAcc.Password != req.Password {
+	ok, needRehash := util.VerifyPassword(dbAcc.Password, req.Password)
+	if !ok {
+		svc.addLoginFailure(ctx, req.Account, req.RemoteIp)
 		return nil, mpberr.ErrPassword
 	}
+	svc.clearLoginFailures(ctx, req.Account, req.RemoteIp)
+	if needRehash {
+		// migrate legacy or outdated record, login should not fail because of this
+		err = svc.dao.rehashPassword(ctx, dbAcc.UserId, dbAcc.Password, req.Password)
+		if err != nil {
+			svc.logger.Error("LoginByPassword rehash password failed", zap.Uint64("user_id", dbAcc.UserId),
+				zap.Error(err))
+		}
+	}
 
-	res := &mpb.ResLoginByPassword{
-		Account: svc.DBAccountInfo2AccountInfo(dbAcc),
+	if dbAcc.TotpEnabled {
+		// password is right, the client needs to finish login by LoginByTOTP
+		ticket, err := util.GenerateRandomToken(com.RefreshTokenLen)
+		if err != nil {
+			svc.logger.Error("LoginByPassword GenerateRandomToken failed", zap.Error(err))
+			return nil, mpberr.ErrUnknown
+		}
+		err = svc.dao.saveTOTPLoginTicket(ctx, ticket, &mpb.DBTOTPLoginTicket{
+			UserId:   dbAcc.UserId,
+			Device:   req.Device,
+			DeviceId: req.DeviceId,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &mpb.ResLoginByPassword{TotpRequired: true, LoginTicket: ticket}, nil
 	}
 
-	token, err := svc.generateLoginToken(dbAcc.UserId, dbAcc.Account, &mpb.Region{
-		Region: "", TcpGatewayId: "0"}, "", dbAcc.AptosAccAddr)
+	return svc.finishLogin(ctx, dbAcc, &mpb.DBSession{
+		Device:   req.Device,
+		DeviceId: req.DeviceId,
+		RemoteIp: req.RemoteIp,
+		Region:   req.Region,
+	}, mpb.ELoginMethod_Method_Password)
+}
+
+func (svc *AccountService) LoginByTOTP(ctx context.Context, req *mpb.ReqLoginByTOTP) (*mpb.ResLoginByPassword, error) {
+	if req.LoginTicket == "" || req.Code == "" {
+		return nil, mpberr.ErrParam
+	}
+
+	lt, err := svc.dao.getTOTPLoginTicket(ctx, req.LoginTicket)
+	if err != nil {
+		return nil, err
+	}
+
+	dbAcc, err := svc.dao.getAccountInfo(ctx, lt.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	err = svc.verifyTOTP(ctx, dbAcc, req.Code)
 	if err != nil {
 		return nil, err
 	}
 
-	err = svc.dao.saveToken(ctx, dbAcc.UserId, gcrypto.MD5SumStr(token), dbAcc.Account, "", "PC")
+	ok, err := svc.dao.delTOTPLoginTicket(ctx, req.LoginTicket)
 	if err != nil {
 		return nil, err
 	}
+	if !ok {
+		return nil, mpberr.ErrTokenVerify
+	}
+
+	return svc.finishLogin(ctx, dbAcc, &mpb.DBSession{
+		Device:   lt.Device,
+		DeviceId: lt.DeviceId,
+		RemoteIp: req.RemoteIp,
+		Region:   req.Region,
+	}, mpb.ELoginMethod_Method_Password)
+}
+
+// finishLogin issue tokens for the new session and fill the login response
+func (svc *AccountService) finishLogin(ctx context.Context, dbAcc *mpb.DBAccountInfo, session *mpb.DBSession,
+	method mpb.ELoginMethod_Method) (*mpb.ResLoginByPassword, error) {
+	res := &mpb.ResLoginByPassword{
+		Account: svc.DBAccountInfo2AccountInfo(dbAcc),
+	}
 
-	res.Token = token
+	var err error
+	res.Token, res.RefreshToken, err = svc.issueToken(ctx, dbAcc, session)
+	if err != nil {
+		return nil, err
+	}
+	svc.recordLogin(ctx, dbAcc, session, method)
 
 	// get wallet resource
 	res.Resources, err = svc.getAptosResources(ctx, dbAcc.AptosAccAddr)
@@ -212,18 +346,48 @@ func (svc *AccountService) GetAccountInfoByAccount(ctx context.Context, req *mpb
 	return svc.DBAccountInfo2AccountInfo(accInfo), nil
 }
 
-func (svc *AccountService) GenerateNonce(ctx context.Context, _ *mpb.Empty) (*mpb.ResGenerateNonce, error) {
-	res := &mpb.ResGenerateNonce{
-		Nonce: util.GenerateRandomCode(com.NonceLen),
+func (svc *AccountService) GenerateNonce(ctx context.Context, req *mpb.ReqGenerateNonce) (*mpb.ResGenerateNonce, error) {
+	if req.WalletAddr == "" {
+		return nil, mpberr.ErrParam
+	}
+	nonce, err := util.GenerateRandomToken(com.WalletNonceLen)
+	if err != nil {
+		svc.logger.Error("GenerateNonce GenerateRandomToken failed", zap.Error(err))
+		return nil, mpberr.ErrUnknown
 	}
+	res := &mpb.ResGenerateNonce{Nonce: nonce}
 
-	err := svc.dao.saveNonce(ctx, res.Nonce)
+	ok, err := svc.dao.saveNonce(ctx, res.Nonce, req.WalletAddr)
 	if err != nil {
 		return nil, err
 	}
+	if !ok { // nonce collision, let client retry
+		return nil, mpberr.ErrRepeatedRequest
+	}
 	return res, nil
 }
 
+// checkWalletMessage validate the sign in message signed by the wallet and consume the nonce in it,
+// the signature has been verified by gateway
+func (svc *AccountService) checkWalletMessage(ctx context.Context, walletAddr, fullMsg string) error {
+	msg, err := util.ParseSIWAMessage(fullMsg)
+	if err != nil {
+		return err
+	}
+	err = msg.Validate(walletAddr, svc.siwaDomains, svc.aptosChainId, time.Now())
+	if err != nil {
+		return err
+	}
+	ok, err := svc.dao.checkNonce(ctx, msg.Nonce, walletAddr)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return mpberr.ErrRepeatedRequest
+	}
+	return nil
+}
+
 func (svc *AccountService) generateLoginToken(userId uint64, account string, region *mpb.Region,
 	clientVersion, walletAddr string) (string, error) {
 	var sToken string
@@ -245,18 +409,149 @@ func (svc *AccountService) generateLoginToken(userId uint64, account string, reg
 	return sToken, nil
 }
 
+// issueToken generate an access token and a refresh token for the session and save them,
+// a new session is created if the session id is empty
+func (svc *AccountService) issueToken(ctx context.Context, dbAcc *mpb.DBAccountInfo, session *mpb.DBSession) (
+	string, string, error) {
+	if banActive(dbAcc.Ban, time.Now().Unix()) {
+		return "", "", mpberr.ErrAccountBanned
+	}
+	token, err := svc.generateLoginToken(dbAcc.UserId, dbAcc.Account, &mpb.Region{
+		Region: "", TcpGatewayId: "0"}, "", dbAcc.AptosAccAddr)
+	if err != nil {
+		return "", "", err
+	}
+
+	refreshToken, err := util.GenerateRandomToken(com.RefreshTokenLen)
+	if err != nil {
+		svc.logger.Error("issueToken GenerateRandomToken failed", zap.Error(err))
+		return "", "", mpberr.ErrUnknown
+	}
+
+	now := time.Now()
+	if session.SessionId == "" {
+		session.SessionId, err = util.GenerateRandomToken(com.SessionIdLen)
+		if err != nil {
+			svc.logger.Error("issueToken GenerateRandomToken failed", zap.Error(err))
+			return "", "", mpberr.ErrUnknown
+		}
+		session.CreateTime = now.Unix()
+	}
+	session.UserId = dbAcc.UserId
+	session.LastSeenTime = now.Unix()
+	session.ExpireTime = now.Add(com.RefreshTokenExpireDuration).Unix()
+	session.Token = gcrypto.MD5SumStr(token)
+	session.RefreshToken = gcrypto.MD5SumStr(refreshToken)
+
+	err = svc.dao.saveToken(ctx, dbAcc.Account, session)
+	if err != nil {
+		return "", "", err
+	}
+	return token, refreshToken, nil
+}
+
+func (svc *AccountService) RefreshToken(ctx context.Context, req *mpb.ReqRefreshToken) (*mpb.ResRefreshToken, error) {
+	if req.RefreshToken == "" {
+		return nil, mpberr.ErrParam
+	}
+
+	rt, err := svc.dao.useRefreshToken(ctx, gcrypto.MD5SumStr(req.RefreshToken))
+	if err != nil {
+		return nil, err
+	}
+
+	dbAcc, err := svc.dao.getAccountInfo(ctx, rt.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	session, err := svc.dao.getSession(ctx, rt.UserId, rt.SessionId)
+	if err == mpberr.ErrSessionNotExist {
+		return nil, mpberr.ErrTokenVerify
+	} else if err != nil {
+		return nil, err
+	}
+	session.RemoteIp = req.RemoteIp
+	session.Region = req.Region
+
+	res := &mpb.ResRefreshToken{}
+	res.Token, res.RefreshToken, err = svc.issueToken(ctx, dbAcc, session)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (svc *AccountService) Logout(ctx context.Context, req *mpb.ReqLogout) (*mpb.Empty, error) {
+	if req.UserId == 0 || req.Token == "" {
+		return nil, mpberr.ErrParam
+	}
+	err := svc.dao.delToken(ctx, req.UserId, gcrypto.MD5SumStr(req.Token))
+	if err != nil {
+		return nil, err
+	}
+	return &mpb.Empty{}, nil
+}
+
+func (svc *AccountService) LogoutAllDevices(ctx context.Context, req *mpb.ReqUserId) (*mpb.Empty, error) {
+	if req.UserId == 0 {
+		return nil, mpberr.ErrParam
+	}
+	err := svc.dao.delAllTokens(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	return &mpb.Empty{}, nil
+}
+
+func (svc *AccountService) GetSessions(ctx context.Context, req *mpb.ReqGetSessions) (*mpb.ResGetSessions, error) {
+	if req.UserId == 0 {
+		return nil, mpberr.ErrParam
+	}
+	sessions, err := svc.dao.getSessions(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	token := gcrypto.MD5SumStr(req.Token)
+	res := &mpb.ResGetSessions{Sessions: make([]*mpb.SessionInfo, 0, len(sessions))}
+	for _, session := range sessions {
+		res.Sessions = append(res.Sessions, &mpb.SessionInfo{
+			SessionId:    session.SessionId,
+			Device:       session.Device,
+			DeviceId:     session.DeviceId,
+			RemoteIp:     session.RemoteIp,
+			Region:       session.Region,
+			CreateTime:   session.CreateTime,
+			LastSeenTime: session.LastSeenTime,
+			Current:      session.Token == token,
+		})
+	}
+	sort.Slice(res.Sessions, func(i, j int) bool {
+		return res.Sessions[i].LastSeenTime > res.Sessions[j].LastSeenTime
+	})
+	return res, nil
+}
+
+func (svc *AccountService) RevokeSession(ctx context.Context, req *mpb.ReqRevokeSession) (*mpb.Empty, error) {
+	if req.UserId == 0 || req.SessionId == "" {
+		return nil, mpberr.ErrParam
+	}
+	err := svc.dao.delSession(ctx, req.UserId, req.SessionId)
+	if err != nil {
+		return nil, err
+	}
+	return &mpb.Empty{}, nil
+}
+
 func (svc *AccountService) WebLoginByWallet(ctx context.Context, req *mpb.ReqWebLoginByWallet) (*mpb.ResWebLoginByWallet,
 	error) {
-	// check nonce
-	ok, err := svc.dao.checkNonce(ctx, req.Nonce)
+	err := svc.checkWalletMessage(ctx, req.WalletAddr, req.AptosFullMsg)
 	if err != nil {
 		return nil, err
 	}
-	if !ok {
-		return nil, mpberr.ErrRepeatedRequest
-	}
 
-	dbAcc, err := svc.dao.getAccountByWallet(ctx, req.WalletAddr, req.PubKey)
+	dbAcc, created, err := svc.dao.getAccountByWallet(ctx, req.WalletAddr, req.PubKey)
 	if err != nil {
 		return nil, err
 	}
@@ -271,18 +566,20 @@ func (svc *AccountService) WebLoginByWallet(ctx context.Context, req *mpb.ReqWeb
 		},
 	}
 
-	token, err := svc.generateLoginToken(dbAcc.UserId, dbAcc.Account, &mpb.Region{
-		Region: "", TcpGatewayId: "0"}, "", dbAcc.AptosAccAddr)
-	if err != nil {
-		return nil, err
+	session := &mpb.DBSession{
+		Device:   req.Device,
+		DeviceId: req.DeviceId,
+		RemoteIp: req.RemoteIp,
+		Region:   req.Region,
 	}
-
-	err = svc.dao.saveToken(ctx, dbAcc.UserId, gcrypto.MD5SumStr(token), dbAcc.Account, "", "PC")
+	if created && req.ReferrerCode != "" {
+		svc.applyReferralCode(ctx, req.ReferrerCode, dbAcc, session)
+	}
+	res.Token, res.RefreshToken, err = svc.issueToken(ctx, dbAcc, session)
 	if err != nil {
 		return nil, err
 	}
-
-	res.Token = token
+	svc.recordLogin(ctx, dbAcc, session, mpb.ELoginMethod_Method_Wallet)
 
 	if dbAcc.Account == "" { // acc not bind email
 		return res, nil
@@ -296,6 +593,104 @@ func (svc *AccountService) WebLoginByWallet(ctx context.Context, req *mpb.ReqWeb
 	return res, nil
 }
 
+func (svc *AccountService) LinkWallet(ctx context.Context, req *mpb.ReqLinkWallet) (*mpb.ResListWallets, error) {
+	if req.UserId == 0 || req.WalletAddr == "" || len(req.PubKey) == 0 {
+		return nil, mpberr.ErrParam
+	}
+	err := svc.checkWalletMessage(ctx, req.WalletAddr, req.AptosFullMsg)
+	if err != nil {
+		return nil, err
+	}
+
+	dbAcc, wallets, err := svc.dao.linkWallet(ctx, req.UserId, req.WalletAddr, req.PubKey)
+	if err != nil {
+		return nil, err
+	}
+	return &mpb.ResListWallets{Wallets: svc.DBLinkedWallets2WalletInfos(dbAcc, wallets)}, nil
+}
+
+func (svc *AccountService) UnlinkWallet(ctx context.Context, req *mpb.ReqWalletAddr) (*mpb.ResListWallets, error) {
+	if req.UserId == 0 || req.WalletAddr == "" {
+		return nil, mpberr.ErrParam
+	}
+
+	dbAcc, err := svc.dao.getAccountInfo(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	wallets, err := svc.dao.getLinkedWallets(ctx, dbAcc)
+	if err != nil {
+		return nil, err
+	}
+	var removed *mpb.DBLinkedWallet
+	for _, w := range wallets {
+		if w.WalletAddr == req.WalletAddr {
+			removed = w
+			break
+		}
+	}
+	if removed == nil {
+		return nil, mpberr.ErrWalletNotLinked
+	}
+	if len(wallets) == 1 && dbAcc.Email == "" {
+		return nil, mpberr.ErrWalletLastLogin
+	}
+
+	// nfts of the wallet should not be counted for the account any more. They are removed before the wallet, if the
+	// unlinking fails after this the nfts come back with the next refresh of the still linked wallet.
+	client, err := com.GetNFTServiceClient(ctx, svc)
+	if err != nil {
+		return nil, err
+	}
+	_, err = client.RemoveWalletNFTs(ctx, &mpb.ReqRemoveWalletNFTs{
+		UserId:     req.UserId,
+		WalletAddr: req.WalletAddr,
+		Legacy:     removed.Legacy,
+	})
+	if err != nil {
+		svc.logger.Error("UnlinkWallet RemoveWalletNFTs failed", zap.Uint64("user_id", req.UserId),
+			zap.String("wallet_addr", req.WalletAddr), zap.Error(err))
+		return nil, err
+	}
+
+	dbAcc, _, rest, err := svc.dao.unlinkWallet(ctx, req.UserId, req.WalletAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	// the access tokens may carry the unlinked wallet in their claims
+	err = svc.dao.revokeAccessTokens(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &mpb.ResListWallets{Wallets: svc.DBLinkedWallets2WalletInfos(dbAcc, rest)}, nil
+}
+
+func (svc *AccountService) SetPrimaryWallet(ctx context.Context, req *mpb.ReqWalletAddr) (*mpb.ResListWallets, error) {
+	if req.UserId == 0 || req.WalletAddr == "" {
+		return nil, mpberr.ErrParam
+	}
+
+	dbAcc, wallets, err := svc.dao.setPrimaryWallet(ctx, req.UserId, req.WalletAddr)
+	if err != nil {
+		return nil, err
+	}
+	return &mpb.ResListWallets{Wallets: svc.DBLinkedWallets2WalletInfos(dbAcc, wallets)}, nil
+}
+
+func (svc *AccountService) ListWallets(ctx context.Context, req *mpb.ReqUserId) (*mpb.ResListWallets, error) {
+	dbAcc, err := svc.dao.getAccountInfo(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	wallets, err := svc.dao.getLinkedWallets(ctx, dbAcc)
+	if err != nil {
+		return nil, err
+	}
+	return &mpb.ResListWallets{Wallets: svc.DBLinkedWallets2WalletInfos(dbAcc, wallets)}, nil
+}
+
 func (svc *AccountService) GenerateAndSendEmailBindCode(ctx context.Context, req *mpb.ReqGenerateAndSendEmailBindCode) (
 	*mpb.Empty, error) {
 
@@ -316,8 +711,9 @@ func (svc *AccountService) GenerateAndSendEmailBindCode(ctx context.Context, req
 	}
 
 	rpcReq := &mpb.ReqSendEmailBindCode{
-		Email: req.Email,
-		Code:  util.GenerateRandomCode(com.VCodeLen),
+		Email:    req.Email,
+		Code:     util.GenerateRandomCode(com.VCodeLen),
+		Language: req.Language,
 	}
 
 	err = svc.dao.saveEmailBindCode(ctx, req.Email, rpcReq.Code)
@@ -372,19 +768,16 @@ func (svc *AccountService) WebBindEmail(ctx context.Context, req *mpb.ReqWebBind
 		},
 	}
 
-	token, err := svc.generateLoginToken(dbAcc.UserId, dbAcc.Account, &mpb.Region{
-		Region: "", TcpGatewayId: "0"}, "", dbAcc.AptosAccAddr)
-	if err != nil {
-		return nil, err
-	}
-
-	err = svc.dao.saveToken(ctx, dbAcc.UserId, gcrypto.MD5SumStr(token), dbAcc.Account, "", "PC")
+	res.Token, res.RefreshToken, err = svc.issueToken(ctx, dbAcc, &mpb.DBSession{
+		Device:   req.Device,
+		DeviceId: req.DeviceId,
+		RemoteIp: req.RemoteIp,
+		Region:   req.Region,
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	res.Token = token
-
 	// get wallet resource
 	res.Resources, err = svc.getAptosResources(ctx, dbAcc.AptosAccAddr)
 	if err != nil {
@@ -393,25 +786,193 @@ func (svc *AccountService) WebBindEmail(ctx context.Context, req *mpb.ReqWebBind
 	return res, nil
 }
 
-func (svc *AccountService) ChangePassword(ctx context.Context, req *mpb.ReqChangePassword) (*mpb.Empty, error) {
-	if req.OldPassword == req.NewPassword {
-		return nil, mpberr.ErrNewPWSameWithOldPW
-	}
-	err := svc.dao.changePassword(ctx, req.UserId, req.OldPassword, req.NewPassword)
+func (svc *AccountService) SendEmailChangeCode(ctx context.Context, req *mpb.ReqSendEmailChangeCode) (*mpb.Empty,
+	error) {
+	dbAcc, err := svc.dao.getAccountInfo(ctx, req.UserId)
 	if err != nil {
 		return nil, err
 	}
-	return &mpb.Empty{}, nil
-}
-
-func (svc *AccountService) SendEmailResetPasswordCode(ctx context.Context, req *mpb.ReqSendEmailResetPasswordCode) (*mpb.Empty, error) {
-	// check mail exist
-	ok, err := svc.dao.checkEmailExist(ctx, req.Email)
+	if dbAcc.Email == "" {
+		return nil, mpberr.ErrEmailNotExist
+	}
+	if dbAcc.Email == req.NewEmail {
+		return nil, mpberr.ErrParam
+	}
+	ok, err := svc.dao.checkEmailExist(ctx, req.NewEmail)
 	if err != nil {
 		return nil, err
 	}
-	if !ok {
-		return nil, mpberr.ErrAccountNotExist
+	if ok {
+		return nil, mpberr.ErrEmailBound
+	}
+
+	client, err := com.GetAPIProxyGRPCClient(ctx, svc)
+	if err != nil {
+		return nil, err
+	}
+
+	// code for the new email
+	ok, err = svc.dao.checkEmailSendLimit(ctx, req.NewEmail)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, mpberr.ErrEmailSendMax
+	}
+	newReq := &mpb.ReqSendEmailChangeEmailCode{
+		Email: req.NewEmail,
+		Code:  util.GenerateRandomCode(com.VCodeLen),
+	}
+	err = svc.dao.saveEmailBindCode(ctx, req.NewEmail, newReq.Code)
+	if err != nil {
+		return nil, err
+	}
+	_, err = client.SendEmailChangeCode(ctx, newReq)
+	if err != nil {
+		return nil, err
+	}
+	if req.SkipOldEmail {
+		return &mpb.Empty{}, nil
+	}
+
+	// code for the old email
+	ok, err = svc.dao.checkEmailSendLimit(ctx, dbAcc.Email)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, mpberr.ErrEmailSendMax
+	}
+	oldReq := &mpb.ReqSendEmailChangeEmailCode{
+		Email:    dbAcc.Email,
+		Code:     util.GenerateRandomCode(com.VCodeLen),
+		NewEmail: req.NewEmail,
+	}
+	err = svc.dao.saveEmailChangeOldCode(ctx, req.UserId, oldReq.Code)
+	if err != nil {
+		return nil, err
+	}
+	_, err = client.SendEmailChangeCode(ctx, oldReq)
+	if err != nil {
+		return nil, err
+	}
+	return &mpb.Empty{}, nil
+}
+
+func (svc *AccountService) ChangeEmail(ctx context.Context, req *mpb.ReqChangeEmail) (*mpb.AccountInfo, error) {
+	if req.NewEmail == "" || req.NewCode == "" || (req.OldCode == "" && req.WalletAddr == "") {
+		return nil, mpberr.ErrParam
+	}
+	dbAcc, err := svc.dao.getAccountInfo(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if dbAcc.Email == "" {
+		return nil, mpberr.ErrEmailNotExist
+	}
+	if dbAcc.Email == req.NewEmail {
+		return nil, mpberr.ErrParam
+	}
+	if dbAcc.TotpEnabled && req.TotpCode == "" {
+		return nil, mpberr.ErrTOTPRequired
+	}
+
+	// check the old email, or the wallet if the old inbox is lost, before the code of the new email is consumed
+	if req.OldCode != "" {
+		ok, err := svc.dao.checkEmailChangeOldCode(ctx, req.UserId, req.OldCode)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, mpberr.ErrEmailVerificationCode
+		}
+	} else {
+		err = svc.checkLinkedWallet(ctx, dbAcc, req.WalletAddr)
+		if err != nil {
+			return nil, err
+		}
+		err = svc.checkWalletMessage(ctx, req.WalletAddr, req.AptosFullMsg)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// check the new email
+	ok, err := svc.dao.checkEmailBindCode(ctx, req.NewEmail, req.NewCode)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, mpberr.ErrEmailBindCode
+	}
+
+	// the totp after both proofs, so a wrong proof neither counts as a totp failure nor uses a recovery code
+	recoveryCode, err := svc.checkTOTP(ctx, dbAcc, req.TotpCode)
+	if err != nil {
+		return nil, err
+	}
+	err = svc.useTOTPRecoveryCode(ctx, req.UserId, recoveryCode)
+	if err != nil {
+		return nil, err
+	}
+	dbAcc, err = svc.dao.changeEmail(ctx, req.UserId, req.NewEmail)
+	if err != nil {
+		return nil, err
+	}
+	return svc.DBAccountInfo2AccountInfo(dbAcc), nil
+}
+
+// checkLinkedWallet check the wallet is linked to the account
+func (svc *AccountService) checkLinkedWallet(ctx context.Context, dbAcc *mpb.DBAccountInfo, walletAddr string) error {
+	wallets, err := svc.dao.getLinkedWallets(ctx, dbAcc)
+	if err != nil {
+		return err
+	}
+	for _, w := range wallets {
+		if w.WalletAddr == walletAddr {
+			return nil
+		}
+	}
+	return mpberr.ErrWalletNotLinked
+}
+
+func (svc *AccountService) ChangePassword(ctx context.Context, req *mpb.ReqChangePassword) (*mpb.Empty, error) {
+	if req.OldPassword == req.NewPassword {
+		return nil, mpberr.ErrNewPWSameWithOldPW
+	}
+	dbAcc, err := svc.dao.getAccountInfo(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	// the old password first, so a wrong one neither counts as a totp failure nor uses a recovery code
+	if dbAcc.Password != "" {
+		if ok, _ := util.VerifyPassword(dbAcc.Password, req.OldPassword); !ok {
+			return nil, mpberr.ErrPassword
+		}
+	}
+	recoveryCode, err := svc.checkTOTP(ctx, dbAcc, req.TotpCode)
+	if err != nil {
+		return nil, err
+	}
+	err = svc.dao.changePassword(ctx, req.UserId, req.OldPassword, req.NewPassword)
+	if err != nil {
+		return nil, err
+	}
+	err = svc.useTOTPRecoveryCode(ctx, req.UserId, recoveryCode)
+	if err != nil {
+		return nil, err
+	}
+	return &mpb.Empty{}, nil
+}
+
+func (svc *AccountService) SendEmailResetPasswordCode(ctx context.Context, req *mpb.ReqSendEmailResetPasswordCode) (*mpb.Empty, error) {
+	// check mail exist
+	ok, err := svc.dao.checkEmailExist(ctx, req.Email)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, mpberr.ErrAccountNotExist
 	}
 
 	// check send email limit
@@ -436,8 +997,9 @@ func (svc *AccountService) SendEmailResetPasswordCode(ctx context.Context, req *
 		return nil, err
 	}
 	_, err = client.SendEmailResetPasswordValidationCode(ctx, &mpb.ReqSendEmailResetPasswordValidationCode{
-		Email: req.Email,
-		Code:  code,
+		Email:    req.Email,
+		Code:     code,
+		Language: req.Language,
 	})
 	if err != nil {
 		return nil, err
@@ -447,12 +1009,18 @@ func (svc *AccountService) SendEmailResetPasswordCode(ctx context.Context, req *
 }
 
 func (svc *AccountService) CheckEmailResetPasswordCode(ctx context.Context, req *mpb.ReqCheckEmailResetPasswordCode) (*mpb.ResCheckEmailResetPasswordCode, error) {
+	err := svc.checkLoginLocked(ctx, req.Email, req.RemoteIp)
+	if err != nil {
+		return nil, err
+	}
+
 	// check v code
 	ok, err := svc.dao.checkEmailResetPasswordValidationCode(ctx, req.Email, req.Code)
 	if err != nil {
 		return nil, err
 	}
 	if !ok {
+		svc.addLoginFailure(ctx, req.Email, req.RemoteIp)
 		return nil, mpberr.ErrEmailVerificationCode
 	}
 	// generate nonce and save
@@ -466,51 +1034,257 @@ func (svc *AccountService) CheckEmailResetPasswordCode(ctx context.Context, req
 }
 
 func (svc *AccountService) ResetPasswordByEmail(ctx context.Context, req *mpb.ReqResetPasswordByEmail) (*mpb.Empty, error) {
+	err := svc.checkLoginLocked(ctx, req.Email, req.RemoteIp)
+	if err != nil {
+		return nil, err
+	}
+
+	dbAcc, err := svc.getResetPasswordAccount(ctx, svc.dao.getUserIdByEmail, req.Email, req.TotpCode)
+	if err != nil {
+		return nil, err
+	}
+
 	// check nonce
 	ok, err := svc.dao.checkEmailResetPasswordNonce(ctx, req.Email, req.Nonce)
 	if err != nil {
 		return nil, err
 	}
 	if !ok {
+		svc.addLoginFailure(ctx, req.Email, req.RemoteIp)
 		return nil, mpberr.ErrRepeatedRequest
 	}
 
-	userId, err := svc.dao.getUserIdByEmail(ctx, req.Email)
+	err = svc.resetPasswordWithTOTP(ctx, dbAcc, req.Password, req.TotpCode)
 	if err != nil {
 		return nil, err
 	}
+	svc.clearLoginFailures(ctx, req.Email, req.RemoteIp)
+	return &mpb.Empty{}, nil
+}
 
-	// reset password
-	err = svc.dao.resetPassword(ctx, userId, req.Password)
+func (svc *AccountService) ResetPasswordByEmailAndVCode(ctx context.Context, req *mpb.ReqResetPasswordByEmailAndVCode) (*mpb.Empty, error) {
+	err := svc.checkLoginLocked(ctx, req.Email, req.RemoteIp)
+	if err != nil {
+		return nil, err
+	}
+
+	dbAcc, err := svc.getResetPasswordAccount(ctx, svc.dao.getUserIdByEmail, req.Email, req.TotpCode)
 	if err != nil {
 		return nil, err
 	}
-	return &mpb.Empty{}, nil
-}
 
-func (svc *AccountService) ResetPasswordByEmailAndVCode(ctx context.Context, req *mpb.ReqResetPasswordByEmailAndVCode) (*mpb.Empty, error) {
 	// check v code
 	ok, err := svc.dao.checkEmailResetPasswordValidationCode(ctx, req.Email, req.Code)
 	if err != nil {
 		return nil, err
 	}
 	if !ok {
+		svc.addLoginFailure(ctx, req.Email, req.RemoteIp)
 		return nil, mpberr.ErrEmailVerificationCode
 	}
 
-	userId, err := svc.dao.getUserIdByEmail(ctx, req.Email)
+	err = svc.resetPasswordWithTOTP(ctx, dbAcc, req.Password, req.TotpCode)
+	if err != nil {
+		return nil, err
+	}
+	svc.clearLoginFailures(ctx, req.Email, req.RemoteIp)
+	return &mpb.Empty{}, nil
+}
+
+// getResetPasswordAccount get the account of the email or phone. The totp code is only required here, it is checked
+// by resetPasswordWithTOTP after the email or phone is proved, so nobody can fail the totp of an account without it.
+func (svc *AccountService) getResetPasswordAccount(ctx context.Context,
+	getUserId func(context.Context, string) (uint64, error), account, totpCode string) (*mpb.DBAccountInfo, error) {
+	userId, err := getUserId(ctx, account)
+	if err != nil {
+		return nil, err
+	}
+	dbAcc, err := svc.dao.resolveAccount(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+	if dbAcc.TotpEnabled && totpCode == "" {
+		return nil, mpberr.ErrTOTPRequired
+	}
+	return dbAcc, nil
+}
+
+// resetPasswordWithTOTP check the totp of the account and reset the password, a recovery code is used up last
+func (svc *AccountService) resetPasswordWithTOTP(ctx context.Context, dbAcc *mpb.DBAccountInfo, password,
+	totpCode string) error {
+	recoveryCode, err := svc.checkTOTP(ctx, dbAcc, totpCode)
+	if err != nil {
+		return err
+	}
+	err = svc.dao.resetPassword(ctx, dbAcc.UserId, password)
+	if err != nil {
+		return err
+	}
+	return svc.useTOTPRecoveryCode(ctx, dbAcc.UserId, recoveryCode)
+}
+
+func (svc *AccountService) EnrollTOTP(ctx context.Context, req *mpb.ReqUserId) (*mpb.ResEnrollTOTP, error) {
+	dbAcc, err := svc.dao.getAccountInfo(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if dbAcc.Email == "" {
+		return nil, mpberr.ErrEmailNotExist
+	}
+	if dbAcc.TotpEnabled {
+		return nil, mpberr.ErrTOTPEnabled
+	}
+
+	secret, err := util.GenerateTOTPSecret()
+	if err != nil {
+		svc.logger.Error("EnrollTOTP GenerateTOTPSecret failed", zap.Error(err))
+		return nil, mpberr.ErrUnknown
+	}
+	err = svc.dao.savePendingTOTPSecret(ctx, req.UserId, secret)
+	if err != nil {
+		return nil, err
+	}
+	return &mpb.ResEnrollTOTP{
+		Secret:     secret,
+		OtpauthUri: util.TOTPURI(com.TOTPIssuer, dbAcc.Email, secret),
+	}, nil
+}
+
+func (svc *AccountService) ConfirmTOTP(ctx context.Context, req *mpb.ReqTOTPCode) (*mpb.ResConfirmTOTP, error) {
+	secret, err := svc.dao.getPendingTOTPSecret(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	cnt, err := svc.dao.getTOTPFailures(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if cnt >= com.TOTPMaxFailures {
+		return nil, mpberr.ErrTOTPTooManyAttempts
+	}
+	counter, ok := util.ValidateTOTP(secret, req.Code, time.Now())
+	if !ok {
+		err = svc.dao.addTOTPFailure(ctx, req.UserId)
+		if err != nil {
+			return nil, err
+		}
+		return nil, mpberr.ErrTOTPCode
+	}
+	_, err = svc.dao.useTOTPCounter(ctx, req.UserId, counter)
+	if err != nil {
+		return nil, err
+	}
+
+	codes, err := util.GenerateRecoveryCodes(com.TOTPRecoveryCodeNum)
+	if err != nil {
+		svc.logger.Error("ConfirmTOTP GenerateRecoveryCodes failed", zap.Error(err))
+		return nil, mpberr.ErrUnknown
+	}
+	hashed := make([]string, 0, len(codes))
+	for _, code := range codes {
+		hashed = append(hashed, util.HashRecoveryCode(code))
+	}
+	err = svc.dao.enableTOTP(ctx, req.UserId, secret, hashed)
 	if err != nil {
 		return nil, err
 	}
+	svc.dao.clearTOTPFailures(ctx, req.UserId)
+	return &mpb.ResConfirmTOTP{RecoveryCodes: codes}, nil
+}
 
-	// reset password
-	err = svc.dao.resetPassword(ctx, userId, req.Password)
+func (svc *AccountService) DisableTOTP(ctx context.Context, req *mpb.ReqTOTPCode) (*mpb.Empty, error) {
+	dbAcc, err := svc.dao.getAccountInfo(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if !dbAcc.TotpEnabled {
+		return nil, mpberr.ErrTOTPNotEnabled
+	}
+	err = svc.verifyTOTP(ctx, dbAcc, req.Code)
+	if err != nil {
+		return nil, err
+	}
+	err = svc.dao.disableTOTP(ctx, req.UserId)
 	if err != nil {
 		return nil, err
 	}
 	return &mpb.Empty{}, nil
 }
 
+// verifyTOTP check the second factor of the account if it is enabled, code can be a totp code or a recovery code,
+// which is used up at once
+func (svc *AccountService) verifyTOTP(ctx context.Context, dbAcc *mpb.DBAccountInfo, code string) error {
+	recoveryCode, err := svc.checkTOTP(ctx, dbAcc, code)
+	if err != nil {
+		return err
+	}
+	return svc.useTOTPRecoveryCode(ctx, dbAcc.UserId, recoveryCode)
+}
+
+// checkTOTP check the second factor like verifyTOTP, but a recovery code is only checked. The hashed recovery code is
+// returned for useTOTPRecoveryCode once the request succeeds, it is empty for a totp code.
+func (svc *AccountService) checkTOTP(ctx context.Context, dbAcc *mpb.DBAccountInfo, code string) (string, error) {
+	if !dbAcc.TotpEnabled {
+		return "", nil
+	}
+	if code == "" {
+		return "", mpberr.ErrTOTPRequired
+	}
+
+	cnt, err := svc.dao.getTOTPFailures(ctx, dbAcc.UserId)
+	if err != nil {
+		return "", err
+	}
+	if cnt >= com.TOTPMaxFailures {
+		return "", mpberr.ErrTOTPTooManyAttempts
+	}
+
+	var ok bool
+	var recoveryCode string
+	if len(code) == com.TOTPCodeLen {
+		counter, valid := util.ValidateTOTP(dbAcc.TotpSecret, code, time.Now())
+		if valid {
+			ok, err = svc.dao.useTOTPCounter(ctx, dbAcc.UserId, counter)
+		}
+	} else {
+		hashed := util.HashRecoveryCode(code)
+		for _, c := range dbAcc.TotpRecoveryCodes {
+			if c == hashed {
+				ok, recoveryCode = true, hashed
+				break
+			}
+		}
+	}
+	if err != nil {
+		return "", err
+	}
+	if !ok {
+		err = svc.dao.addTOTPFailure(ctx, dbAcc.UserId)
+		if err != nil {
+			return "", err
+		}
+		return "", mpberr.ErrTOTPCode
+	}
+	svc.dao.clearTOTPFailures(ctx, dbAcc.UserId)
+	return recoveryCode, nil
+}
+
+// useTOTPRecoveryCode use up the recovery code returned by checkTOTP, it fails if the code is used by another request
+func (svc *AccountService) useTOTPRecoveryCode(ctx context.Context, userId uint64, recoveryCode string) error {
+	if recoveryCode == "" {
+		return nil
+	}
+	ok, err := svc.dao.useTOTPRecoveryCode(ctx, userId, recoveryCode)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return mpberr.ErrTOTPCode
+	}
+	return nil
+}
+
 func (svc *AccountService) GetAptosAccount(ctx context.Context, req *mpb.ReqUserId) (*mpb.ResGetAptosAccount, error) {
 	dbAcc, err := svc.dao.getAccountInfo(ctx, req.UserId)
 	if err != nil {
@@ -566,3 +1340,1284 @@ func (svc *AccountService) BatchGetAccountsByWalletAddrs(ctx context.Context, re
 	}
 	return res, nil
 }
+
+// AdminRepairAccounts is run manually by the GM page, page by page, to fix the wallet accounts left partial by the old
+// two-step creation. New accounts are created atomically and need no repair.
+func (svc *AccountService) AdminRepairAccounts(ctx context.Context, req *mpb.ReqAdminRepairAccounts) (
+	*mpb.ResAdminRepairAccounts, error) {
+	if req.Limit == 0 || req.Limit > com.DBBatchNum10000 {
+		req.Limit = com.DBBatchNum10000
+	}
+	repairs, next, err := svc.dao.repairAccounts(ctx, req.StartUserId, req.Limit, req.DryRun)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range repairs {
+		svc.logger.Info("AdminRepairAccounts", zap.Uint64("user_id", r.UserId), zap.String("wallet_addr", r.WalletAddr),
+			zap.String("action", r.Action.String()), zap.Uint64("live_user_id", r.LiveUserId),
+			zap.Bool("dry_run", req.DryRun))
+	}
+	return &mpb.ResAdminRepairAccounts{
+		Repairs:    repairs,
+		NextUserId: next,
+	}, nil
+}
+
+// RequestAccountDeletion schedule the account to be deleted after the grace period, it can be canceled before that.
+// The access token is not enough, the ownership is proved again and the request is added to the login history.
+func (svc *AccountService) RequestAccountDeletion(ctx context.Context, req *mpb.ReqRequestAccountDeletion) (
+	*mpb.ResRequestAccountDeletion, error) {
+	dbAcc, err := svc.dao.getAccountInfo(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if dbAcc.TotpEnabled && req.TotpCode == "" {
+		return nil, mpberr.ErrTOTPRequired
+	}
+	err = svc.checkDeletionProof(ctx, dbAcc, req)
+	if err != nil {
+		return nil, err
+	}
+	recoveryCode, err := svc.checkTOTP(ctx, dbAcc, req.TotpCode)
+	if err != nil {
+		return nil, err
+	}
+	err = svc.useTOTPRecoveryCode(ctx, req.UserId, recoveryCode)
+	if err != nil {
+		return nil, err
+	}
+
+	dbAcc, err = svc.dao.requestAccountDeletion(ctx, req.UserId, time.Now().Add(com.AccountDeletionGracePeriod).Unix())
+	if err != nil {
+		return nil, err
+	}
+
+	svc.logger.Info("RequestAccountDeletion", zap.Uint64("user_id", req.UserId),
+		zap.Int64("deletion_time", dbAcc.DeletionTime), zap.String("ip", req.RemoteIp))
+	_, err = svc.dao.addLoginRecord(ctx, req.UserId, &mpb.DBLoginRecord{
+		LoginTime: time.Now().Unix(),
+		RemoteIp:  req.RemoteIp,
+		Region:    req.Region,
+		Method:    uint32(mpb.ELoginMethod_Method_DeletionRequest),
+		Device:    req.Device,
+		DeviceId:  req.DeviceId,
+	})
+	if err != nil {
+		svc.logger.Error("RequestAccountDeletion addLoginRecord failed", zap.Uint64("user_id", req.UserId),
+			zap.Error(err))
+	}
+	if dbAcc.Email != "" {
+		client, err := com.GetAPIProxyGRPCClient(ctx, svc)
+		if err == nil {
+			_, err = client.SendEmailAccountDeletion(ctx, &mpb.ReqSendEmailAccountDeletion{
+				Email:        dbAcc.Email,
+				DeletionTime: dbAcc.DeletionTime,
+			})
+		}
+		if err != nil {
+			svc.logger.Error("RequestAccountDeletion SendEmailAccountDeletion failed",
+				zap.Uint64("user_id", req.UserId), zap.Error(err))
+		}
+	}
+	return &mpb.ResRequestAccountDeletion{DeletionTime: dbAcc.DeletionTime}, nil
+}
+
+// checkDeletionProof check the proof of the deletion request: the password, a linked wallet, a linked platform, or
+// the device id for a guest account which has none of them
+func (svc *AccountService) checkDeletionProof(ctx context.Context, dbAcc *mpb.DBAccountInfo,
+	req *mpb.ReqRequestAccountDeletion) error {
+	switch {
+	case req.Password != "":
+		if dbAcc.Password == "" {
+			return mpberr.ErrPassword
+		}
+		err := svc.checkLoginLocked(ctx, dbAcc.Account, req.RemoteIp)
+		if err != nil {
+			return err
+		}
+		ok, _ := util.VerifyPassword(dbAcc.Password, req.Password)
+		if !ok {
+			svc.addLoginFailure(ctx, dbAcc.Account, req.RemoteIp)
+			return mpberr.ErrPassword
+		}
+		svc.clearLoginFailures(ctx, dbAcc.Account, req.RemoteIp)
+		return nil
+	case req.WalletAddr != "":
+		err := svc.checkLinkedWallet(ctx, dbAcc, req.WalletAddr)
+		if err != nil {
+			return err
+		}
+		return svc.checkWalletMessage(ctx, req.WalletAddr, req.AptosFullMsg)
+	case req.IdToken != "":
+		identity, err := svc.verifyPlatformToken(ctx, req.Provider, req.IdToken, req.Nonce)
+		if err != nil {
+			return err
+		}
+		for _, link := range dbAcc.PlatformLinks {
+			if link.Provider == req.Provider && link.Subject == identity.Subject {
+				return nil
+			}
+		}
+		return mpberr.ErrPlatformToken
+	case req.DeviceId != "":
+		if !dbAcc.Guest || dbAcc.DeviceId != req.DeviceId {
+			return mpberr.ErrParam
+		}
+		return nil
+	}
+	return mpberr.ErrParam
+}
+
+func (svc *AccountService) CancelAccountDeletion(ctx context.Context, req *mpb.ReqUserId) (*mpb.Empty, error) {
+	err := svc.dao.cancelAccountDeletion(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	return &mpb.Empty{}, nil
+}
+
+// ExportAccountData return every personal data of the user in a machine-readable archive
+func (svc *AccountService) ExportAccountData(ctx context.Context, req *mpb.ReqUserId) (*mpb.ResExportAccountData, error) {
+	dbAcc, err := svc.dao.getAccountInfo(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	wallets, err := svc.dao.getLinkedWallets(ctx, dbAcc)
+	if err != nil {
+		return nil, err
+	}
+	sessions, err := svc.GetSessions(ctx, &mpb.ReqGetSessions{UserId: req.UserId})
+	if err != nil {
+		return nil, err
+	}
+	logins, err := svc.dao.getLoginHistory(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := com.GetNFTServiceClient(ctx, svc)
+	if err != nil {
+		return nil, err
+	}
+	// the stored nfts, an export should not spend the indexer quota of the wallets
+	nfts, err := client.GetStoredNFTs(ctx, &mpb.ReqUserId{UserId: req.UserId})
+	if err != nil {
+		return nil, err
+	}
+
+	archive, err := json.Marshal(&mpb.AccountDataArchive{
+		ExportTime:   time.Now().Unix(),
+		Account:      svc.DBAccountInfo2AccountInfo(dbAcc),
+		RegisterTime: dbAcc.RegisterTime,
+		Device:       dbAcc.Device,
+		Os:           dbAcc.Os,
+		Region:       dbAcc.Region,
+		Platform:     dbAcc.Platform,
+		Wallets:      svc.DBLinkedWallets2WalletInfos(dbAcc, wallets),
+		Sessions:     sessions.Sessions,
+		Nfts:         nfts.Nfts,
+		Logins:       svc.DBLoginRecords2LoginRecords(logins),
+	})
+	if err != nil {
+		svc.logger.Error("ExportAccountData Marshal failed", zap.Uint64("user_id", req.UserId), zap.Error(err))
+		return nil, mpberr.ErrUnknown
+	}
+	return &mpb.ResExportAccountData{Archive: string(archive)}, nil
+}
+
+// runAccountDeletionJob delete the accounts whose grace period is over, one instance runs the job in an interval
+func (svc *AccountService) runAccountDeletionJob(ctx context.Context) {
+	ticker := time.NewTicker(com.AccountDeletionJobInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		ok, err := svc.dao.lockAccountDeletionJob(ctx)
+		if err != nil || !ok {
+			continue
+		}
+		userIds, err := svc.dao.getDueAccountDeletions(ctx, time.Now().Unix())
+		if err != nil {
+			continue
+		}
+		for _, userId := range userIds {
+			err = svc.deleteAccount(ctx, userId)
+			if err != nil {
+				svc.logger.Error("runAccountDeletionJob deleteAccount failed", zap.Uint64("user_id", userId),
+					zap.Error(err))
+			}
+		}
+	}
+}
+
+func (svc *AccountService) deleteAccount(ctx context.Context, userId uint64) error {
+	dbAcc, err := svc.dao.getAccountInfo(ctx, userId)
+	if err == mpberr.ErrAccountNotExist {
+		return svc.dao.unscheduleAccountDeletion(ctx, userId)
+	} else if err != nil {
+		return err
+	}
+	if dbAcc.DeletionTime == 0 {
+		return svc.dao.unscheduleAccountDeletion(ctx, userId)
+	}
+	if dbAcc.DeletionTime > time.Now().Unix() {
+		return nil
+	}
+
+	wallets, err := svc.dao.getLinkedWallets(ctx, dbAcc)
+	if err != nil {
+		return err
+	}
+	addrs := make([]string, 0, len(wallets))
+	for _, w := range wallets {
+		addrs = append(addrs, w.WalletAddr)
+	}
+	client, err := com.GetNFTServiceClient(ctx, svc)
+	if err != nil {
+		return err
+	}
+	_, err = client.DeleteUserNFTs(ctx, &mpb.ReqDeleteUserNFTs{
+		UserId:      userId,
+		WalletAddrs: addrs,
+	})
+	if err != nil {
+		return err
+	}
+
+	err = svc.dao.deleteAccount(ctx, dbAcc, wallets)
+	if err != nil {
+		return err
+	}
+	svc.logger.Info("account deleted", zap.Uint64("user_id", userId))
+	return nil
+}
+
+func (svc *AccountService) UpdateProfile(ctx context.Context, req *mpb.ReqUpdateProfile) (*mpb.AccountInfo, error) {
+	req.Nickname = strings.TrimSpace(req.Nickname)
+	if req.UserId == 0 || (req.Nickname == "" && req.Icon == "") {
+		return nil, mpberr.ErrParam
+	}
+	dbAcc, err := svc.dao.getAccountInfo(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if banActive(dbAcc.Mute, time.Now().Unix()) {
+		return nil, mpberr.ErrAccountMuted
+	}
+
+	if req.Nickname == dbAcc.Nickname {
+		req.Nickname = ""
+	}
+	if req.Nickname != "" {
+		err = svc.checkNickname(req.Nickname)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if req.Icon == dbAcc.Icon {
+		req.Icon = ""
+	}
+	if req.Icon != "" && !checkIcon(req.Icon) {
+		return nil, mpberr.ErrIconInvalid
+	}
+	if req.Nickname == "" && req.Icon == "" {
+		return svc.DBAccountInfo2AccountInfo(dbAcc), nil
+	}
+
+	dbAcc, err = svc.dao.updateProfile(ctx, req.UserId, req.Nickname, req.Icon)
+	if err != nil {
+		return nil, err
+	}
+	return svc.DBAccountInfo2AccountInfo(dbAcc), nil
+}
+
+// checkNickname nicknames are letters, digits and underscores, the default nickname pattern is reserved
+func (svc *AccountService) checkNickname(nickname string) error {
+	n := utf8.RuneCountInString(nickname)
+	if n < com.NicknameMinLen || n > com.NicknameMaxLen {
+		return mpberr.ErrNicknameInvalid
+	}
+	for _, r := range nickname {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '_' {
+			return mpberr.ErrNicknameInvalid
+		}
+	}
+	prefix := com.DefaultNicknamePrefix
+	if len(nickname) > len(prefix) && strings.EqualFold(nickname[:len(prefix)], prefix) {
+		_, err := strconv.ParseUint(nickname[len(prefix):], 10, 64)
+		if err == nil {
+			return mpberr.ErrNicknameInvalid
+		}
+	}
+	if svc.rm.containsSensitiveWord(nickname) {
+		return mpberr.ErrNicknameSensitive
+	}
+	return nil
+}
+
+// checkIcon icons are the ids of the avatar resources of the client
+func checkIcon(icon string) bool {
+	if len(icon) > com.IconMaxLen {
+		return false
+	}
+	_, err := strconv.ParseUint(icon, 10, 64)
+	return err == nil
+}
+
+// LoginAsGuest log in the guest account of the device, the device id is the only credential of a guest account
+func (svc *AccountService) LoginAsGuest(ctx context.Context, req *mpb.ReqLoginAsGuest) (*mpb.ResLoginByPassword,
+	error) {
+	if req.DeviceId == "" {
+		return nil, mpberr.ErrParam
+	}
+
+	dbAcc, err := svc.dao.getGuestAccount(ctx, req.DeviceId, req.Device, req.Os, req.Region, req.Platform,
+		req.NewAccount)
+	if err != nil {
+		return nil, err
+	}
+
+	svc.logger.Info("LoginAsGuest", zap.Uint64("user_id", dbAcc.UserId), zap.String("device_id", req.DeviceId),
+		zap.String("device", req.Device), zap.String("os", req.Os), zap.String("client_version", req.ClientVersion),
+		zap.String("region", req.Region), zap.String("ip", req.RemoteIp))
+
+	return svc.finishLogin(ctx, dbAcc, &mpb.DBSession{
+		Device:   req.Device,
+		DeviceId: req.DeviceId,
+		RemoteIp: req.RemoteIp,
+		Region:   req.Region,
+	}, mpb.ELoginMethod_Method_Guest)
+}
+
+// UpgradeGuestByEmail attach a verified email and a password to the guest account, the user id is kept
+func (svc *AccountService) UpgradeGuestByEmail(ctx context.Context, req *mpb.ReqUpgradeGuestByEmail) (
+	*mpb.AccountInfo, error) {
+	if req.UserId == 0 || req.Email == "" || len(req.Password) != com.PasswordLen {
+		return nil, mpberr.ErrParam
+	}
+
+	ok, err := svc.dao.checkEmailBindCode(ctx, req.Email, req.Code)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, mpberr.ErrEmailBindCode
+	}
+
+	dbAcc, err := svc.dao.upgradeGuestByEmail(ctx, req.UserId, req.Email, req.Password)
+	if err != nil {
+		return nil, err
+	}
+	svc.logger.Info("UpgradeGuestByEmail", zap.Uint64("user_id", req.UserId), zap.String("email", req.Email))
+	return svc.DBAccountInfo2AccountInfo(dbAcc), nil
+}
+
+// UpgradeGuestByWallet link the first wallet to the guest account, the user id is kept
+func (svc *AccountService) UpgradeGuestByWallet(ctx context.Context, req *mpb.ReqLinkWallet) (*mpb.AccountInfo, error) {
+	if req.UserId == 0 || req.WalletAddr == "" || len(req.PubKey) == 0 {
+		return nil, mpberr.ErrParam
+	}
+	dbAcc, err := svc.dao.getAccountInfo(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if !dbAcc.Guest {
+		return nil, mpberr.ErrNotGuest
+	}
+	err = svc.checkWalletMessage(ctx, req.WalletAddr, req.AptosFullMsg)
+	if err != nil {
+		return nil, err
+	}
+
+	dbAcc, _, err = svc.dao.linkWallet(ctx, req.UserId, req.WalletAddr, req.PubKey)
+	if err != nil {
+		return nil, err
+	}
+	svc.logger.Info("UpgradeGuestByWallet", zap.Uint64("user_id", req.UserId),
+		zap.String("wallet_addr", req.WalletAddr))
+	return svc.DBAccountInfo2AccountInfo(dbAcc), nil
+}
+
+func loginLockTarget(t mpb.ELoginLock_Type, target string) string {
+	if t == mpb.ELoginLock_Type_Account || t == mpb.ELoginLock_Type_AccountIP {
+		target = strings.ToLower(target)
+	}
+	return strconv.Itoa(int(t)) + ":" + target
+}
+
+func parseLoginLockTarget(s string) (mpb.ELoginLock_Type, string) {
+	t, target, ok := strings.Cut(s, ":")
+	if !ok {
+		return mpb.ELoginLock_Type_None, s
+	}
+	n, _ := strconv.Atoi(t)
+	return mpb.ELoginLock_Type(n), target
+}
+
+// accountIPTarget the target locking the guesses of the account from the ip
+func accountIPTarget(account, ip string) string {
+	return loginLockTarget(mpb.ELoginLock_Type_AccountIP, account+"|"+ip)
+}
+
+// checkLoginLocked return ErrLoginLocked if the account from the ip, the account or the ip is locked by too many
+// failures
+func (svc *AccountService) checkLoginLocked(ctx context.Context, account, ip string) error {
+	targets := make([]string, 0, 3)
+	if account != "" {
+		targets = append(targets, accountIPTarget(account, ip), loginLockTarget(mpb.ELoginLock_Type_Account, account))
+	}
+	if ip != "" {
+		targets = append(targets, loginLockTarget(mpb.ELoginLock_Type_IP, ip))
+	}
+	for _, target := range targets {
+		unlockTime, err := svc.dao.getLoginLock(ctx, target)
+		if err != nil {
+			return err
+		}
+		if unlockTime > 0 {
+			return mpberr.ErrLoginLocked
+		}
+	}
+	return nil
+}
+
+// addLoginFailure count a failed guess against the account from the ip, the account and the ip, failures of the
+// counters are only logged. The account as a whole gets more free failures and only short locks, so a spray from many
+// ips is slowed down but nobody can lock the owner out for long by failing on purpose.
+func (svc *AccountService) addLoginFailure(ctx context.Context, account, ip string) {
+	if account != "" {
+		err := svc.dao.addLoginFailure(ctx, accountIPTarget(account, ip), com.LoginFreeFailuresPerAccount,
+			com.LoginLockMaxDuration)
+		if err != nil {
+			svc.logger.Error("addLoginFailure failed", zap.String("account", account), zap.Error(err))
+		}
+		err = svc.dao.addLoginFailure(ctx, loginLockTarget(mpb.ELoginLock_Type_Account, account),
+			com.LoginFreeFailuresAccount, com.LoginAccountLockMaxDuration)
+		if err != nil {
+			svc.logger.Error("addLoginFailure failed", zap.String("account", account), zap.Error(err))
+		}
+	}
+	if ip != "" {
+		err := svc.dao.addLoginFailure(ctx, loginLockTarget(mpb.ELoginLock_Type_IP, ip), com.LoginFreeFailuresPerIP,
+			com.LoginLockMaxDuration)
+		if err != nil {
+			svc.logger.Error("addLoginFailure failed", zap.String("ip", ip), zap.Error(err))
+		}
+	}
+}
+
+// clearLoginFailures clear the failures of the account from the ip after a success. The failures of the account
+// from every ip are kept until they expire, a success of the owner must not give a spray more free guesses.
+func (svc *AccountService) clearLoginFailures(ctx context.Context, account, ip string) {
+	err := svc.dao.clearLoginFailures(ctx, accountIPTarget(account, ip))
+	if err != nil {
+		svc.logger.Error("clearLoginFailures failed", zap.String("account", account), zap.String("ip", ip),
+			zap.Error(err))
+	}
+}
+
+// AdminGetLoginLocks return the failures and the lock of the target, or every locked target if no target is given.
+// The failures of the account from every ip are returned with those of the account from an ip.
+func (svc *AccountService) AdminGetLoginLocks(ctx context.Context, req *mpb.ReqAdminGetLoginLocks) (
+	*mpb.ResAdminGetLoginLocks, error) {
+	var targets []string
+	if req.Type != mpb.ELoginLock_Type_None {
+		if req.Target == "" {
+			return nil, mpberr.ErrParam
+		}
+		targets = []string{loginLockTarget(req.Type, req.Target)}
+		if req.Type == mpb.ELoginLock_Type_AccountIP {
+			account, _, _ := strings.Cut(req.Target, "|")
+			targets = append(targets, loginLockTarget(mpb.ELoginLock_Type_Account, account))
+		}
+	} else {
+		var err error
+		targets, err = svc.dao.getLockedLoginTargets(ctx, time.Now().Unix())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	res := &mpb.ResAdminGetLoginLocks{Locks: make([]*mpb.LoginLock, 0, len(targets))}
+	for _, target := range targets {
+		failures, err := svc.dao.getLoginFailures(ctx, target)
+		if err != nil {
+			return nil, err
+		}
+		unlockTime, err := svc.dao.getLoginLock(ctx, target)
+		if err != nil {
+			return nil, err
+		}
+		lock := &mpb.LoginLock{
+			Failures:   failures,
+			UnlockTime: unlockTime,
+		}
+		lock.Type, lock.Target = parseLoginLockTarget(target)
+		res.Locks = append(res.Locks, lock)
+	}
+	return res, nil
+}
+
+func (svc *AccountService) AdminClearLoginLock(ctx context.Context, req *mpb.ReqAdminClearLoginLock) (*mpb.Empty,
+	error) {
+	if req.Type == mpb.ELoginLock_Type_None || req.Target == "" {
+		return nil, mpberr.ErrParam
+	}
+	err := svc.dao.clearLoginFailures(ctx, loginLockTarget(req.Type, req.Target))
+	if err != nil {
+		return nil, err
+	}
+	svc.logger.Info("AdminClearLoginLock", zap.String("type", req.Type.String()), zap.String("target", req.Target))
+	return &mpb.Empty{}, nil
+}
+
+// recordLogin add the login to the history of the user and alert the user by email if it comes from a region or
+// a device never seen before. The first login is not alerted. Failures are only logged, the login goes on.
+func (svc *AccountService) recordLogin(ctx context.Context, dbAcc *mpb.DBAccountInfo, session *mpb.DBSession,
+	method mpb.ELoginMethod_Method) {
+	record := &mpb.DBLoginRecord{
+		LoginTime: time.Now().Unix(),
+		RemoteIp:  session.RemoteIp,
+		Region:    session.Region,
+		Method:    uint32(method),
+		Device:    session.Device,
+		DeviceId:  session.DeviceId,
+	}
+	prev, err := svc.dao.addLoginRecord(ctx, dbAcc.UserId, record)
+	if err != nil {
+		return
+	}
+	if dbAcc.Email == "" || len(prev) == 0 || !isNewLoginPlace(prev, record) {
+		return
+	}
+
+	client, err := com.GetAPIProxyGRPCClient(ctx, svc)
+	if err == nil {
+		_, err = client.SendEmailLoginAlert(ctx, &mpb.ReqSendEmailLoginAlert{
+			Email:     dbAcc.Email,
+			LoginTime: record.LoginTime,
+			RemoteIp:  record.RemoteIp,
+			Region:    record.Region,
+			Device:    record.Device,
+		})
+	}
+	if err != nil {
+		svc.logger.Error("recordLogin SendEmailLoginAlert failed", zap.Uint64("user_id", dbAcc.UserId),
+			zap.Error(err))
+	}
+}
+
+func isNewLoginPlace(prev []*mpb.DBLoginRecord, record *mpb.DBLoginRecord) bool {
+	newRegion, newDevice := true, true
+	for _, r := range prev {
+		if r.Region == record.Region {
+			newRegion = false
+		}
+		if r.DeviceId != "" && record.DeviceId != "" {
+			if r.DeviceId == record.DeviceId {
+				newDevice = false
+			}
+		} else if r.Device == record.Device {
+			newDevice = false
+		}
+	}
+	return newRegion || newDevice
+}
+
+func (svc *AccountService) GetLoginHistory(ctx context.Context, req *mpb.ReqUserId) (*mpb.ResGetLoginHistory, error) {
+	if req.UserId == 0 {
+		return nil, mpberr.ErrParam
+	}
+	records, err := svc.dao.getLoginHistory(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	return &mpb.ResGetLoginHistory{Records: svc.DBLoginRecords2LoginRecords(records)}, nil
+}
+
+// banActive return true if the ban record is in force
+func banActive(rec *mpb.DBBanRecord, now int64) bool {
+	return rec != nil && (rec.ExpireTime == 0 || rec.ExpireTime > now)
+}
+
+// banOutlasts check whether the ban record a is active and lasts at least as long as b
+func banOutlasts(a, b *mpb.DBBanRecord, now int64) bool {
+	return banActive(a, now) && (a.ExpireTime == 0 || (b != nil && b.ExpireTime != 0 && a.ExpireTime >= b.ExpireTime))
+}
+
+// AdminBanAccount ban, suspend or mute the user. A ban is permanent and a suspension lasts for the duration,
+// both refuse the logins and kick the user out. A mute without duration is permanent.
+func (svc *AccountService) AdminBanAccount(ctx context.Context, req *mpb.ReqAdminBanAccount) (*mpb.BanRecord, error) {
+	if req.UserId == 0 || req.Operator == "" || req.Duration < 0 {
+		return nil, mpberr.ErrParam
+	}
+	switch req.Type {
+	case mpb.EBan_Type_Ban:
+		if req.Duration != 0 {
+			return nil, mpberr.ErrParam
+		}
+	case mpb.EBan_Type_Suspend:
+		if req.Duration == 0 {
+			return nil, mpberr.ErrParam
+		}
+	case mpb.EBan_Type_Mute:
+	default:
+		return nil, mpberr.ErrParam
+	}
+
+	now := time.Now().Unix()
+	rec := &mpb.DBBanRecord{
+		Type:     uint32(req.Type),
+		Reason:   req.Reason,
+		Operator: req.Operator,
+		BanTime:  now,
+	}
+	if req.Duration > 0 {
+		rec.ExpireTime = now + req.Duration
+	}
+	err := svc.dao.banAccount(ctx, req.UserId, rec)
+	if err != nil {
+		return nil, err
+	}
+	err = svc.dao.addBanAuditLog(ctx, req.UserId, &mpb.DBBanAuditLog{
+		Type:       rec.Type,
+		Reason:     rec.Reason,
+		Operator:   rec.Operator,
+		OpTime:     now,
+		ExpireTime: rec.ExpireTime,
+	})
+	if err != nil {
+		return nil, err
+	}
+	svc.logger.Info("AdminBanAccount", zap.Uint64("user_id", req.UserId), zap.String("type", req.Type.String()),
+		zap.String("operator", req.Operator), zap.Int64("expire_time", rec.ExpireTime))
+	return svc.DBBanRecord2BanRecord(req.UserId, rec), nil
+}
+
+// AdminUnbanAccount lift the mute, or the login ban if the type is ban or suspend
+func (svc *AccountService) AdminUnbanAccount(ctx context.Context, req *mpb.ReqAdminUnbanAccount) (*mpb.Empty,
+	error) {
+	if req.UserId == 0 || req.Operator == "" || req.Type == mpb.EBan_Type_None {
+		return nil, mpberr.ErrParam
+	}
+
+	rec, err := svc.dao.unbanAccount(ctx, req.UserId, req.Type == mpb.EBan_Type_Mute)
+	if err != nil {
+		return nil, err
+	}
+	log := &mpb.DBBanAuditLog{
+		Type:     uint32(req.Type),
+		Unban:    true,
+		Reason:   req.Reason,
+		Operator: req.Operator,
+		OpTime:   time.Now().Unix(),
+	}
+	if rec != nil {
+		log.Type = rec.Type
+		log.ExpireTime = rec.ExpireTime
+	}
+	err = svc.dao.addBanAuditLog(ctx, req.UserId, log)
+	if err != nil {
+		return nil, err
+	}
+	svc.logger.Info("AdminUnbanAccount", zap.Uint64("user_id", req.UserId), zap.String("type", req.Type.String()),
+		zap.String("operator", req.Operator), zap.Bool("banned", rec != nil))
+	return &mpb.Empty{}, nil
+}
+
+// AdminListBans return the bans in force, the bans and the audit trail of the user if a user is given
+func (svc *AccountService) AdminListBans(ctx context.Context, req *mpb.ReqAdminListBans) (*mpb.ResAdminListBans,
+	error) {
+	types := []mpb.EBan_Type{mpb.EBan_Type_Ban, mpb.EBan_Type_Suspend, mpb.EBan_Type_Mute}
+	if req.Type != mpb.EBan_Type_None {
+		types = []mpb.EBan_Type{req.Type}
+	}
+	now := time.Now().Unix()
+	res := &mpb.ResAdminListBans{}
+	addBans := func(dbAcc *mpb.DBAccountInfo) {
+		for _, rec := range []*mpb.DBBanRecord{dbAcc.Ban, dbAcc.Mute} {
+			if !banActive(rec, now) {
+				continue
+			}
+			for _, t := range types {
+				if rec.Type == uint32(t) {
+					res.Bans = append(res.Bans, svc.DBBanRecord2BanRecord(dbAcc.UserId, rec))
+				}
+			}
+		}
+	}
+
+	if req.UserId != 0 {
+		dbAcc, err := svc.dao.getAccountInfo(ctx, req.UserId)
+		if err != nil {
+			return nil, err
+		}
+		addBans(dbAcc)
+		logs, err := svc.dao.getBanAuditLogs(ctx, req.UserId)
+		if err != nil {
+			return nil, err
+		}
+		res.Logs = svc.DBBanAuditLogs2BanAuditLogs(req.UserId, logs)
+		return res, nil
+	}
+
+	userIds := make([]uint64, 0)
+	for _, t := range types {
+		ids, err := svc.dao.getBannedUserIds(ctx, uint32(t), now)
+		if err != nil {
+			return nil, err
+		}
+		userIds = append(userIds, ids...)
+	}
+	if len(userIds) == 0 {
+		return res, nil
+	}
+	dbAccs, err := svc.dao.batchGetAccounts(ctx, userIds)
+	if err != nil {
+		return nil, mpberr.ErrDB
+	}
+	added := make(map[uint64]bool, len(dbAccs))
+	for _, dbAcc := range dbAccs {
+		if dbAcc == nil || added[dbAcc.UserId] { // nil if deleted
+			continue
+		}
+		added[dbAcc.UserId] = true
+		addBans(dbAcc)
+	}
+	return res, nil
+}
+
+// getReferrerId return the owner of the invite code, codes are case insensitive
+func (svc *AccountService) getReferrerId(ctx context.Context, code string) (uint64, error) {
+	if len(code) != com.InviteCodeLen {
+		return 0, mpberr.ErrReferrerCode
+	}
+	userId, err := svc.dao.getUserIdByInviteCode(ctx, strings.ToUpper(code))
+	if err != nil {
+		return 0, err
+	}
+	// the code of a merged account works for the kept one
+	dbAcc, err := svc.dao.resolveAccount(ctx, userId)
+	if err == mpberr.ErrAccountNotExist {
+		return 0, mpberr.ErrReferrerCode
+	} else if err != nil {
+		return 0, err
+	}
+	return dbAcc.UserId, nil
+}
+
+// applyReferralCode apply the referral of an account created by a login, the account exists already so a wrong
+// code is only logged and does not fail the login
+func (svc *AccountService) applyReferralCode(ctx context.Context, code string, dbAcc *mpb.DBAccountInfo,
+	session *mpb.DBSession) {
+	referrerId, err := svc.getReferrerId(ctx, code)
+	if err != nil {
+		svc.logger.Warn("applyReferralCode referrer code invalid", zap.Uint64("user_id", dbAcc.UserId),
+			zap.String("referrer_code", code), zap.Error(err))
+		return
+	}
+	svc.applyReferral(ctx, referrerId, dbAcc, session)
+}
+
+// applyReferral store the referral of the new account. Referrals failing the anti-abuse checks are stored as
+// rejected and not counted for the referrer. Failures are only logged, the registration goes on.
+func (svc *AccountService) applyReferral(ctx context.Context, referrerId uint64, dbAcc *mpb.DBAccountInfo,
+	session *mpb.DBSession) {
+	ref := &mpb.DBReferral{
+		UserId:       dbAcc.UserId,
+		ReferralTime: time.Now().Unix(),
+		DeviceId:     session.DeviceId,
+		RemoteIp:     session.RemoteIp,
+	}
+	reason, err := svc.checkReferral(ctx, referrerId, ref)
+	if err != nil {
+		svc.logger.Error("applyReferral checkReferral failed", zap.Uint64("user_id", dbAcc.UserId),
+			zap.Uint64("referrer_id", referrerId), zap.Error(err))
+		return
+	}
+	ref.RejectReason = uint32(reason)
+	err = svc.dao.addReferral(ctx, referrerId, ref)
+	if err != nil {
+		svc.logger.Error("applyReferral addReferral failed", zap.Uint64("user_id", dbAcc.UserId),
+			zap.Uint64("referrer_id", referrerId), zap.Error(err))
+		return
+	}
+	if reason == mpb.EReferralReject_Reason_None {
+		dbAcc.ReferrerId = referrerId
+	}
+	svc.logger.Info("Referral", zap.Uint64("user_id", dbAcc.UserId), zap.Uint64("referrer_id", referrerId),
+		zap.String("reject_reason", reason.String()))
+}
+
+// checkReferral reject self referrals, referrals to a muted referrer, referrals from a device or a subnet the
+// referrer logged in from, referrals from a subnet many invitees of the referrer come from, and referrals from
+// a device which has been referred before
+func (svc *AccountService) checkReferral(ctx context.Context, referrerId uint64, ref *mpb.DBReferral) (
+	mpb.EReferralReject_Reason, error) {
+	if referrerId == ref.UserId {
+		return mpb.EReferralReject_Reason_SelfReferral, nil
+	}
+	referrer, err := svc.dao.getAccountInfo(ctx, referrerId)
+	if err != nil {
+		return mpb.EReferralReject_Reason_None, err
+	}
+	if banActive(referrer.Mute, time.Now().Unix()) {
+		return mpb.EReferralReject_Reason_ReferrerMuted, nil
+	}
+	if ref.DeviceId != "" && referrer.DeviceId == ref.DeviceId {
+		return mpb.EReferralReject_Reason_SameDevice, nil
+	}
+	history, err := svc.dao.getLoginHistory(ctx, referrerId)
+	if err != nil {
+		return mpb.EReferralReject_Reason_None, err
+	}
+	subnet := util.IPSubnet(ref.RemoteIp)
+	for _, r := range history {
+		if ref.DeviceId != "" && r.DeviceId == ref.DeviceId {
+			return mpb.EReferralReject_Reason_SameDevice, nil
+		}
+		if subnet != "" && util.IPSubnet(r.RemoteIp) == subnet {
+			return mpb.EReferralReject_Reason_SameSubnet, nil
+		}
+	}
+	if subnet != "" {
+		refs, err := svc.dao.getReferrals(ctx, referrerId)
+		if err != nil {
+			return mpb.EReferralReject_Reason_None, err
+		}
+		cnt := 0
+		for _, r := range refs {
+			if r.RejectReason == uint32(mpb.EReferralReject_Reason_None) && util.IPSubnet(r.RemoteIp) == subnet {
+				cnt++
+			}
+		}
+		if cnt >= com.MaxReferralsPerSubnet {
+			return mpb.EReferralReject_Reason_SubnetUsed, nil
+		}
+	}
+	if ref.DeviceId != "" {
+		ok, err := svc.dao.claimReferralDevice(ctx, ref.DeviceId, ref.UserId)
+		if err != nil {
+			return mpb.EReferralReject_Reason_None, err
+		}
+		if !ok {
+			return mpb.EReferralReject_Reason_DeviceUsed, nil
+		}
+	}
+	return mpb.EReferralReject_Reason_None, nil
+}
+
+// GetReferralStats return the invite code of the user and the accepted referrals, the invite code is generated
+// on the first call
+func (svc *AccountService) GetReferralStats(ctx context.Context, req *mpb.ReqUserId) (*mpb.ResGetReferralStats,
+	error) {
+	if req.UserId == 0 {
+		return nil, mpberr.ErrParam
+	}
+	dbAcc, err := svc.dao.getAccountInfo(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	code, err := svc.dao.getOrCreateInviteCode(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	refs, err := svc.dao.getReferrals(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &mpb.ResGetReferralStats{
+		InviteCode: code,
+		ReferrerId: dbAcc.ReferrerId,
+	}
+	accepted := make([]*mpb.DBReferral, 0, len(refs))
+	for _, ref := range refs {
+		if ref.RejectReason != uint32(mpb.EReferralReject_Reason_None) {
+			res.RejectedCount++
+			continue
+		}
+		accepted = append(accepted, ref)
+	}
+	res.InvitedCount = uint32(len(accepted))
+	if len(accepted) == 0 {
+		return res, nil
+	}
+
+	sort.Slice(accepted, func(i, j int) bool {
+		return accepted[i].ReferralTime > accepted[j].ReferralTime
+	})
+	if len(accepted) > com.MaxReferralsListed {
+		accepted = accepted[:com.MaxReferralsListed]
+	}
+	userIds := make([]uint64, 0, len(accepted))
+	for _, ref := range accepted {
+		userIds = append(userIds, ref.UserId)
+	}
+	dbAccs, err := svc.dao.batchGetAccounts(ctx, userIds)
+	if err != nil {
+		return nil, mpberr.ErrDB
+	}
+	res.Referrals = svc.DBReferrals2Referrals(accepted, dbAccs)
+	return res, nil
+}
+
+// AdminGetTopReferrers return the referrers with the most accepted referrals
+func (svc *AccountService) AdminGetTopReferrers(ctx context.Context, req *mpb.ReqAdminGetTopReferrers) (
+	*mpb.ResAdminGetTopReferrers, error) {
+	limit := req.Limit
+	if limit == 0 {
+		limit = com.DefaultTopReferrers
+	} else if limit > com.MaxTopReferrers {
+		limit = com.MaxTopReferrers
+	}
+	userIds, counts, err := svc.dao.getTopReferrers(ctx, limit)
+	if err != nil {
+		return nil, err
+	}
+	res := &mpb.ResAdminGetTopReferrers{Referrers: make([]*mpb.ReferrerRank, 0, len(userIds))}
+	if len(userIds) == 0 {
+		return res, nil
+	}
+	dbAccs, err := svc.dao.batchGetAccounts(ctx, userIds)
+	if err != nil {
+		return nil, mpberr.ErrDB
+	}
+	for i, dbAcc := range dbAccs {
+		if dbAcc == nil { // deleted
+			continue
+		}
+		res.Referrers = append(res.Referrers, &mpb.ReferrerRank{
+			Account:      svc.DBAccountInfo2AccountInfo(dbAcc),
+			InvitedCount: counts[i],
+		})
+	}
+	return res, nil
+}
+
+// verifyPlatformToken verify the ID token by the provider and consume its nonce, so the token is accepted once
+func (svc *AccountService) verifyPlatformToken(ctx context.Context, provider, idToken, nonce string) (
+	*PlatformIdentity, error) {
+	if idToken == "" || nonce == "" {
+		return nil, mpberr.ErrParam
+	}
+	idp, ok := svc.idps[provider]
+	if !ok {
+		return nil, mpberr.ErrPlatformNotSupported
+	}
+	identity, err := idp.VerifyIDToken(ctx, idToken, nonce)
+	if err != nil {
+		return nil, err
+	}
+	ok, err = svc.dao.usePlatformNonce(ctx, provider, nonce, identity.ExpireTime)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, mpberr.ErrPlatformToken
+	}
+	return identity, nil
+}
+
+// LoginByPlatform login by the ID token of an identity provider, the account is keyed by the provider subject and
+// created on the first login
+func (svc *AccountService) LoginByPlatform(ctx context.Context, req *mpb.ReqLoginByPlatform) (
+	*mpb.ResLoginByPassword, error) {
+	identity, err := svc.verifyPlatformToken(ctx, req.Provider, req.IdToken, req.Nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	dbAcc, created, err := svc.dao.getAccountByPlatform(ctx, req.Provider, identity, req.Device, req.DeviceId,
+		req.Os, req.Region, req.Platform)
+	if err != nil {
+		return nil, err
+	}
+	session := &mpb.DBSession{
+		Device:   req.Device,
+		DeviceId: req.DeviceId,
+		RemoteIp: req.RemoteIp,
+		Region:   req.Region,
+	}
+	if created {
+		svc.logger.Info("Register Account", zap.Uint64("user_id", dbAcc.UserId), zap.String("provider", req.Provider),
+			zap.String("device", req.Device), zap.String("os", req.Os), zap.String("device_id", req.DeviceId),
+			zap.String("client_version", req.ClientVersion), zap.String("region", req.Region),
+			zap.String("ip", req.RemoteIp))
+		if req.ReferrerCode != "" {
+			svc.applyReferralCode(ctx, req.ReferrerCode, dbAcc, session)
+		}
+	}
+	return svc.finishLogin(ctx, dbAcc, session, mpb.ELoginMethod_Method_Platform)
+}
+
+// LinkPlatform link the identity of the ID token to the user
+func (svc *AccountService) LinkPlatform(ctx context.Context, req *mpb.ReqLinkPlatform) (*mpb.AccountInfo, error) {
+	if req.UserId == 0 {
+		return nil, mpberr.ErrParam
+	}
+	identity, err := svc.verifyPlatformToken(ctx, req.Provider, req.IdToken, req.Nonce)
+	if err != nil {
+		return nil, err
+	}
+	dbAcc, err := svc.dao.linkPlatform(ctx, req.UserId, req.Provider, identity)
+	if err != nil {
+		return nil, err
+	}
+	svc.logger.Info("LinkPlatform", zap.Uint64("user_id", req.UserId), zap.String("provider", req.Provider))
+	return svc.DBAccountInfo2AccountInfo(dbAcc), nil
+}
+
+// MergeAccount merge another account of the user into the logged-in one. The other account is proved by its email
+// and password or by a wallet linked to it, and the totp of both accounts is checked if enabled.
+func (svc *AccountService) MergeAccount(ctx context.Context, req *mpb.ReqMergeAccount) (*mpb.AccountInfo, error) {
+	if req.UserId == 0 || (req.Email == "") == (req.WalletAddr == "") {
+		return nil, mpberr.ErrParam
+	}
+	dbAcc, err := svc.dao.getAccountInfo(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if dbAcc.TotpEnabled && req.TotpCode == "" {
+		return nil, mpberr.ErrTOTPRequired
+	}
+
+	var merged *mpb.DBAccountInfo
+	if req.Email != "" {
+		merged, err = svc.checkMergePassword(ctx, req.Email, req.Password, req.RemoteIp)
+	} else {
+		merged, err = svc.checkMergeWallet(ctx, req.WalletAddr, req.AptosFullMsg)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if merged.UserId == req.UserId {
+		return nil, mpberr.ErrParam
+	}
+
+	// the totp of both accounts after the proof of the other one, the recovery codes are used only if all pass
+	recoveryCode, err := svc.checkTOTP(ctx, dbAcc, req.TotpCode)
+	if err != nil {
+		return nil, err
+	}
+	otherRecoveryCode, err := svc.checkTOTP(ctx, merged, req.OtherTotpCode)
+	if err != nil {
+		return nil, err
+	}
+	err = svc.useTOTPRecoveryCode(ctx, dbAcc.UserId, recoveryCode)
+	if err != nil {
+		return nil, err
+	}
+	err = svc.useTOTPRecoveryCode(ctx, merged.UserId, otherRecoveryCode)
+	if err != nil {
+		return nil, err
+	}
+
+	dbAcc, _, err = svc.mergeAccounts(ctx, req.UserId, merged.UserId, "", "")
+	if err != nil {
+		return nil, err
+	}
+	return svc.DBAccountInfo2AccountInfo(dbAcc), nil
+}
+
+// checkMergePassword return the account of the email if the password is right, the guesses are counted like logins
+func (svc *AccountService) checkMergePassword(ctx context.Context, email, password, ip string) (*mpb.DBAccountInfo,
+	error) {
+	err := svc.checkLoginLocked(ctx, email, ip)
+	if err != nil {
+		return nil, err
+	}
+	dbAcc, err := svc.dao.getAccountInfoByAccount(ctx, email)
+	if err == mpberr.ErrAccountNotExist {
+		svc.addLoginFailure(ctx, email, ip)
+	}
+	if err != nil {
+		return nil, err
+	}
+	ok, _ := util.VerifyPassword(dbAcc.Password, password)
+	if !ok {
+		svc.addLoginFailure(ctx, email, ip)
+		return nil, mpberr.ErrPassword
+	}
+	svc.clearLoginFailures(ctx, email, ip)
+	return dbAcc, nil
+}
+
+// checkMergeWallet return the account of the wallet if the sign in message is valid, no account is created
+func (svc *AccountService) checkMergeWallet(ctx context.Context, walletAddr, fullMsg string) (*mpb.DBAccountInfo,
+	error) {
+	err := svc.checkWalletMessage(ctx, walletAddr, fullMsg)
+	if err != nil {
+		return nil, err
+	}
+	wa, err := svc.dao.getWalletAcc(ctx, walletAddr)
+	if err != nil {
+		return nil, err
+	}
+	if wa.UserId == 0 {
+		return nil, mpberr.ErrAccountNotExist
+	}
+	return svc.dao.resolveAccount(ctx, wa.UserId)
+}
+
+// AdminMergeAccounts merge the accounts on behalf of the user after the operator has checked they are owned by
+// the same person. It can be called again to finish a merge which failed halfway.
+func (svc *AccountService) AdminMergeAccounts(ctx context.Context, req *mpb.ReqAdminMergeAccounts) (
+	*mpb.AccountMergeLog, error) {
+	if req.UserId == 0 || req.MergedUserId == 0 || req.UserId == req.MergedUserId || req.Operator == "" {
+		return nil, mpberr.ErrParam
+	}
+	_, log, err := svc.mergeAccounts(ctx, req.UserId, req.MergedUserId, req.Operator, req.Reason)
+	if err != nil {
+		return nil, err
+	}
+	return svc.DBAccountMergeLog2AccountMergeLog(req.UserId, log), nil
+}
+
+// mergeAccounts merge the account of mergedUserId into the account of userId and move the nfts of the merged
+// account. The nfts are moved after the accounts, a failed move is retried by merging the accounts again.
+func (svc *AccountService) mergeAccounts(ctx context.Context, userId, mergedUserId uint64, operator, reason string) (
+	*mpb.DBAccountInfo, *mpb.DBAccountMergeLog, error) {
+	log := &mpb.DBAccountMergeLog{
+		MergedUserId: mergedUserId,
+		Operator:     operator,
+		Reason:       reason,
+		MergeTime:    time.Now().Unix(),
+	}
+	dbAcc, log, err := svc.dao.mergeAccounts(ctx, userId, mergedUserId, log)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	client, err := com.GetNFTServiceClient(ctx, svc)
+	if err == nil {
+		_, err = client.MergeUserNFTs(ctx, &mpb.ReqMergeUserNFTs{UserId: userId, MergedUserId: mergedUserId})
+	}
+	if err != nil {
+		svc.logger.Error("mergeAccounts MergeUserNFTs failed", zap.Uint64("user_id", userId),
+			zap.Uint64("merged_user_id", mergedUserId), zap.Error(err))
+		return nil, nil, err
+	}
+
+	svc.logger.Info("accounts merged", zap.Uint64("user_id", userId), zap.Uint64("merged_user_id", mergedUserId),
+		zap.String("operator", operator), zap.String("reason", reason), zap.Strings("wallet_addrs", log.WalletAddrs),
+		zap.String("email", log.Email), zap.Strings("platforms", log.Platforms))
+	return dbAcc, log, nil
+}
+
+// SendSMSCode send a verification code to the phone, to bind it to the logged-in account or to reset the password
+// of the account it is bound to. Every send is counted in the daily limits of the account, the ip and the phone number
+// before the provider is called, guest accounts are free to create.
+func (svc *AccountService) SendSMSCode(ctx context.Context, req *mpb.ReqSendSMSCode) (*mpb.Empty, error) {
+	if !util.CheckTelNumber(req.Tel) {
+		return nil, mpberr.ErrPhoneNumber
+	}
+	var key string
+	switch req.Purpose {
+	case mpb.ESMSCode_Purpose_BindPhone:
+		dbAcc, err := svc.dao.getAccountInfo(ctx, req.UserId)
+		if err != nil {
+			return nil, err
+		}
+		if dbAcc.Tel != "" {
+			return nil, mpberr.ErrAccBoundPhone
+		}
+		ok, err := svc.dao.checkTelExist(ctx, req.Tel)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			return nil, mpberr.ErrPhoneBound
+		}
+		key = com.TelBindCodeKey(req.Tel)
+	case mpb.ESMSCode_Purpose_ResetPassword:
+		ok, err := svc.dao.checkTelExist(ctx, req.Tel)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, mpberr.ErrAccountNotExist
+		}
+		key = com.TelResetPasswordValidationCodeKey(req.Tel)
+	default:
+		return nil, mpberr.ErrParam
+	}
+
+	ok, err := svc.dao.checkSMSSendLimit(ctx, req.UserId, req.RemoteIp, req.Tel)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, mpberr.ErrSMSSendMax
+	}
+
+	code := util.GenerateRandomCode(com.VCodeLen)
+	err = svc.dao.saveSMSCode(ctx, key, code)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := com.GetAPIProxyGRPCClient(ctx, svc)
+	if err != nil {
+		return nil, err
+	}
+	_, err = client.SendSMSVerificationCode(ctx, &mpb.ReqSendSMSVerificationCode{
+		Tel:     req.Tel,
+		Code:    code,
+		Purpose: req.Purpose,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &mpb.Empty{}, nil
+}
+
+func (svc *AccountService) BindPhone(ctx context.Context, req *mpb.ReqBindPhone) (*mpb.AccountInfo, error) {
+	ok, err := svc.dao.checkSMSCode(ctx, com.TelBindCodeKey(req.Tel), req.Code)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, mpberr.ErrSMSVerificationCode
+	}
+
+	dbAcc, err := svc.dao.bindTel(ctx, req.UserId, req.Tel)
+	if err != nil {
+		return nil, err
+	}
+	return svc.DBAccountInfo2AccountInfo(dbAcc), nil
+}
+
+func (svc *AccountService) ResetPasswordByPhone(ctx context.Context, req *mpb.ReqResetPasswordByPhone) (*mpb.Empty,
+	error) {
+	err := svc.checkLoginLocked(ctx, req.Tel, req.RemoteIp)
+	if err != nil {
+		return nil, err
+	}
+
+	dbAcc, err := svc.getResetPasswordAccount(ctx, svc.dao.getUserIdByTel, req.Tel, req.TotpCode)
+	if err != nil {
+		return nil, err
+	}
+
+	// check v code
+	ok, err := svc.dao.checkSMSCode(ctx, com.TelResetPasswordValidationCodeKey(req.Tel), req.Code)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		svc.addLoginFailure(ctx, req.Tel, req.RemoteIp)
+		return nil, mpberr.ErrSMSVerificationCode
+	}
+
+	err = svc.resetPasswordWithTOTP(ctx, dbAcc, req.Password, req.TotpCode)
+	if err != nil {
+		return nil, err
+	}
+	svc.clearLoginFailures(ctx, req.Tel, req.RemoteIp)
+	return &mpb.Empty{}, nil
+}
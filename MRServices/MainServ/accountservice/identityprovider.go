@@ -0,0 +1,365 @@
+package accountservice
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/aureontu/MRWebServer/mr_services/mpberr"
+	"github.com/aureontu/MRWebServer/mr_services/util"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/oldjon/gutil/env"
+	"go.uber.org/zap"
+)
+
+const (
+	jwksCacheDuration      = time.Hour
+	jwksMinRefreshInterval = time.Minute
+	jwksMaxRetryInterval   = 30 * time.Minute
+	jwksFetchTimeout       = 10 * time.Second
+)
+
+// PlatformIdentity the user identity asserted by an identity provider
+type PlatformIdentity struct {
+	Subject       string
+	Email         string
+	EmailVerified bool
+	ExpireTime    time.Time // of the ID token
+}
+
+// verifiedEmail return the email only if the provider verified it, an unverified one may belong to someone else
+func (pi *PlatformIdentity) verifiedEmail() string {
+	if !pi.EmailVerified {
+		return ""
+	}
+	return pi.Email
+}
+
+// IdentityProvider validate the ID tokens issued by a third-party platform. The token must carry the nonce the
+// client passed to the platform, either raw or as its sha256 hex like apple does.
+type IdentityProvider interface {
+	Name() string
+	VerifyIDToken(ctx context.Context, rawToken, nonce string) (*PlatformIdentity, error)
+}
+
+type oidcProviderConfig struct {
+	issuers []string
+	jwksURL string
+}
+
+// defaultOIDCProviders the supported platforms, the issuers and the jwks url can be overridden by the config
+var defaultOIDCProviders = map[string]oidcProviderConfig{
+	"google": {
+		issuers: []string{"https://accounts.google.com", "accounts.google.com"},
+		jwksURL: "https://www.googleapis.com/oauth2/v3/certs",
+	},
+	"apple": {
+		issuers: []string{"https://appleid.apple.com"},
+		jwksURL: "https://appleid.apple.com/auth/keys",
+	},
+	"discord": {
+		issuers: []string{"https://discord.com"},
+		jwksURL: "https://discord.com/api/oauth2/keys",
+	},
+}
+
+// newIdentityProviders create the providers configured under identity_providers.<name>, a provider is enabled
+// only if its client_ids are configured. issuers and jwks_url are optional.
+func newIdentityProviders(logger *zap.Logger, config env.ModuleConfig) map[string]IdentityProvider {
+	idps := make(map[string]IdentityProvider)
+	client := util.NewPooledHTTPClient()
+	for name, def := range defaultOIDCProviders {
+		prefix := "identity_providers." + name + "."
+		clientIds := config.GetStringSlice(prefix + "client_ids")
+		if len(clientIds) == 0 {
+			continue
+		}
+		issuers := config.GetStringSlice(prefix + "issuers")
+		if len(issuers) == 0 {
+			issuers = def.issuers
+		}
+		jwksURL := config.GetString(prefix + "jwks_url")
+		if jwksURL == "" {
+			jwksURL = def.jwksURL
+		}
+		idps[name] = newOIDCProvider(logger, client, name, issuers, jwksURL, clientIds)
+		logger.Info("identity provider enabled", zap.String("name", name), zap.Strings("issuers", issuers),
+			zap.String("jwks_url", jwksURL))
+	}
+	return idps
+}
+
+// oidcProvider validate OIDC ID tokens by the keys published at the jwks url of the issuer
+type oidcProvider struct {
+	logger    *zap.Logger
+	client    *http.Client
+	name      string
+	issuers   []string
+	jwksURL   string
+	audiences []string
+
+	mu        sync.Mutex
+	keys      map[string]crypto.PublicKey // kid -> key
+	fetchTime time.Time                   // of the last successful fetch
+	retryTime time.Time                   // no fetch before it
+	failures  int                         // fetch failures in a row
+
+	fetchMu sync.Mutex // serialize the fetches, the cached keys are read without waiting for a fetch
+}
+
+func newOIDCProvider(logger *zap.Logger, client *http.Client, name string, issuers []string, jwksURL string,
+	audiences []string) *oidcProvider {
+	return &oidcProvider{
+		logger:    logger,
+		client:    client,
+		name:      name,
+		issuers:   issuers,
+		jwksURL:   jwksURL,
+		audiences: audiences,
+	}
+}
+
+func (p *oidcProvider) Name() string {
+	return p.name
+}
+
+type oidcClaims struct {
+	Email         string `json:"email"`
+	EmailVerified any    `json:"email_verified"` // apple sends "true" as a string
+	Nonce         string `json:"nonce"`
+	jwt.RegisteredClaims
+}
+
+func (p *oidcProvider) VerifyIDToken(ctx context.Context, rawToken, nonce string) (*PlatformIdentity, error) {
+	if nonce == "" {
+		return nil, mpberr.ErrPlatformToken
+	}
+	claims := &oidcClaims{}
+	_, err := jwt.ParseWithClaims(rawToken, claims, func(token *jwt.Token) (interface{}, error) {
+		kid, _ := token.Header["kid"].(string)
+		return p.getKey(ctx, kid)
+	}, jwt.WithValidMethods([]string{"RS256", "ES256"}))
+	if err != nil {
+		p.logger.Warn("VerifyIDToken parse failed", zap.String("provider", p.name), zap.Error(err))
+		return nil, mpberr.ErrPlatformToken
+	}
+
+	// exp is checked by the parser only if it is present
+	if claims.ExpiresAt == nil || !p.checkIssuer(claims.Issuer) || !p.checkAudience(claims.Audience) ||
+		claims.Subject == "" || !checkIDTokenNonce(claims.Nonce, nonce) {
+		p.logger.Warn("VerifyIDToken claims mismatch", zap.String("provider", p.name),
+			zap.String("iss", claims.Issuer), zap.Strings("aud", claims.Audience))
+		return nil, mpberr.ErrPlatformToken
+	}
+
+	identity := &PlatformIdentity{
+		Subject:    claims.Subject,
+		Email:      claims.Email,
+		ExpireTime: claims.ExpiresAt.Time,
+	}
+	switch v := claims.EmailVerified.(type) {
+	case bool:
+		identity.EmailVerified = v
+	case string:
+		identity.EmailVerified = v == "true"
+	}
+	return identity, nil
+}
+
+// checkIDTokenNonce check the nonce claim against the nonce of the client, raw or sha256 hex
+func checkIDTokenNonce(claim, nonce string) bool {
+	if claim == "" {
+		return false
+	}
+	sum := sha256.Sum256([]byte(nonce))
+	return claim == nonce || claim == hex.EncodeToString(sum[:])
+}
+
+func (p *oidcProvider) checkIssuer(iss string) bool {
+	for _, v := range p.issuers {
+		if v == iss {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *oidcProvider) checkAudience(aud jwt.ClaimStrings) bool {
+	for _, a := range aud {
+		for _, v := range p.audiences {
+			if a == v {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// getKey return the key of the kid, the keys are fetched again if they are outdated or the kid is unknown.
+// A fetch follows the last one after jwksMinRefreshInterval at least, and the interval doubles on every failure
+// up to jwksMaxRetryInterval, so unknown kids do not hammer an issuer which is down.
+func (p *oidcProvider) getKey(ctx context.Context, kid string) (crypto.PublicKey, error) {
+	key, ok, fetch := p.cachedKey(kid)
+	if !fetch {
+		if !ok {
+			return nil, fmt.Errorf("unknown kid %s", kid)
+		}
+		return key, nil
+	}
+
+	p.fetchMu.Lock()
+	defer p.fetchMu.Unlock()
+	// the keys may have been fetched while waiting
+	key, ok, fetch = p.cachedKey(kid)
+	if !fetch {
+		if !ok {
+			return nil, fmt.Errorf("unknown kid %s", kid)
+		}
+		return key, nil
+	}
+
+	keys, err := p.fetchKeys(ctx)
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	now := time.Now()
+	if err != nil {
+		p.failures++
+		retry := jwksMaxRetryInterval
+		if n := p.failures - 1; n < 16 && jwksMinRefreshInterval<<n < retry {
+			retry = jwksMinRefreshInterval << n
+		}
+		p.retryTime = now.Add(retry)
+		if ok { // keep using the cached key while the issuer is unreachable
+			return key, nil
+		}
+		return nil, err
+	}
+	p.keys = keys
+	p.fetchTime = now
+	p.retryTime = now.Add(jwksMinRefreshInterval)
+	p.failures = 0
+	key, ok = p.keys[kid]
+	if !ok {
+		return nil, fmt.Errorf("unknown kid %s", kid)
+	}
+	return key, nil
+}
+
+// cachedKey return the cached key of the kid and whether the keys should be fetched now
+func (p *oidcProvider) cachedKey(kid string) (crypto.PublicKey, bool, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	key, ok := p.keys[kid]
+	if ok && time.Since(p.fetchTime) < jwksCacheDuration {
+		return key, true, false
+	}
+	return key, ok, !time.Now().Before(p.retryTime)
+}
+
+type jwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+func (p *oidcProvider) fetchKeys(ctx context.Context) (map[string]crypto.PublicKey, error) {
+	ctx, cancel := util.CloneContextWithTimeout(ctx, jwksFetchTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.jwksURL, nil)
+	if err != nil {
+		p.logger.Error("fetchKeys NewRequest failed", zap.String("url", p.jwksURL), zap.Error(err))
+		return nil, err
+	}
+	res, err := p.client.Do(req)
+	if err != nil {
+		p.logger.Error("fetchKeys Do failed", zap.String("url", p.jwksURL), zap.Error(err))
+		return nil, err
+	}
+	defer func() {
+		_ = res.Body.Close()
+	}()
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		p.logger.Error("fetchKeys bad status", zap.String("url", p.jwksURL), zap.Int("status", res.StatusCode))
+		return nil, fmt.Errorf("jwks status %d", res.StatusCode)
+	}
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		p.logger.Error("fetchKeys ReadAll failed", zap.String("url", p.jwksURL), zap.Error(err))
+		return nil, err
+	}
+	var set struct {
+		Keys []*jwk `json:"keys"`
+	}
+	err = json.Unmarshal(body, &set)
+	if err != nil {
+		p.logger.Error("fetchKeys Unmarshal failed", zap.String("url", p.jwksURL), zap.Error(err))
+		return nil, err
+	}
+
+	keys := make(map[string]crypto.PublicKey, len(set.Keys))
+	for _, k := range set.Keys {
+		if k.Use != "" && k.Use != "sig" {
+			continue
+		}
+		key, err := k.publicKey()
+		if err != nil {
+			p.logger.Warn("fetchKeys skip key", zap.String("url", p.jwksURL), zap.String("kid", k.Kid),
+				zap.Error(err))
+			continue
+		}
+		keys[k.Kid] = key
+	}
+	if len(keys) == 0 {
+		p.logger.Error("fetchKeys no key", zap.String("url", p.jwksURL))
+		return nil, errors.New("no key in jwks")
+	}
+	return keys, nil
+}
+
+func (k *jwk) publicKey() (crypto.PublicKey, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := base64.RawURLEncoding.DecodeString(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := base64.RawURLEncoding.DecodeString(k.E)
+		if err != nil {
+			return nil, err
+		}
+		return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}, nil
+	case "EC":
+		if k.Crv != "P-256" {
+			return nil, fmt.Errorf("unsupported curve %s", k.Crv)
+		}
+		x, err := base64.RawURLEncoding.DecodeString(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := base64.RawURLEncoding.DecodeString(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		return &ecdsa.PublicKey{Curve: elliptic.P256(), X: new(big.Int).SetBytes(x), Y: new(big.Int).SetBytes(y)}, nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %s", k.Kty)
+	}
+}
@@ -0,0 +1,247 @@
+package accountservice
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/aureontu/MRWebServer/mr_services/mpberr"
+	"github.com/golang-jwt/jwt/v5"
+	"go.uber.org/zap"
+)
+
+const (
+	testIssuer   = "https://idp.test"
+	testAudience = "mirrorrealms"
+	testNonce    = "n-0S6_WzA2Mj"
+)
+
+// fakeIdP serve the jwks of an rsa and an ec key and sign the ID tokens by them
+type fakeIdP struct {
+	rsaKey  *rsa.PrivateKey
+	ecKey   *ecdsa.PrivateKey
+	fetches int32
+	server  *httptest.Server
+}
+
+func newFakeIdP(t *testing.T) *fakeIdP {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	idp := &fakeIdP{rsaKey: rsaKey, ecKey: ecKey}
+	b64 := func(i *big.Int) string { return base64.RawURLEncoding.EncodeToString(i.Bytes()) }
+	jwks, _ := json.Marshal(map[string]any{"keys": []*jwk{
+		{Kty: "RSA", Kid: "rsa", Use: "sig", N: b64(rsaKey.N), E: b64(big.NewInt(int64(rsaKey.E)))},
+		{Kty: "EC", Kid: "ec", Crv: "P-256", X: b64(ecKey.X), Y: b64(ecKey.Y)},
+		{Kty: "RSA", Kid: "enc", Use: "enc", N: b64(rsaKey.N), E: b64(big.NewInt(int64(rsaKey.E)))},
+	}})
+	idp.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&idp.fetches, 1)
+		_, _ = w.Write(jwks)
+	}))
+	t.Cleanup(idp.server.Close)
+	return idp
+}
+
+func (idp *fakeIdP) sign(t *testing.T, method jwt.SigningMethod, kid string, claims jwt.MapClaims) string {
+	token := jwt.NewWithClaims(method, claims)
+	token.Header["kid"] = kid
+	var key any = idp.rsaKey
+	if method == jwt.SigningMethodES256 {
+		key = idp.ecKey
+	}
+	raw, err := token.SignedString(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return raw
+}
+
+func (idp *fakeIdP) provider() *oidcProvider {
+	return newOIDCProvider(zap.NewNop(), idp.server.Client(), "test", []string{testIssuer}, idp.server.URL,
+		[]string{testAudience})
+}
+
+func TestOIDCProviderVerifyIDToken(t *testing.T) {
+	idp := newFakeIdP(t)
+	hashed := sha256.Sum256([]byte(testNonce))
+	exp := time.Now().Add(time.Hour).Truncate(time.Second)
+	claims := func(modify func(c jwt.MapClaims)) jwt.MapClaims {
+		c := jwt.MapClaims{
+			"iss":            testIssuer,
+			"aud":            testAudience,
+			"sub":            "user-1",
+			"exp":            exp.Unix(),
+			"nonce":          testNonce,
+			"email":          "a@b.io",
+			"email_verified": true,
+		}
+		if modify != nil {
+			modify(c)
+		}
+		return c
+	}
+	cases := []struct {
+		name     string
+		method   jwt.SigningMethod
+		kid      string
+		claims   jwt.MapClaims
+		nonce    string
+		err      error
+		verified bool
+	}{
+		{name: "rsa", method: jwt.SigningMethodRS256, kid: "rsa", claims: claims(nil), nonce: testNonce,
+			verified: true},
+		{name: "ec", method: jwt.SigningMethodES256, kid: "ec", claims: claims(nil), nonce: testNonce, verified: true},
+		{name: "hashed nonce", method: jwt.SigningMethodRS256, kid: "rsa",
+			claims: claims(func(c jwt.MapClaims) { c["nonce"] = hex.EncodeToString(hashed[:]) }), nonce: testNonce,
+			verified: true},
+		{name: "email verified as string", method: jwt.SigningMethodRS256, kid: "rsa",
+			claims: claims(func(c jwt.MapClaims) { c["email_verified"] = "true" }), nonce: testNonce, verified: true},
+		{name: "email not verified", method: jwt.SigningMethodRS256, kid: "rsa",
+			claims: claims(func(c jwt.MapClaims) { delete(c, "email_verified") }), nonce: testNonce},
+		{name: "audience in list", method: jwt.SigningMethodRS256, kid: "rsa",
+			claims: claims(func(c jwt.MapClaims) { c["aud"] = []string{"other", testAudience} }), nonce: testNonce,
+			verified: true},
+		{name: "no nonce of client", method: jwt.SigningMethodRS256, kid: "rsa", claims: claims(nil),
+			err: mpberr.ErrPlatformToken},
+		{name: "other nonce", method: jwt.SigningMethodRS256, kid: "rsa", claims: claims(nil), nonce: "other",
+			err: mpberr.ErrPlatformToken},
+		{name: "no nonce claim", method: jwt.SigningMethodRS256, kid: "rsa",
+			claims: claims(func(c jwt.MapClaims) { delete(c, "nonce") }), nonce: testNonce,
+			err: mpberr.ErrPlatformToken},
+		{name: "other issuer", method: jwt.SigningMethodRS256, kid: "rsa",
+			claims: claims(func(c jwt.MapClaims) { c["iss"] = "https://evil.test" }), nonce: testNonce,
+			err: mpberr.ErrPlatformToken},
+		{name: "other audience", method: jwt.SigningMethodRS256, kid: "rsa",
+			claims: claims(func(c jwt.MapClaims) { c["aud"] = "other" }), nonce: testNonce,
+			err: mpberr.ErrPlatformToken},
+		{name: "no subject", method: jwt.SigningMethodRS256, kid: "rsa",
+			claims: claims(func(c jwt.MapClaims) { delete(c, "sub") }), nonce: testNonce,
+			err: mpberr.ErrPlatformToken},
+		{name: "expired", method: jwt.SigningMethodRS256, kid: "rsa",
+			claims: claims(func(c jwt.MapClaims) { c["exp"] = time.Now().Add(-time.Minute).Unix() }), nonce: testNonce,
+			err: mpberr.ErrPlatformToken},
+		{name: "no expiry", method: jwt.SigningMethodRS256, kid: "rsa",
+			claims: claims(func(c jwt.MapClaims) { delete(c, "exp") }), nonce: testNonce,
+			err: mpberr.ErrPlatformToken},
+		{name: "unknown kid", method: jwt.SigningMethodRS256, kid: "other", claims: claims(nil), nonce: testNonce,
+			err: mpberr.ErrPlatformToken},
+		{name: "encryption key", method: jwt.SigningMethodRS256, kid: "enc", claims: claims(nil), nonce: testNonce,
+			err: mpberr.ErrPlatformToken},
+		{name: "key of other kid", method: jwt.SigningMethodES256, kid: "rsa", claims: claims(nil), nonce: testNonce,
+			err: mpberr.ErrPlatformToken},
+		{name: "hmac", method: jwt.SigningMethodHS256, kid: "rsa", claims: claims(nil), nonce: testNonce,
+			err: mpberr.ErrPlatformToken},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			var raw string
+			if c.method == jwt.SigningMethodHS256 {
+				token := jwt.NewWithClaims(c.method, c.claims)
+				token.Header["kid"] = c.kid
+				raw, _ = token.SignedString([]byte("secret"))
+			} else {
+				raw = idp.sign(t, c.method, c.kid, c.claims)
+			}
+			identity, err := idp.provider().VerifyIDToken(context.Background(), raw, c.nonce)
+			if err != c.err {
+				t.Fatalf("err = %v, want %v", err, c.err)
+			}
+			if err != nil {
+				return
+			}
+			if identity.Subject != "user-1" || identity.Email != "a@b.io" || !identity.ExpireTime.Equal(exp) {
+				t.Fatalf("identity = %+v", identity)
+			}
+			if identity.EmailVerified != c.verified {
+				t.Fatalf("email verified = %v, want %v", identity.EmailVerified, c.verified)
+			}
+		})
+	}
+}
+
+func TestOIDCProviderKeyFetches(t *testing.T) {
+	idp := newFakeIdP(t)
+	p := idp.provider()
+	ctx := context.Background()
+	token := func(kid string) string {
+		return idp.sign(t, jwt.SigningMethodRS256, kid, jwt.MapClaims{
+			"iss": testIssuer, "aud": testAudience, "sub": "user-1", "exp": time.Now().Add(time.Hour).Unix(),
+			"nonce": testNonce,
+		})
+	}
+
+	for i := 0; i < 3; i++ {
+		if _, err := p.VerifyIDToken(ctx, token("rsa"), testNonce); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if atomic.LoadInt32(&idp.fetches) != 1 {
+		t.Fatalf("fetches = %d, want the keys cached", idp.fetches)
+	}
+	for i := 0; i < 3; i++ {
+		if _, err := p.VerifyIDToken(ctx, token("other"), testNonce); err != mpberr.ErrPlatformToken {
+			t.Fatalf("err = %v", err)
+		}
+	}
+	if atomic.LoadInt32(&idp.fetches) != 1 {
+		t.Fatalf("fetches = %d, want unknown kids throttled", idp.fetches)
+	}
+
+	// an issuer which is down is retried with backoff, the cached keys are still used
+	idp.server.Close()
+	p.mu.Lock()
+	p.fetchTime = time.Now().Add(-jwksCacheDuration)
+	p.retryTime = time.Time{}
+	p.mu.Unlock()
+	if _, err := p.VerifyIDToken(ctx, token("rsa"), testNonce); err != nil {
+		t.Fatalf("err = %v, want the cached key used", err)
+	}
+	p.mu.Lock()
+	failures, retry := p.failures, time.Until(p.retryTime)
+	p.mu.Unlock()
+	if failures != 1 || retry <= 0 || retry > jwksMinRefreshInterval {
+		t.Fatalf("failures = %d, retry in %v", failures, retry)
+	}
+}
+
+func TestCheckIDTokenNonce(t *testing.T) {
+	hashed := sha256.Sum256([]byte(testNonce))
+	cases := []struct {
+		name  string
+		claim string
+		nonce string
+		ok    bool
+	}{
+		{name: "raw", claim: testNonce, nonce: testNonce, ok: true},
+		{name: "sha256 hex", claim: hex.EncodeToString(hashed[:]), nonce: testNonce, ok: true},
+		{name: "other", claim: "other", nonce: testNonce},
+		{name: "hash of claim", claim: testNonce, nonce: hex.EncodeToString(hashed[:])},
+		{name: "empty claim", claim: "", nonce: ""},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if ok := checkIDTokenNonce(c.claim, c.nonce); ok != c.ok {
+				t.Fatalf("checkIDTokenNonce(%q, %q) = %v, want %v", c.claim, c.nonce, ok, c.ok)
+			}
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"net/http"
 
+	com "github.com/aureontu/MRWebServer/mr_services/common"
 	"github.com/aureontu/MRWebServer/mr_services/util"
 	pb "github.com/golang/protobuf/proto"
 	"github.com/oldjon/gutil/conv"
@@ -127,5 +128,6 @@ func (ap *APIProxy) writeHTTPRes(w http.ResponseWriter, msg pb.Message) error {
 func (ap *APIProxy) helloWorld(w http.ResponseWriter, r *http.Request) error {
 	//_, err := w.Write([]byte("hello world"))
 
-	return APIProxyGRPCGetMe().sendEmailBindCode("lrunwow@gmail.com", "123456")
+	_, err := APIProxyGRPCGetMe().sendEmailBindCode(r.Context(), "lrunwow@gmail.com", com.CommonLanguage, "123456")
+	return err
 }
@@ -2,10 +2,14 @@ package apiproxy
 
 import (
 	"context"
+	"time"
 
+	com "github.com/aureontu/MRWebServer/mr_services/common"
 	"github.com/aureontu/MRWebServer/mr_services/mpb"
+	"github.com/aureontu/MRWebServer/mr_services/mpberr"
 	"github.com/aureontu/MRWebServer/mr_services/util"
 	"github.com/oldjon/gutil/env"
+	"github.com/oldjon/gutil/gdb"
 	gxgrpc "github.com/oldjon/gx/modules/grpc"
 	"github.com/oldjon/gx/service"
 	etcd "go.etcd.io/etcd/client/v3"
@@ -24,15 +28,16 @@ func APIProxyGRPCGetMe() *APIProxyGRPCService { // nolint:unused
 
 type APIProxyGRPCService struct {
 	mpb.UnimplementedAPIProxyGRPCServer
-	logger         *zap.Logger
-	config         env.ModuleConfig
-	etcdClient     *etcd.Client
-	cm             *gxgrpc.ConnManager // 转发至其他gateway的消息，需要通过grpc
-	host           service.Host
-	sm             *util.ServiceMetrics
-	rm             *APIProxyResourceMgr
-	aptos          *AptosManager
-	emailSendIndex uint32
+	logger     *zap.Logger
+	config     env.ModuleConfig
+	etcdClient *etcd.Client
+	cm         *gxgrpc.ConnManager // 转发至其他gateway的消息，需要通过grpc
+	host       service.Host
+	sm         *util.ServiceMetrics
+	rm         *APIProxyResourceMgr
+	aptos      *AptosManager
+	outbox     *emailOutbox
+	sms        SMSSender
 }
 
 // NewAPIProxyGRPCService create a APIProxyGRPCService entity
@@ -61,8 +66,35 @@ func NewAPIProxyGRPCService(driver service.ModuleDriver) (gxgrpc.GRPCServer, err
 	if err != nil {
 		return nil, err
 	}
-	as.aptos = newAptosManager(as.config.GetString("aptos_api_url"), as.config.GetString("moralis_api_url"),
-		as.config.GetString("graphiql_api_url"), as.logger)
+	as.aptos, err = newAptosManager(as.config.GetString("aptos_api_url"), as.config.GetString("moralis_api_url"),
+		as.config.GetString("graphiql_api_url"), aptosTimeouts{
+			aptos:   time.Duration(as.config.GetInt64("aptos_timeout_ms")) * time.Millisecond,
+			moralis: time.Duration(as.config.GetInt64("moralis_timeout_ms")) * time.Millisecond,
+			indexer: time.Duration(as.config.GetInt64("graphiql_timeout_ms")) * time.Millisecond,
+		}, newUpstreamClient(as.logger, newUpstreamMetrics(driver)), as.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	pool, err := newEmailSenderPool(as.logger, as.rm, as.config.GetString("email_transport"),
+		as.config.GetString("email_sink_dir"))
+	if err != nil {
+		return nil, err
+	}
+	emailRedis, err := gdb.NewRedisClientByConfig(as.config.SubConfig("email_redis"),
+		as.config.GetString("db_marshaller"), driver.Tracer())
+	if err != nil {
+		return nil, err
+	}
+	as.outbox, err = newEmailOutbox(context.Background(), as.logger, emailRedis,
+		util.NewRedisUniversalClient(as.config.SubConfig("email_redis")), pool, newEmailMetrics(driver), driver.Host().Name())
+	if err != nil {
+		return nil, err
+	}
+	as.sms, err = newSMSSender(as.logger, as.config)
+	if err != nil {
+		return nil, err
+	}
 	as.logger.Info("apiproxy grpc service start success")
 	apiproxygrpc = as
 	return as, nil
@@ -73,23 +105,68 @@ func (svc *APIProxyGRPCService) Register(grpcServer *grpc.Server) {
 }
 
 func (svc *APIProxyGRPCService) Serve(ctx context.Context) error {
-	<-ctx.Done()
+	svc.outbox.run(ctx)
 	return ctx.Err()
 }
 
-func (svc *APIProxyGRPCService) SendEmailBindCode(ctx context.Context, req *mpb.ReqSendEmailBindCode) (*mpb.Empty, error) {
-	err := svc.sendEmailBindCode(req.Email, req.Code)
+func (svc *APIProxyGRPCService) SendEmailBindCode(ctx context.Context, req *mpb.ReqSendEmailBindCode) (*mpb.ResSendEmail, error) {
+	msg, err := svc.sendEmailBindCode(ctx, req.Email, req.Language, req.Code)
 	if err != nil {
 		return nil, err
 	}
-	return &mpb.Empty{}, nil
+	return &mpb.ResSendEmail{MessageId: msg.MessageId, Status: mpb.EEmailStatus_Status(msg.Status)}, nil
+}
+
+func (svc *APIProxyGRPCService) SendEmailResetPasswordValidationCode(ctx context.Context, req *mpb.ReqSendEmailResetPasswordValidationCode) (*mpb.ResSendEmail, error) {
+	msg, err := svc.sendEmailResetPasswordValidationCode(ctx, req.Email, req.Language, req.Code)
+	if err != nil {
+		return nil, err
+	}
+	return &mpb.ResSendEmail{MessageId: msg.MessageId, Status: mpb.EEmailStatus_Status(msg.Status)}, nil
 }
 
-func (svc *APIProxyGRPCService) SendEmailResetPasswordValidationCode(ctx context.Context, req *mpb.ReqSendEmailResetPasswordValidationCode) (*mpb.Empty, error) {
-	err := svc.sendEmailResetPasswordValidationCode(req.Email, req.Code)
+func (svc *APIProxyGRPCService) SendEmailChangeCode(ctx context.Context, req *mpb.ReqSendEmailChangeEmailCode) (*mpb.ResSendEmail, error) {
+	msg, err := svc.sendEmailChangeCode(ctx, req.Email, req.Language, req.Code, req.NewEmail)
 	if err != nil {
 		return nil, err
 	}
+	return &mpb.ResSendEmail{MessageId: msg.MessageId, Status: mpb.EEmailStatus_Status(msg.Status)}, nil
+}
+
+func (svc *APIProxyGRPCService) SendEmailAccountDeletion(ctx context.Context, req *mpb.ReqSendEmailAccountDeletion) (*mpb.ResSendEmail, error) {
+	msg, err := svc.sendEmailAccountDeletion(ctx, req.Email, req.DeletionTime)
+	if err != nil {
+		return nil, err
+	}
+	return &mpb.ResSendEmail{MessageId: msg.MessageId, Status: mpb.EEmailStatus_Status(msg.Status)}, nil
+}
+
+func (svc *APIProxyGRPCService) SendEmailLoginAlert(ctx context.Context, req *mpb.ReqSendEmailLoginAlert) (*mpb.ResSendEmail, error) {
+	msg, err := svc.sendEmailLoginAlert(ctx, req.Email, req.LoginTime, req.RemoteIp, req.Region, req.Device)
+	if err != nil {
+		return nil, err
+	}
+	return &mpb.ResSendEmail{MessageId: msg.MessageId, Status: mpb.EEmailStatus_Status(msg.Status)}, nil
+}
+
+func (svc *APIProxyGRPCService) GetEmailStatus(ctx context.Context, req *mpb.ReqGetEmailStatus) (*mpb.EmailStatus, error) {
+	msg, err := svc.outbox.getMessage(ctx, req.MessageId)
+	if err != nil {
+		return nil, err
+	}
+	return svc.DBEmailMessage2EmailStatus(msg), nil
+}
+
+func (svc *APIProxyGRPCService) SendSMSVerificationCode(ctx context.Context, req *mpb.ReqSendSMSVerificationCode) (
+	*mpb.Empty, error) {
+	ctx, cancel := context.WithTimeout(ctx, com.SMSSendTimeout)
+	defer cancel()
+	err := svc.sms.Send(ctx, req.Tel, smsVerificationContent(req.Purpose, req.Code))
+	if err != nil {
+		svc.logger.Error("SendSMSVerificationCode Send failed", zap.String("sender", svc.sms.Name()),
+			zap.String("tel", req.Tel), zap.Error(err))
+		return nil, mpberr.ErrSMSSend
+	}
 	return &mpb.Empty{}, nil
 }
 
@@ -103,6 +180,16 @@ func (svc *APIProxyGRPCService) GetAptosResources(ctx context.Context, req *mpb.
 	}, nil
 }
 
+func (svc *APIProxyGRPCService) GetAptosAuthKey(ctx context.Context, req *mpb.ReqGetAptosAuthKey) (*mpb.ResGetAptosAuthKey, error) {
+	authKey, err := svc.aptos.GetAuthKey(ctx, req.AptosAccAddr)
+	if err != nil {
+		return nil, err
+	}
+	return &mpb.ResGetAptosAuthKey{
+		AuthenticationKey: authKey,
+	}, nil
+}
+
 func (svc *APIProxyGRPCService) MoralisGetNFTByWallets(ctx context.Context, req *mpb.ReqMoralisGetNFTByWallets) (*mpb.ResMoralisGetNFTByWallets, error) {
 	apikey, err := svc.rm.getMoralisAPIKey()
 	if err != nil {
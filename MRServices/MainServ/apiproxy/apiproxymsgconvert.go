@@ -0,0 +1,16 @@
+package apiproxy
+
+import "github.com/aureontu/MRWebServer/mr_services/mpb"
+
+func (svc *APIProxyGRPCService) DBEmailMessage2EmailStatus(in *mpb.DBEmailMessage) *mpb.EmailStatus {
+	return &mpb.EmailStatus{
+		MessageId:       in.MessageId,
+		Status:          mpb.EEmailStatus_Status(in.Status),
+		Attempts:        in.Attempts,
+		Sender:          in.Sender,
+		LastError:       in.LastError,
+		CreateTime:      in.CreateTime,
+		UpdateTime:      in.UpdateTime,
+		NextAttemptTime: in.NextAttemptTime,
+	}
+}
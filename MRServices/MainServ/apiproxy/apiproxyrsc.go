@@ -23,8 +23,9 @@ type APIProxyResourceMgr struct {
 	dm     *gdm.DirMonitor
 	mtr    *metrics
 
-	emailAddrs  []*mpb.EmailAddrRsc
-	moralisInfo *mpb.MoralisInfoRsc
+	emailAddrs     []*mpb.EmailAddrRsc
+	moralisInfo    *mpb.MoralisInfoRsc
+	emailTemplates *emailTemplateMgr
 }
 
 func newAPIProxyResourceMgr(logger *zap.Logger, mtr *metrics) (*APIProxyResourceMgr, error) {
@@ -49,6 +50,11 @@ func newAPIProxyResourceMgr(logger *zap.Logger, mtr *metrics) (*APIProxyResource
 		return nil, err
 	}
 
+	rMgr.emailTemplates, err = newEmailTemplateMgr(logger)
+	if err != nil {
+		return nil, err
+	}
+
 	return rMgr, nil
 }
 
@@ -123,3 +129,7 @@ func (rm *APIProxyResourceMgr) getMoralisAPIKey() (string, error) {
 	}
 	return rm.moralisInfo.ApiKey, nil
 }
+
+func (rm *APIProxyResourceMgr) renderEmail(lang, name string, data any) (subject string, content string, err error) {
+	return rm.emailTemplates.render(lang, name, data)
+}
@@ -5,9 +5,11 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/url"
+	"time"
 
+	com "github.com/aureontu/MRWebServer/mr_services/common"
 	"github.com/aureontu/MRWebServer/mr_services/mpb"
-	"github.com/aureontu/MRWebServer/mr_services/util"
+	"github.com/aureontu/MRWebServer/mr_services/mpberr"
 	"go.uber.org/zap"
 )
 
@@ -22,36 +24,74 @@ const (
 	MoralisApiGetNFTsByWallets = "/wallets/nfts?%s"
 )
 
+// aptosTimeouts the per-attempt timeouts of the upstreams, the defaults of common are used for the zero ones
+type aptosTimeouts struct {
+	aptos   time.Duration
+	moralis time.Duration
+	indexer time.Duration
+}
+
 type AptosManager struct {
-	logger      *zap.Logger
-	aptosUrl    string
-	moralisUrl  string
-	graphiqlUrl string
+	logger  *zap.Logger
+	aptos   *upstream
+	moralis *upstream
+	graphql *graphqlClient
 }
 
-func newAptosManager(aptosUrl, moralisUrl, graphiqlUrl string, logger *zap.Logger) *AptosManager {
-	return &AptosManager{
-		aptosUrl:    aptosUrl,
-		moralisUrl:  moralisUrl,
-		graphiqlUrl: graphiqlUrl,
-		logger:      logger,
+func newAptosManager(aptosUrl, moralisUrl, graphiqlUrl string, timeouts aptosTimeouts, uc *upstreamClient,
+	logger *zap.Logger) (*AptosManager, error) {
+	indexer := uc.newUpstream(upstreamIndexer, graphiqlUrl, timeouts.indexer, com.UpstreamIndexerTimeout)
+	gc, err := newGraphqlClient(logger, indexer, ApiGraphiQL)
+	if err != nil {
+		return nil, err
 	}
+	return &AptosManager{
+		aptos:   uc.newUpstream(upstreamAptos, aptosUrl, timeouts.aptos, com.UpstreamAptosTimeout),
+		moralis: uc.newUpstream(upstreamMoralis, moralisUrl, timeouts.moralis, com.UpstreamMoralisTimeout),
+		graphql: gc,
+		logger:  logger,
+	}, nil
 }
 
+// GetAccount return the account of the address, the body of the not found error is returned with
+// mpberr.ErrUpstreamNotFound
 func (aptos *AptosManager) GetAccount(ctx context.Context, addr string) ([]byte, error) {
-	data, err := util.HttpsGet(ctx, fmt.Sprintf(aptos.aptosUrl+ApiGetAccount, addr))
-	if err != nil {
-		return nil, err
+	return aptos.aptos.get(ctx, fmt.Sprintf(ApiGetAccount, addr), nil)
+}
+
+type aptosAccount struct {
+	SequenceNumber    string `json:"sequence_number"`
+	AuthenticationKey string `json:"authentication_key"`
+	ErrorCode         string `json:"error_code"`
+	Message           string `json:"message"`
+}
+
+// GetAuthKey return the current authentication key of the account, which differs from the address after the key
+// is rotated, an empty key is returned if the account does not exist on chain
+func (aptos *AptosManager) GetAuthKey(ctx context.Context, addr string) (string, error) {
+	data, err := aptos.GetAccount(ctx, addr)
+	if err != nil && err != mpberr.ErrUpstreamNotFound {
+		return "", err
 	}
-	return data, nil
+	var acc aptosAccount
+	if jerr := json.Unmarshal(data, &acc); jerr != nil {
+		aptos.logger.Error("GetAuthKey unmarshal failed", zap.String("addr", addr), zap.ByteString("data", data),
+			zap.Error(jerr))
+		return "", mpberr.ErrUpstreamBadResponse
+	}
+	if acc.ErrorCode == "account_not_found" {
+		return "", nil
+	}
+	if err != nil || acc.AuthenticationKey == "" {
+		aptos.logger.Error("GetAuthKey failed", zap.String("addr", addr), zap.String("error_code", acc.ErrorCode),
+			zap.String("message", acc.Message))
+		return "", mpberr.ErrUpstreamBadResponse
+	}
+	return acc.AuthenticationKey, nil
 }
 
 func (aptos *AptosManager) GetAccountResources(ctx context.Context, addr string) ([]byte, error) {
-	data, err := util.HttpsGet(ctx, fmt.Sprintf(aptos.aptosUrl+ApiGetAccountResources, addr))
-	if err != nil {
-		return nil, err
-	}
-	return data, nil
+	return aptos.aptos.get(ctx, fmt.Sprintf(ApiGetAccountResources, addr), nil)
 }
 
 func (aptos *AptosManager) MoralisGetNFTByWallets(ctx context.Context, addresses, collectionsHash []string, apiKey string) (map[string][]*mpb.MoralisNFTData, error) {
@@ -72,7 +112,7 @@ func (aptos *AptosManager) MoralisGetNFTByWallets(ctx context.Context, addresses
 		headers := make(map[string]string)
 		headers["Accept"] = "application/json"
 		headers["X-API-Key"] = apiKey
-		bys, err := util.HttpGetWithHeader(ctx, aptos.moralisUrl+fmt.Sprintf(MoralisApiGetNFTsByWallets, values.Encode()), headers)
+		bys, err := aptos.moralis.get(ctx, fmt.Sprintf(MoralisApiGetNFTsByWallets, values.Encode()), headers)
 		if err != nil {
 			return nil, err
 		}
@@ -80,7 +120,8 @@ func (aptos *AptosManager) MoralisGetNFTByWallets(ctx context.Context, addresses
 		n := &mpb.MoralisNFTsData{}
 		err = json.Unmarshal(bys, n)
 		if err != nil {
-			return nil, err
+			aptos.logger.Error("MoralisGetNFTByWallets json unmarshal failed", zap.Error(err))
+			return nil, mpberr.ErrUpstreamBadResponse
 		}
 
 		for _, v := range n.Result {
@@ -96,45 +137,27 @@ func (aptos *AptosManager) MoralisGetNFTByWallets(ctx context.Context, addresses
 }
 
 func (aptos *AptosManager) GraphiQLGetAccountTransactions(ctx context.Context, addr string, startIndex, pageNum uint64) (*mpb.AptosAccountTransactions, error) {
-	headers := make(map[string]string)
-	headers["Accept"] = "application/json"
-	headers["Content-Type"] = "application/json"
-	query := fmt.Sprintf(`{"query":"query MyQuery {\n  account_transactions(\n    where: {account_address: {_eq: \"%s\"}}\n    limit: %d\n    offset: %d\n    order_by: {transaction_version: asc}\n  ) {\n    transaction_version\n    token_activities_v2 {\n      entry_function_id_str\n      event_account_address\n      event_index\n      from_address\n      to_address\n      token_amount\n      token_data_id\n      token_standard\n      transaction_timestamp\n      transaction_version\n      type\n      current_token_data {\n        collection_id\n        description\n        last_transaction_timestamp\n        last_transaction_version\n        token_data_id\n        token_name\n        token_properties\n        token_standard\n        token_uri\n      }\n    }\n  }\n}\n","variables":null,"operationName":"MyQuery"}`, addr, pageNum, startIndex)
-
-	bys, err := util.HttpPost(ctx, aptos.graphiqlUrl+ApiGraphiQL, headers, []byte(query))
-	if err != nil {
-		aptos.logger.Error("GraphiQLGetAccountTransactions http post failed", zap.Error(err))
-		return nil, err
-	}
 	res := &mpb.AptosAccountTransactions{}
-
-	err = json.Unmarshal(bys, res)
+	err := aptos.graphql.query(ctx, graphqlAccountTransactions, map[string]any{
+		"address": addr,
+		"limit":   pageNum,
+		"offset":  startIndex,
+	}, &res.Data)
 	if err != nil {
-		aptos.logger.Error("GraphiQLGetAccountTransactions json unmarshal failed", zap.Error(err))
 		return nil, err
 	}
-
 	return res, nil
 }
 
 func (aptos *AptosManager) GraphiQLGetCollectionTransactions(ctx context.Context, collectionId string, startIndex, pageNum uint64) (*mpb.AptosTransactions, error) {
-	headers := make(map[string]string)
-	headers["Accept"] = "application/json"
-	headers["Content-Type"] = "application/json"
-	query := fmt.Sprintf(`{"query":"query MyQuery {\n  token_activities_v2(\n    where: {current_token_data: {collection_id: {_eq: \"%s\"}}}\n    limit: %d\n    offset: %d\n  ) {\n    type\n    from_address\n    to_address\n    current_token_data {\n      collection_id\n      token_name\n      token_data_id\n    token_uri\n  }\n    transaction_timestamp\n    transaction_version\n  }\n}\n","variables":null,"operationName":"MyQuery"}`, collectionId, pageNum, startIndex)
-
-	bys, err := util.HttpPost(ctx, aptos.graphiqlUrl+ApiGraphiQL, headers, []byte(query))
-	if err != nil {
-		aptos.logger.Error("GraphiQLGetCollectionTransactions http post failed", zap.Error(err))
-		return nil, err
-	}
 	res := &mpb.AptosTransactions{}
-
-	err = json.Unmarshal(bys, res)
+	err := aptos.graphql.query(ctx, graphqlCollectionTransactions, map[string]any{
+		"collection_id": collectionId,
+		"limit":         pageNum,
+		"offset":        startIndex,
+	}, &res.Data)
 	if err != nil {
-		aptos.logger.Error("GraphiQLGetCollectionTransactions json unmarshal failed", zap.Error(err))
 		return nil, err
 	}
-
 	return res, nil
 }
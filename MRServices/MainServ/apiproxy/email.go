@@ -1,50 +1,74 @@
 package apiproxy
 
 import (
-	"fmt"
-	"net/smtp"
-	"sync/atomic"
+	"context"
+	"time"
 
-	"go.uber.org/zap"
+	com "github.com/aureontu/MRWebServer/mr_services/common"
+	"github.com/aureontu/MRWebServer/mr_services/mpb"
 )
 
-func (svc *APIProxyGRPCService) sendEmail(toEmail string, subject, content string) error {
-	go func() {
-		curIndex := atomic.AddUint32(&svc.emailSendIndex, 1)
-		emails := svc.rm.getEmailAddrs()
-		for i := 0; i < len(emails); i++ {
-			email := emails[(int(curIndex)+i)%len(emails)]
-			auth := smtp.PlainAuth("", email.Addr, email.Passwd, email.Host)
-			fmt.Println(email)
-			msg := []byte(fmt.Sprintf("To: %s \r\nSubject: %s\r\nMIME-version: 1.0;\r\nContent-Type: text/html;\r\n\r\n%s\r\n", toEmail, subject, content))
-			err := smtp.SendMail(email.Host+":"+email.Port, auth, email.Addr, []string{toEmail}, msg)
-			if err == nil {
-				break
-			}
-			svc.logger.Error("send email failed ", zap.Error(err))
-		}
-	}()
-	return nil
-}
-
-func (svc *APIProxyGRPCService) sendEmailBindCode(toEmail string, bindCode string) error {
-	subject := "Register Mirror Realms Account With Email Verification Code"
-	content := fmt.Sprintf(`<html><body><p>Your verification code is:</p>
-<p style="font-size: 20px; font-weight: bold;">%s</p>
-<p>This code will be used for Mirror Realms account registration, and is valid for 10 minutes.</p>
-<p>For your security, please don't share this code with anyone else. If you did not make this request, ignore this message.</p>
-<p>Thanks!</p>
-<p>Mirror Realms Team</p></body></html>`, bindCode)
-	return svc.sendEmail(toEmail, subject, content)
-}
-
-func (svc *APIProxyGRPCService) sendEmailResetPasswordValidationCode(toEmail string, code string) error {
-	subject := "Change Your Password With Email Verification Code"
-	content := fmt.Sprintf(`<html><body><p>Your verification code is:</p>
-<p style="font-size: 20px; font-weight: bold;">%s</p>
-<p>This verification code is used to change the password, and is valid for 10 minutes.</p>
-<p>For your security, please don't share this code with anyone else. If you did not make this request, ignore this message.</p>
-<p>Thanks!</p>
-<p>Mirror Realms Team</p></body></html>`, code)
-	return svc.sendEmail(toEmail, subject, content)
+// sendEmail put the email into the outbox, the workers deliver it with retries
+func (svc *APIProxyGRPCService) sendEmail(ctx context.Context, toEmail string, subject, content string) (
+	*mpb.DBEmailMessage, error) {
+	return svc.outbox.enqueue(ctx, toEmail, subject, content)
+}
+
+// sendTemplateEmail render the template in the language and put the email into the outbox
+func (svc *APIProxyGRPCService) sendTemplateEmail(ctx context.Context, toEmail string, lang, name string, data any) (
+	*mpb.DBEmailMessage, error) {
+	subject, content, err := svc.rm.renderEmail(lang, name, data)
+	if err != nil {
+		return nil, err
+	}
+	return svc.sendEmail(ctx, toEmail, subject, content)
+}
+
+func (svc *APIProxyGRPCService) sendEmailBindCode(ctx context.Context, toEmail string, lang string, bindCode string) (
+	*mpb.DBEmailMessage, error) {
+	return svc.sendTemplateEmail(ctx, toEmail, lang, emailTemplateBindCode, map[string]any{
+		"Code": bindCode,
+	})
+}
+
+// sendEmailChangeCode send the code confirming the email change to the old address if newEmail is set, otherwise to
+// the new address
+func (svc *APIProxyGRPCService) sendEmailChangeCode(ctx context.Context, toEmail string, lang string, code string,
+	newEmail string) (*mpb.DBEmailMessage, error) {
+	name := emailTemplateChangeEmailNew
+	if newEmail != "" {
+		name = emailTemplateChangeEmailOld
+	}
+	return svc.sendTemplateEmail(ctx, toEmail, lang, name, map[string]any{
+		"Code":     code,
+		"NewEmail": newEmail,
+	})
+}
+
+func (svc *APIProxyGRPCService) sendEmailAccountDeletion(ctx context.Context, toEmail string, deletionTime int64) (
+	*mpb.DBEmailMessage, error) {
+	return svc.sendTemplateEmail(ctx, toEmail, com.CommonLanguage, emailTemplateAccountDeletion, map[string]any{
+		"DeletionTime": formatEmailTime(deletionTime),
+	})
+}
+
+func (svc *APIProxyGRPCService) sendEmailLoginAlert(ctx context.Context, toEmail string, loginTime int64, remoteIP, region,
+	device string) (*mpb.DBEmailMessage, error) {
+	return svc.sendTemplateEmail(ctx, toEmail, com.CommonLanguage, emailTemplateLoginAlert, map[string]any{
+		"LoginTime": formatEmailTime(loginTime),
+		"RemoteIP":  remoteIP,
+		"Region":    region,
+		"Device":    device,
+	})
+}
+
+func (svc *APIProxyGRPCService) sendEmailResetPasswordValidationCode(ctx context.Context, toEmail string, lang string,
+	code string) (*mpb.DBEmailMessage, error) {
+	return svc.sendTemplateEmail(ctx, toEmail, lang, emailTemplateResetPassword, map[string]any{
+		"Code": code,
+	})
+}
+
+func formatEmailTime(t int64) string {
+	return time.Unix(t, 0).UTC().Format("2006-01-02 15:04:05 UTC")
 }
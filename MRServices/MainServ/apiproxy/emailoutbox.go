@@ -0,0 +1,328 @@
+package apiproxy
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	com "github.com/aureontu/MRWebServer/mr_services/common"
+	"github.com/aureontu/MRWebServer/mr_services/mpb"
+	"github.com/aureontu/MRWebServer/mr_services/mpberr"
+	"github.com/aureontu/MRWebServer/mr_services/util"
+	"github.com/go-redis/redis/v8"
+	"github.com/oldjon/gutil/gdb"
+	"go.uber.org/zap"
+)
+
+const emailOutboxField = "id"
+
+// emailOutbox keep the emails in redis until they are delivered. The messages and their status are stored as
+// objects, the stream only carries the message ids to the workers of every apiproxy instance. A failed attempt is
+// parked in the retry zset until its backoff ends, and the message goes to the dead letters after
+// com.EmailMaxAttempts attempts. The content is dropped once the message is sent or dead, it may carry a
+// verification code and only the status is kept until the message expires.
+type emailOutbox struct {
+	logger   *zap.Logger
+	db       *gdb.DB
+	stream   redis.UniversalClient // gdb has no stream commands
+	pool     *emailSenderPool
+	metrics  *emailMetrics
+	consumer string
+}
+
+func newEmailOutbox(ctx context.Context, logger *zap.Logger, emailRedis gdb.RedisClient, stream redis.UniversalClient,
+	pool *emailSenderPool, metrics *emailMetrics, consumer string) (*emailOutbox, error) {
+	ob := &emailOutbox{
+		logger:   logger,
+		db:       gdb.NewDB(emailRedis),
+		stream:   stream,
+		pool:     pool,
+		metrics:  metrics,
+		consumer: consumer,
+	}
+	err := stream.XGroupCreateMkStream(ctx, com.EmailOutboxKey(), com.EmailOutboxGroup, "0").Err()
+	if err != nil && !strings.HasPrefix(err.Error(), "BUSYGROUP") {
+		return nil, fmt.Errorf("create email outbox group failed: %w", err)
+	}
+	return ob, nil
+}
+
+// emailRetryDelay the backoff before the next attempt, doubled by each failed attempt
+func emailRetryDelay(attempts uint32) time.Duration {
+	delay := com.EmailRetryBaseDelay
+	for i := uint32(1); i < attempts && delay < com.EmailRetryMaxDelay; i++ {
+		delay *= 2
+	}
+	if delay > com.EmailRetryMaxDelay {
+		delay = com.EmailRetryMaxDelay
+	}
+	return delay
+}
+
+func (ob *emailOutbox) getMessage(ctx context.Context, messageId string) (*mpb.DBEmailMessage, error) {
+	key := com.EmailMessageKey(messageId)
+	msg := &mpb.DBEmailMessage{}
+	err := ob.db.GetObject(ctx, key, msg)
+	if ob.db.IsErrNil(err) {
+		return nil, mpberr.ErrEmailMessageNotExist
+	}
+	if err != nil {
+		ob.logger.Error("getMessage GetObject failed", zap.String("key", key), zap.Error(err))
+		return nil, mpberr.ErrDB
+	}
+	return msg, nil
+}
+
+func (ob *emailOutbox) saveMessage(ctx context.Context, msg *mpb.DBEmailMessage) error {
+	key := com.EmailMessageKey(msg.MessageId)
+	err := ob.db.SetObjectEX(ctx, key, msg, com.EmailMessageExpireDuration)
+	if err != nil {
+		ob.logger.Error("saveMessage SetObjectEX failed", zap.String("key", key), zap.Error(err))
+		return mpberr.ErrDB
+	}
+	return nil
+}
+
+func (ob *emailOutbox) push(ctx context.Context, messageId string) error {
+	err := ob.stream.XAdd(ctx, &redis.XAddArgs{
+		Stream: com.EmailOutboxKey(),
+		Values: map[string]any{emailOutboxField: messageId},
+	}).Err()
+	if err != nil {
+		ob.logger.Error("push XAdd failed", zap.String("message_id", messageId), zap.Error(err))
+		return mpberr.ErrDB
+	}
+	return nil
+}
+
+// enqueue store the email and hand it to the workers
+func (ob *emailOutbox) enqueue(ctx context.Context, to, subject, content string) (*mpb.DBEmailMessage, error) {
+	messageId, err := util.GenerateRandomToken(com.EmailMessageIdLen)
+	if err != nil {
+		ob.logger.Error("enqueue GenerateRandomToken failed", zap.Error(err))
+		return nil, err
+	}
+	now := time.Now().Unix()
+	msg := &mpb.DBEmailMessage{
+		MessageId:  messageId,
+		To:         to,
+		Subject:    subject,
+		Content:    content,
+		Status:     uint32(mpb.EEmailStatus_Status_Queued),
+		CreateTime: now,
+		UpdateTime: now,
+	}
+	err = ob.saveMessage(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+	err = ob.push(ctx, messageId)
+	if err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+// run start the workers and poll the retries, the stale deliveries and the dead letters until the ctx is done
+func (ob *emailOutbox) run(ctx context.Context) {
+	for i := 0; i < com.EmailOutboxWorkers; i++ {
+		go ob.work(ctx, fmt.Sprintf("%s-%d", ob.consumer, i))
+	}
+	ticker := time.NewTicker(com.EmailOutboxPollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			ob.requeueDue(ctx)
+			ob.claimStale(ctx)
+			ob.trimDead(ctx)
+		}
+	}
+}
+
+func (ob *emailOutbox) work(ctx context.Context, consumer string) {
+	for ctx.Err() == nil {
+		streams, err := ob.stream.XReadGroup(ctx, &redis.XReadGroupArgs{
+			Group:    com.EmailOutboxGroup,
+			Consumer: consumer,
+			Streams:  []string{com.EmailOutboxKey(), ">"},
+			Count:    1,
+			Block:    com.EmailOutboxBlockDuration,
+		}).Result()
+		if err == redis.Nil {
+			continue
+		}
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			ob.logger.Error("work XReadGroup failed", zap.String("consumer", consumer), zap.Error(err))
+			select {
+			case <-ctx.Done():
+			case <-time.After(time.Second):
+			}
+			continue
+		}
+		for _, s := range streams {
+			for _, m := range s.Messages {
+				ob.handle(ctx, m)
+			}
+		}
+	}
+}
+
+// handle deliver a stream entry. The entry stays pending if the outcome can not be recorded, and is claimed again
+// after com.EmailClaimIdleDuration.
+func (ob *emailOutbox) handle(ctx context.Context, m redis.XMessage) {
+	messageId, _ := m.Values[emailOutboxField].(string)
+	if messageId != "" {
+		err := ob.deliver(ctx, messageId)
+		if err != nil {
+			return
+		}
+	}
+	key := com.EmailOutboxKey()
+	err := ob.stream.XAck(ctx, key, com.EmailOutboxGroup, m.ID).Err()
+	if err != nil {
+		ob.logger.Error("handle XAck failed", zap.String("message_id", messageId), zap.Error(err))
+		return
+	}
+	err = ob.stream.XDel(ctx, key, m.ID).Err()
+	if err != nil {
+		ob.logger.Error("handle XDel failed", zap.String("message_id", messageId), zap.Error(err))
+	}
+}
+
+func (ob *emailOutbox) deliver(ctx context.Context, messageId string) error {
+	msg, err := ob.getMessage(ctx, messageId)
+	if err == mpberr.ErrEmailMessageNotExist {
+		ob.logger.Warn("deliver message expired", zap.String("message_id", messageId))
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if msg.Status == uint32(mpb.EEmailStatus_Status_Sent) || msg.Status == uint32(mpb.EEmailStatus_Status_Dead) {
+		return nil
+	}
+
+	sender, err := ob.pool.next()
+	senderName := ""
+	if err == nil {
+		senderName = sender.Name()
+		sendCtx, cancel := context.WithTimeout(ctx, com.EmailSendTimeout)
+		start := time.Now()
+		err = sender.Send(sendCtx, msg)
+		cancel()
+		ob.metrics.observeEmailSend(senderName, err, time.Since(start))
+	}
+
+	now := time.Now().Unix()
+	msg.Attempts++
+	msg.Sender = senderName
+	msg.UpdateTime = now
+	msg.NextAttemptTime = 0
+	if err == nil {
+		msg.Status = uint32(mpb.EEmailStatus_Status_Sent)
+		msg.LastError = ""
+		msg.Content = ""
+		return ob.saveMessage(ctx, msg)
+	}
+
+	msg.LastError = err.Error()
+	if msg.Attempts >= com.EmailMaxAttempts {
+		ob.logger.Error("deliver email dead", zap.String("message_id", messageId), zap.String("sender", senderName),
+			zap.Uint32("attempts", msg.Attempts), zap.Error(err))
+		msg.Status = uint32(mpb.EEmailStatus_Status_Dead)
+		msg.Content = ""
+		err = ob.saveMessage(ctx, msg)
+		if err != nil {
+			return err
+		}
+		_, err = ob.db.ZAdd(ctx, com.EmailDeadKey(), now, messageId)
+		if err != nil {
+			ob.logger.Error("deliver ZAdd failed", zap.String("key", com.EmailDeadKey()), zap.Error(err))
+			return mpberr.ErrDB
+		}
+		ob.metrics.incEmailDead()
+		return nil
+	}
+
+	ob.logger.Warn("deliver email failed", zap.String("message_id", messageId), zap.String("sender", senderName),
+		zap.Uint32("attempts", msg.Attempts), zap.Error(err))
+	msg.Status = uint32(mpb.EEmailStatus_Status_Retrying)
+	msg.NextAttemptTime = now + int64(emailRetryDelay(msg.Attempts)/time.Second)
+	err = ob.saveMessage(ctx, msg)
+	if err != nil {
+		return err
+	}
+	_, err = ob.db.ZAdd(ctx, com.EmailRetryKey(), msg.NextAttemptTime, messageId)
+	if err != nil {
+		ob.logger.Error("deliver ZAdd failed", zap.String("key", com.EmailRetryKey()), zap.Error(err))
+		return mpberr.ErrDB
+	}
+	return nil
+}
+
+// requeueDue push the messages whose backoff ended back to the stream. Only the instance removing the id pushes it.
+func (ob *emailOutbox) requeueDue(ctx context.Context) {
+	key := com.EmailRetryKey()
+	now := time.Now().Unix()
+	messageIds, err := ob.db.ZRangeByScore(ctx, key, "-inf", strconv.FormatInt(now, 10))
+	if err != nil {
+		ob.logger.Error("requeueDue ZRangeByScore failed", zap.String("key", key), zap.Error(err))
+		return
+	}
+	for _, messageId := range messageIds {
+		n, err := ob.db.ZRem(ctx, key, messageId)
+		if err != nil {
+			ob.logger.Error("requeueDue ZRem failed", zap.String("key", key), zap.Error(err))
+			return
+		}
+		if n == 0 {
+			continue
+		}
+		err = ob.push(ctx, messageId)
+		if err != nil {
+			_, err = ob.db.ZAdd(ctx, key, now, messageId)
+			if err != nil {
+				ob.logger.Error("requeueDue ZAdd failed", zap.String("key", key),
+					zap.String("message_id", messageId), zap.Error(err))
+			}
+			return
+		}
+	}
+}
+
+// claimStale take over the entries left pending by a crashed or stuck worker
+func (ob *emailOutbox) claimStale(ctx context.Context) {
+	msgs, _, err := ob.stream.XAutoClaim(ctx, &redis.XAutoClaimArgs{
+		Stream:   com.EmailOutboxKey(),
+		Group:    com.EmailOutboxGroup,
+		MinIdle:  com.EmailClaimIdleDuration,
+		Start:    "0-0",
+		Count:    int64(com.EmailOutboxWorkers),
+		Consumer: ob.consumer,
+	}).Result()
+	if err != nil {
+		ob.logger.Error("claimStale XAutoClaim failed", zap.Error(err))
+		return
+	}
+	for _, m := range msgs {
+		ob.handle(ctx, m)
+	}
+}
+
+// trimDead drop the dead letters whose messages have expired
+func (ob *emailOutbox) trimDead(ctx context.Context) {
+	key := com.EmailDeadKey()
+	max := time.Now().Add(-com.EmailMessageExpireDuration).Unix()
+	_, err := ob.db.ZRemRangeByScore(ctx, key, "-inf", strconv.FormatInt(max, 10))
+	if err != nil {
+		ob.logger.Error("trimDead ZRemRangeByScore failed", zap.String("key", key), zap.Error(err))
+	}
+}
@@ -0,0 +1,178 @@
+package apiproxy
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"net/smtp"
+	"os"
+	fp "path/filepath"
+	"sync"
+	"sync/atomic"
+
+	"github.com/aureontu/MRWebServer/mr_services/mpb"
+	"go.uber.org/zap"
+)
+
+const (
+	emailTransportSMTP   = "smtp"
+	emailTransportFile   = "file"
+	emailTransportMemory = "memory"
+)
+
+var errNoEmailSender = errors.New("no email sender")
+
+// EmailSender deliver an email through one sender account
+type EmailSender interface {
+	Name() string // the sender account, used as the metrics label
+	Send(ctx context.Context, msg *mpb.DBEmailMessage) error
+}
+
+func buildEmailBody(from string, msg *mpb.DBEmailMessage) []byte {
+	return []byte(fmt.Sprintf("From: %s\r\nTo: %s\r\nSubject: %s\r\nMIME-version: 1.0;\r\n"+
+		"Content-Type: text/html;\r\n\r\n%s\r\n", from, msg.To, msg.Subject, msg.Content))
+}
+
+// smtpSender send emails by an SMTP account of EmailAddr.csv
+type smtpSender struct {
+	rsc *mpb.EmailAddrRsc
+}
+
+func (s *smtpSender) Name() string {
+	return s.rsc.Addr
+}
+
+// Send works like smtp.SendMail, but the whole conversation is bounded by the deadline of the ctx
+func (s *smtpSender) Send(ctx context.Context, msg *mpb.DBEmailMessage) error {
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, "tcp", net.JoinHostPort(s.rsc.Host, s.rsc.Port))
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	if deadline, ok := ctx.Deadline(); ok {
+		err = conn.SetDeadline(deadline)
+		if err != nil {
+			return err
+		}
+	}
+
+	c, err := smtp.NewClient(conn, s.rsc.Host)
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+	if ok, _ := c.Extension("STARTTLS"); ok {
+		err = c.StartTLS(&tls.Config{ServerName: s.rsc.Host})
+		if err != nil {
+			return err
+		}
+	}
+	if ok, _ := c.Extension("AUTH"); ok {
+		err = c.Auth(smtp.PlainAuth("", s.rsc.Addr, s.rsc.Passwd, s.rsc.Host))
+		if err != nil {
+			return err
+		}
+	}
+	err = c.Mail(s.rsc.Addr)
+	if err != nil {
+		return err
+	}
+	err = c.Rcpt(msg.To)
+	if err != nil {
+		return err
+	}
+	w, err := c.Data()
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(buildEmailBody(s.rsc.Addr, msg))
+	if err != nil {
+		return err
+	}
+	err = w.Close()
+	if err != nil {
+		return err
+	}
+	return c.Quit()
+}
+
+// fileSender write each email to a file in the dir, for local runs
+type fileSender struct {
+	dir string
+}
+
+func (s *fileSender) Name() string {
+	return emailTransportFile
+}
+
+func (s *fileSender) Send(ctx context.Context, msg *mpb.DBEmailMessage) error {
+	err := os.MkdirAll(s.dir, 0o755)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(fp.Join(s.dir, msg.MessageId+".eml"), buildEmailBody("", msg), 0o644)
+}
+
+// memorySender keep the emails in memory and log them, for local runs
+type memorySender struct {
+	logger *zap.Logger
+	mu     sync.Mutex
+	msgs   []*mpb.DBEmailMessage
+}
+
+func (s *memorySender) Name() string {
+	return emailTransportMemory
+}
+
+func (s *memorySender) Send(ctx context.Context, msg *mpb.DBEmailMessage) error {
+	s.mu.Lock()
+	s.msgs = append(s.msgs, msg)
+	s.mu.Unlock()
+	s.logger.Info("email sent to memory", zap.String("message_id", msg.MessageId), zap.String("to", msg.To),
+		zap.String("subject", msg.Subject))
+	return nil
+}
+
+// emailSenderPool pick the sender of each attempt. The SMTP accounts are taken in turn, so a retry goes through
+// another account, and the account list follows the reloads of EmailAddr.csv.
+type emailSenderPool struct {
+	rm     *APIProxyResourceMgr
+	sender EmailSender // set if the transport is not smtp
+	index  uint32
+}
+
+// newEmailSenderPool create the pool of the transport configured by email_transport, smtp by default.
+// The file transport writes to email_sink_dir.
+func newEmailSenderPool(logger *zap.Logger, rm *APIProxyResourceMgr, transport, sinkDir string) (*emailSenderPool,
+	error) {
+	pool := &emailSenderPool{rm: rm}
+	switch transport {
+	case "", emailTransportSMTP:
+	case emailTransportFile:
+		if sinkDir == "" {
+			return nil, errors.New("email_sink_dir not configured")
+		}
+		pool.sender = &fileSender{dir: sinkDir}
+	case emailTransportMemory:
+		pool.sender = &memorySender{logger: logger}
+	default:
+		return nil, fmt.Errorf("unknown email transport %s", transport)
+	}
+	logger.Info("email transport", zap.String("transport", transport))
+	return pool, nil
+}
+
+func (p *emailSenderPool) next() (EmailSender, error) {
+	if p.sender != nil {
+		return p.sender, nil
+	}
+	addrs := p.rm.getEmailAddrs()
+	if len(addrs) == 0 {
+		return nil, errNoEmailSender
+	}
+	i := atomic.AddUint32(&p.index, 1)
+	return &smtpSender{rsc: addrs[int(i)%len(addrs)]}, nil
+}
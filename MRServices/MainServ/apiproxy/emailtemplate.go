@@ -0,0 +1,183 @@
+package apiproxy
+
+import (
+	"bytes"
+	"html"
+	"html/template"
+	"os"
+	fp "path/filepath"
+	"strings"
+	"sync"
+
+	com "github.com/aureontu/MRWebServer/mr_services/common"
+	"github.com/aureontu/MRWebServer/mr_services/mpberr"
+	gdm "github.com/oldjon/gutil/dirmonitor"
+	"go.uber.org/zap"
+)
+
+const (
+	emailTemplatePath   = baseCSVPath + "templates/"
+	emailTemplateSuffix = ".html"
+
+	emailTemplateBindCode        = "bind_code"
+	emailTemplateResetPassword   = "reset_password"
+	emailTemplateChangeEmailOld  = "change_email_old"
+	emailTemplateChangeEmailNew  = "change_email_new"
+	emailTemplateAccountDeletion = "account_deletion"
+	emailTemplateLoginAlert      = "login_alert"
+
+	emailTemplateSubject = "subject"
+	emailTemplateBody    = "body"
+)
+
+// emailTemplateMgr hold the email templates of every language.
+// A template is the file templates/<lang>/<name>.html, which defines a "subject" and a "body" html/template.
+// A template is reloaded when its file is written, a broken file keeps the previous version. The language
+// directories are listed at startup, a new language needs a restart.
+type emailTemplateMgr struct {
+	logger *zap.Logger
+	dm     *gdm.DirMonitor
+
+	mu    sync.RWMutex
+	tmpls map[string]map[string]*template.Template // lang -> name -> template
+}
+
+func newEmailTemplateMgr(logger *zap.Logger) (*emailTemplateMgr, error) {
+	tm := &emailTemplateMgr{
+		logger: logger,
+		tmpls:  make(map[string]map[string]*template.Template),
+	}
+
+	var err error
+	tm.dm, err = gdm.NewDirMonitorRecursively(emailTemplatePath)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, lang := range com.AllLanguageSlice {
+		files, err := os.ReadDir(fp.Join(emailTemplatePath, lang))
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		for _, f := range files {
+			if f.IsDir() || !strings.HasSuffix(f.Name(), emailTemplateSuffix) {
+				continue
+			}
+			err = tm.loadTemplate(fp.Join(lang, f.Name()))
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	tm.dm.BindAny(func(filename string) error {
+		if !strings.HasSuffix(filename, emailTemplateSuffix) {
+			return nil
+		}
+		return tm.loadTemplate(filename)
+	})
+	err = tm.dm.StartWatch()
+	if err != nil {
+		return nil, err
+	}
+	return tm, nil
+}
+
+// loadTemplate load the file <lang>/<name>.html under emailTemplatePath
+func (tm *emailTemplateMgr) loadTemplate(filename string) error {
+	lang, name := fp.Split(filename)
+	lang = fp.Clean(lang)
+	name = strings.TrimSuffix(name, emailTemplateSuffix)
+	if !isEmailLanguage(lang) {
+		tm.logger.Warn("loadTemplate unknown language", zap.String("file", filename))
+		return nil
+	}
+
+	t, err := template.ParseFiles(fp.Join(emailTemplatePath, filename))
+	if err != nil {
+		tm.logger.Error("loadTemplate parse failed", zap.String("file", filename), zap.Error(err))
+		return err
+	}
+	if t.Lookup(emailTemplateSubject) == nil || t.Lookup(emailTemplateBody) == nil {
+		tm.logger.Error("loadTemplate subject or body not defined", zap.String("file", filename))
+		return mpberr.ErrConfig
+	}
+
+	tm.mu.Lock()
+	if tm.tmpls[lang] == nil {
+		tm.tmpls[lang] = make(map[string]*template.Template)
+	}
+	tm.tmpls[lang][name] = t
+	tm.mu.Unlock()
+	tm.logger.Info("email template loaded", zap.String("lang", lang), zap.String("name", name))
+	return nil
+}
+
+func isEmailLanguage(lang string) bool {
+	for _, l := range com.AllLanguageSlice {
+		if l == lang {
+			return true
+		}
+	}
+	return false
+}
+
+// emailLanguageChain the languages tried in order for the requested one: the language itself, the first language of
+// the same family (zh -> zh-chs, en-us -> en), com.CommonLanguage, then every language of com.AllLanguageSlice
+func emailLanguageChain(lang string) []string {
+	lang = strings.ToLower(strings.TrimSpace(lang))
+	chain := make([]string, 0, len(com.AllLanguageSlice)+2)
+	if isEmailLanguage(lang) {
+		chain = append(chain, lang)
+	} else if lang != "" {
+		family, _, _ := strings.Cut(lang, "-")
+		for _, l := range com.AllLanguageSlice {
+			if l == family || strings.HasPrefix(l, family+"-") {
+				chain = append(chain, l)
+				break
+			}
+		}
+	}
+	chain = append(chain, com.CommonLanguage)
+	return append(chain, com.AllLanguageSlice...)
+}
+
+func (tm *emailTemplateMgr) getTemplate(lang, name string) *template.Template {
+	tm.mu.RLock()
+	defer tm.mu.RUnlock()
+	for _, l := range emailLanguageChain(lang) {
+		if t := tm.tmpls[l][name]; t != nil {
+			return t
+		}
+	}
+	return nil
+}
+
+// render build the subject and the html content of the email in the language, or the nearest one
+func (tm *emailTemplateMgr) render(lang, name string, data any) (subject string, content string, err error) {
+	t := tm.getTemplate(lang, name)
+	if t == nil {
+		tm.logger.Error("render template not found", zap.String("lang", lang), zap.String("name", name))
+		return "", "", mpberr.ErrConfig
+	}
+
+	var buf bytes.Buffer
+	err = t.ExecuteTemplate(&buf, emailTemplateSubject, data)
+	if err != nil {
+		tm.logger.Error("render subject failed", zap.String("lang", lang), zap.String("name", name), zap.Error(err))
+		return "", "", mpberr.ErrConfig
+	}
+	// the subject is a header, not html
+	subject = strings.TrimSpace(html.UnescapeString(buf.String()))
+
+	buf.Reset()
+	err = t.ExecuteTemplate(&buf, emailTemplateBody, data)
+	if err != nil {
+		tm.logger.Error("render body failed", zap.String("lang", lang), zap.String("name", name), zap.Error(err))
+		return "", "", mpberr.ErrConfig
+	}
+	return subject, buf.String(), nil
+}
@@ -0,0 +1,33 @@
+package apiproxy
+
+import (
+	"reflect"
+	"testing"
+
+	com "github.com/aureontu/MRWebServer/mr_services/common"
+)
+
+func TestEmailLanguageChain(t *testing.T) {
+	cases := []struct {
+		name string
+		lang string
+		head []string // the chain before com.AllLanguageSlice
+	}{
+		{name: "supported", lang: "ja", head: []string{"ja", "en"}},
+		{name: "supported region", lang: "pt-br", head: []string{"pt-br", "en"}},
+		{name: "case and spaces", lang: " ZH-CHT ", head: []string{"zh-cht", "en"}},
+		{name: "family of region", lang: "zh", head: []string{"zh-chs", "en"}},
+		{name: "other region of family", lang: "zh-hk", head: []string{"zh-chs", "en"}},
+		{name: "region of language", lang: "en-us", head: []string{"en", "en"}},
+		{name: "unsupported", lang: "xx", head: []string{"en"}},
+		{name: "empty", lang: "", head: []string{"en"}},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			want := append(append([]string{}, c.head...), com.AllLanguageSlice...)
+			if got := emailLanguageChain(c.lang); !reflect.DeepEqual(got, want) {
+				t.Fatalf("emailLanguageChain(%q) = %v, want %v", c.lang, got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,126 @@
+package apiproxy
+
+import (
+	"context"
+	"embed"
+	"encoding/json"
+	fp "path/filepath"
+	"strings"
+
+	"github.com/aureontu/MRWebServer/mr_services/mpberr"
+	"go.uber.org/zap"
+)
+
+// the query documents of the indexer, a new query is a new .graphql file under graphql/ named by its const here,
+// run by an AptosManager method that decodes the data into its proto, e.g. graphql/account_transactions.graphql
+// is run by GraphiQLGetAccountTransactions:
+//
+//	err := aptos.graphql.query(ctx, graphqlAccountTransactions, map[string]any{
+//		"address": addr,
+//		"limit":   pageNum,
+//		"offset":  startIndex,
+//	}, &res.Data)
+const (
+	graphqlAccountTransactions    = "account_transactions"
+	graphqlCollectionTransactions = "collection_transactions"
+
+	graphqlDir    = "graphql"
+	graphqlSuffix = ".graphql"
+)
+
+//go:embed graphql/*.graphql
+var graphqlFS embed.FS
+
+type graphqlReq struct {
+	Query     string         `json:"query"`
+	Variables map[string]any `json:"variables,omitempty"`
+}
+
+type graphqlRes struct {
+	Data   json.RawMessage `json:"data"`
+	Errors []graphqlError  `json:"errors"`
+}
+
+type graphqlError struct {
+	Message    string          `json:"message"`
+	Path       []any           `json:"path"`
+	Extensions json.RawMessage `json:"extensions"`
+}
+
+// graphqlClient run the embedded query documents against the indexer upstream
+type graphqlClient struct {
+	logger  *zap.Logger
+	indexer *upstream
+	path    string
+	queries map[string]string // name -> document
+}
+
+func newGraphqlClient(logger *zap.Logger, indexer *upstream, path string) (*graphqlClient, error) {
+	files, err := graphqlFS.ReadDir(graphqlDir)
+	if err != nil {
+		return nil, err
+	}
+	gc := &graphqlClient{
+		logger:  logger,
+		indexer: indexer,
+		path:    path,
+		queries: make(map[string]string, len(files)),
+	}
+	for _, f := range files {
+		doc, err := graphqlFS.ReadFile(graphqlDir + "/" + f.Name())
+		if err != nil {
+			return nil, err
+		}
+		gc.queries[strings.TrimSuffix(f.Name(), fp.Ext(f.Name()))] = string(doc)
+	}
+	return gc, nil
+}
+
+// query run the named query with the variables and decode its data into data,
+// any error reported by the indexer fails the query, partial data is not returned
+func (gc *graphqlClient) query(ctx context.Context, name string, variables map[string]any, data any) error {
+	doc, ok := gc.queries[name]
+	if !ok {
+		gc.logger.Error("graphql query not found", zap.String("name", name))
+		return mpberr.ErrConfig
+	}
+	body, err := json.Marshal(&graphqlReq{Query: doc, Variables: variables})
+	if err != nil {
+		gc.logger.Error("graphql marshal request failed", zap.String("name", name), zap.Error(err))
+		return mpberr.ErrParam
+	}
+
+	headers := map[string]string{
+		"Accept":       "application/json",
+		"Content-Type": "application/json",
+	}
+	bys, err := gc.indexer.post(ctx, gc.path, headers, body)
+	if err != nil {
+		gc.logger.Error("graphql post failed", zap.String("name", name), zap.Error(err))
+		return err
+	}
+
+	var res graphqlRes
+	err = json.Unmarshal(bys, &res)
+	if err != nil {
+		gc.logger.Error("graphql unmarshal response failed", zap.String("name", name), zap.ByteString("body", bys),
+			zap.Error(err))
+		return mpberr.ErrUpstreamBadResponse
+	}
+	if len(res.Errors) > 0 {
+		gc.logger.Error("graphql query failed", zap.String("name", name), zap.Any("variables", variables),
+			zap.Any("errors", res.Errors))
+		return mpberr.ErrUpstreamBadResponse
+	}
+	if len(res.Data) == 0 || string(res.Data) == "null" {
+		gc.logger.Error("graphql query returned no data", zap.String("name", name))
+		return mpberr.ErrUpstreamBadResponse
+	}
+	err = json.Unmarshal(res.Data, data)
+	if err != nil {
+		gc.logger.Error("graphql unmarshal data failed", zap.String("name", name), zap.ByteString("data", res.Data),
+			zap.Error(err))
+		return mpberr.ErrUpstreamBadResponse
+	}
+	return nil
+}
@@ -0,0 +1,93 @@
+package apiproxy
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aureontu/MRWebServer/mr_services/mpberr"
+	"go.uber.org/zap"
+)
+
+func TestGraphqlClientQuery(t *testing.T) {
+	type txs struct {
+		AccountTransactions []struct {
+			TransactionVersion uint64 `json:"transaction_version"`
+		} `json:"account_transactions"`
+	}
+	cases := []struct {
+		name    string
+		query   string
+		status  int
+		res     string
+		err     error
+		version uint64
+	}{
+		{name: "ok", query: graphqlAccountTransactions, status: http.StatusOK,
+			res: `{"data":{"account_transactions":[{"transaction_version":7}]}}`, version: 7},
+		{name: "unknown query", query: "unknown", err: mpberr.ErrConfig},
+		{name: "errors with partial data", query: graphqlAccountTransactions, status: http.StatusOK,
+			res: `{"data":{"account_transactions":[]},"errors":[{"message":"field not found"}]}`,
+			err: mpberr.ErrUpstreamBadResponse},
+		{name: "null data", query: graphqlAccountTransactions, status: http.StatusOK, res: `{"data":null}`,
+			err: mpberr.ErrUpstreamBadResponse},
+		{name: "no data", query: graphqlAccountTransactions, status: http.StatusOK, res: `{}`,
+			err: mpberr.ErrUpstreamBadResponse},
+		{name: "not json", query: graphqlAccountTransactions, status: http.StatusOK, res: `<html>`,
+			err: mpberr.ErrUpstreamBadResponse},
+		{name: "data of other shape", query: graphqlAccountTransactions, status: http.StatusOK,
+			res: `{"data":{"account_transactions":{}}}`, err: mpberr.ErrUpstreamBadResponse},
+		{name: "rejected", query: graphqlAccountTransactions, status: http.StatusBadRequest, res: `{}`,
+			err: mpberr.ErrUpstreamBadResponse},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			var req graphqlReq
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPost || r.URL.Path != ApiGraphiQL ||
+					r.Header.Get("Content-Type") != "application/json" {
+					t.Errorf("request %s %s %s", r.Method, r.URL.Path, r.Header.Get("Content-Type"))
+				}
+				_ = json.NewDecoder(r.Body).Decode(&req)
+				w.WriteHeader(c.status)
+				_, _ = w.Write([]byte(c.res))
+			}))
+			defer srv.Close()
+
+			gc, err := newGraphqlClient(zap.NewNop(), newTestUpstream(srv.URL), ApiGraphiQL)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var data txs
+			err = gc.query(context.Background(), c.query, map[string]any{"address": "0x1", "limit": 10, "offset": 0},
+				&data)
+			if err != c.err {
+				t.Fatalf("err = %v, want %v", err, c.err)
+			}
+			if c.err == mpberr.ErrConfig {
+				return
+			}
+			if req.Query != gc.queries[graphqlAccountTransactions] || req.Variables["address"] != "0x1" {
+				t.Fatalf("request = %+v", req)
+			}
+			if err == nil && (len(data.AccountTransactions) != 1 ||
+				data.AccountTransactions[0].TransactionVersion != c.version) {
+				t.Fatalf("data = %+v", data)
+			}
+		})
+	}
+}
+
+func TestGraphqlQueriesEmbedded(t *testing.T) {
+	gc, err := newGraphqlClient(zap.NewNop(), nil, ApiGraphiQL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{graphqlAccountTransactions, graphqlCollectionTransactions} {
+		if gc.queries[name] == "" {
+			t.Errorf("query %s not embedded", name)
+		}
+	}
+}
@@ -1,6 +1,8 @@
 package apiproxy
 
 import (
+	"time"
+
 	com "github.com/aureontu/MRWebServer/mr_services/common"
 	"github.com/oldjon/gx/service"
 	"github.com/prometheus/client_golang/prometheus"
@@ -41,3 +43,133 @@ func (m *metrics) incReadHTTPFail(protocol string, err error) { // nolint:unused
 		err.Error(),
 	).Inc()
 }
+
+type emailMetrics struct {
+	com.BasicMetrics
+
+	emailSendTotal    *prometheus.CounterVec
+	emailSendDuration *prometheus.HistogramVec
+	emailDeadTotal    prometheus.Counter
+}
+
+func newEmailMetrics(driver service.ModuleDriver) *emailMetrics {
+	m := &emailMetrics{}
+	m.Metrics = driver.Metrics()
+	m.MetricsNamespace = driver.HostName()
+	m.MetricsSubSystem = driver.ModuleName()
+
+	m.emailSendTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: m.MetricsNamespace,
+			Subsystem: m.MetricsSubSystem,
+			Name:      "email_send_total",
+			Help:      "email send attempts by sender account",
+		},
+		[]string{
+			"sender",
+			"result",
+		},
+	)
+	m.emailSendDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: m.MetricsNamespace,
+			Subsystem: m.MetricsSubSystem,
+			Name:      "email_send_duration_seconds",
+			Help:      "email send duration by sender account",
+			Buckets:   prometheus.DefBuckets,
+		},
+		[]string{
+			"sender",
+		},
+	)
+	m.emailDeadTotal = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: m.MetricsNamespace,
+			Subsystem: m.MetricsSubSystem,
+			Name:      "email_dead_total",
+			Help:      "emails moved to the dead letters",
+		},
+	)
+
+	m.Metrics.MustRegister(m.emailSendTotal, m.emailSendDuration, m.emailDeadTotal)
+	return m
+}
+
+func (m *emailMetrics) observeEmailSend(sender string, err error, duration time.Duration) {
+	result := "ok"
+	if err != nil {
+		result = "fail"
+	}
+	m.emailSendTotal.WithLabelValues(sender, result).Inc()
+	m.emailSendDuration.WithLabelValues(sender).Observe(duration.Seconds())
+}
+
+func (m *emailMetrics) incEmailDead() {
+	m.emailDeadTotal.Inc()
+}
+
+type upstreamMetrics struct {
+	com.BasicMetrics
+
+	upstreamRequestTotal     *prometheus.CounterVec
+	upstreamRequestDuration  *prometheus.HistogramVec
+	upstreamCircuitOpenTotal *prometheus.CounterVec
+}
+
+func newUpstreamMetrics(driver service.ModuleDriver) *upstreamMetrics {
+	m := &upstreamMetrics{}
+	m.Metrics = driver.Metrics()
+	m.MetricsNamespace = driver.HostName()
+	m.MetricsSubSystem = driver.ModuleName()
+
+	m.upstreamRequestTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: m.MetricsNamespace,
+			Subsystem: m.MetricsSubSystem,
+			Name:      "upstream_request_total",
+			Help:      "external api requests by upstream and result",
+		},
+		[]string{
+			"upstream",
+			"result",
+		},
+	)
+	m.upstreamRequestDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: m.MetricsNamespace,
+			Subsystem: m.MetricsSubSystem,
+			Name:      "upstream_request_duration_seconds",
+			Help:      "external api request duration by upstream",
+			Buckets:   prometheus.DefBuckets,
+		},
+		[]string{
+			"upstream",
+		},
+	)
+	m.upstreamCircuitOpenTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: m.MetricsNamespace,
+			Subsystem: m.MetricsSubSystem,
+			Name:      "upstream_circuit_open_total",
+			Help:      "times the circuit breaker of the upstream opened",
+		},
+		[]string{
+			"upstream",
+		},
+	)
+
+	m.Metrics.MustRegister(m.upstreamRequestTotal, m.upstreamRequestDuration, m.upstreamCircuitOpenTotal)
+	return m
+}
+
+// observeUpstream count an attempt, the duration is not observed for the requests rejected without attempt
+func (m *upstreamMetrics) observeUpstream(upstream, result string, duration time.Duration) {
+	m.upstreamRequestTotal.WithLabelValues(upstream, result).Inc()
+	if result != upstreamResultCircuitOpen {
+		m.upstreamRequestDuration.WithLabelValues(upstream).Observe(duration.Seconds())
+	}
+}
+
+func (m *upstreamMetrics) incUpstreamCircuitOpen(upstream string) {
+	m.upstreamCircuitOpenTotal.WithLabelValues(upstream).Inc()
+}
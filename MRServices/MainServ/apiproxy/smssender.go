@@ -0,0 +1,119 @@
+package apiproxy
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/aureontu/MRWebServer/mr_services/mpb"
+	"github.com/oldjon/gutil/env"
+	"go.uber.org/zap"
+)
+
+const (
+	smsTransportHTTP = "http"
+	smsTransportLog  = "log"
+)
+
+// SMSSender deliver a text message through an SMS provider
+type SMSSender interface {
+	Name() string
+	Send(ctx context.Context, tel string, content string) error
+}
+
+// httpSMSSender post the message as json to the http api of the provider, configured by sms_http_url,
+// sms_http_api_key and sms_http_from
+type httpSMSSender struct {
+	client *http.Client
+	url    string
+	apiKey string
+	from   string
+}
+
+type httpSMSReq struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+	Text string `json:"text"`
+}
+
+func (s *httpSMSSender) Name() string {
+	return smsTransportHTTP
+}
+
+func (s *httpSMSSender) Send(ctx context.Context, tel string, content string) error {
+	body, err := json.Marshal(&httpSMSReq{From: s.from, To: tel, Text: content})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+s.apiKey)
+	res, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode/100 != 2 {
+		data, _ := io.ReadAll(io.LimitReader(res.Body, 512))
+		return fmt.Errorf("sms provider status %d: %s", res.StatusCode, data)
+	}
+	return nil
+}
+
+// logSMSSender only log the messages, for local runs. It must be chosen explicitly by sms_transport: log
+type logSMSSender struct {
+	logger *zap.Logger
+}
+
+func (s *logSMSSender) Name() string {
+	return smsTransportLog
+}
+
+func (s *logSMSSender) Send(ctx context.Context, tel string, content string) error {
+	s.logger.Info("sms sent to log", zap.String("tel", tel), zap.String("content", content))
+	return nil
+}
+
+// newSMSSender create the sender of the transport configured by sms_transport, which is required so a deployment can
+// not fall back to the log stub by a missing config
+func newSMSSender(logger *zap.Logger, config env.ModuleConfig) (SMSSender, error) {
+	transport := config.GetString("sms_transport")
+	logger.Info("sms transport", zap.String("transport", transport))
+	switch transport {
+	case "":
+		return nil, fmt.Errorf("sms_transport not configured")
+	case smsTransportLog:
+		logger.Warn("sms messages are only logged")
+		return &logSMSSender{logger: logger}, nil
+	case smsTransportHTTP:
+		url := config.GetString("sms_http_url")
+		if url == "" {
+			return nil, fmt.Errorf("sms_http_url not configured")
+		}
+		return &httpSMSSender{
+			client: &http.Client{},
+			url:    url,
+			apiKey: config.GetString("sms_http_api_key"),
+			from:   config.GetString("sms_http_from"),
+		}, nil
+	default:
+		return nil, fmt.Errorf("unknown sms transport %s", transport)
+	}
+}
+
+func smsVerificationContent(purpose mpb.ESMSCode_Purpose, code string) string {
+	switch purpose {
+	case mpb.ESMSCode_Purpose_ResetPassword:
+		return fmt.Sprintf("Your Mirror Realms password reset code is %s, valid for 10 minutes. "+
+			"Don't share it with anyone.", code)
+	default:
+		return fmt.Sprintf("Your Mirror Realms phone verification code is %s, valid for 10 minutes. "+
+			"Don't share it with anyone.", code)
+	}
+}
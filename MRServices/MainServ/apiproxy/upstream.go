@@ -0,0 +1,243 @@
+package apiproxy
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	com "github.com/aureontu/MRWebServer/mr_services/common"
+	"github.com/aureontu/MRWebServer/mr_services/mpberr"
+	"github.com/aureontu/MRWebServer/mr_services/util"
+	"go.uber.org/zap"
+)
+
+// the upstream names, used as the metrics label
+const (
+	upstreamAptos   = "aptos"
+	upstreamMoralis = "moralis"
+	upstreamIndexer = "indexer"
+)
+
+// the results of an attempt, used as the metrics label
+const (
+	upstreamResultOK          = "ok"
+	upstreamResultTimeout     = "timeout"
+	upstreamResultError       = "error"
+	upstreamResultRateLimited = "429"
+	upstreamResultServerError = "5xx"
+	upstreamResultClientError = "4xx"
+	upstreamResultCircuitOpen = "circuit_open"
+)
+
+// upstreamClient the outbound http client shared by the external apis, the connections are pooled per host
+type upstreamClient struct {
+	logger  *zap.Logger
+	client  *http.Client
+	metrics *upstreamMetrics
+}
+
+func newUpstreamClient(logger *zap.Logger, metrics *upstreamMetrics) *upstreamClient {
+	return &upstreamClient{
+		logger:  logger,
+		client:  util.NewPooledHTTPClient(),
+		metrics: metrics,
+	}
+}
+
+// upstream an external api with its own timeout and circuit breaker
+type upstream struct {
+	uc      *upstreamClient
+	name    string
+	baseURL string
+	timeout time.Duration // of each attempt
+	breaker *circuitBreaker
+}
+
+// newUpstream create the upstream of the base url, timeout is the default if it is 0
+func (uc *upstreamClient) newUpstream(name, baseURL string, timeout, defaultTimeout time.Duration) *upstream {
+	if timeout <= 0 {
+		timeout = defaultTimeout
+	}
+	return &upstream{
+		uc:      uc,
+		name:    name,
+		baseURL: baseURL,
+		timeout: timeout,
+		breaker: newCircuitBreaker(com.UpstreamBreakerFailures, com.UpstreamBreakerCooldown),
+	}
+}
+
+func (u *upstream) get(ctx context.Context, path string, headers map[string]string) ([]byte, error) {
+	return u.do(ctx, http.MethodGet, path, headers, nil)
+}
+
+// post is retried like get, the upstreams only take read-only queries by post
+func (u *upstream) post(ctx context.Context, path string, headers map[string]string, body []byte) ([]byte, error) {
+	return u.do(ctx, http.MethodPost, path, headers, body)
+}
+
+// do send the request, retrying 429, 5xx, timeouts and network errors with jittered backoff.
+// The errors are mapped into mpberr, the body of a 404 is returned with mpberr.ErrUpstreamNotFound.
+func (u *upstream) do(ctx context.Context, method, path string, headers map[string]string, body []byte) ([]byte,
+	error) {
+	var err error
+	for i := 0; ; i++ {
+		if !u.breaker.allow() {
+			u.uc.metrics.observeUpstream(u.name, upstreamResultCircuitOpen, 0)
+			return nil, mpberr.ErrUpstreamUnavailable
+		}
+		var data []byte
+		var retryAfter time.Duration
+		var retry bool
+		data, retryAfter, retry, err = u.attempt(ctx, method, path, headers, body)
+		if ctx.Err() != nil { // cancelled by the caller, says nothing about the upstream
+			u.breaker.release()
+			return nil, ctx.Err()
+		}
+		if u.breaker.record(!retry) {
+			u.uc.metrics.incUpstreamCircuitOpen(u.name)
+			u.uc.logger.Warn("upstream circuit open", zap.String("upstream", u.name), zap.Error(err))
+		}
+		if !retry || i >= com.UpstreamMaxRetries {
+			if retry {
+				u.uc.logger.Error("upstream request failed", zap.String("upstream", u.name),
+					zap.String("method", method), zap.String("path", path), zap.Int("attempts", i+1), zap.Error(err))
+			}
+			return data, err
+		}
+
+		delay := upstreamRetryDelay(i)
+		if retryAfter > delay && retryAfter <= com.UpstreamRetryMaxDelay {
+			delay = retryAfter
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(delay):
+		}
+	}
+}
+
+// attempt send the request once, retry is true if the failure is worth another attempt
+func (u *upstream) attempt(ctx context.Context, method, path string, headers map[string]string, body []byte) (
+	data []byte, retryAfter time.Duration, retry bool, err error) {
+	attemptCtx, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(attemptCtx, method, u.baseURL+path, bytes.NewReader(body))
+	if err != nil {
+		return nil, 0, false, err
+	}
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+
+	start := time.Now()
+	res, err := u.uc.client.Do(req)
+	if err == nil {
+		data, err = io.ReadAll(io.LimitReader(res.Body, com.UpstreamMaxResponseBytes))
+		_ = res.Body.Close()
+	}
+	duration := time.Since(start)
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, 0, false, ctx.Err()
+		}
+		if errors.Is(err, context.DeadlineExceeded) {
+			u.uc.metrics.observeUpstream(u.name, upstreamResultTimeout, duration)
+			return nil, 0, true, mpberr.ErrUpstreamTimeout
+		}
+		u.uc.logger.Warn("upstream attempt failed", zap.String("upstream", u.name), zap.Error(err))
+		u.uc.metrics.observeUpstream(u.name, upstreamResultError, duration)
+		return nil, 0, true, mpberr.ErrUpstreamUnavailable
+	}
+
+	switch {
+	case res.StatusCode >= 200 && res.StatusCode < 300:
+		u.uc.metrics.observeUpstream(u.name, upstreamResultOK, duration)
+		return data, 0, false, nil
+	case res.StatusCode == http.StatusTooManyRequests:
+		u.uc.metrics.observeUpstream(u.name, upstreamResultRateLimited, duration)
+		secs, _ := strconv.Atoi(res.Header.Get("Retry-After"))
+		return nil, time.Duration(secs) * time.Second, true, mpberr.ErrUpstreamRateLimited
+	case res.StatusCode >= 500:
+		u.uc.metrics.observeUpstream(u.name, upstreamResultServerError, duration)
+		return nil, 0, true, mpberr.ErrUpstreamUnavailable
+	case res.StatusCode == http.StatusNotFound:
+		u.uc.metrics.observeUpstream(u.name, upstreamResultClientError, duration)
+		return data, 0, false, mpberr.ErrUpstreamNotFound
+	default:
+		u.uc.metrics.observeUpstream(u.name, upstreamResultClientError, duration)
+		u.uc.logger.Error("upstream rejected request", zap.String("upstream", u.name), zap.String("path", path),
+			zap.Int("status", res.StatusCode), zap.ByteString("body", data))
+		return nil, 0, false, mpberr.ErrUpstreamBadResponse
+	}
+}
+
+// upstreamRetryDelay the exponential backoff of the retry with equal jitter, so the instances do not retry together
+func upstreamRetryDelay(retries int) time.Duration {
+	delay := com.UpstreamRetryBaseDelay << retries
+	if delay > com.UpstreamRetryMaxDelay || delay <= 0 {
+		delay = com.UpstreamRetryMaxDelay
+	}
+	return delay/2 + time.Duration(rand.Int63n(int64(delay/2)+1))
+}
+
+// circuitBreaker open after threshold consecutive failures and reject the requests until the cooldown ends,
+// then let one probe through, which closes it on success or opens it for another cooldown
+type circuitBreaker struct {
+	threshold int
+	cooldown  time.Duration
+
+	mu        sync.Mutex
+	failures  int
+	openUntil time.Time
+	probing   bool
+}
+
+func newCircuitBreaker(threshold int, cooldown time.Duration) *circuitBreaker {
+	return &circuitBreaker{threshold: threshold, cooldown: cooldown}
+}
+
+func (b *circuitBreaker) allow() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.failures < b.threshold {
+		return true
+	}
+	if b.probing || time.Now().Before(b.openUntil) {
+		return false
+	}
+	b.probing = true
+	return true
+}
+
+// record the result of a request, true if the breaker is opened by it
+func (b *circuitBreaker) record(ok bool) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	probing := b.probing
+	b.probing = false
+	if ok {
+		b.failures = 0
+		return false
+	}
+	b.failures++
+	if b.failures < b.threshold {
+		return false
+	}
+	b.openUntil = time.Now().Add(b.cooldown)
+	return probing || b.failures == b.threshold
+}
+
+// release give back the probe of a request without result
+func (b *circuitBreaker) release() {
+	b.mu.Lock()
+	b.probing = false
+	b.mu.Unlock()
+}
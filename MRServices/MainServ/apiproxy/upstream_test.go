@@ -0,0 +1,166 @@
+package apiproxy
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/aureontu/MRWebServer/mr_services/mpberr"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+)
+
+// newTestUpstream create the upstream of the test server with unregistered metrics
+func newTestUpstream(url string) *upstream {
+	metrics := &upstreamMetrics{
+		upstreamRequestTotal: prometheus.NewCounterVec(prometheus.CounterOpts{Name: "upstream_request_total"},
+			[]string{"upstream", "result"}),
+		upstreamRequestDuration: prometheus.NewHistogramVec(
+			prometheus.HistogramOpts{Name: "upstream_request_duration_seconds"}, []string{"upstream"}),
+		upstreamCircuitOpenTotal: prometheus.NewCounterVec(prometheus.CounterOpts{Name: "upstream_circuit_open_total"},
+			[]string{"upstream"}),
+	}
+	uc := newUpstreamClient(zap.NewNop(), metrics)
+	return uc.newUpstream(upstreamIndexer, url, time.Second, time.Second)
+}
+
+func TestCircuitBreaker(t *testing.T) {
+	// the steps run against a breaker of threshold 2, "allow" and "deny" check allow, "ok" and "fail" record a
+	// result which opens the breaker if opened is set, "release" gives back the probe
+	type step struct {
+		op     string
+		opened bool
+	}
+	cases := []struct {
+		name     string
+		cooldown time.Duration
+		steps    []step
+	}{
+		{
+			name:     "closed below threshold",
+			cooldown: time.Hour,
+			steps:    []step{{op: "allow"}, {op: "fail"}, {op: "allow"}, {op: "ok"}, {op: "fail"}, {op: "allow"}},
+		},
+		{
+			name:     "success resets the failures",
+			cooldown: time.Hour,
+			steps:    []step{{op: "fail"}, {op: "ok"}, {op: "fail"}, {op: "allow"}},
+		},
+		{
+			name:     "opens at threshold",
+			cooldown: time.Hour,
+			steps:    []step{{op: "fail"}, {op: "fail", opened: true}, {op: "deny"}, {op: "fail"}, {op: "deny"}},
+		},
+		{
+			name:     "one probe after cooldown",
+			cooldown: 0,
+			steps:    []step{{op: "fail"}, {op: "fail", opened: true}, {op: "allow"}, {op: "deny"}},
+		},
+		{
+			name:     "probe success closes",
+			cooldown: 0,
+			steps: []step{{op: "fail"}, {op: "fail", opened: true}, {op: "allow"}, {op: "ok"}, {op: "allow"},
+				{op: "allow"}},
+		},
+		{
+			name:     "probe failure opens again",
+			cooldown: 0,
+			steps: []step{{op: "fail"}, {op: "fail", opened: true}, {op: "allow"}, {op: "fail", opened: true},
+				{op: "allow"}},
+		},
+		{
+			name:     "released probe is given back",
+			cooldown: 0,
+			steps:    []step{{op: "fail"}, {op: "fail", opened: true}, {op: "allow"}, {op: "release"}, {op: "allow"}},
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			b := newCircuitBreaker(2, c.cooldown)
+			for i, s := range c.steps {
+				switch s.op {
+				case "allow", "deny":
+					if b.allow() != (s.op == "allow") {
+						t.Fatalf("step %d: allow() = %v", i, s.op != "allow")
+					}
+				case "ok", "fail":
+					if opened := b.record(s.op == "ok"); opened != s.opened {
+						t.Fatalf("step %d: record() = %v, want %v", i, opened, s.opened)
+					}
+				case "release":
+					b.release()
+				}
+			}
+		})
+	}
+}
+
+func TestUpstreamDo(t *testing.T) {
+	cases := []struct {
+		name     string
+		statuses []int // of the attempts in order, the last one repeats
+		err      error
+		attempts int32
+		body     string
+	}{
+		{name: "ok", statuses: []int{http.StatusOK}, attempts: 1, body: "data"},
+		{name: "retried 5xx", statuses: []int{http.StatusBadGateway, http.StatusOK}, attempts: 2, body: "data"},
+		{name: "retried 429", statuses: []int{http.StatusTooManyRequests, http.StatusOK}, attempts: 2, body: "data"},
+		{name: "retries used up", statuses: []int{http.StatusServiceUnavailable}, err: mpberr.ErrUpstreamUnavailable,
+			attempts: 3},
+		{name: "not found", statuses: []int{http.StatusNotFound}, err: mpberr.ErrUpstreamNotFound, attempts: 1,
+			body: "data"},
+		{name: "bad request", statuses: []int{http.StatusBadRequest}, err: mpberr.ErrUpstreamBadResponse, attempts: 1},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			var attempts int32
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				n := int(atomic.AddInt32(&attempts, 1)) - 1
+				if n >= len(c.statuses) {
+					n = len(c.statuses) - 1
+				}
+				w.WriteHeader(c.statuses[n])
+				_, _ = w.Write([]byte("data"))
+			}))
+			defer srv.Close()
+
+			data, err := newTestUpstream(srv.URL).get(context.Background(), "/", nil)
+			if err != c.err {
+				t.Fatalf("err = %v, want %v", err, c.err)
+			}
+			if string(data) != c.body {
+				t.Fatalf("body = %q, want %q", data, c.body)
+			}
+			if attempts != c.attempts {
+				t.Fatalf("attempts = %d, want %d", attempts, c.attempts)
+			}
+		})
+	}
+}
+
+func TestUpstreamCircuitOpen(t *testing.T) {
+	var attempts int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+
+	u := newTestUpstream(srv.URL)
+	u.breaker = newCircuitBreaker(2, time.Hour)
+	_, err := u.get(context.Background(), "/", nil)
+	if err != mpberr.ErrUpstreamUnavailable {
+		t.Fatalf("err = %v", err)
+	}
+	if attempts != 2 {
+		t.Fatalf("attempts = %d, want the breaker to stop the retries at 2", attempts)
+	}
+	_, err = u.get(context.Background(), "/", nil)
+	if err != mpberr.ErrUpstreamUnavailable || attempts != 2 {
+		t.Fatalf("err = %v, attempts = %d, want the request rejected by the open breaker", err, attempts)
+	}
+}
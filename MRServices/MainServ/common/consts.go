@@ -19,18 +19,20 @@ const (
 )
 
 const (
-	Secs1Min            = 60
-	Secs10Mins          = 10 * Secs1Min
-	Dur10Mins           = 10 * time.Minute
-	Secs1Hour           = 6 * Secs1Min
-	Secs1Day            = 24 * Secs1Hour
-	Dur1Day             = 24 * time.Hour
-	Secs7Days           = Secs1Day * 7
-	Secs1Week           = Secs7Days
-	ResetHour           = 4
-	SecsRestHour        = Secs1Hour * ResetHour
-	CtxTimeout          = 10 * time.Second
-	TokenExpireDuration = 7 * 86400 * time.Second
+	Secs1Min                   = 60
+	Secs10Mins                 = 10 * Secs1Min
+	Dur10Mins                  = 10 * time.Minute
+	Secs1Hour                  = 6 * Secs1Min
+	Secs1Day                   = 24 * Secs1Hour
+	Dur1Day                    = 24 * time.Hour
+	Secs7Days                  = Secs1Day * 7
+	Secs1Week                  = Secs7Days
+	ResetHour                  = 4
+	SecsRestHour               = Secs1Hour * ResetHour
+	CtxTimeout                 = 10 * time.Second
+	TokenExpireDuration        = 2 * time.Hour
+	RefreshTokenExpireDuration = 30 * Dur1Day
+	SessionSeenInterval        = time.Minute // the last seen time of a session is updated at most once per interval
 )
 
 const (
@@ -47,14 +49,100 @@ const (
 )
 
 const (
-	NonceLen             = 6
-	VCodeLen             = 6
-	EmailSendDailyLimit  = 50
-	PasswordLen          = 32
-	DefaultWebNFTPageNum = 10
-	MaxWebNFTPageNum     = 200
+	NonceLen              = 6
+	WalletNonceLen        = 16 // bytes of the sign in nonce of the wallet, sent as hex
+	RefreshTokenLen       = 32
+	SessionIdLen          = 8
+	DeviceMaxLen          = 128
+	DeviceIdMaxLen        = 64
+	MaxLinkedWallets      = 10
+	MaxGuestsPerDevice    = 3
+	MaxLoginHistory       = 50
+	MaxBanAuditLogs       = 100
+	MaxAccountMergeLogs   = 100
+	AccountMergeMaxHops   = 8 // merges followed from an index to the live account
+	InviteCodeLen         = 8
+	MaxReferralsListed    = 100
+	MaxReferralsPerSubnet = 3 // accepted invitees of a referrer from one subnet
+	DefaultTopReferrers   = 20
+	MaxTopReferrers       = 100
+	VCodeLen              = 6
+	EmailSendDailyLimit   = 50
+	SMSSendDailyLimit     = 10
+	SMSSendUserDailyLimit = 10
+	SMSSendIPDailyLimit   = 20
+	PasswordLen           = 32
+	DefaultWebNFTPageNum  = 10
+	MaxWebNFTPageNum      = 200
+)
+
+const (
+	DefaultNicknamePrefix  = "MR"
+	NicknameMinLen         = 2
+	NicknameMaxLen         = 16
+	IconMaxLen             = 16
+	NicknameChangeCooldown = 7 * Dur1Day
+	IconChangeCooldown     = time.Minute
+)
+
+const (
+	AccountDeletionGracePeriod = 7 * Dur1Day
+	AccountDeletionJobInterval = time.Minute
+)
+
+const (
+	NonceExpireDuration = Dur10Mins
+	SIWAClockSkew       = time.Minute
+)
+
+const (
+	LoginFreeFailuresPerAccount = 5 // of an account from one ip
+	LoginFreeFailuresPerIP      = 20
+	LoginFreeFailuresAccount    = 20 // of an account from every ip
+	LoginFailExpireDuration     = Dur1Day
+	LoginLockBaseDuration       = time.Minute
+	LoginLockMaxDuration        = Dur1Day
+	LoginAccountLockMaxDuration = 15 * time.Minute // short, a lock of the whole account also locks out its owner
+	VCodeMaxFailures            = 5
+)
+
+const (
+	TOTPIssuer                    = "MirrorRealms"
+	TOTPCodeLen                   = 6
+	TOTPRecoveryCodeLen           = 10
+	TOTPRecoveryCodeNum           = 10
+	TOTPMaxFailures               = 5
+	TOTPEnrollExpireDuration      = Dur10Mins
+	TOTPLoginTicketExpireDuration = 5 * time.Minute
+	TOTPFailExpireDuration        = Dur10Mins
+	TOTPUsedExpireDuration        = 2 * time.Minute
 )
 
 const (
 	AptosNFTTokenIdLen = 64
 )
+
+const (
+	EmailOutboxGroup           = "emailsender"
+	EmailOutboxWorkers         = 4
+	EmailOutboxBlockDuration   = 5 * time.Second
+	EmailOutboxPollInterval    = 10 * time.Second
+	EmailClaimIdleDuration     = 5 * time.Minute
+	EmailSendTimeout           = 30 * time.Second
+	EmailMaxAttempts           = 5
+	EmailRetryBaseDelay        = 30 * time.Second
+	EmailRetryMaxDelay         = 30 * time.Minute
+	EmailMessageExpireDuration = 7 * Dur1Day
+	EmailMessageIdLen          = 16
+	SMSSendTimeout             = 10 * time.Second
+
+	UpstreamAptosTimeout     = 5 * time.Second
+	UpstreamMoralisTimeout   = 10 * time.Second
+	UpstreamIndexerTimeout   = 10 * time.Second
+	UpstreamMaxRetries       = 2
+	UpstreamRetryBaseDelay   = 200 * time.Millisecond
+	UpstreamRetryMaxDelay    = 2 * time.Second
+	UpstreamBreakerFailures  = 5
+	UpstreamBreakerCooldown  = 30 * time.Second
+	UpstreamMaxResponseBytes = 16 << 20
+)
@@ -2,6 +2,7 @@ package common
 
 import (
 	"fmt"
+	"strings"
 )
 
 const (
@@ -12,19 +13,63 @@ const (
 	userIdIndexKeyFmt         = "uidindex"
 	accountKeyFmt             = "acc:%d"
 	accountUIDKeyFmt          = "accuid:%s"
-	nonceKeyFmt               = "nonce:%s"
+	nonceKeyFmt               = "nonce:%s:%s"
 	walletAccKeyFmt           = "walletacc:%s"
+	uidWalletsKeyFmt          = "uidwallets:%d"
 	emailSendDailyLimitKeyFmt = "esdl:%s:%s"
 	emailBindCodeKeyFmt       = "ebc:%s"
 	emailAcc                  = "emailacc:%s"
 	emailResetPWCodeKeyFmt    = "erpwc:%s"
 	emailResetPWNonceKeyFmt   = "erpwn:%s"
+	emailChangeOldCodeKeyFmt  = "ecoc:%d"
+	accountDeletionKeyFmt     = "accdel"
+	accountDeletionJobKeyFmt  = "accdeljob"
+	nicknameKeyFmt            = "nickname:%s"
+	accountMergeLogKeyFmt     = "mergelog:%d"
+	smsSendDailyLimitKeyFmt   = "ssdl:%s:%s"
+	smsSendUserLimitKeyFmt    = "ssudl:%d:%s"
+	smsSendIPLimitKeyFmt      = "ssidl:%s:%s"
+	telAccKeyFmt              = "telacc:%s"
+	telBindCodeKeyFmt         = "tbc:%s"
+	telResetPWCodeKeyFmt      = "trpwc:%s"
 
 	// login
 	tokenKeyFmt          = "token:%s"
-	uidTokenKeyFmt       = "uidtoken:%d"
+	refreshTokenKeyFmt   = "rtoken:%s"
+	uidSessionsKeyFmt    = "uidsessions:%d"
+	uidSessionSeenKeyFmt = "uidsessionseen:%d"
+	sessionSeenKeyFmt    = "sessionseen:%s"
 	deviceAccountsKeyFmt = "devaccs:%s"
+	platformAccKeyFmt    = "platformacc:%s:%s"
+	platformNonceKeyFmt  = "platformnonce:%s:%s"
 	loginInfoKeyFmt      = "login:%d"
+	loginFailKeyFmt      = "loginfail:%s"
+	loginLockKeyFmt      = "loginlock:%s"
+	loginLocksKeyFmt     = "loginlocks"
+	vcodeFailKeyFmt      = "vcodefail:%s"
+
+	// ban
+	banKeyFmt    = "ban:%d"
+	bansKeyFmt   = "bans:%d"
+	banLogKeyFmt = "banlog:%d"
+
+	// referral
+	inviteCodeKeyFmt     = "invite:%s"
+	referralsKeyFmt      = "referrals:%d"
+	referrersKeyFmt      = "referrers"
+	referralDeviceKeyFmt = "refdev:%s"
+
+	// totp
+	totpPendingKeyFmt     = "totppending:%d"
+	totpUsedKeyFmt        = "totpused:%d:%d"
+	totpFailKeyFmt        = "totpfail:%d"
+	totpLoginTicketKeyFmt = "totpticket:%s"
+
+	// email
+	emailMessageKeyFmt = "emailmsg:%s"
+	emailOutboxKeyFmt  = "emailoutbox"
+	emailRetryKeyFmt   = "emailretry"
+	emailDeadKeyFmt    = "emaildead"
 
 	// user
 	userKeyFmt      = "user:%d"
@@ -74,14 +119,18 @@ func AccountUIDKey(acc string) string {
 	return fmt.Sprintf(accountUIDKeyFmt, acc)
 }
 
-func NonceKey(nonce string) string {
-	return fmt.Sprintf(nonceKeyFmt, nonce)
+func NonceKey(walletAddr, nonce string) string {
+	return fmt.Sprintf(nonceKeyFmt, walletAddr, nonce)
 }
 
 func WalletAccKey(walletAddr string) string {
 	return fmt.Sprintf(walletAccKeyFmt, walletAddr)
 }
 
+func UIDWalletsKey(userId uint64) string {
+	return fmt.Sprintf(uidWalletsKeyFmt, userId)
+}
+
 func EmailSendDailyLimitKey(emailAddr string, date string) string {
 	return fmt.Sprintf(emailSendDailyLimitKeyFmt, emailAddr, date)
 }
@@ -102,23 +151,183 @@ func EmailResetPasswordNonceKey(emailAddr string) string {
 	return fmt.Sprintf(emailResetPWNonceKeyFmt, emailAddr)
 }
 
+func EmailChangeOldCodeKey(userId uint64) string {
+	return fmt.Sprintf(emailChangeOldCodeKeyFmt, userId)
+}
+
+func SMSSendDailyLimitKey(tel string, date string) string {
+	return fmt.Sprintf(smsSendDailyLimitKeyFmt, tel, date)
+}
+
+// SMSSendUserLimitKey the daily sms sends requested by the account
+func SMSSendUserLimitKey(userId uint64, date string) string {
+	return fmt.Sprintf(smsSendUserLimitKeyFmt, userId, date)
+}
+
+// SMSSendIPLimitKey the daily sms sends requested from the ip
+func SMSSendIPLimitKey(ip string, date string) string {
+	return fmt.Sprintf(smsSendIPLimitKeyFmt, ip, date)
+}
+
+// TelAccKey the user id of the bound phone number
+func TelAccKey(tel string) string {
+	return fmt.Sprintf(telAccKeyFmt, tel)
+}
+
+func TelBindCodeKey(tel string) string {
+	return fmt.Sprintf(telBindCodeKeyFmt, tel)
+}
+
+func TelResetPasswordValidationCodeKey(tel string) string {
+	return fmt.Sprintf(telResetPWCodeKeyFmt, tel)
+}
+
+func AccountDeletionKey() string {
+	return accountDeletionKeyFmt
+}
+
+func AccountDeletionJobKey() string {
+	return accountDeletionJobKeyFmt
+}
+
+// NicknameKey the nickname index is case-insensitive
+func NicknameKey(nickname string) string {
+	return fmt.Sprintf(nicknameKeyFmt, strings.ToLower(nickname))
+}
+
+// AccountMergeLogKey the merges into the user
+func AccountMergeLogKey(userId uint64) string {
+	return fmt.Sprintf(accountMergeLogKeyFmt, userId)
+}
+
 // login
 func TokenKey(token string) string {
 	return fmt.Sprintf(tokenKeyFmt, token)
 }
 
-func UIDTokenKey(userId uint64) string {
-	return fmt.Sprintf(uidTokenKeyFmt, userId)
+func RefreshTokenKey(refreshToken string) string {
+	return fmt.Sprintf(refreshTokenKeyFmt, refreshToken)
+}
+
+func UIDSessionsKey(userId uint64) string {
+	return fmt.Sprintf(uidSessionsKeyFmt, userId)
+}
+
+// UIDSessionSeenKey the hash of the last seen time of the sessions of the user, written by the gateway
+func UIDSessionSeenKey(userId uint64) string {
+	return fmt.Sprintf(uidSessionSeenKeyFmt, userId)
+}
+
+// SessionSeenKey throttle the last seen time updates of the session
+func SessionSeenKey(sessionId string) string {
+	return fmt.Sprintf(sessionSeenKeyFmt, sessionId)
 }
 
 func DeviceAccountsKey(deviceId string) string {
 	return fmt.Sprintf(deviceAccountsKeyFmt, deviceId)
 }
 
+// PlatformAccKey the account of the subject of the identity provider
+func PlatformAccKey(provider, subject string) string {
+	return fmt.Sprintf(platformAccKeyFmt, provider, subject)
+}
+
+// PlatformNonceKey mark the nonce of an ID token of the provider is used
+func PlatformNonceKey(provider, nonce string) string {
+	return fmt.Sprintf(platformNonceKeyFmt, provider, nonce)
+}
+
 func LoginInfoKey(userId uint64) string {
 	return fmt.Sprintf(loginInfoKeyFmt, userId)
 }
 
+// LoginFailKey the failure counter of a login target, the target is an account or an ip
+func LoginFailKey(target string) string {
+	return fmt.Sprintf(loginFailKeyFmt, target)
+}
+
+func LoginLockKey(target string) string {
+	return fmt.Sprintf(loginLockKeyFmt, target)
+}
+
+func LoginLocksKey() string {
+	return loginLocksKeyFmt
+}
+
+// VCodeFailKey the wrong guess counter of the verification code stored at codeKey
+func VCodeFailKey(codeKey string) string {
+	return fmt.Sprintf(vcodeFailKeyFmt, codeKey)
+}
+
+// BanKey mark the user is refused to login, it is read by the gateways to tell banned users from expired tokens
+func BanKey(userId uint64) string {
+	return fmt.Sprintf(banKeyFmt, userId)
+}
+
+// BansKey the users banned with the EBan.Type, scored by the expire time
+func BansKey(banType uint32) string {
+	return fmt.Sprintf(bansKeyFmt, banType)
+}
+
+func BanLogKey(userId uint64) string {
+	return fmt.Sprintf(banLogKeyFmt, userId)
+}
+
+func InviteCodeKey(code string) string {
+	return fmt.Sprintf(inviteCodeKeyFmt, code)
+}
+
+func ReferralsKey(userId uint64) string {
+	return fmt.Sprintf(referralsKeyFmt, userId)
+}
+
+// ReferrersKey the referrers scored by the count of their accepted referrals
+func ReferrersKey() string {
+	return referrersKeyFmt
+}
+
+// ReferralDeviceKey mark the device has been referred
+func ReferralDeviceKey(deviceId string) string {
+	return fmt.Sprintf(referralDeviceKeyFmt, deviceId)
+}
+
+// email
+func EmailMessageKey(messageId string) string {
+	return fmt.Sprintf(emailMessageKeyFmt, messageId)
+}
+
+// EmailOutboxKey the stream of the message ids to send
+func EmailOutboxKey() string {
+	return emailOutboxKeyFmt
+}
+
+// EmailRetryKey the message ids waiting for the next attempt, scored by the attempt time
+func EmailRetryKey() string {
+	return emailRetryKeyFmt
+}
+
+// EmailDeadKey the dead letters, the message ids whose attempts all failed, scored by the failure time
+func EmailDeadKey() string {
+	return emailDeadKeyFmt
+}
+
+// totp
+func TOTPPendingKey(userId uint64) string {
+	return fmt.Sprintf(totpPendingKeyFmt, userId)
+}
+
+func TOTPUsedKey(userId uint64, counter uint64) string {
+	return fmt.Sprintf(totpUsedKeyFmt, userId, counter)
+}
+
+func TOTPFailKey(userId uint64) string {
+	return fmt.Sprintf(totpFailKeyFmt, userId)
+}
+
+func TOTPLoginTicketKey(ticket string) string {
+	return fmt.Sprintf(totpLoginTicketKeyFmt, ticket)
+}
+
 // user
 func UserKey(userId uint64) string {
 	return fmt.Sprintf(userKeyFmt, userId)
@@ -182,3 +182,197 @@ func (gg *GMGateway) adminGetCollectionNFTOffers(w http.ResponseWriter, r *http.
 	}
 	return gg.writeHTTPRes(w, cres)
 }
+
+func (gg *GMGateway) adminRepairAccounts(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	req := &mpb.CReqAdminRepairAccounts{}
+	err := gg.readHTTPReq(w, r, req)
+	if err != nil {
+		return err
+	}
+
+	client, err := com.GetAccountServiceClient(ctx, gg)
+	if err != nil {
+		return err
+	}
+	res, err := client.AdminRepairAccounts(ctx, &mpb.ReqAdminRepairAccounts{
+		StartUserId: req.StartUserId,
+		Limit:       req.Limit,
+		DryRun:      req.DryRun,
+	})
+	if err != nil {
+		return err
+	}
+	cres := &mpb.CResAdminRepairAccounts{
+		Repairs:    res.Repairs,
+		NextUserId: res.NextUserId,
+	}
+	return gg.writeHTTPRes(w, cres)
+}
+
+func (gg *GMGateway) adminGetLoginLocks(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	req := &mpb.CReqAdminGetLoginLocks{}
+	err := gg.readHTTPReq(w, r, req)
+	if err != nil {
+		return err
+	}
+
+	client, err := com.GetAccountServiceClient(ctx, gg)
+	if err != nil {
+		return err
+	}
+	res, err := client.AdminGetLoginLocks(ctx, &mpb.ReqAdminGetLoginLocks{
+		Type:   req.Type,
+		Target: req.Target,
+	})
+	if err != nil {
+		return err
+	}
+	return gg.writeHTTPRes(w, &mpb.CResAdminGetLoginLocks{Locks: res.Locks})
+}
+
+func (gg *GMGateway) adminClearLoginLock(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	req := &mpb.CReqAdminClearLoginLock{}
+	err := gg.readHTTPReq(w, r, req)
+	if err != nil {
+		return err
+	}
+
+	client, err := com.GetAccountServiceClient(ctx, gg)
+	if err != nil {
+		return err
+	}
+	_, err = client.AdminClearLoginLock(ctx, &mpb.ReqAdminClearLoginLock{
+		Type:   req.Type,
+		Target: req.Target,
+	})
+	if err != nil {
+		return err
+	}
+	return gg.writeHTTPRes(w, &mpb.Empty{})
+}
+
+func (gg *GMGateway) adminBanAccount(w http.ResponseWriter, r *http.Request) error {
+	claim, ctx, err := util.ClaimFromContext(r.Context())
+	if err != nil {
+		return err
+	}
+	req := &mpb.CReqAdminBanAccount{}
+	err = gg.readHTTPReq(w, r, req)
+	if err != nil {
+		return err
+	}
+
+	client, err := com.GetGMServiceClient(ctx, gg)
+	if err != nil {
+		return err
+	}
+	res, err := client.AdminBanAccount(ctx, &mpb.ReqAdminBanAccount{
+		UserId:   req.UserId,
+		Type:     req.Type,
+		Reason:   req.Reason,
+		Operator: claim.Account,
+		Duration: req.Duration,
+	})
+	if err != nil {
+		return err
+	}
+	return gg.writeHTTPRes(w, &mpb.CResAdminBanAccount{Ban: res})
+}
+
+func (gg *GMGateway) adminUnbanAccount(w http.ResponseWriter, r *http.Request) error {
+	claim, ctx, err := util.ClaimFromContext(r.Context())
+	if err != nil {
+		return err
+	}
+	req := &mpb.CReqAdminUnbanAccount{}
+	err = gg.readHTTPReq(w, r, req)
+	if err != nil {
+		return err
+	}
+
+	client, err := com.GetGMServiceClient(ctx, gg)
+	if err != nil {
+		return err
+	}
+	_, err = client.AdminUnbanAccount(ctx, &mpb.ReqAdminUnbanAccount{
+		UserId:   req.UserId,
+		Type:     req.Type,
+		Reason:   req.Reason,
+		Operator: claim.Account,
+	})
+	if err != nil {
+		return err
+	}
+	return gg.writeHTTPRes(w, &mpb.Empty{})
+}
+
+func (gg *GMGateway) adminListBans(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	req := &mpb.CReqAdminListBans{}
+	err := gg.readHTTPReq(w, r, req)
+	if err != nil {
+		return err
+	}
+
+	client, err := com.GetGMServiceClient(ctx, gg)
+	if err != nil {
+		return err
+	}
+	res, err := client.AdminListBans(ctx, &mpb.ReqAdminListBans{
+		Type:   req.Type,
+		UserId: req.UserId,
+	})
+	if err != nil {
+		return err
+	}
+	return gg.writeHTTPRes(w, &mpb.CResAdminListBans{Bans: res.Bans, Logs: res.Logs})
+}
+
+func (gg *GMGateway) adminGetTopReferrers(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	req := &mpb.CReqAdminGetTopReferrers{}
+	err := gg.readHTTPReq(w, r, req)
+	if err != nil {
+		return err
+	}
+
+	client, err := com.GetAccountServiceClient(ctx, gg)
+	if err != nil {
+		return err
+	}
+	res, err := client.AdminGetTopReferrers(ctx, &mpb.ReqAdminGetTopReferrers{Limit: req.Limit})
+	if err != nil {
+		return err
+	}
+	return gg.writeHTTPRes(w, &mpb.CResAdminGetTopReferrers{Referrers: res.Referrers})
+}
+
+func (gg *GMGateway) adminMergeAccounts(w http.ResponseWriter, r *http.Request) error {
+	claim, ctx, err := util.ClaimFromContext(r.Context())
+	if err != nil {
+		return err
+	}
+	req := &mpb.CReqAdminMergeAccounts{}
+	err = gg.readHTTPReq(w, r, req)
+	if err != nil {
+		return err
+	}
+
+	client, err := com.GetGMServiceClient(ctx, gg)
+	if err != nil {
+		return err
+	}
+	res, err := client.AdminMergeAccounts(ctx, &mpb.ReqAdminMergeAccounts{
+		UserId:       req.UserId,
+		MergedUserId: req.MergedUserId,
+		Reason:       req.Reason,
+		Operator:     claim.Account,
+	})
+	if err != nil {
+		return err
+	}
+	return gg.writeHTTPRes(w, &mpb.CResAdminMergeAccounts{Log: res})
+}
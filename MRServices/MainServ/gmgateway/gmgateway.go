@@ -134,6 +134,14 @@ func NewGMGateway(driver service.ModuleDriver) (gxhttp.GXHttpHandler, error) {
 	mux.Handle("/HelloWorld", eh.Handler(gateway.helloWorld))
 	mux.Handle("/AdminLoginByPassword", eh.Handler(gateway.adminLoginByPassword))
 	mux.Handle("/AdminGetAptosNFTOwner", jm.Handler(eh.Handler(gateway.adminGetAptosNFTOwner)))
+	mux.Handle("/AdminRepairAccounts", jm.Handler(eh.Handler(gateway.adminRepairAccounts)))
+	mux.Handle("/AdminGetLoginLocks", jm.Handler(eh.Handler(gateway.adminGetLoginLocks)))
+	mux.Handle("/AdminClearLoginLock", jm.Handler(eh.Handler(gateway.adminClearLoginLock)))
+	mux.Handle("/AdminBanAccount", jm.Handler(eh.Handler(gateway.adminBanAccount)))
+	mux.Handle("/AdminUnbanAccount", jm.Handler(eh.Handler(gateway.adminUnbanAccount)))
+	mux.Handle("/AdminListBans", jm.Handler(eh.Handler(gateway.adminListBans)))
+	mux.Handle("/AdminGetTopReferrers", jm.Handler(eh.Handler(gateway.adminGetTopReferrers)))
+	mux.Handle("/AdminMergeAccounts", jm.Handler(eh.Handler(gateway.adminMergeAccounts)))
 	mux.Handle("/AdminGetAptosNFTsInCollection", eh.Handler(gateway.adminGetAptosNFTsInCollection))
 	mux.Handle("/AdminGetCollectionNFTBuyers", eh.Handler(gateway.adminGetCollectionNFTBuyers))
 	mux.Handle("/AdminGetCollectionNFTOffers", eh.Handler(gateway.adminGetCollectionNFTOffers))
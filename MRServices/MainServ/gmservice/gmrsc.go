@@ -2,6 +2,7 @@ package gmservice
 
 import (
 	"fmt"
+	"sync"
 
 	"github.com/aureontu/MRWebServer/mr_services/mpb"
 	"github.com/aureontu/MRWebServer/mr_services/mpberr"
@@ -23,6 +24,7 @@ type GMResourceMgr struct {
 	dm     *gdm.DirMonitor
 	mtr    *util.ServiceMetrics
 
+	adminMux sync.RWMutex
 	adminMap map[string]*mpb.AdminRsc
 }
 
@@ -78,20 +80,50 @@ func (rm *GMResourceMgr) loadAdmins(csvPath string) error {
 			Account:  data["account"],
 			Password: data["password"],
 		}
+		if !util.IsPasswordHashed(node.Password) {
+			// keep plaintext out of memory, the csv should be updated with the hashed value
+			rm.logger.Warn("loadAdmins admin password is not hashed", zap.String("account", node.Account))
+			node.Password, err = util.HashPassword(node.Password)
+			if err != nil {
+				rm.logger.Error(fmt.Sprintf("load %s failed: %s", csvPath, err.Error()))
+				return err
+			}
+		}
 
 		m[node.Account] = node
 		rm.logger.Debug("loadAdmins read:", zap.Any("row", node))
 	}
 
+	rm.adminMux.Lock()
 	rm.adminMap = m
-	rm.logger.Debug("loadAdmins read finish:", zap.Any("rows", rm.adminMap))
+	rm.adminMux.Unlock()
+	rm.logger.Debug("loadAdmins read finish:", zap.Any("rows", m))
 	return nil
 }
 
 func (rm *GMResourceMgr) getAdminRSC(account string) (*mpb.AdminRsc, error) {
+	rm.adminMux.RLock()
 	accRsc, ok := rm.adminMap[account]
+	rm.adminMux.RUnlock()
 	if !ok {
 		return nil, mpberr.ErrAdminAccountOrPasswd
 	}
 	return accRsc, nil
 }
+
+// rehashAdminPassword upgrade an outdated admin hash in memory, the csv itself is not rewritten
+func (rm *GMResourceMgr) rehashAdminPassword(account, oldStored, password string) {
+	hash, err := util.HashPassword(password)
+	if err != nil {
+		rm.logger.Error("rehashAdminPassword failed", zap.String("account", account), zap.Error(err))
+		return
+	}
+	rm.adminMux.Lock()
+	defer rm.adminMux.Unlock()
+	accRsc, ok := rm.adminMap[account]
+	if !ok || accRsc.Password != oldStored {
+		return
+	}
+	rm.adminMap[account] = &mpb.AdminRsc{Account: account, Password: hash}
+	rm.logger.Warn("admin password hash is outdated, please update Admins.csv", zap.String("account", account))
+}
@@ -6,6 +6,7 @@ import (
 
 	com "github.com/aureontu/MRWebServer/mr_services/common"
 	"github.com/aureontu/MRWebServer/mr_services/mpb"
+	"github.com/aureontu/MRWebServer/mr_services/mpberr"
 	"github.com/aureontu/MRWebServer/mr_services/util"
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/oldjon/gutil/env"
@@ -122,8 +123,12 @@ func (svc *GMService) AdminLoginByPassword(ctx context.Context, req *mpb.ReqAdmi
 	if err != nil {
 		return nil, err
 	}
-	if rsc.Password != req.Password {
-		return nil, err
+	ok, needRehash := util.VerifyPassword(rsc.Password, req.Password)
+	if !ok {
+		return nil, mpberr.ErrAdminAccountOrPasswd
+	}
+	if needRehash {
+		svc.rm.rehashAdminPassword(req.Account, rsc.Password, req.Password)
 	}
 
 	token, err := svc.generateAdminLoginToken(req.Account)
@@ -135,3 +140,57 @@ func (svc *GMService) AdminLoginByPassword(ctx context.Context, req *mpb.ReqAdmi
 		Token: token,
 	}, nil
 }
+
+// checkOperator the operator must be a known admin, it is recorded in the ban audit trail and the merge logs
+func (svc *GMService) checkOperator(operator string) error {
+	if operator == "" {
+		return mpberr.ErrParam
+	}
+	_, err := svc.rm.getAdminRSC(operator)
+	return err
+}
+
+func (svc *GMService) AdminBanAccount(ctx context.Context, req *mpb.ReqAdminBanAccount) (*mpb.BanRecord, error) {
+	err := svc.checkOperator(req.Operator)
+	if err != nil {
+		return nil, err
+	}
+	client, err := com.GetAccountServiceClient(ctx, svc)
+	if err != nil {
+		return nil, err
+	}
+	return client.AdminBanAccount(ctx, req)
+}
+
+func (svc *GMService) AdminUnbanAccount(ctx context.Context, req *mpb.ReqAdminUnbanAccount) (*mpb.Empty, error) {
+	err := svc.checkOperator(req.Operator)
+	if err != nil {
+		return nil, err
+	}
+	client, err := com.GetAccountServiceClient(ctx, svc)
+	if err != nil {
+		return nil, err
+	}
+	return client.AdminUnbanAccount(ctx, req)
+}
+
+func (svc *GMService) AdminListBans(ctx context.Context, req *mpb.ReqAdminListBans) (*mpb.ResAdminListBans, error) {
+	client, err := com.GetAccountServiceClient(ctx, svc)
+	if err != nil {
+		return nil, err
+	}
+	return client.AdminListBans(ctx, req)
+}
+
+func (svc *GMService) AdminMergeAccounts(ctx context.Context, req *mpb.ReqAdminMergeAccounts) (*mpb.AccountMergeLog,
+	error) {
+	err := svc.checkOperator(req.Operator)
+	if err != nil {
+		return nil, err
+	}
+	client, err := com.GetAccountServiceClient(ctx, svc)
+	if err != nil {
+		return nil, err
+	}
+	return client.AdminMergeAccounts(ctx, req)
+}
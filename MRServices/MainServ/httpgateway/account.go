@@ -1,6 +1,7 @@
 package httpgateway
 
 import (
+	"context"
 	"net/http"
 	"strconv"
 	"strings"
@@ -9,6 +10,7 @@ import (
 	"github.com/aureontu/MRWebServer/mr_services/mpb"
 	"github.com/aureontu/MRWebServer/mr_services/mpberr"
 	"github.com/aureontu/MRWebServer/mr_services/util"
+	gjwt "github.com/oldjon/gutil/jwt"
 )
 
 func (hg *HTTPGateway) loginByPassword(w http.ResponseWriter, r *http.Request) error {
@@ -36,46 +38,73 @@ func (hg *HTTPGateway) loginByPassword(w http.ResponseWriter, r *http.Request) e
 		Password: req.Password,
 		RemoteIp: remoteIP,
 		Region:   getRegionByIP(remoteIP),
+		Device:   getDevice(r, req.Device),
+		DeviceId: truncateString(req.DeviceId, com.DeviceIdMaxLen),
 	}
 	res, err := client.LoginByPassword(ctx, &rpcReq)
 	if err != nil {
 		return err
 	}
 	cres := &mpb.CResLoginByPassword{
-		Account:   res.Account,
-		Resources: res.Resources,
-		Token:     res.Token,
+		Account:      res.Account,
+		Resources:    res.Resources,
+		Token:        res.Token,
+		RefreshToken: res.RefreshToken,
+		TotpRequired: res.TotpRequired,
+		LoginTicket:  res.LoginTicket,
 	}
 	return hg.writeHTTPRes(w, cres)
 }
 
-func (hg *HTTPGateway) WebLoginByWallet(w http.ResponseWriter, r *http.Request) error {
+func (hg *HTTPGateway) loginByTOTP(w http.ResponseWriter, r *http.Request) error {
 	ctx := r.Context()
-	req := &mpb.CReqWebLoginByWallet{}
+	req := &mpb.CReqLoginByTOTP{}
 	err := hg.readHTTPReq(w, r, req)
 	if err != nil {
 		return err
 	}
-	req.WalletAddr, err = util.FixHashId(req.WalletAddr)
-	if err != nil {
-		return mpberr.ErrParam
-	}
-	if req.WalletAddr == "" || req.PubKey == "" {
+	if req.LoginTicket == "" || req.Code == "" {
 		return mpberr.ErrParam
 	}
 
-	nonce := util.ReadNonceFromAptosFullMsg(req.AptosFullMsg)
-	if len(nonce) != com.NonceLen {
-		return mpberr.ErrParam
-	}
+	remoteIP := getRemoteIPAddress(r)
 
-	pubKey, err := util.DecodeAptosPubKey(req.PubKey)
+	client, err := com.GetAccountServiceClient(ctx, hg)
+	if err != nil {
+		return err
+	}
+	res, err := client.LoginByTOTP(ctx, &mpb.ReqLoginByTOTP{
+		LoginTicket: req.LoginTicket,
+		Code:        req.Code,
+		RemoteIp:    remoteIP,
+		Region:      getRegionByIP(remoteIP),
+	})
 	if err != nil {
-		return mpberr.ErrAptosPublicKey
+		return err
 	}
+	cres := &mpb.CResLoginByPassword{
+		Account:      res.Account,
+		Resources:    res.Resources,
+		Token:        res.Token,
+		RefreshToken: res.RefreshToken,
+	}
+	return hg.writeHTTPRes(w, cres)
+}
 
-	if !util.VerifySignature(pubKey, req.AptosFullMsg, req.AptosSignature) {
-		return mpberr.ErrAptosVerifySignature
+func (hg *HTTPGateway) WebLoginByWallet(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	req := &mpb.CReqWebLoginByWallet{}
+	err := hg.readHTTPReq(w, r, req)
+	if err != nil {
+		return err
+	}
+	req.WalletAddr, err = util.FixHashId(req.WalletAddr)
+	if err != nil {
+		return mpberr.ErrParam
+	}
+	pubKey, err := hg.verifyWalletSignature(ctx, req.WalletAddr, req.PubKey, req.AptosFullMsg, req.AptosSignature)
+	if err != nil {
+		return err
 	}
 
 	remoteIP := getRemoteIPAddress(r)
@@ -85,323 +114,1384 @@ func (hg *HTTPGateway) WebLoginByWallet(w http.ResponseWriter, r *http.Request)
 		return err
 	}
 	rpcReq := mpb.ReqWebLoginByWallet{
-		WalletAddr: req.WalletAddr,
-		PubKey:     pubKey,
-		Nonce:      nonce,
-		RemoteIp:   remoteIP,
-		Region:     getRegionByIP(remoteIP),
+		WalletAddr:   req.WalletAddr,
+		PubKey:       pubKey,
+		AptosFullMsg: req.AptosFullMsg,
+		RemoteIp:     remoteIP,
+		Region:       getRegionByIP(remoteIP),
+		Device:       getDevice(r, req.Device),
+		DeviceId:     truncateString(req.DeviceId, com.DeviceIdMaxLen),
+		ReferrerCode: req.ReferrerCode,
 	}
 	res, err := client.WebLoginByWallet(ctx, &rpcReq)
 	if err != nil {
 		return err
 	}
 	cres := &mpb.CResWebLoginByWallet{
-		Account:   res.Account,
-		Resources: res.Resources,
-		Token:     res.Token,
+		Account:      res.Account,
+		Resources:    res.Resources,
+		Token:        res.Token,
+		RefreshToken: res.RefreshToken,
 	}
 	return hg.writeHTTPRes(w, cres)
 }
 
-func (hg *HTTPGateway) generateNonce(w http.ResponseWriter, r *http.Request) error {
+func (hg *HTTPGateway) registerAccount(w http.ResponseWriter, r *http.Request) error {
 	ctx := r.Context()
+	req := &mpb.CReqRegisterAccount{}
+	err := hg.readHTTPReq(w, r, req)
+	if err != nil {
+		return err
+	}
+
+	if !util.CheckEmailAddr(req.Email) {
+		return mpberr.ErrEmailAddress
+	}
+	req.Password = strings.ToLower(req.Password)
+	if len(req.Password) != com.PasswordLen {
+		return mpberr.ErrPassword
+	}
+	if len(req.Code) != com.VCodeLen {
+		return mpberr.ErrEmailBindCode
+	}
+
+	remoteIP := getRemoteIPAddress(r)
+
 	client, err := com.GetAccountServiceClient(ctx, hg)
 	if err != nil {
 		return err
 	}
-	res, err := client.GenerateNonce(ctx, &mpb.Empty{})
+	res, err := client.RegisterAccount(ctx, &mpb.ReqRegisterAccount{
+		Account:      req.Email,
+		Password:     req.Password,
+		Code:         req.Code,
+		Device:       getDevice(r, req.Device),
+		DeviceId:     truncateString(req.DeviceId, com.DeviceIdMaxLen),
+		Os:           truncateString(req.Os, com.DeviceMaxLen),
+		Platform:     truncateString(req.Platform, com.DeviceMaxLen),
+		RemoteIp:     remoteIP,
+		Region:       getRegionByIP(remoteIP),
+		ReferrerCode: req.ReferrerCode,
+	})
 	if err != nil {
 		return err
 	}
-	cres := &mpb.CResGenerateNonce{
-		Nonce: res.Nonce,
+	cres := &mpb.CResRegisterAccount{
+		Account:      res.Account,
+		Token:        res.Token,
+		RefreshToken: res.RefreshToken,
 	}
 	return hg.writeHTTPRes(w, cres)
 }
 
-func (hg *HTTPGateway) SendEmailBindCode(w http.ResponseWriter, r *http.Request) error {
+func (hg *HTTPGateway) loginAsGuest(w http.ResponseWriter, r *http.Request) error {
 	ctx := r.Context()
-	req := &mpb.CReqSendEmailBindCode{}
+	req := &mpb.CReqLoginAsGuest{}
 	err := hg.readHTTPReq(w, r, req)
 	if err != nil {
 		return err
 	}
-
-	if !util.CheckEmailAddr(req.Email) {
-		return mpberr.ErrEmailAddress
+	if req.DeviceId == "" || len(req.DeviceId) > com.DeviceIdMaxLen {
+		return mpberr.ErrParam
 	}
 
+	remoteIP := getRemoteIPAddress(r)
+
 	client, err := com.GetAccountServiceClient(ctx, hg)
 	if err != nil {
 		return err
 	}
-	_, err = client.GenerateAndSendEmailBindCode(ctx, &mpb.ReqGenerateAndSendEmailBindCode{Email: req.Email})
+	res, err := client.LoginAsGuest(ctx, &mpb.ReqLoginAsGuest{
+		DeviceId:   req.DeviceId,
+		Device:     getDevice(r, req.Device),
+		Os:         truncateString(req.Os, com.DeviceMaxLen),
+		Platform:   truncateString(req.Platform, com.DeviceMaxLen),
+		RemoteIp:   remoteIP,
+		Region:     getRegionByIP(remoteIP),
+		NewAccount: req.NewAccount,
+	})
 	if err != nil {
 		return err
 	}
-
-	return hg.writeHTTPRes(w, &mpb.Empty{})
+	cres := &mpb.CResLoginByPassword{
+		Account:      res.Account,
+		Resources:    res.Resources,
+		Token:        res.Token,
+		RefreshToken: res.RefreshToken,
+	}
+	return hg.writeHTTPRes(w, cres)
 }
 
-func (hg *HTTPGateway) webBindEmail(w http.ResponseWriter, r *http.Request) error {
+func (hg *HTTPGateway) loginByPlatform(w http.ResponseWriter, r *http.Request) error {
 	ctx := r.Context()
-	claim, ctx, err := util.ClaimFromContext(r.Context())
+	req := &mpb.CReqLoginByPlatform{}
+	err := hg.readHTTPReq(w, r, req)
 	if err != nil {
 		return err
 	}
+	if req.Provider == "" || req.IdToken == "" || req.Nonce == "" {
+		return mpberr.ErrParam
+	}
 
-	req := &mpb.CReqWebBindEmail{}
-	err = hg.readHTTPReq(w, r, req)
+	remoteIP := getRemoteIPAddress(r)
+
+	client, err := com.GetAccountServiceClient(ctx, hg)
 	if err != nil {
 		return err
 	}
-
-	if len(req.Code) != com.VCodeLen {
-		return mpberr.ErrEmailBindCode
+	res, err := client.LoginByPlatform(ctx, &mpb.ReqLoginByPlatform{
+		Provider:     req.Provider,
+		IdToken:      req.IdToken,
+		Device:       getDevice(r, req.Device),
+		DeviceId:     truncateString(req.DeviceId, com.DeviceIdMaxLen),
+		Os:           truncateString(req.Os, com.DeviceMaxLen),
+		Platform:     truncateString(req.Platform, com.DeviceMaxLen),
+		RemoteIp:     remoteIP,
+		Region:       getRegionByIP(remoteIP),
+		ReferrerCode: req.ReferrerCode,
+		Nonce:        req.Nonce,
+	})
+	if err != nil {
+		return err
+	}
+	cres := &mpb.CResLoginByPassword{
+		Account:      res.Account,
+		Resources:    res.Resources,
+		Token:        res.Token,
+		RefreshToken: res.RefreshToken,
 	}
+	return hg.writeHTTPRes(w, cres)
+}
 
-	_, err = strconv.Atoi(req.Code)
+func (hg *HTTPGateway) linkPlatform(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	claim, ctx, err := util.ClaimFromContext(r.Context())
 	if err != nil {
-		return mpberr.ErrEmailBindCode
+		return err
 	}
 
-	if !util.CheckEmailAddr(req.Email) {
-		return mpberr.ErrEmailAddress
+	req := &mpb.CReqLinkPlatform{}
+	err = hg.readHTTPReq(w, r, req)
+	if err != nil {
+		return err
+	}
+	if req.Provider == "" || req.IdToken == "" || req.Nonce == "" {
+		return mpberr.ErrParam
 	}
 
 	client, err := com.GetAccountServiceClient(ctx, hg)
 	if err != nil {
 		return err
 	}
-	res, err := client.WebBindEmail(ctx, &mpb.ReqWebBindEmail{UserId: claim.UserId, Email: req.Email, Code: req.Code})
+	res, err := client.LinkPlatform(ctx, &mpb.ReqLinkPlatform{
+		UserId:   claim.UserId,
+		Provider: req.Provider,
+		IdToken:  req.IdToken,
+		Nonce:    req.Nonce,
+	})
 	if err != nil {
 		return err
 	}
-	cres := &mpb.CResWebBindEmail{
-		Account:   res.Account,
-		Resources: res.Resources,
-		Token:     res.Token,
-	}
-	return hg.writeHTTPRes(w, cres)
+	return hg.writeHTTPRes(w, &mpb.CResLinkPlatform{Account: res})
 }
 
-func (hg *HTTPGateway) getAccountInfo(w http.ResponseWriter, r *http.Request) error {
+func (hg *HTTPGateway) upgradeGuestByEmail(w http.ResponseWriter, r *http.Request) error {
 	ctx := r.Context()
 	claim, ctx, err := util.ClaimFromContext(r.Context())
 	if err != nil {
 		return err
 	}
 
-	client, err := com.GetAccountServiceClient(ctx, hg)
+	req := &mpb.CReqUpgradeGuestByEmail{}
+	err = hg.readHTTPReq(w, r, req)
 	if err != nil {
 		return err
 	}
+	if !util.CheckEmailAddr(req.Email) {
+		return mpberr.ErrEmailAddress
+	}
+	req.Password = strings.ToLower(req.Password)
+	if len(req.Password) != com.PasswordLen {
+		return mpberr.ErrPassword
+	}
+	if len(req.Code) != com.VCodeLen {
+		return mpberr.ErrEmailBindCode
+	}
 
-	res, err := client.GetAccountInfo(ctx, &mpb.ReqUserId{UserId: claim.UserId})
+	client, err := com.GetAccountServiceClient(ctx, hg)
 	if err != nil {
 		return err
 	}
-	cres := &mpb.CResGetAccountInfo{
-		Account: &mpb.AccountInfo{
-			Account:  res.Account,
-			UserId:   res.UserId,
-			Nickname: res.Nickname,
-			Icon:     res.Icon,
-			Email:    res.Email,
-		},
+	res, err := client.UpgradeGuestByEmail(ctx, &mpb.ReqUpgradeGuestByEmail{
+		UserId:   claim.UserId,
+		Email:    req.Email,
+		Password: req.Password,
+		Code:     req.Code,
+	})
+	if err != nil {
+		return err
 	}
-	return hg.writeHTTPRes(w, cres)
+	return hg.writeHTTPRes(w, &mpb.CResUpgradeGuest{Account: res})
 }
 
-func (hg *HTTPGateway) changePassword(w http.ResponseWriter, r *http.Request) error {
+func (hg *HTTPGateway) upgradeGuestByWallet(w http.ResponseWriter, r *http.Request) error {
 	ctx := r.Context()
 	claim, ctx, err := util.ClaimFromContext(r.Context())
 	if err != nil {
 		return err
 	}
 
-	req := &mpb.CReqChangePassword{}
+	req := &mpb.CReqLinkWallet{}
 	err = hg.readHTTPReq(w, r, req)
 	if err != nil {
 		return err
 	}
-	req.NewPassword = strings.ToLower(req.NewPassword)
-	req.OldPassword = strings.ToLower(req.OldPassword)
-	if len(req.NewPassword) != com.PasswordLen {
+	req.WalletAddr, err = util.FixHashId(req.WalletAddr)
+	if err != nil {
 		return mpberr.ErrParam
 	}
-
-	if req.OldPassword == req.NewPassword {
-		return mpberr.ErrNewPWSameWithOldPW
+	pubKey, err := hg.verifyWalletSignature(ctx, req.WalletAddr, req.PubKey, req.AptosFullMsg, req.AptosSignature)
+	if err != nil {
+		return err
 	}
 
 	client, err := com.GetAccountServiceClient(ctx, hg)
 	if err != nil {
 		return err
 	}
-
-	res, err := client.ChangePassword(ctx, &mpb.ReqChangePassword{
-		UserId:      claim.UserId,
-		OldPassword: req.OldPassword,
-		NewPassword: req.NewPassword,
+	res, err := client.UpgradeGuestByWallet(ctx, &mpb.ReqLinkWallet{
+		UserId:       claim.UserId,
+		WalletAddr:   req.WalletAddr,
+		PubKey:       pubKey,
+		AptosFullMsg: req.AptosFullMsg,
 	})
 	if err != nil {
 		return err
 	}
-	return hg.writeHTTPRes(w, res)
+	return hg.writeHTTPRes(w, &mpb.CResUpgradeGuest{Account: res})
 }
 
-func (hg *HTTPGateway) sendEmailResetPasswordCode(w http.ResponseWriter, r *http.Request) error {
+func (hg *HTTPGateway) linkWallet(w http.ResponseWriter, r *http.Request) error {
 	ctx := r.Context()
-	req := &mpb.CReqSendEmailResetPasswordCode{}
-	err := hg.readHTTPReq(w, r, req)
+	claim, ctx, err := util.ClaimFromContext(r.Context())
 	if err != nil {
 		return err
 	}
-	if util.CheckEmailAddr(req.Email) {
-		return mpberr.ErrEmailAddress
+
+	req := &mpb.CReqLinkWallet{}
+	err = hg.readHTTPReq(w, r, req)
+	if err != nil {
+		return err
+	}
+	req.WalletAddr, err = util.FixHashId(req.WalletAddr)
+	if err != nil {
+		return mpberr.ErrParam
+	}
+	pubKey, err := hg.verifyWalletSignature(ctx, req.WalletAddr, req.PubKey, req.AptosFullMsg, req.AptosSignature)
+	if err != nil {
+		return err
 	}
 
 	client, err := com.GetAccountServiceClient(ctx, hg)
 	if err != nil {
 		return err
 	}
-
-	_, err = client.SendEmailResetPasswordCode(ctx, &mpb.ReqSendEmailResetPasswordCode{
-		Email: req.Email,
+	res, err := client.LinkWallet(ctx, &mpb.ReqLinkWallet{
+		UserId:       claim.UserId,
+		WalletAddr:   req.WalletAddr,
+		PubKey:       pubKey,
+		AptosFullMsg: req.AptosFullMsg,
 	})
 	if err != nil {
 		return err
 	}
-
-	return hg.writeHTTPRes(w, &mpb.Empty{})
+	return hg.writeHTTPRes(w, &mpb.CResListWallets{Wallets: res.Wallets})
 }
 
-func (hg *HTTPGateway) checkEmailResetPasswordCode(w http.ResponseWriter, r *http.Request) error {
+// mergeAccount merge the account proved by the email and password or by the wallet signature into the logged-in one
+func (hg *HTTPGateway) mergeAccount(w http.ResponseWriter, r *http.Request) error {
 	ctx := r.Context()
-	req := &mpb.CReqCheckEmailResetPasswordCode{}
-	err := hg.readHTTPReq(w, r, req)
+	claim, ctx, err := util.ClaimFromContext(r.Context())
 	if err != nil {
 		return err
 	}
 
-	if len(req.Code) != com.VCodeLen {
-		return mpberr.ErrEmailVerificationCode
+	req := &mpb.CReqMergeAccount{}
+	err = hg.readHTTPReq(w, r, req)
+	if err != nil {
+		return err
 	}
-
-	if util.CheckEmailAddr(req.Email) {
-		return mpberr.ErrEmailAddress
+	if (req.Email == "") == (req.WalletAddr == "") {
+		return mpberr.ErrParam
+	}
+	if req.Email != "" {
+		req.Password = strings.ToLower(req.Password)
+		if len(req.Password) != com.PasswordLen {
+			return mpberr.ErrPassword
+		}
+	} else {
+		req.WalletAddr, err = util.FixHashId(req.WalletAddr)
+		if err != nil {
+			return mpberr.ErrParam
+		}
+		_, err = hg.verifyWalletSignature(ctx, req.WalletAddr, req.PubKey, req.AptosFullMsg, req.AptosSignature)
+		if err != nil {
+			return err
+		}
 	}
 
 	client, err := com.GetAccountServiceClient(ctx, hg)
 	if err != nil {
 		return err
 	}
-
-	res, err := client.CheckEmailResetPasswordCode(ctx, &mpb.ReqCheckEmailResetPasswordCode{
-		Email: req.Email,
-		Code:  req.Code,
+	res, err := client.MergeAccount(ctx, &mpb.ReqMergeAccount{
+		UserId:        claim.UserId,
+		TotpCode:      req.TotpCode,
+		Email:         req.Email,
+		Password:      req.Password,
+		WalletAddr:    req.WalletAddr,
+		AptosFullMsg:  req.AptosFullMsg,
+		OtherTotpCode: req.OtherTotpCode,
+		RemoteIp:      getRemoteIPAddress(r),
 	})
 	if err != nil {
 		return err
 	}
-
-	return hg.writeHTTPRes(w, &mpb.CResCheckEmailResetPasswordCode{Nonce: res.Nonce})
+	return hg.writeHTTPRes(w, &mpb.CResMergeAccount{Account: res})
 }
 
-func (hg *HTTPGateway) resetPasswordByEmail(w http.ResponseWriter, r *http.Request) error {
+func (hg *HTTPGateway) unlinkWallet(w http.ResponseWriter, r *http.Request) error {
 	ctx := r.Context()
-	req := &mpb.CReqResetPasswordByEmail{}
-	err := hg.readHTTPReq(w, r, req)
+	claim, ctx, err := util.ClaimFromContext(r.Context())
 	if err != nil {
 		return err
 	}
 
-	if len(req.Nonce) != com.NonceLen {
-		return mpberr.ErrParam
+	req := &mpb.CReqWalletAddr{}
+	err = hg.readHTTPReq(w, r, req)
+	if err != nil {
+		return err
 	}
-
-	req.Password = strings.ToLower(req.Password)
-	if len(req.Password) != com.PasswordLen {
+	req.WalletAddr, err = util.FixHashId(req.WalletAddr)
+	if err != nil || req.WalletAddr == "" {
 		return mpberr.ErrParam
 	}
 
-	if util.CheckEmailAddr(req.Email) {
-		return mpberr.ErrEmailAddress
-	}
-
 	client, err := com.GetAccountServiceClient(ctx, hg)
 	if err != nil {
 		return err
 	}
-
-	_, err = client.ResetPasswordByEmail(ctx, &mpb.ReqResetPasswordByEmail{
-		Email:    req.Email,
-		Password: req.Password,
-		Nonce:    req.Nonce,
-	})
+	res, err := client.UnlinkWallet(ctx, &mpb.ReqWalletAddr{UserId: claim.UserId, WalletAddr: req.WalletAddr})
 	if err != nil {
 		return err
 	}
-
-	return hg.writeHTTPRes(w, &mpb.Empty{})
+	return hg.writeHTTPRes(w, &mpb.CResListWallets{Wallets: res.Wallets})
 }
 
-func (hg *HTTPGateway) resetPasswordByEmailAndVCode(w http.ResponseWriter, r *http.Request) error {
+func (hg *HTTPGateway) setPrimaryWallet(w http.ResponseWriter, r *http.Request) error {
 	ctx := r.Context()
-	req := &mpb.CReqResetPasswordByEmailAndVCode{}
-	err := hg.readHTTPReq(w, r, req)
+	claim, ctx, err := util.ClaimFromContext(r.Context())
 	if err != nil {
 		return err
 	}
 
-	if len(req.Code) != com.VCodeLen {
-		return mpberr.ErrParam
+	req := &mpb.CReqWalletAddr{}
+	err = hg.readHTTPReq(w, r, req)
+	if err != nil {
+		return err
 	}
-
-	req.Password = strings.ToLower(req.Password)
-	if len(req.Password) != com.PasswordLen {
+	req.WalletAddr, err = util.FixHashId(req.WalletAddr)
+	if err != nil || req.WalletAddr == "" {
 		return mpberr.ErrParam
 	}
 
-	if util.CheckEmailAddr(req.Email) {
-		return mpberr.ErrEmailAddress
-	}
-
 	client, err := com.GetAccountServiceClient(ctx, hg)
 	if err != nil {
 		return err
 	}
-
-	_, err = client.ResetPasswordByEmailAndVCode(ctx, &mpb.ReqResetPasswordByEmailAndVCode{
-		Email:    req.Email,
-		Password: req.Password,
-		Code:     req.Code,
-	})
+	res, err := client.SetPrimaryWallet(ctx, &mpb.ReqWalletAddr{UserId: claim.UserId, WalletAddr: req.WalletAddr})
 	if err != nil {
 		return err
 	}
-
-	return hg.writeHTTPRes(w, &mpb.Empty{})
+	return hg.writeHTTPRes(w, &mpb.CResListWallets{Wallets: res.Wallets})
 }
 
-func (hg *HTTPGateway) getAptosResources(w http.ResponseWriter, r *http.Request) error {
+func (hg *HTTPGateway) listWallets(w http.ResponseWriter, r *http.Request) error {
 	ctx := r.Context()
 	claim, ctx, err := util.ClaimFromContext(r.Context())
 	if err != nil {
 		return err
 	}
 
-	clientApp, err := com.GetAPIProxyGRPCClient(ctx, hg)
+	client, err := com.GetAccountServiceClient(ctx, hg)
 	if err != nil {
 		return err
 	}
-	res, err := clientApp.GetAptosResources(ctx, &mpb.ReqGetAptosResources{AptosAccAddr: claim.WalletAddr})
+	res, err := client.ListWallets(ctx, &mpb.ReqUserId{UserId: claim.UserId})
 	if err != nil {
 		return err
 	}
-	cres := &mpb.CResGetAptosResources{
-		Resources: res.Resources,
-	}
-	return hg.writeHTTPRes(w, cres)
+	return hg.writeHTTPRes(w, &mpb.CResListWallets{Wallets: res.Wallets})
+}
+
+func (hg *HTTPGateway) refreshToken(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	req := &mpb.CReqRefreshToken{}
+	err := hg.readHTTPReq(w, r, req)
+	if err != nil {
+		return err
+	}
+	if len(req.RefreshToken) != com.RefreshTokenLen*2 {
+		return mpberr.ErrTokenVerify
+	}
+
+	remoteIP := getRemoteIPAddress(r)
+
+	client, err := com.GetAccountServiceClient(ctx, hg)
+	if err != nil {
+		return err
+	}
+	res, err := client.RefreshToken(ctx, &mpb.ReqRefreshToken{
+		RefreshToken: req.RefreshToken,
+		RemoteIp:     remoteIP,
+		Region:       getRegionByIP(remoteIP),
+	})
+	if err != nil {
+		return err
+	}
+	cres := &mpb.CResRefreshToken{
+		Token:        res.Token,
+		RefreshToken: res.RefreshToken,
+	}
+	return hg.writeHTTPRes(w, cres)
+}
+
+func (hg *HTTPGateway) logout(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	claim, ctx, err := util.ClaimFromContext(r.Context())
+	if err != nil {
+		return err
+	}
+
+	client, err := com.GetAccountServiceClient(ctx, hg)
+	if err != nil {
+		return err
+	}
+	_, err = client.Logout(ctx, &mpb.ReqLogout{UserId: claim.UserId, Token: gjwt.GetJWTTokenStr(r)})
+	if err != nil {
+		return err
+	}
+	return hg.writeHTTPRes(w, &mpb.Empty{})
+}
+
+func (hg *HTTPGateway) logoutAllDevices(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	claim, ctx, err := util.ClaimFromContext(r.Context())
+	if err != nil {
+		return err
+	}
+
+	client, err := com.GetAccountServiceClient(ctx, hg)
+	if err != nil {
+		return err
+	}
+	_, err = client.LogoutAllDevices(ctx, &mpb.ReqUserId{UserId: claim.UserId})
+	if err != nil {
+		return err
+	}
+	return hg.writeHTTPRes(w, &mpb.Empty{})
+}
+
+func (hg *HTTPGateway) getLoginHistory(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	claim, ctx, err := util.ClaimFromContext(r.Context())
+	if err != nil {
+		return err
+	}
+
+	client, err := com.GetAccountServiceClient(ctx, hg)
+	if err != nil {
+		return err
+	}
+	res, err := client.GetLoginHistory(ctx, &mpb.ReqUserId{UserId: claim.UserId})
+	if err != nil {
+		return err
+	}
+	return hg.writeHTTPRes(w, &mpb.CResGetLoginHistory{Records: res.Records})
+}
+
+func (hg *HTTPGateway) getReferralStats(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	claim, ctx, err := util.ClaimFromContext(r.Context())
+	if err != nil {
+		return err
+	}
+
+	client, err := com.GetAccountServiceClient(ctx, hg)
+	if err != nil {
+		return err
+	}
+	res, err := client.GetReferralStats(ctx, &mpb.ReqUserId{UserId: claim.UserId})
+	if err != nil {
+		return err
+	}
+	return hg.writeHTTPRes(w, &mpb.CResGetReferralStats{
+		InviteCode:    res.InviteCode,
+		ReferrerId:    res.ReferrerId,
+		InvitedCount:  res.InvitedCount,
+		RejectedCount: res.RejectedCount,
+		Referrals:     res.Referrals,
+	})
+}
+
+func (hg *HTTPGateway) getSessions(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	claim, ctx, err := util.ClaimFromContext(r.Context())
+	if err != nil {
+		return err
+	}
+
+	client, err := com.GetAccountServiceClient(ctx, hg)
+	if err != nil {
+		return err
+	}
+	res, err := client.GetSessions(ctx, &mpb.ReqGetSessions{UserId: claim.UserId, Token: gjwt.GetJWTTokenStr(r)})
+	if err != nil {
+		return err
+	}
+	return hg.writeHTTPRes(w, &mpb.CResGetSessions{Sessions: res.Sessions})
+}
+
+func (hg *HTTPGateway) revokeSession(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	claim, ctx, err := util.ClaimFromContext(r.Context())
+	if err != nil {
+		return err
+	}
+
+	req := &mpb.CReqRevokeSession{}
+	err = hg.readHTTPReq(w, r, req)
+	if err != nil {
+		return err
+	}
+	if req.SessionId == "" {
+		return mpberr.ErrParam
+	}
+
+	client, err := com.GetAccountServiceClient(ctx, hg)
+	if err != nil {
+		return err
+	}
+	_, err = client.RevokeSession(ctx, &mpb.ReqRevokeSession{UserId: claim.UserId, SessionId: req.SessionId})
+	if err != nil {
+		return err
+	}
+	return hg.writeHTTPRes(w, &mpb.Empty{})
+}
+
+func (hg *HTTPGateway) generateNonce(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	req := &mpb.CReqGenerateNonce{}
+	err := hg.readHTTPReq(w, r, req)
+	if err != nil {
+		return err
+	}
+	req.WalletAddr, err = util.FixHashId(req.WalletAddr)
+	if err != nil {
+		return mpberr.ErrParam
+	}
+	client, err := com.GetAccountServiceClient(ctx, hg)
+	if err != nil {
+		return err
+	}
+	res, err := client.GenerateNonce(ctx, &mpb.ReqGenerateNonce{WalletAddr: req.WalletAddr})
+	if err != nil {
+		return err
+	}
+	cres := &mpb.CResGenerateNonce{
+		Nonce: res.Nonce,
+	}
+	return hg.writeHTTPRes(w, cres)
+}
+
+func (hg *HTTPGateway) SendEmailBindCode(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	req := &mpb.CReqSendEmailBindCode{}
+	err := hg.readHTTPReq(w, r, req)
+	if err != nil {
+		return err
+	}
+
+	if !util.CheckEmailAddr(req.Email) {
+		return mpberr.ErrEmailAddress
+	}
+
+	client, err := com.GetAccountServiceClient(ctx, hg)
+	if err != nil {
+		return err
+	}
+	_, err = client.GenerateAndSendEmailBindCode(ctx, &mpb.ReqGenerateAndSendEmailBindCode{Email: req.Email,
+		Language: req.Language})
+	if err != nil {
+		return err
+	}
+
+	return hg.writeHTTPRes(w, &mpb.Empty{})
+}
+
+func (hg *HTTPGateway) webBindEmail(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	claim, ctx, err := util.ClaimFromContext(r.Context())
+	if err != nil {
+		return err
+	}
+
+	req := &mpb.CReqWebBindEmail{}
+	err = hg.readHTTPReq(w, r, req)
+	if err != nil {
+		return err
+	}
+
+	if len(req.Code) != com.VCodeLen {
+		return mpberr.ErrEmailBindCode
+	}
+
+	_, err = strconv.Atoi(req.Code)
+	if err != nil {
+		return mpberr.ErrEmailBindCode
+	}
+
+	if !util.CheckEmailAddr(req.Email) {
+		return mpberr.ErrEmailAddress
+	}
+
+	remoteIP := getRemoteIPAddress(r)
+
+	client, err := com.GetAccountServiceClient(ctx, hg)
+	if err != nil {
+		return err
+	}
+	res, err := client.WebBindEmail(ctx, &mpb.ReqWebBindEmail{
+		UserId:   claim.UserId,
+		Email:    req.Email,
+		Code:     req.Code,
+		Device:   getDevice(r, req.Device),
+		DeviceId: truncateString(req.DeviceId, com.DeviceIdMaxLen),
+		RemoteIp: remoteIP,
+		Region:   getRegionByIP(remoteIP),
+	})
+	if err != nil {
+		return err
+	}
+	cres := &mpb.CResWebBindEmail{
+		Account:      res.Account,
+		Resources:    res.Resources,
+		Token:        res.Token,
+		RefreshToken: res.RefreshToken,
+	}
+	return hg.writeHTTPRes(w, cres)
+}
+
+func (hg *HTTPGateway) getAccountInfo(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	claim, ctx, err := util.ClaimFromContext(r.Context())
+	if err != nil {
+		return err
+	}
+
+	client, err := com.GetAccountServiceClient(ctx, hg)
+	if err != nil {
+		return err
+	}
+
+	res, err := client.GetAccountInfo(ctx, &mpb.ReqUserId{UserId: claim.UserId})
+	if err != nil {
+		return err
+	}
+	cres := &mpb.CResGetAccountInfo{
+		Account: &mpb.AccountInfo{
+			Account:  res.Account,
+			UserId:   res.UserId,
+			Nickname: res.Nickname,
+			Icon:     res.Icon,
+			Email:    res.Email,
+		},
+	}
+	return hg.writeHTTPRes(w, cres)
+}
+
+func (hg *HTTPGateway) updateProfile(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	claim, ctx, err := util.ClaimFromContext(r.Context())
+	if err != nil {
+		return err
+	}
+
+	req := &mpb.CReqUpdateProfile{}
+	err = hg.readHTTPReq(w, r, req)
+	if err != nil {
+		return err
+	}
+	if req.Nickname == "" && req.Icon == "" {
+		return mpberr.ErrParam
+	}
+
+	client, err := com.GetAccountServiceClient(ctx, hg)
+	if err != nil {
+		return err
+	}
+	res, err := client.UpdateProfile(ctx, &mpb.ReqUpdateProfile{
+		UserId:   claim.UserId,
+		Nickname: req.Nickname,
+		Icon:     req.Icon,
+	})
+	if err != nil {
+		return err
+	}
+	return hg.writeHTTPRes(w, &mpb.CResUpdateProfile{Account: res})
+}
+
+func (hg *HTTPGateway) changePassword(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	claim, ctx, err := util.ClaimFromContext(r.Context())
+	if err != nil {
+		return err
+	}
+
+	req := &mpb.CReqChangePassword{}
+	err = hg.readHTTPReq(w, r, req)
+	if err != nil {
+		return err
+	}
+	req.NewPassword = strings.ToLower(req.NewPassword)
+	req.OldPassword = strings.ToLower(req.OldPassword)
+	if len(req.NewPassword) != com.PasswordLen {
+		return mpberr.ErrParam
+	}
+
+	if req.OldPassword == req.NewPassword {
+		return mpberr.ErrNewPWSameWithOldPW
+	}
+
+	client, err := com.GetAccountServiceClient(ctx, hg)
+	if err != nil {
+		return err
+	}
+
+	res, err := client.ChangePassword(ctx, &mpb.ReqChangePassword{
+		UserId:      claim.UserId,
+		OldPassword: req.OldPassword,
+		NewPassword: req.NewPassword,
+		TotpCode:    req.TotpCode,
+	})
+	if err != nil {
+		return err
+	}
+	return hg.writeHTTPRes(w, res)
+}
+
+func (hg *HTTPGateway) sendEmailChangeCode(w http.ResponseWriter, r *http.Request) error {
+	claim, ctx, err := util.ClaimFromContext(r.Context())
+	if err != nil {
+		return err
+	}
+
+	req := &mpb.CReqSendEmailChangeCode{}
+	err = hg.readHTTPReq(w, r, req)
+	if err != nil {
+		return err
+	}
+	if !util.CheckEmailAddr(req.NewEmail) {
+		return mpberr.ErrEmailAddress
+	}
+
+	client, err := com.GetAccountServiceClient(ctx, hg)
+	if err != nil {
+		return err
+	}
+	_, err = client.SendEmailChangeCode(ctx, &mpb.ReqSendEmailChangeCode{
+		UserId:       claim.UserId,
+		NewEmail:     req.NewEmail,
+		SkipOldEmail: req.SkipOldEmail,
+	})
+	if err != nil {
+		return err
+	}
+	return hg.writeHTTPRes(w, &mpb.Empty{})
+}
+
+func (hg *HTTPGateway) changeEmail(w http.ResponseWriter, r *http.Request) error {
+	claim, ctx, err := util.ClaimFromContext(r.Context())
+	if err != nil {
+		return err
+	}
+
+	req := &mpb.CReqChangeEmail{}
+	err = hg.readHTTPReq(w, r, req)
+	if err != nil {
+		return err
+	}
+	if !util.CheckEmailAddr(req.NewEmail) {
+		return mpberr.ErrEmailAddress
+	}
+	if len(req.NewCode) != com.VCodeLen {
+		return mpberr.ErrParam
+	}
+	if req.OldCode == "" {
+		// old inbox is lost, prove the ownership by a linked wallet
+		req.WalletAddr, err = util.FixHashId(req.WalletAddr)
+		if err != nil {
+			return mpberr.ErrParam
+		}
+		_, err = hg.verifyWalletSignature(ctx, req.WalletAddr, req.PubKey, req.AptosFullMsg, req.AptosSignature)
+		if err != nil {
+			return err
+		}
+	} else {
+		req.WalletAddr = ""
+	}
+
+	client, err := com.GetAccountServiceClient(ctx, hg)
+	if err != nil {
+		return err
+	}
+	res, err := client.ChangeEmail(ctx, &mpb.ReqChangeEmail{
+		UserId:       claim.UserId,
+		NewEmail:     req.NewEmail,
+		NewCode:      req.NewCode,
+		OldCode:      req.OldCode,
+		WalletAddr:   req.WalletAddr,
+		AptosFullMsg: req.AptosFullMsg,
+		TotpCode:     req.TotpCode,
+	})
+	if err != nil {
+		return err
+	}
+	return hg.writeHTTPRes(w, &mpb.CResChangeEmail{Account: res})
+}
+
+func (hg *HTTPGateway) sendEmailResetPasswordCode(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	req := &mpb.CReqSendEmailResetPasswordCode{}
+	err := hg.readHTTPReq(w, r, req)
+	if err != nil {
+		return err
+	}
+	if !util.CheckEmailAddr(req.Email) {
+		return mpberr.ErrEmailAddress
+	}
+
+	client, err := com.GetAccountServiceClient(ctx, hg)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.SendEmailResetPasswordCode(ctx, &mpb.ReqSendEmailResetPasswordCode{
+		Email:    req.Email,
+		Language: req.Language,
+	})
+	if err != nil {
+		return err
+	}
+
+	return hg.writeHTTPRes(w, &mpb.Empty{})
+}
+
+func (hg *HTTPGateway) checkEmailResetPasswordCode(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	req := &mpb.CReqCheckEmailResetPasswordCode{}
+	err := hg.readHTTPReq(w, r, req)
+	if err != nil {
+		return err
+	}
+
+	if len(req.Code) != com.VCodeLen {
+		return mpberr.ErrEmailVerificationCode
+	}
+
+	if !util.CheckEmailAddr(req.Email) {
+		return mpberr.ErrEmailAddress
+	}
+
+	client, err := com.GetAccountServiceClient(ctx, hg)
+	if err != nil {
+		return err
+	}
+
+	res, err := client.CheckEmailResetPasswordCode(ctx, &mpb.ReqCheckEmailResetPasswordCode{
+		Email:    req.Email,
+		Code:     req.Code,
+		RemoteIp: getRemoteIPAddress(r),
+	})
+	if err != nil {
+		return err
+	}
+
+	return hg.writeHTTPRes(w, &mpb.CResCheckEmailResetPasswordCode{Nonce: res.Nonce})
+}
+
+func (hg *HTTPGateway) resetPasswordByEmail(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	req := &mpb.CReqResetPasswordByEmail{}
+	err := hg.readHTTPReq(w, r, req)
+	if err != nil {
+		return err
+	}
+
+	if len(req.Nonce) != com.NonceLen {
+		return mpberr.ErrParam
+	}
+
+	req.Password = strings.ToLower(req.Password)
+	if len(req.Password) != com.PasswordLen {
+		return mpberr.ErrParam
+	}
+
+	if !util.CheckEmailAddr(req.Email) {
+		return mpberr.ErrEmailAddress
+	}
+
+	client, err := com.GetAccountServiceClient(ctx, hg)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.ResetPasswordByEmail(ctx, &mpb.ReqResetPasswordByEmail{
+		Email:    req.Email,
+		Password: req.Password,
+		Nonce:    req.Nonce,
+		TotpCode: req.TotpCode,
+		RemoteIp: getRemoteIPAddress(r),
+	})
+	if err != nil {
+		return err
+	}
+
+	return hg.writeHTTPRes(w, &mpb.Empty{})
+}
+
+func (hg *HTTPGateway) resetPasswordByEmailAndVCode(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	req := &mpb.CReqResetPasswordByEmailAndVCode{}
+	err := hg.readHTTPReq(w, r, req)
+	if err != nil {
+		return err
+	}
+
+	if len(req.Code) != com.VCodeLen {
+		return mpberr.ErrParam
+	}
+
+	req.Password = strings.ToLower(req.Password)
+	if len(req.Password) != com.PasswordLen {
+		return mpberr.ErrParam
+	}
+
+	if !util.CheckEmailAddr(req.Email) {
+		return mpberr.ErrEmailAddress
+	}
+
+	client, err := com.GetAccountServiceClient(ctx, hg)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.ResetPasswordByEmailAndVCode(ctx, &mpb.ReqResetPasswordByEmailAndVCode{
+		Email:    req.Email,
+		Password: req.Password,
+		Code:     req.Code,
+		TotpCode: req.TotpCode,
+		RemoteIp: getRemoteIPAddress(r),
+	})
+	if err != nil {
+		return err
+	}
+
+	return hg.writeHTTPRes(w, &mpb.Empty{})
+}
+
+func (hg *HTTPGateway) enrollTOTP(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	claim, ctx, err := util.ClaimFromContext(r.Context())
+	if err != nil {
+		return err
+	}
+
+	client, err := com.GetAccountServiceClient(ctx, hg)
+	if err != nil {
+		return err
+	}
+	res, err := client.EnrollTOTP(ctx, &mpb.ReqUserId{UserId: claim.UserId})
+	if err != nil {
+		return err
+	}
+	cres := &mpb.CResEnrollTOTP{
+		Secret:     res.Secret,
+		OtpauthUri: res.OtpauthUri,
+	}
+	return hg.writeHTTPRes(w, cres)
+}
+
+func (hg *HTTPGateway) confirmTOTP(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	claim, ctx, err := util.ClaimFromContext(r.Context())
+	if err != nil {
+		return err
+	}
+
+	req := &mpb.CReqTOTPCode{}
+	err = hg.readHTTPReq(w, r, req)
+	if err != nil {
+		return err
+	}
+	if len(req.Code) != com.TOTPCodeLen {
+		return mpberr.ErrTOTPCode
+	}
+
+	client, err := com.GetAccountServiceClient(ctx, hg)
+	if err != nil {
+		return err
+	}
+	res, err := client.ConfirmTOTP(ctx, &mpb.ReqTOTPCode{UserId: claim.UserId, Code: req.Code})
+	if err != nil {
+		return err
+	}
+	return hg.writeHTTPRes(w, &mpb.CResConfirmTOTP{RecoveryCodes: res.RecoveryCodes})
+}
+
+func (hg *HTTPGateway) disableTOTP(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	claim, ctx, err := util.ClaimFromContext(r.Context())
+	if err != nil {
+		return err
+	}
+
+	req := &mpb.CReqTOTPCode{}
+	err = hg.readHTTPReq(w, r, req)
+	if err != nil {
+		return err
+	}
+	if req.Code == "" {
+		return mpberr.ErrTOTPRequired
+	}
+
+	client, err := com.GetAccountServiceClient(ctx, hg)
+	if err != nil {
+		return err
+	}
+	_, err = client.DisableTOTP(ctx, &mpb.ReqTOTPCode{UserId: claim.UserId, Code: req.Code})
+	if err != nil {
+		return err
+	}
+	return hg.writeHTTPRes(w, &mpb.Empty{})
+}
+
+func (hg *HTTPGateway) requestAccountDeletion(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	claim, ctx, err := util.ClaimFromContext(r.Context())
+	if err != nil {
+		return err
+	}
+
+	req := &mpb.CReqRequestAccountDeletion{}
+	err = hg.readHTTPReq(w, r, req)
+	if err != nil {
+		return err
+	}
+	switch {
+	case req.Password != "":
+		req.Password = strings.ToLower(req.Password)
+		if len(req.Password) != com.PasswordLen {
+			return mpberr.ErrPassword
+		}
+	case req.WalletAddr != "":
+		req.WalletAddr, err = util.FixHashId(req.WalletAddr)
+		if err != nil {
+			return mpberr.ErrParam
+		}
+		_, err = hg.verifyWalletSignature(ctx, req.WalletAddr, req.PubKey, req.AptosFullMsg, req.AptosSignature)
+		if err != nil {
+			return err
+		}
+	}
+
+	client, err := com.GetAccountServiceClient(ctx, hg)
+	if err != nil {
+		return err
+	}
+	remoteIP := getRemoteIPAddress(r)
+	res, err := client.RequestAccountDeletion(ctx, &mpb.ReqRequestAccountDeletion{
+		UserId:       claim.UserId,
+		TotpCode:     req.TotpCode,
+		Password:     req.Password,
+		WalletAddr:   req.WalletAddr,
+		AptosFullMsg: req.AptosFullMsg,
+		Provider:     req.Provider,
+		IdToken:      req.IdToken,
+		Nonce:        req.Nonce,
+		DeviceId:     truncateString(req.DeviceId, com.DeviceIdMaxLen),
+		Device:       getDevice(r, req.Device),
+		RemoteIp:     remoteIP,
+		Region:       getRegionByIP(remoteIP),
+	})
+	if err != nil {
+		return err
+	}
+	return hg.writeHTTPRes(w, &mpb.CResRequestAccountDeletion{DeletionTime: res.DeletionTime})
+}
+
+func (hg *HTTPGateway) cancelAccountDeletion(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	claim, ctx, err := util.ClaimFromContext(r.Context())
+	if err != nil {
+		return err
+	}
+
+	client, err := com.GetAccountServiceClient(ctx, hg)
+	if err != nil {
+		return err
+	}
+	_, err = client.CancelAccountDeletion(ctx, &mpb.ReqUserId{UserId: claim.UserId})
+	if err != nil {
+		return err
+	}
+	return hg.writeHTTPRes(w, &mpb.Empty{})
+}
+
+func (hg *HTTPGateway) exportAccountData(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	claim, ctx, err := util.ClaimFromContext(r.Context())
+	if err != nil {
+		return err
+	}
+
+	client, err := com.GetAccountServiceClient(ctx, hg)
+	if err != nil {
+		return err
+	}
+	res, err := client.ExportAccountData(ctx, &mpb.ReqUserId{UserId: claim.UserId})
+	if err != nil {
+		return err
+	}
+	return hg.writeHTTPRes(w, &mpb.CResExportAccountData{Archive: res.Archive})
+}
+
+func (hg *HTTPGateway) getAptosResources(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	claim, ctx, err := util.ClaimFromContext(r.Context())
+	if err != nil {
+		return err
+	}
+
+	clientApp, err := com.GetAPIProxyGRPCClient(ctx, hg)
+	if err != nil {
+		return err
+	}
+	res, err := clientApp.GetAptosResources(ctx, &mpb.ReqGetAptosResources{AptosAccAddr: claim.WalletAddr})
+	if err != nil {
+		return err
+	}
+	cres := &mpb.CResGetAptosResources{
+		Resources: res.Resources,
+	}
+	return hg.writeHTTPRes(w, cres)
+}
+
+// verifyWalletSignature check the aptos signature of the full message and that the public key owns the wallet,
+// the decoded public key is returned, the content of the message is checked by account service
+func (hg *HTTPGateway) verifyWalletSignature(ctx context.Context, walletAddr, pubKeyStr, fullMsg,
+	signature string) ([]byte, error) {
+	if walletAddr == "" || pubKeyStr == "" || fullMsg == "" {
+		return nil, mpberr.ErrParam
+	}
+
+	pubKey, err := util.DecodeAptosPubKey(pubKeyStr)
+	if err != nil {
+		return nil, mpberr.ErrAptosPublicKey
+	}
+
+	err = hg.checkAptosAuthKey(ctx, walletAddr, pubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	err = util.VerifyAptosSignature(pubKey, fullMsg, signature)
+	if err != nil {
+		return nil, err
+	}
+	return pubKey, nil
+}
+
+// checkAptosAuthKey check the authentication key derived from the public key matches the current authentication key
+// of the account on chain, so a key rotated out of the account is rejected. Only an account not created on chain yet
+// is checked against its address, which is the authentication key of its first key.
+func (hg *HTTPGateway) checkAptosAuthKey(ctx context.Context, walletAddr string, pubKey []byte) error {
+	authKey, err := util.AptosAuthKey(pubKey)
+	if err != nil {
+		return err
+	}
+	authKeyHex := util.EncodeAptosPubKey(authKey)
+
+	client, err := com.GetAPIProxyGRPCClient(ctx, hg)
+	if err != nil {
+		return err
+	}
+	res, err := client.GetAptosAuthKey(ctx, &mpb.ReqGetAptosAuthKey{AptosAccAddr: walletAddr})
+	if err != nil {
+		return err
+	}
+	if res.AuthenticationKey == "" {
+		if authKeyHex != walletAddr {
+			return mpberr.ErrAptosAuthKey
+		}
+		return nil
+	}
+	onChainAuthKey, err := util.FixHashId(res.AuthenticationKey)
+	if err != nil || onChainAuthKey != authKeyHex {
+		return mpberr.ErrAptosAuthKey
+	}
+	return nil
+}
+
+// getDevice use the device name reported by client, or the user agent if not reported
+func getDevice(r *http.Request, device string) string {
+	if device == "" {
+		device = r.UserAgent()
+	}
+	return truncateString(device, com.DeviceMaxLen)
+}
+
+func truncateString(s string, maxLen int) string {
+	if len(s) <= maxLen {
+		return s
+	}
+	return s[:maxLen]
+}
+
+func (hg *HTTPGateway) sendSMSBindCode(w http.ResponseWriter, r *http.Request) error {
+	claim, ctx, err := util.ClaimFromContext(r.Context())
+	if err != nil {
+		return err
+	}
+	req := &mpb.CReqSendSMSCode{}
+	err = hg.readHTTPReq(w, r, req)
+	if err != nil {
+		return err
+	}
+	if !util.CheckTelNumber(req.Tel) {
+		return mpberr.ErrPhoneNumber
+	}
+
+	client, err := com.GetAccountServiceClient(ctx, hg)
+	if err != nil {
+		return err
+	}
+	_, err = client.SendSMSCode(ctx, &mpb.ReqSendSMSCode{
+		UserId:   claim.UserId,
+		Tel:      req.Tel,
+		Purpose:  mpb.ESMSCode_Purpose_BindPhone,
+		RemoteIp: getRemoteIPAddress(r),
+	})
+	if err != nil {
+		return err
+	}
+	return hg.writeHTTPRes(w, &mpb.Empty{})
+}
+
+func (hg *HTTPGateway) bindPhone(w http.ResponseWriter, r *http.Request) error {
+	claim, ctx, err := util.ClaimFromContext(r.Context())
+	if err != nil {
+		return err
+	}
+	req := &mpb.CReqBindPhone{}
+	err = hg.readHTTPReq(w, r, req)
+	if err != nil {
+		return err
+	}
+	if len(req.Code) != com.VCodeLen {
+		return mpberr.ErrSMSVerificationCode
+	}
+	if !util.CheckTelNumber(req.Tel) {
+		return mpberr.ErrPhoneNumber
+	}
+
+	client, err := com.GetAccountServiceClient(ctx, hg)
+	if err != nil {
+		return err
+	}
+	acc, err := client.BindPhone(ctx, &mpb.ReqBindPhone{
+		UserId: claim.UserId,
+		Tel:    req.Tel,
+		Code:   req.Code,
+	})
+	if err != nil {
+		return err
+	}
+	return hg.writeHTTPRes(w, &mpb.CResBindPhone{Account: acc})
+}
+
+func (hg *HTTPGateway) sendSMSResetPasswordCode(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	req := &mpb.CReqSendSMSCode{}
+	err := hg.readHTTPReq(w, r, req)
+	if err != nil {
+		return err
+	}
+	if !util.CheckTelNumber(req.Tel) {
+		return mpberr.ErrPhoneNumber
+	}
+
+	client, err := com.GetAccountServiceClient(ctx, hg)
+	if err != nil {
+		return err
+	}
+	_, err = client.SendSMSCode(ctx, &mpb.ReqSendSMSCode{
+		Tel:      req.Tel,
+		Purpose:  mpb.ESMSCode_Purpose_ResetPassword,
+		RemoteIp: getRemoteIPAddress(r),
+	})
+	if err != nil {
+		return err
+	}
+	return hg.writeHTTPRes(w, &mpb.Empty{})
+}
+
+func (hg *HTTPGateway) resetPasswordByPhone(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	req := &mpb.CReqResetPasswordByPhone{}
+	err := hg.readHTTPReq(w, r, req)
+	if err != nil {
+		return err
+	}
+
+	if len(req.Code) != com.VCodeLen {
+		return mpberr.ErrParam
+	}
+
+	req.Password = strings.ToLower(req.Password)
+	if len(req.Password) != com.PasswordLen {
+		return mpberr.ErrParam
+	}
+
+	if !util.CheckTelNumber(req.Tel) {
+		return mpberr.ErrPhoneNumber
+	}
+
+	client, err := com.GetAccountServiceClient(ctx, hg)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.ResetPasswordByPhone(ctx, &mpb.ReqResetPasswordByPhone{
+		Tel:      req.Tel,
+		Password: req.Password,
+		Code:     req.Code,
+		TotpCode: req.TotpCode,
+		RemoteIp: getRemoteIPAddress(r),
+	})
+	if err != nil {
+		return err
+	}
+
+	return hg.writeHTTPRes(w, &mpb.Empty{})
 }
@@ -12,6 +12,7 @@ import (
 	pb "github.com/golang/protobuf/proto"
 	"github.com/oldjon/gutil/conv"
 	"github.com/oldjon/gutil/env"
+	"github.com/oldjon/gutil/gdb"
 	gjwt "github.com/oldjon/gutil/jwt"
 	gxgrpc "github.com/oldjon/gx/modules/grpc"
 	gxhttp "github.com/oldjon/gx/modules/http"
@@ -98,7 +99,13 @@ func NewHTTPGateway(driver service.ModuleDriver) (gxhttp.GXHttpHandler, error) {
 	_ = jm
 	gateway.connMgr = gxgrpc.NewConnManager(&dialer)
 
-	// var err error
+	tmpRedis, err := gdb.NewRedisClientByConfig(gateway.config.SubConfig("tmp_redis"),
+		gateway.config.GetString("db_marshaller"), driver.Tracer())
+	if err != nil {
+		return nil, err
+	}
+	tm := newTokenMiddleware(gateway.logger, tmpRedis, eh)
+
 	// gateway.HTTPClient, err = wire.NewHTTPClient(host, wire.HTTPClientOptions{})
 	// if err != nil {
 	// 	return nil, err
@@ -133,31 +140,63 @@ func NewHTTPGateway(driver service.ModuleDriver) (gxhttp.GXHttpHandler, error) {
 
 	mux.Handle("/HelloWorld", eh.Handler(gateway.helloWorld))
 	mux.Handle("/LoginByPassword", eh.Handler(gateway.loginByPassword))
+	mux.Handle("/LoginByTOTP", eh.Handler(gateway.loginByTOTP))
 	mux.Handle("/GenerateNonce", eh.Handler(gateway.generateNonce))
-	//mux.Handle("/RegisterAccount", eh.Handler(gateway.RegisterAccount))
+	mux.Handle("/SendEmailRegisterCode", eh.Handler(gateway.SendEmailBindCode))
+	mux.Handle("/RegisterAccount", eh.Handler(gateway.registerAccount))
+	mux.Handle("/LoginAsGuest", eh.Handler(gateway.loginAsGuest))
+	mux.Handle("/LoginByPlatform", eh.Handler(gateway.loginByPlatform))
+	mux.Handle("/LinkPlatform", jm.Handler(tm.Handler(eh.Handler(gateway.linkPlatform))))
+	mux.Handle("/UpgradeGuestByEmail", jm.Handler(tm.Handler(eh.Handler(gateway.upgradeGuestByEmail))))
+	mux.Handle("/UpgradeGuestByWallet", jm.Handler(tm.Handler(eh.Handler(gateway.upgradeGuestByWallet))))
 	mux.Handle("/WebLoginByWallet", eh.Handler(gateway.WebLoginByWallet))
-	mux.Handle("/SendEmailBindCode", jm.Handler(eh.Handler(gateway.SendEmailBindCode)))
-	mux.Handle("/WebBindEmail", jm.Handler(eh.Handler(gateway.webBindEmail)))
-	mux.Handle("/ChangePassword", jm.Handler(eh.Handler(gateway.changePassword)))
+	mux.Handle("/SendEmailBindCode", jm.Handler(tm.Handler(eh.Handler(gateway.SendEmailBindCode))))
+	mux.Handle("/WebBindEmail", jm.Handler(tm.Handler(eh.Handler(gateway.webBindEmail))))
+	mux.Handle("/SendEmailChangeCode", jm.Handler(tm.Handler(eh.Handler(gateway.sendEmailChangeCode))))
+	mux.Handle("/ChangeEmail", jm.Handler(tm.Handler(eh.Handler(gateway.changeEmail))))
+	mux.Handle("/LinkWallet", jm.Handler(tm.Handler(eh.Handler(gateway.linkWallet))))
+	mux.Handle("/UnlinkWallet", jm.Handler(tm.Handler(eh.Handler(gateway.unlinkWallet))))
+	mux.Handle("/SetPrimaryWallet", jm.Handler(tm.Handler(eh.Handler(gateway.setPrimaryWallet))))
+	mux.Handle("/ListWallets", jm.Handler(tm.Handler(eh.Handler(gateway.listWallets))))
+	mux.Handle("/MergeAccount", jm.Handler(tm.Handler(eh.Handler(gateway.mergeAccount))))
+	mux.Handle("/ChangePassword", jm.Handler(tm.Handler(eh.Handler(gateway.changePassword))))
 	mux.Handle("/SendEmailResetPasswordCode", eh.Handler(gateway.sendEmailResetPasswordCode))
 	mux.Handle("/CheckEmailResetPasswordCode", eh.Handler(gateway.checkEmailResetPasswordCode))
 	mux.Handle("/ResetPasswordByEmail", eh.Handler(gateway.resetPasswordByEmail))
 	mux.Handle("/ResetPasswordByEmailAndVCode", eh.Handler(gateway.resetPasswordByEmailAndVCode))
-	mux.Handle("/GetAccountInfo", jm.Handler(eh.Handler(gateway.getAccountInfo)))
-	mux.Handle("/GetAptosResources", jm.Handler(eh.Handler(gateway.getAptosResources)))
-	mux.Handle("/GetAptosNFTs", jm.Handler(eh.Handler(gateway.getAptosNFTs)))
-	mux.Handle("/GetAptosNFTMetadatas", jm.Handler(eh.Handler(gateway.getAptosNFTMetaDatas)))
-	mux.Handle("/GetAptosNFTsV2", jm.Handler(eh.Handler(gateway.getAptosNFTsV2)))
+	mux.Handle("/SendSMSBindCode", jm.Handler(tm.Handler(eh.Handler(gateway.sendSMSBindCode))))
+	mux.Handle("/BindPhone", jm.Handler(tm.Handler(eh.Handler(gateway.bindPhone))))
+	mux.Handle("/SendSMSResetPasswordCode", eh.Handler(gateway.sendSMSResetPasswordCode))
+	mux.Handle("/ResetPasswordByPhone", eh.Handler(gateway.resetPasswordByPhone))
+	mux.Handle("/RefreshToken", eh.Handler(gateway.refreshToken))
+	mux.Handle("/Logout", jm.Handler(tm.Handler(eh.Handler(gateway.logout))))
+	mux.Handle("/LogoutAllDevices", jm.Handler(tm.Handler(eh.Handler(gateway.logoutAllDevices))))
+	mux.Handle("/GetSessions", jm.Handler(tm.Handler(eh.Handler(gateway.getSessions))))
+	mux.Handle("/GetLoginHistory", jm.Handler(tm.Handler(eh.Handler(gateway.getLoginHistory))))
+	mux.Handle("/GetReferralStats", jm.Handler(tm.Handler(eh.Handler(gateway.getReferralStats))))
+	mux.Handle("/RevokeSession", jm.Handler(tm.Handler(eh.Handler(gateway.revokeSession))))
+	mux.Handle("/EnrollTOTP", jm.Handler(tm.Handler(eh.Handler(gateway.enrollTOTP))))
+	mux.Handle("/ConfirmTOTP", jm.Handler(tm.Handler(eh.Handler(gateway.confirmTOTP))))
+	mux.Handle("/DisableTOTP", jm.Handler(tm.Handler(eh.Handler(gateway.disableTOTP))))
+	mux.Handle("/RequestAccountDeletion", jm.Handler(tm.Handler(eh.Handler(gateway.requestAccountDeletion))))
+	mux.Handle("/CancelAccountDeletion", jm.Handler(tm.Handler(eh.Handler(gateway.cancelAccountDeletion))))
+	mux.Handle("/ExportAccountData", jm.Handler(tm.Handler(eh.Handler(gateway.exportAccountData))))
+	mux.Handle("/GetAccountInfo", jm.Handler(tm.Handler(eh.Handler(gateway.getAccountInfo))))
+	mux.Handle("/UpdateProfile", jm.Handler(tm.Handler(eh.Handler(gateway.updateProfile))))
+	mux.Handle("/GetAptosResources", jm.Handler(tm.Handler(eh.Handler(gateway.getAptosResources))))
+	mux.Handle("/GetAptosNFTs", jm.Handler(tm.Handler(eh.Handler(gateway.getAptosNFTs))))
+	mux.Handle("/GetAptosNFTMetadatas", jm.Handler(tm.Handler(eh.Handler(gateway.getAptosNFTMetaDatas))))
+	mux.Handle("/GetAptosNFTsV2", jm.Handler(tm.Handler(eh.Handler(gateway.getAptosNFTsV2))))
 	mux.Handle("/TestGetAptosNFTsV2", eh.Handler(gateway.testGetAptosNFTsV2))
 
-	//mux.Handle("/GetUser", jm.Handler(eh.Handler(gateway.GetUser)))
-	//mux.Handle("/GetItems", jm.Handler(eh.Handler(gateway.GetItems)))
-	//mux.Handle("/ExchangeItems", jm.Handler(eh.Handler(gateway.ExchangeItems))) //TODO Delete in live
+	//mux.Handle("/GetUser", jm.Handler(tm.Handler(eh.Handler(gateway.GetUser))))
+	//mux.Handle("/GetItems", jm.Handler(tm.Handler(eh.Handler(gateway.GetItems))))
+	//mux.Handle("/ExchangeItems", jm.Handler(tm.Handler(eh.Handler(gateway.ExchangeItems)))) //TODO Delete in live
 	// mail
-	//mux.Handle("GetMailList", jm.Handler(eh.Handler(gateway.GetMailList)))
-	//mux.Handle("ReadMails", jm.Handler(eh.Handler(gateway.ReadMails)))
-	//mux.Handle("DelMails", jm.Handler(eh.Handler(gateway.DelMails)))
-	//mux.Handle("GetMailsAwards", jm.Handler(eh.Handler(gateway.GetMailsAwards)))
+	//mux.Handle("GetMailList", jm.Handler(tm.Handler(eh.Handler(gateway.GetMailList))))
+	//mux.Handle("ReadMails", jm.Handler(tm.Handler(eh.Handler(gateway.ReadMails))))
+	//mux.Handle("DelMails", jm.Handler(tm.Handler(eh.Handler(gateway.DelMails))))
+	//mux.Handle("GetMailsAwards", jm.Handler(tm.Handler(eh.Handler(gateway.GetMailsAwards))))
 
 	return &gateway, nil
 }
@@ -0,0 +1,99 @@
+package httpgateway
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	com "github.com/aureontu/MRWebServer/mr_services/common"
+	"github.com/aureontu/MRWebServer/mr_services/mpb"
+	"github.com/aureontu/MRWebServer/mr_services/mpberr"
+	"github.com/aureontu/MRWebServer/mr_services/util"
+	gcrypto "github.com/oldjon/gutil/crypto"
+	"github.com/oldjon/gutil/gdb"
+	gjwt "github.com/oldjon/gutil/jwt"
+	"go.uber.org/zap"
+)
+
+// tokenMiddleware reject jwt tokens which have been revoked or expired on server side,
+// the tokens of banned users are revoked and refused with ErrAccountBanned.
+// It must be used behind the jwt middleware, the errors are written by the error handler like those of the handlers.
+type tokenMiddleware struct {
+	logger *zap.Logger
+	tmpDB  *gdb.DB
+	eh     *util.HTTPErrorHandler
+}
+
+func newTokenMiddleware(logger *zap.Logger, tmpRedis gdb.RedisClient, eh *util.HTTPErrorHandler) *tokenMiddleware {
+	return &tokenMiddleware{
+		logger: logger,
+		tmpDB:  gdb.NewDB(tmpRedis),
+		eh:     eh,
+	}
+}
+
+func (tm *tokenMiddleware) Handler(h http.Handler) http.Handler {
+	return tm.eh.Handler(func(w http.ResponseWriter, r *http.Request) error {
+		key := com.TokenKey(gcrypto.MD5SumStr(gjwt.GetJWTTokenStr(r)))
+		ti := &mpb.DBTokenInfo{}
+		err := tm.tmpDB.GetObject(r.Context(), key, ti)
+		if tm.tmpDB.IsErrNil(err) {
+			if tm.isBanned(r) {
+				return mpberr.ErrAccountBanned
+			}
+			return mpberr.ErrTokenVerify
+		} else if err != nil {
+			tm.logger.Error("check token failed", zap.String("key", key), zap.Error(err))
+			return mpberr.ErrDB
+		}
+		tm.touchSession(r.Context(), ti)
+		h.ServeHTTP(w, r)
+		return nil
+	})
+}
+
+// touchSession record the last seen time of the session of the token, at most once per com.SessionSeenInterval.
+// Failures are only logged, the request goes on.
+func (tm *tokenMiddleware) touchSession(ctx context.Context, ti *mpb.DBTokenInfo) {
+	if ti.SessionId == "" {
+		return
+	}
+	key := com.SessionSeenKey(ti.SessionId)
+	ok, err := tm.tmpDB.SetEXNX(ctx, key, 1, com.SessionSeenInterval)
+	if err != nil {
+		tm.logger.Error("touchSession SetEXNX failed", zap.String("key", key), zap.Error(err))
+		return
+	}
+	if !ok {
+		return
+	}
+	sKey := com.UIDSessionSeenKey(ti.UserId)
+	err = tm.tmpDB.HSet(ctx, sKey, ti.SessionId, time.Now().Unix())
+	if err != nil {
+		tm.logger.Error("touchSession HSet failed", zap.String("key", sKey), zap.Error(err))
+		return
+	}
+	_, err = tm.tmpDB.Expire(ctx, sKey, com.RefreshTokenExpireDuration)
+	if err != nil {
+		tm.logger.Error("touchSession Expire failed", zap.String("key", sKey), zap.Error(err))
+	}
+}
+
+// isBanned check whether the token is revoked because the user is banned
+func (tm *tokenMiddleware) isBanned(r *http.Request) bool {
+	claim, ok := gjwt.FromContext(r.Context())
+	if !ok {
+		return false
+	}
+	c, ok := claim.(*mpb.JWTClaims)
+	if !ok {
+		return false
+	}
+	key := com.BanKey(c.UserId)
+	ok, err := tm.tmpDB.Exists(r.Context(), key)
+	if err != nil {
+		tm.logger.Error("check ban failed", zap.String("key", key), zap.Error(err))
+		return false
+	}
+	return ok
+}
@@ -72,6 +72,177 @@ func (EUser_UserState) EnumDescriptor() ([]byte, []int) {
 	return file_common_proto_rawDescGZIP(), []int{0, 0}
 }
 
+type ELoginMethod_Method int32
+
+const (
+	ELoginMethod_Method_None            ELoginMethod_Method = 0
+	ELoginMethod_Method_Password        ELoginMethod_Method = 1 // password, and totp if enabled
+	ELoginMethod_Method_Wallet          ELoginMethod_Method = 2
+	ELoginMethod_Method_Guest           ELoginMethod_Method = 3
+	ELoginMethod_Method_Platform        ELoginMethod_Method = 4
+	ELoginMethod_Method_DeletionRequest ELoginMethod_Method = 5 // not a login, the deletion of the account was requested
+)
+
+// Enum value maps for ELoginMethod_Method.
+var (
+	ELoginMethod_Method_name = map[int32]string{
+		0: "Method_None",
+		1: "Method_Password",
+		2: "Method_Wallet",
+		3: "Method_Guest",
+		4: "Method_Platform",
+		5: "Method_DeletionRequest",
+	}
+	ELoginMethod_Method_value = map[string]int32{
+		"Method_None":            0,
+		"Method_Password":        1,
+		"Method_Wallet":          2,
+		"Method_Guest":           3,
+		"Method_Platform":        4,
+		"Method_DeletionRequest": 5,
+	}
+)
+
+func (x ELoginMethod_Method) Enum() *ELoginMethod_Method {
+	p := new(ELoginMethod_Method)
+	*p = x
+	return p
+}
+
+func (x ELoginMethod_Method) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ELoginMethod_Method) Descriptor() protoreflect.EnumDescriptor {
+	return file_common_proto_enumTypes[1].Descriptor()
+}
+
+func (ELoginMethod_Method) Type() protoreflect.EnumType {
+	return &file_common_proto_enumTypes[1]
+}
+
+func (x ELoginMethod_Method) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ELoginMethod_Method.Descriptor instead.
+func (ELoginMethod_Method) EnumDescriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{7, 0}
+}
+
+type EBan_Type int32
+
+const (
+	EBan_Type_None    EBan_Type = 0
+	EBan_Type_Ban     EBan_Type = 1 // login refused permanently
+	EBan_Type_Suspend EBan_Type = 2 // login refused until the expire time
+	EBan_Type_Mute    EBan_Type = 3 // profile editing refused, permanently if no expire time
+)
+
+// Enum value maps for EBan_Type.
+var (
+	EBan_Type_name = map[int32]string{
+		0: "Type_None",
+		1: "Type_Ban",
+		2: "Type_Suspend",
+		3: "Type_Mute",
+	}
+	EBan_Type_value = map[string]int32{
+		"Type_None":    0,
+		"Type_Ban":     1,
+		"Type_Suspend": 2,
+		"Type_Mute":    3,
+	}
+)
+
+func (x EBan_Type) Enum() *EBan_Type {
+	p := new(EBan_Type)
+	*p = x
+	return p
+}
+
+func (x EBan_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EBan_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_common_proto_enumTypes[2].Descriptor()
+}
+
+func (EBan_Type) Type() protoreflect.EnumType {
+	return &file_common_proto_enumTypes[2]
+}
+
+func (x EBan_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EBan_Type.Descriptor instead.
+func (EBan_Type) EnumDescriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{8, 0}
+}
+
+type EReferralReject_Reason int32
+
+const (
+	EReferralReject_Reason_None          EReferralReject_Reason = 0
+	EReferralReject_Reason_SelfReferral  EReferralReject_Reason = 1
+	EReferralReject_Reason_SameDevice    EReferralReject_Reason = 2 // the invitee uses a device of the referrer
+	EReferralReject_Reason_SameSubnet    EReferralReject_Reason = 3 // the invitee comes from a subnet the referrer logged in from
+	EReferralReject_Reason_DeviceUsed    EReferralReject_Reason = 4 // the device has been referred before
+	EReferralReject_Reason_ReferrerMuted EReferralReject_Reason = 5 // the referrer is muted, a muted user can not promote the invite code
+	EReferralReject_Reason_SubnetUsed    EReferralReject_Reason = 6 // too many invitees of the referrer come from the subnet
+)
+
+// Enum value maps for EReferralReject_Reason.
+var (
+	EReferralReject_Reason_name = map[int32]string{
+		0: "Reason_None",
+		1: "Reason_SelfReferral",
+		2: "Reason_SameDevice",
+		3: "Reason_SameSubnet",
+		4: "Reason_DeviceUsed",
+		5: "Reason_ReferrerMuted",
+		6: "Reason_SubnetUsed",
+	}
+	EReferralReject_Reason_value = map[string]int32{
+		"Reason_None":          0,
+		"Reason_SelfReferral":  1,
+		"Reason_SameDevice":    2,
+		"Reason_SameSubnet":    3,
+		"Reason_DeviceUsed":    4,
+		"Reason_ReferrerMuted": 5,
+		"Reason_SubnetUsed":    6,
+	}
+)
+
+func (x EReferralReject_Reason) Enum() *EReferralReject_Reason {
+	p := new(EReferralReject_Reason)
+	*p = x
+	return p
+}
+
+func (x EReferralReject_Reason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EReferralReject_Reason) Descriptor() protoreflect.EnumDescriptor {
+	return file_common_proto_enumTypes[3].Descriptor()
+}
+
+func (EReferralReject_Reason) Type() protoreflect.EnumType {
+	return &file_common_proto_enumTypes[3]
+}
+
+func (x EReferralReject_Reason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EReferralReject_Reason.Descriptor instead.
+func (EReferralReject_Reason) EnumDescriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{12, 0}
+}
+
 type EItem_ItemType int32
 
 const (
@@ -111,11 +282,11 @@ func (x EItem_ItemType) String() string {
 }
 
 func (EItem_ItemType) Descriptor() protoreflect.EnumDescriptor {
-	return file_common_proto_enumTypes[1].Descriptor()
+	return file_common_proto_enumTypes[4].Descriptor()
 }
 
 func (EItem_ItemType) Type() protoreflect.EnumType {
-	return &file_common_proto_enumTypes[1]
+	return &file_common_proto_enumTypes[4]
 }
 
 func (x EItem_ItemType) Number() protoreflect.EnumNumber {
@@ -124,7 +295,7 @@ func (x EItem_ItemType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use EItem_ItemType.Descriptor instead.
 func (EItem_ItemType) EnumDescriptor() ([]byte, []int) {
-	return file_common_proto_rawDescGZIP(), []int{10, 0}
+	return file_common_proto_rawDescGZIP(), []int{21, 0}
 }
 
 type EItem_ItemId int32
@@ -160,11 +331,11 @@ func (x EItem_ItemId) String() string {
 }
 
 func (EItem_ItemId) Descriptor() protoreflect.EnumDescriptor {
-	return file_common_proto_enumTypes[2].Descriptor()
+	return file_common_proto_enumTypes[5].Descriptor()
 }
 
 func (EItem_ItemId) Type() protoreflect.EnumType {
-	return &file_common_proto_enumTypes[2]
+	return &file_common_proto_enumTypes[5]
 }
 
 func (x EItem_ItemId) Number() protoreflect.EnumNumber {
@@ -173,7 +344,7 @@ func (x EItem_ItemId) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use EItem_ItemId.Descriptor instead.
 func (EItem_ItemId) EnumDescriptor() ([]byte, []int) {
-	return file_common_proto_rawDescGZIP(), []int{10, 1}
+	return file_common_proto_rawDescGZIP(), []int{21, 1}
 }
 
 type EItem_DropType int32
@@ -212,11 +383,11 @@ func (x EItem_DropType) String() string {
 }
 
 func (EItem_DropType) Descriptor() protoreflect.EnumDescriptor {
-	return file_common_proto_enumTypes[3].Descriptor()
+	return file_common_proto_enumTypes[6].Descriptor()
 }
 
 func (EItem_DropType) Type() protoreflect.EnumType {
-	return &file_common_proto_enumTypes[3]
+	return &file_common_proto_enumTypes[6]
 }
 
 func (x EItem_DropType) Number() protoreflect.EnumNumber {
@@ -225,7 +396,7 @@ func (x EItem_DropType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use EItem_DropType.Descriptor instead.
 func (EItem_DropType) EnumDescriptor() ([]byte, []int) {
-	return file_common_proto_rawDescGZIP(), []int{10, 2}
+	return file_common_proto_rawDescGZIP(), []int{21, 2}
 }
 
 type EItem_TransReason int32
@@ -258,11 +429,11 @@ func (x EItem_TransReason) String() string {
 }
 
 func (EItem_TransReason) Descriptor() protoreflect.EnumDescriptor {
-	return file_common_proto_enumTypes[4].Descriptor()
+	return file_common_proto_enumTypes[7].Descriptor()
 }
 
 func (EItem_TransReason) Type() protoreflect.EnumType {
-	return &file_common_proto_enumTypes[4]
+	return &file_common_proto_enumTypes[7]
 }
 
 func (x EItem_TransReason) Number() protoreflect.EnumNumber {
@@ -271,7 +442,7 @@ func (x EItem_TransReason) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use EItem_TransReason.Descriptor instead.
 func (EItem_TransReason) EnumDescriptor() ([]byte, []int) {
-	return file_common_proto_rawDescGZIP(), []int{10, 3}
+	return file_common_proto_rawDescGZIP(), []int{21, 3}
 }
 
 type EMail_MailType int32
@@ -304,11 +475,11 @@ func (x EMail_MailType) String() string {
 }
 
 func (EMail_MailType) Descriptor() protoreflect.EnumDescriptor {
-	return file_common_proto_enumTypes[5].Descriptor()
+	return file_common_proto_enumTypes[8].Descriptor()
 }
 
 func (EMail_MailType) Type() protoreflect.EnumType {
-	return &file_common_proto_enumTypes[5]
+	return &file_common_proto_enumTypes[8]
 }
 
 func (x EMail_MailType) Number() protoreflect.EnumNumber {
@@ -317,7 +488,7 @@ func (x EMail_MailType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use EMail_MailType.Descriptor instead.
 func (EMail_MailType) EnumDescriptor() ([]byte, []int) {
-	return file_common_proto_rawDescGZIP(), []int{12, 0}
+	return file_common_proto_rawDescGZIP(), []int{23, 0}
 }
 
 type EMail_MailState int32
@@ -353,11 +524,11 @@ func (x EMail_MailState) String() string {
 }
 
 func (EMail_MailState) Descriptor() protoreflect.EnumDescriptor {
-	return file_common_proto_enumTypes[6].Descriptor()
+	return file_common_proto_enumTypes[9].Descriptor()
 }
 
 func (EMail_MailState) Type() protoreflect.EnumType {
-	return &file_common_proto_enumTypes[6]
+	return &file_common_proto_enumTypes[9]
 }
 
 func (x EMail_MailState) Number() protoreflect.EnumNumber {
@@ -366,7 +537,7 @@ func (x EMail_MailState) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use EMail_MailState.Descriptor instead.
 func (EMail_MailState) EnumDescriptor() ([]byte, []int) {
-	return file_common_proto_rawDescGZIP(), []int{12, 1}
+	return file_common_proto_rawDescGZIP(), []int{23, 1}
 }
 
 type EMail_MailReadOption int32
@@ -399,11 +570,11 @@ func (x EMail_MailReadOption) String() string {
 }
 
 func (EMail_MailReadOption) Descriptor() protoreflect.EnumDescriptor {
-	return file_common_proto_enumTypes[7].Descriptor()
+	return file_common_proto_enumTypes[10].Descriptor()
 }
 
 func (EMail_MailReadOption) Type() protoreflect.EnumType {
-	return &file_common_proto_enumTypes[7]
+	return &file_common_proto_enumTypes[10]
 }
 
 func (x EMail_MailReadOption) Number() protoreflect.EnumNumber {
@@ -412,7 +583,7 @@ func (x EMail_MailReadOption) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use EMail_MailReadOption.Descriptor instead.
 func (EMail_MailReadOption) EnumDescriptor() ([]byte, []int) {
-	return file_common_proto_rawDescGZIP(), []int{12, 2}
+	return file_common_proto_rawDescGZIP(), []int{23, 2}
 }
 
 type EMail_MailDelOption int32
@@ -448,11 +619,11 @@ func (x EMail_MailDelOption) String() string {
 }
 
 func (EMail_MailDelOption) Descriptor() protoreflect.EnumDescriptor {
-	return file_common_proto_enumTypes[8].Descriptor()
+	return file_common_proto_enumTypes[11].Descriptor()
 }
 
 func (EMail_MailDelOption) Type() protoreflect.EnumType {
-	return &file_common_proto_enumTypes[8]
+	return &file_common_proto_enumTypes[11]
 }
 
 func (x EMail_MailDelOption) Number() protoreflect.EnumNumber {
@@ -461,7 +632,7 @@ func (x EMail_MailDelOption) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use EMail_MailDelOption.Descriptor instead.
 func (EMail_MailDelOption) EnumDescriptor() ([]byte, []int) {
-	return file_common_proto_rawDescGZIP(), []int{12, 3}
+	return file_common_proto_rawDescGZIP(), []int{23, 3}
 }
 
 type EMail_MailGetAwardOption int32
@@ -494,11 +665,11 @@ func (x EMail_MailGetAwardOption) String() string {
 }
 
 func (EMail_MailGetAwardOption) Descriptor() protoreflect.EnumDescriptor {
-	return file_common_proto_enumTypes[9].Descriptor()
+	return file_common_proto_enumTypes[12].Descriptor()
 }
 
 func (EMail_MailGetAwardOption) Type() protoreflect.EnumType {
-	return &file_common_proto_enumTypes[9]
+	return &file_common_proto_enumTypes[12]
 }
 
 func (x EMail_MailGetAwardOption) Number() protoreflect.EnumNumber {
@@ -507,7 +678,7 @@ func (x EMail_MailGetAwardOption) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use EMail_MailGetAwardOption.Descriptor instead.
 func (EMail_MailGetAwardOption) EnumDescriptor() ([]byte, []int) {
-	return file_common_proto_rawDescGZIP(), []int{12, 4}
+	return file_common_proto_rawDescGZIP(), []int{23, 4}
 }
 
 type EMail_EMailType int32
@@ -540,11 +711,11 @@ func (x EMail_EMailType) String() string {
 }
 
 func (EMail_EMailType) Descriptor() protoreflect.EnumDescriptor {
-	return file_common_proto_enumTypes[10].Descriptor()
+	return file_common_proto_enumTypes[13].Descriptor()
 }
 
 func (EMail_EMailType) Type() protoreflect.EnumType {
-	return &file_common_proto_enumTypes[10]
+	return &file_common_proto_enumTypes[13]
 }
 
 func (x EMail_EMailType) Number() protoreflect.EnumNumber {
@@ -553,7 +724,7 @@ func (x EMail_EMailType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use EMail_EMailType.Descriptor instead.
 func (EMail_EMailType) EnumDescriptor() ([]byte, []int) {
-	return file_common_proto_rawDescGZIP(), []int{12, 5}
+	return file_common_proto_rawDescGZIP(), []int{23, 5}
 }
 
 type EFriend_ReplyOption int32
@@ -586,11 +757,11 @@ func (x EFriend_ReplyOption) String() string {
 }
 
 func (EFriend_ReplyOption) Descriptor() protoreflect.EnumDescriptor {
-	return file_common_proto_enumTypes[11].Descriptor()
+	return file_common_proto_enumTypes[14].Descriptor()
 }
 
 func (EFriend_ReplyOption) Type() protoreflect.EnumType {
-	return &file_common_proto_enumTypes[11]
+	return &file_common_proto_enumTypes[14]
 }
 
 func (x EFriend_ReplyOption) Number() protoreflect.EnumNumber {
@@ -599,7 +770,7 @@ func (x EFriend_ReplyOption) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use EFriend_ReplyOption.Descriptor instead.
 func (EFriend_ReplyOption) EnumDescriptor() ([]byte, []int) {
-	return file_common_proto_rawDescGZIP(), []int{14, 0}
+	return file_common_proto_rawDescGZIP(), []int{25, 0}
 }
 
 type EFriend_ListSortType int32
@@ -632,11 +803,11 @@ func (x EFriend_ListSortType) String() string {
 }
 
 func (EFriend_ListSortType) Descriptor() protoreflect.EnumDescriptor {
-	return file_common_proto_enumTypes[12].Descriptor()
+	return file_common_proto_enumTypes[15].Descriptor()
 }
 
 func (EFriend_ListSortType) Type() protoreflect.EnumType {
-	return &file_common_proto_enumTypes[12]
+	return &file_common_proto_enumTypes[15]
 }
 
 func (x EFriend_ListSortType) Number() protoreflect.EnumNumber {
@@ -645,7 +816,7 @@ func (x EFriend_ListSortType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use EFriend_ListSortType.Descriptor instead.
 func (EFriend_ListSortType) EnumDescriptor() ([]byte, []int) {
-	return file_common_proto_rawDescGZIP(), []int{14, 1}
+	return file_common_proto_rawDescGZIP(), []int{25, 1}
 }
 
 type ENFT_NFTType int32
@@ -678,11 +849,11 @@ func (x ENFT_NFTType) String() string {
 }
 
 func (ENFT_NFTType) Descriptor() protoreflect.EnumDescriptor {
-	return file_common_proto_enumTypes[13].Descriptor()
+	return file_common_proto_enumTypes[16].Descriptor()
 }
 
 func (ENFT_NFTType) Type() protoreflect.EnumType {
-	return &file_common_proto_enumTypes[13]
+	return &file_common_proto_enumTypes[16]
 }
 
 func (x ENFT_NFTType) Number() protoreflect.EnumNumber {
@@ -691,7 +862,56 @@ func (x ENFT_NFTType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ENFT_NFTType.Descriptor instead.
 func (ENFT_NFTType) EnumDescriptor() ([]byte, []int) {
-	return file_common_proto_rawDescGZIP(), []int{15, 0}
+	return file_common_proto_rawDescGZIP(), []int{26, 0}
+}
+
+type ESMSCode_Purpose int32
+
+const (
+	ESMSCode_Purpose_None          ESMSCode_Purpose = 0
+	ESMSCode_Purpose_BindPhone     ESMSCode_Purpose = 1
+	ESMSCode_Purpose_ResetPassword ESMSCode_Purpose = 2
+)
+
+// Enum value maps for ESMSCode_Purpose.
+var (
+	ESMSCode_Purpose_name = map[int32]string{
+		0: "Purpose_None",
+		1: "Purpose_BindPhone",
+		2: "Purpose_ResetPassword",
+	}
+	ESMSCode_Purpose_value = map[string]int32{
+		"Purpose_None":          0,
+		"Purpose_BindPhone":     1,
+		"Purpose_ResetPassword": 2,
+	}
+)
+
+func (x ESMSCode_Purpose) Enum() *ESMSCode_Purpose {
+	p := new(ESMSCode_Purpose)
+	*p = x
+	return p
+}
+
+func (x ESMSCode_Purpose) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ESMSCode_Purpose) Descriptor() protoreflect.EnumDescriptor {
+	return file_common_proto_enumTypes[17].Descriptor()
+}
+
+func (ESMSCode_Purpose) Type() protoreflect.EnumType {
+	return &file_common_proto_enumTypes[17]
+}
+
+func (x ESMSCode_Purpose) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ESMSCode_Purpose.Descriptor instead.
+func (ESMSCode_Purpose) EnumDescriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{30, 0}
 }
 
 type EUser struct {
@@ -964,12 +1184,18 @@ type AccountInfo struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Account         string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
-	UserId          uint64 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Nickname        string `protobuf:"bytes,3,opt,name=nickname,proto3" json:"nickname,omitempty"`
-	Icon            string `protobuf:"bytes,4,opt,name=icon,proto3" json:"icon,omitempty"`
-	Email           string `protobuf:"bytes,5,opt,name=email,proto3" json:"email,omitempty"`
-	AptosWalletAddr string `protobuf:"bytes,6,opt,name=aptos_wallet_addr,json=aptosWalletAddr,proto3" json:"aptos_wallet_addr,omitempty"`
+	Account         string     `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	UserId          uint64     `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Nickname        string     `protobuf:"bytes,3,opt,name=nickname,proto3" json:"nickname,omitempty"`
+	Icon            string     `protobuf:"bytes,4,opt,name=icon,proto3" json:"icon,omitempty"`
+	Email           string     `protobuf:"bytes,5,opt,name=email,proto3" json:"email,omitempty"`
+	AptosWalletAddr string     `protobuf:"bytes,6,opt,name=aptos_wallet_addr,json=aptosWalletAddr,proto3" json:"aptos_wallet_addr,omitempty"`
+	TotpEnabled     bool       `protobuf:"varint,7,opt,name=totp_enabled,json=totpEnabled,proto3" json:"totp_enabled,omitempty"`
+	DeletionTime    int64      `protobuf:"varint,8,opt,name=deletion_time,json=deletionTime,proto3" json:"deletion_time,omitempty"`
+	Guest           bool       `protobuf:"varint,9,opt,name=guest,proto3" json:"guest,omitempty"`
+	Mute            *BanRecord `protobuf:"bytes,10,opt,name=mute,proto3" json:"mute,omitempty"`           // null if not muted
+	Platforms       []string   `protobuf:"bytes,11,rep,name=platforms,proto3" json:"platforms,omitempty"` // the linked identity providers
+	Tel             string     `protobuf:"bytes,12,opt,name=tel,proto3" json:"tel,omitempty"`
 }
 
 func (x *AccountInfo) Reset() {
@@ -999,51 +1225,863 @@ func (x *AccountInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AccountInfo.ProtoReflect.Descriptor instead.
-func (*AccountInfo) Descriptor() ([]byte, []int) {
-	return file_common_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use AccountInfo.ProtoReflect.Descriptor instead.
+func (*AccountInfo) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *AccountInfo) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+func (x *AccountInfo) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *AccountInfo) GetNickname() string {
+	if x != nil {
+		return x.Nickname
+	}
+	return ""
+}
+
+func (x *AccountInfo) GetIcon() string {
+	if x != nil {
+		return x.Icon
+	}
+	return ""
+}
+
+func (x *AccountInfo) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *AccountInfo) GetAptosWalletAddr() string {
+	if x != nil {
+		return x.AptosWalletAddr
+	}
+	return ""
+}
+
+func (x *AccountInfo) GetTotpEnabled() bool {
+	if x != nil {
+		return x.TotpEnabled
+	}
+	return false
+}
+
+func (x *AccountInfo) GetDeletionTime() int64 {
+	if x != nil {
+		return x.DeletionTime
+	}
+	return 0
+}
+
+func (x *AccountInfo) GetGuest() bool {
+	if x != nil {
+		return x.Guest
+	}
+	return false
+}
+
+func (x *AccountInfo) GetMute() *BanRecord {
+	if x != nil {
+		return x.Mute
+	}
+	return nil
+}
+
+func (x *AccountInfo) GetPlatforms() []string {
+	if x != nil {
+		return x.Platforms
+	}
+	return nil
+}
+
+func (x *AccountInfo) GetTel() string {
+	if x != nil {
+		return x.Tel
+	}
+	return ""
+}
+
+type WalletInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WalletAddr string `protobuf:"bytes,1,opt,name=wallet_addr,json=walletAddr,proto3" json:"wallet_addr,omitempty"`
+	Primary    bool   `protobuf:"varint,2,opt,name=primary,proto3" json:"primary,omitempty"`
+	LinkTime   int64  `protobuf:"varint,3,opt,name=link_time,json=linkTime,proto3" json:"link_time,omitempty"`
+}
+
+func (x *WalletInfo) Reset() {
+	*x = WalletInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_common_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WalletInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WalletInfo) ProtoMessage() {}
+
+func (x *WalletInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WalletInfo.ProtoReflect.Descriptor instead.
+func (*WalletInfo) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *WalletInfo) GetWalletAddr() string {
+	if x != nil {
+		return x.WalletAddr
+	}
+	return ""
+}
+
+func (x *WalletInfo) GetPrimary() bool {
+	if x != nil {
+		return x.Primary
+	}
+	return false
+}
+
+func (x *WalletInfo) GetLinkTime() int64 {
+	if x != nil {
+		return x.LinkTime
+	}
+	return 0
+}
+
+type ELoginMethod struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ELoginMethod) Reset() {
+	*x = ELoginMethod{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_common_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ELoginMethod) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ELoginMethod) ProtoMessage() {}
+
+func (x *ELoginMethod) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ELoginMethod.ProtoReflect.Descriptor instead.
+func (*ELoginMethod) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{7}
+}
+
+type EBan struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *EBan) Reset() {
+	*x = EBan{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_common_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EBan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EBan) ProtoMessage() {}
+
+func (x *EBan) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EBan.ProtoReflect.Descriptor instead.
+func (*EBan) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{8}
+}
+
+type BanRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId     uint64    `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Type       EBan_Type `protobuf:"varint,2,opt,name=type,proto3,enum=mpb.EBan_Type" json:"type,omitempty"`
+	Reason     string    `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	Operator   string    `protobuf:"bytes,4,opt,name=operator,proto3" json:"operator,omitempty"`
+	BanTime    int64     `protobuf:"varint,5,opt,name=ban_time,json=banTime,proto3" json:"ban_time,omitempty"`
+	ExpireTime int64     `protobuf:"varint,6,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"` // 0 if permanent
+}
+
+func (x *BanRecord) Reset() {
+	*x = BanRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_common_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BanRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BanRecord) ProtoMessage() {}
+
+func (x *BanRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BanRecord.ProtoReflect.Descriptor instead.
+func (*BanRecord) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *BanRecord) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *BanRecord) GetType() EBan_Type {
+	if x != nil {
+		return x.Type
+	}
+	return EBan_Type_None
+}
+
+func (x *BanRecord) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *BanRecord) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *BanRecord) GetBanTime() int64 {
+	if x != nil {
+		return x.BanTime
+	}
+	return 0
+}
+
+func (x *BanRecord) GetExpireTime() int64 {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return 0
+}
+
+type BanAuditLog struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId     uint64    `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Type       EBan_Type `protobuf:"varint,2,opt,name=type,proto3,enum=mpb.EBan_Type" json:"type,omitempty"`
+	Unban      bool      `protobuf:"varint,3,opt,name=unban,proto3" json:"unban,omitempty"`
+	Reason     string    `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	Operator   string    `protobuf:"bytes,5,opt,name=operator,proto3" json:"operator,omitempty"`
+	OpTime     int64     `protobuf:"varint,6,opt,name=op_time,json=opTime,proto3" json:"op_time,omitempty"`
+	ExpireTime int64     `protobuf:"varint,7,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"` // expire time of the ban, 0 if permanent
+}
+
+func (x *BanAuditLog) Reset() {
+	*x = BanAuditLog{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_common_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BanAuditLog) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BanAuditLog) ProtoMessage() {}
+
+func (x *BanAuditLog) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BanAuditLog.ProtoReflect.Descriptor instead.
+func (*BanAuditLog) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *BanAuditLog) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *BanAuditLog) GetType() EBan_Type {
+	if x != nil {
+		return x.Type
+	}
+	return EBan_Type_None
+}
+
+func (x *BanAuditLog) GetUnban() bool {
+	if x != nil {
+		return x.Unban
+	}
+	return false
+}
+
+func (x *BanAuditLog) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *BanAuditLog) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *BanAuditLog) GetOpTime() int64 {
+	if x != nil {
+		return x.OpTime
+	}
+	return 0
+}
+
+func (x *BanAuditLog) GetExpireTime() int64 {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return 0
+}
+
+type AccountMergeLog struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId       uint64   `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                     // the account kept
+	MergedUserId uint64   `protobuf:"varint,2,opt,name=merged_user_id,json=mergedUserId,proto3" json:"merged_user_id,omitempty"` // the account tombstoned
+	Operator     string   `protobuf:"bytes,3,opt,name=operator,proto3" json:"operator,omitempty"`                                // the gm who approved the merge, empty if merged by the user
+	Reason       string   `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	MergeTime    int64    `protobuf:"varint,5,opt,name=merge_time,json=mergeTime,proto3" json:"merge_time,omitempty"`
+	WalletAddrs  []string `protobuf:"bytes,6,rep,name=wallet_addrs,json=walletAddrs,proto3" json:"wallet_addrs,omitempty"` // moved from the merged account
+	Email        string   `protobuf:"bytes,7,opt,name=email,proto3" json:"email,omitempty"`                                // moved from the merged account
+	Platforms    []string `protobuf:"bytes,8,rep,name=platforms,proto3" json:"platforms,omitempty"`                        // moved from the merged account
+}
+
+func (x *AccountMergeLog) Reset() {
+	*x = AccountMergeLog{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_common_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccountMergeLog) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountMergeLog) ProtoMessage() {}
+
+func (x *AccountMergeLog) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountMergeLog.ProtoReflect.Descriptor instead.
+func (*AccountMergeLog) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *AccountMergeLog) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *AccountMergeLog) GetMergedUserId() uint64 {
+	if x != nil {
+		return x.MergedUserId
+	}
+	return 0
+}
+
+func (x *AccountMergeLog) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *AccountMergeLog) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *AccountMergeLog) GetMergeTime() int64 {
+	if x != nil {
+		return x.MergeTime
+	}
+	return 0
+}
+
+func (x *AccountMergeLog) GetWalletAddrs() []string {
+	if x != nil {
+		return x.WalletAddrs
+	}
+	return nil
+}
+
+func (x *AccountMergeLog) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *AccountMergeLog) GetPlatforms() []string {
+	if x != nil {
+		return x.Platforms
+	}
+	return nil
+}
+
+type EReferralReject struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *EReferralReject) Reset() {
+	*x = EReferralReject{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_common_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EReferralReject) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EReferralReject) ProtoMessage() {}
+
+func (x *EReferralReject) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EReferralReject.ProtoReflect.Descriptor instead.
+func (*EReferralReject) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{12}
+}
+
+type Referral struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId       uint64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Nickname     string `protobuf:"bytes,2,opt,name=nickname,proto3" json:"nickname,omitempty"`
+	Icon         string `protobuf:"bytes,3,opt,name=icon,proto3" json:"icon,omitempty"`
+	ReferralTime int64  `protobuf:"varint,4,opt,name=referral_time,json=referralTime,proto3" json:"referral_time,omitempty"`
+}
+
+func (x *Referral) Reset() {
+	*x = Referral{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_common_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Referral) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Referral) ProtoMessage() {}
+
+func (x *Referral) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Referral.ProtoReflect.Descriptor instead.
+func (*Referral) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *Referral) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *Referral) GetNickname() string {
+	if x != nil {
+		return x.Nickname
+	}
+	return ""
+}
+
+func (x *Referral) GetIcon() string {
+	if x != nil {
+		return x.Icon
+	}
+	return ""
+}
+
+func (x *Referral) GetReferralTime() int64 {
+	if x != nil {
+		return x.ReferralTime
+	}
+	return 0
+}
+
+type ReferrerRank struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Account      *AccountInfo `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	InvitedCount uint64       `protobuf:"varint,2,opt,name=invited_count,json=invitedCount,proto3" json:"invited_count,omitempty"`
+}
+
+func (x *ReferrerRank) Reset() {
+	*x = ReferrerRank{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_common_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReferrerRank) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReferrerRank) ProtoMessage() {}
+
+func (x *ReferrerRank) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReferrerRank.ProtoReflect.Descriptor instead.
+func (*ReferrerRank) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ReferrerRank) GetAccount() *AccountInfo {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *ReferrerRank) GetInvitedCount() uint64 {
+	if x != nil {
+		return x.InvitedCount
+	}
+	return 0
+}
+
+type LoginRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LoginTime int64               `protobuf:"varint,1,opt,name=login_time,json=loginTime,proto3" json:"login_time,omitempty"`
+	RemoteIp  string              `protobuf:"bytes,2,opt,name=remote_ip,json=remoteIp,proto3" json:"remote_ip,omitempty"`
+	Region    string              `protobuf:"bytes,3,opt,name=region,proto3" json:"region,omitempty"`
+	Method    ELoginMethod_Method `protobuf:"varint,4,opt,name=method,proto3,enum=mpb.ELoginMethod_Method" json:"method,omitempty"`
+	Device    string              `protobuf:"bytes,5,opt,name=device,proto3" json:"device,omitempty"`
+}
+
+func (x *LoginRecord) Reset() {
+	*x = LoginRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_common_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LoginRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginRecord) ProtoMessage() {}
+
+func (x *LoginRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginRecord.ProtoReflect.Descriptor instead.
+func (*LoginRecord) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *LoginRecord) GetLoginTime() int64 {
+	if x != nil {
+		return x.LoginTime
+	}
+	return 0
+}
+
+func (x *LoginRecord) GetRemoteIp() string {
+	if x != nil {
+		return x.RemoteIp
+	}
+	return ""
+}
+
+func (x *LoginRecord) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *LoginRecord) GetMethod() ELoginMethod_Method {
+	if x != nil {
+		return x.Method
+	}
+	return ELoginMethod_Method_None
+}
+
+func (x *LoginRecord) GetDevice() string {
+	if x != nil {
+		return x.Device
+	}
+	return ""
+}
+
+type SessionInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionId    string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Device       string `protobuf:"bytes,2,opt,name=device,proto3" json:"device,omitempty"`
+	DeviceId     string `protobuf:"bytes,3,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	RemoteIp     string `protobuf:"bytes,4,opt,name=remote_ip,json=remoteIp,proto3" json:"remote_ip,omitempty"`
+	Region       string `protobuf:"bytes,5,opt,name=region,proto3" json:"region,omitempty"`
+	CreateTime   int64  `protobuf:"varint,6,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	LastSeenTime int64  `protobuf:"varint,7,opt,name=last_seen_time,json=lastSeenTime,proto3" json:"last_seen_time,omitempty"`
+	Current      bool   `protobuf:"varint,8,opt,name=current,proto3" json:"current,omitempty"`
+}
+
+func (x *SessionInfo) Reset() {
+	*x = SessionInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_common_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SessionInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionInfo) ProtoMessage() {}
+
+func (x *SessionInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionInfo.ProtoReflect.Descriptor instead.
+func (*SessionInfo) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *SessionInfo) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
 }
 
-func (x *AccountInfo) GetAccount() string {
+func (x *SessionInfo) GetDevice() string {
 	if x != nil {
-		return x.Account
+		return x.Device
 	}
 	return ""
 }
 
-func (x *AccountInfo) GetUserId() uint64 {
+func (x *SessionInfo) GetDeviceId() string {
 	if x != nil {
-		return x.UserId
+		return x.DeviceId
 	}
-	return 0
+	return ""
 }
 
-func (x *AccountInfo) GetNickname() string {
+func (x *SessionInfo) GetRemoteIp() string {
 	if x != nil {
-		return x.Nickname
+		return x.RemoteIp
 	}
 	return ""
 }
 
-func (x *AccountInfo) GetIcon() string {
+func (x *SessionInfo) GetRegion() string {
 	if x != nil {
-		return x.Icon
+		return x.Region
 	}
 	return ""
 }
 
-func (x *AccountInfo) GetEmail() string {
+func (x *SessionInfo) GetCreateTime() int64 {
 	if x != nil {
-		return x.Email
+		return x.CreateTime
 	}
-	return ""
+	return 0
 }
 
-func (x *AccountInfo) GetAptosWalletAddr() string {
+func (x *SessionInfo) GetLastSeenTime() int64 {
 	if x != nil {
-		return x.AptosWalletAddr
+		return x.LastSeenTime
 	}
-	return ""
+	return 0
+}
+
+func (x *SessionInfo) GetCurrent() bool {
+	if x != nil {
+		return x.Current
+	}
+	return false
 }
 
 type UserProfile struct {
@@ -1065,7 +2103,7 @@ type UserProfile struct {
 func (x *UserProfile) Reset() {
 	*x = UserProfile{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_common_proto_msgTypes[6]
+		mi := &file_common_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1078,7 +2116,7 @@ func (x *UserProfile) String() string {
 func (*UserProfile) ProtoMessage() {}
 
 func (x *UserProfile) ProtoReflect() protoreflect.Message {
-	mi := &file_common_proto_msgTypes[6]
+	mi := &file_common_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1091,7 +2129,7 @@ func (x *UserProfile) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserProfile.ProtoReflect.Descriptor instead.
 func (*UserProfile) Descriptor() ([]byte, []int) {
-	return file_common_proto_rawDescGZIP(), []int{6}
+	return file_common_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *UserProfile) GetUserId() uint64 {
@@ -1169,7 +2207,7 @@ type UserInfo struct {
 func (x *UserInfo) Reset() {
 	*x = UserInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_common_proto_msgTypes[7]
+		mi := &file_common_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1182,7 +2220,7 @@ func (x *UserInfo) String() string {
 func (*UserInfo) ProtoMessage() {}
 
 func (x *UserInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_common_proto_msgTypes[7]
+	mi := &file_common_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1195,7 +2233,7 @@ func (x *UserInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserInfo.ProtoReflect.Descriptor instead.
 func (*UserInfo) Descriptor() ([]byte, []int) {
-	return file_common_proto_rawDescGZIP(), []int{7}
+	return file_common_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *UserInfo) GetUserId() uint64 {
@@ -1223,7 +2261,7 @@ type ReqUserId struct {
 func (x *ReqUserId) Reset() {
 	*x = ReqUserId{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_common_proto_msgTypes[8]
+		mi := &file_common_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1236,7 +2274,7 @@ func (x *ReqUserId) String() string {
 func (*ReqUserId) ProtoMessage() {}
 
 func (x *ReqUserId) ProtoReflect() protoreflect.Message {
-	mi := &file_common_proto_msgTypes[8]
+	mi := &file_common_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1249,7 +2287,7 @@ func (x *ReqUserId) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReqUserId.ProtoReflect.Descriptor instead.
 func (*ReqUserId) Descriptor() ([]byte, []int) {
-	return file_common_proto_rawDescGZIP(), []int{8}
+	return file_common_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *ReqUserId) GetUserId() uint64 {
@@ -1271,7 +2309,7 @@ type ReqUserIdRegion struct {
 func (x *ReqUserIdRegion) Reset() {
 	*x = ReqUserIdRegion{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_common_proto_msgTypes[9]
+		mi := &file_common_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1284,7 +2322,7 @@ func (x *ReqUserIdRegion) String() string {
 func (*ReqUserIdRegion) ProtoMessage() {}
 
 func (x *ReqUserIdRegion) ProtoReflect() protoreflect.Message {
-	mi := &file_common_proto_msgTypes[9]
+	mi := &file_common_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1297,7 +2335,7 @@ func (x *ReqUserIdRegion) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReqUserIdRegion.ProtoReflect.Descriptor instead.
 func (*ReqUserIdRegion) Descriptor() ([]byte, []int) {
-	return file_common_proto_rawDescGZIP(), []int{9}
+	return file_common_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *ReqUserIdRegion) GetUserId() uint64 {
@@ -1323,7 +2361,7 @@ type EItem struct {
 func (x *EItem) Reset() {
 	*x = EItem{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_common_proto_msgTypes[10]
+		mi := &file_common_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1336,7 +2374,7 @@ func (x *EItem) String() string {
 func (*EItem) ProtoMessage() {}
 
 func (x *EItem) ProtoReflect() protoreflect.Message {
-	mi := &file_common_proto_msgTypes[10]
+	mi := &file_common_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1349,7 +2387,7 @@ func (x *EItem) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EItem.ProtoReflect.Descriptor instead.
 func (*EItem) Descriptor() ([]byte, []int) {
-	return file_common_proto_rawDescGZIP(), []int{10}
+	return file_common_proto_rawDescGZIP(), []int{21}
 }
 
 type Item struct {
@@ -1367,7 +2405,7 @@ type Item struct {
 func (x *Item) Reset() {
 	*x = Item{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_common_proto_msgTypes[11]
+		mi := &file_common_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1380,7 +2418,7 @@ func (x *Item) String() string {
 func (*Item) ProtoMessage() {}
 
 func (x *Item) ProtoReflect() protoreflect.Message {
-	mi := &file_common_proto_msgTypes[11]
+	mi := &file_common_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1393,7 +2431,7 @@ func (x *Item) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Item.ProtoReflect.Descriptor instead.
 func (*Item) Descriptor() ([]byte, []int) {
-	return file_common_proto_rawDescGZIP(), []int{11}
+	return file_common_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *Item) GetId() uint32 {
@@ -1440,7 +2478,7 @@ type EMail struct {
 func (x *EMail) Reset() {
 	*x = EMail{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_common_proto_msgTypes[12]
+		mi := &file_common_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1453,7 +2491,7 @@ func (x *EMail) String() string {
 func (*EMail) ProtoMessage() {}
 
 func (x *EMail) ProtoReflect() protoreflect.Message {
-	mi := &file_common_proto_msgTypes[12]
+	mi := &file_common_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1466,7 +2504,7 @@ func (x *EMail) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EMail.ProtoReflect.Descriptor instead.
 func (*EMail) Descriptor() ([]byte, []int) {
-	return file_common_proto_rawDescGZIP(), []int{12}
+	return file_common_proto_rawDescGZIP(), []int{23}
 }
 
 type Mail struct {
@@ -1488,7 +2526,7 @@ type Mail struct {
 func (x *Mail) Reset() {
 	*x = Mail{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_common_proto_msgTypes[13]
+		mi := &file_common_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1501,7 +2539,7 @@ func (x *Mail) String() string {
 func (*Mail) ProtoMessage() {}
 
 func (x *Mail) ProtoReflect() protoreflect.Message {
-	mi := &file_common_proto_msgTypes[13]
+	mi := &file_common_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1514,7 +2552,7 @@ func (x *Mail) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Mail.ProtoReflect.Descriptor instead.
 func (*Mail) Descriptor() ([]byte, []int) {
-	return file_common_proto_rawDescGZIP(), []int{13}
+	return file_common_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *Mail) GetMailId() uint64 {
@@ -1590,7 +2628,7 @@ type EFriend struct {
 func (x *EFriend) Reset() {
 	*x = EFriend{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_common_proto_msgTypes[14]
+		mi := &file_common_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1603,7 +2641,7 @@ func (x *EFriend) String() string {
 func (*EFriend) ProtoMessage() {}
 
 func (x *EFriend) ProtoReflect() protoreflect.Message {
-	mi := &file_common_proto_msgTypes[14]
+	mi := &file_common_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1616,7 +2654,7 @@ func (x *EFriend) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EFriend.ProtoReflect.Descriptor instead.
 func (*EFriend) Descriptor() ([]byte, []int) {
-	return file_common_proto_rawDescGZIP(), []int{14}
+	return file_common_proto_rawDescGZIP(), []int{25}
 }
 
 // nft
@@ -1629,7 +2667,7 @@ type ENFT struct {
 func (x *ENFT) Reset() {
 	*x = ENFT{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_common_proto_msgTypes[15]
+		mi := &file_common_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1642,7 +2680,7 @@ func (x *ENFT) String() string {
 func (*ENFT) ProtoMessage() {}
 
 func (x *ENFT) ProtoReflect() protoreflect.Message {
-	mi := &file_common_proto_msgTypes[15]
+	mi := &file_common_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1655,7 +2693,7 @@ func (x *ENFT) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ENFT.ProtoReflect.Descriptor instead.
 func (*ENFT) Descriptor() ([]byte, []int) {
-	return file_common_proto_rawDescGZIP(), []int{15}
+	return file_common_proto_rawDescGZIP(), []int{26}
 }
 
 type AptosNFTNode struct {
@@ -1671,7 +2709,7 @@ type AptosNFTNode struct {
 func (x *AptosNFTNode) Reset() {
 	*x = AptosNFTNode{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_common_proto_msgTypes[16]
+		mi := &file_common_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1684,7 +2722,7 @@ func (x *AptosNFTNode) String() string {
 func (*AptosNFTNode) ProtoMessage() {}
 
 func (x *AptosNFTNode) ProtoReflect() protoreflect.Message {
-	mi := &file_common_proto_msgTypes[16]
+	mi := &file_common_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1697,7 +2735,7 @@ func (x *AptosNFTNode) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AptosNFTNode.ProtoReflect.Descriptor instead.
 func (*AptosNFTNode) Descriptor() ([]byte, []int) {
-	return file_common_proto_rawDescGZIP(), []int{16}
+	return file_common_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *AptosNFTNode) GetNftType() uint32 {
@@ -1733,7 +2771,7 @@ type AptosNFTMetadata struct {
 func (x *AptosNFTMetadata) Reset() {
 	*x = AptosNFTMetadata{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_common_proto_msgTypes[17]
+		mi := &file_common_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1746,7 +2784,7 @@ func (x *AptosNFTMetadata) String() string {
 func (*AptosNFTMetadata) ProtoMessage() {}
 
 func (x *AptosNFTMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_common_proto_msgTypes[17]
+	mi := &file_common_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1759,7 +2797,7 @@ func (x *AptosNFTMetadata) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AptosNFTMetadata.ProtoReflect.Descriptor instead.
 func (*AptosNFTMetadata) Descriptor() ([]byte, []int) {
-	return file_common_proto_rawDescGZIP(), []int{17}
+	return file_common_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *AptosNFTMetadata) GetNftId() uint64 {
@@ -1790,12 +2828,13 @@ type AptosNFTNodeV2 struct {
 	TokenStandard        string                     `protobuf:"bytes,7,opt,name=token_standard,json=tokenStandard,proto3" json:"token_standard,omitempty"`
 	TokenUri             string                     `protobuf:"bytes,8,opt,name=token_uri,json=tokenUri,proto3" json:"token_uri,omitempty"`
 	TransactionTimestamp string                     `protobuf:"bytes,9,opt,name=transaction_timestamp,json=transactionTimestamp,proto3" json:"transaction_timestamp,omitempty"`
+	OwnerAddr            string                     `protobuf:"bytes,10,opt,name=owner_addr,json=ownerAddr,proto3" json:"owner_addr,omitempty"`
 }
 
 func (x *AptosNFTNodeV2) Reset() {
 	*x = AptosNFTNodeV2{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_common_proto_msgTypes[18]
+		mi := &file_common_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1808,7 +2847,7 @@ func (x *AptosNFTNodeV2) String() string {
 func (*AptosNFTNodeV2) ProtoMessage() {}
 
 func (x *AptosNFTNodeV2) ProtoReflect() protoreflect.Message {
-	mi := &file_common_proto_msgTypes[18]
+	mi := &file_common_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1821,7 +2860,7 @@ func (x *AptosNFTNodeV2) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AptosNFTNodeV2.ProtoReflect.Descriptor instead.
 func (*AptosNFTNodeV2) Descriptor() ([]byte, []int) {
-	return file_common_proto_rawDescGZIP(), []int{18}
+	return file_common_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *AptosNFTNodeV2) GetCollectionId() string {
@@ -1887,6 +2926,52 @@ func (x *AptosNFTNodeV2) GetTransactionTimestamp() string {
 	return ""
 }
 
+func (x *AptosNFTNodeV2) GetOwnerAddr() string {
+	if x != nil {
+		return x.OwnerAddr
+	}
+	return ""
+}
+
+// ESMSCode what the sms verification code is sent for
+type ESMSCode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ESMSCode) Reset() {
+	*x = ESMSCode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_common_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ESMSCode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ESMSCode) ProtoMessage() {}
+
+func (x *ESMSCode) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ESMSCode.ProtoReflect.Descriptor instead.
+func (*ESMSCode) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{30}
+}
+
 type AptosNFTNodeV2_Properties struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1902,7 +2987,7 @@ type AptosNFTNodeV2_Properties struct {
 func (x *AptosNFTNodeV2_Properties) Reset() {
 	*x = AptosNFTNodeV2_Properties{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_common_proto_msgTypes[20]
+		mi := &file_common_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1915,7 +3000,7 @@ func (x *AptosNFTNodeV2_Properties) String() string {
 func (*AptosNFTNodeV2_Properties) ProtoMessage() {}
 
 func (x *AptosNFTNodeV2_Properties) ProtoReflect() protoreflect.Message {
-	mi := &file_common_proto_msgTypes[20]
+	mi := &file_common_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1928,7 +3013,7 @@ func (x *AptosNFTNodeV2_Properties) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AptosNFTNodeV2_Properties.ProtoReflect.Descriptor instead.
 func (*AptosNFTNodeV2_Properties) Descriptor() ([]byte, []int) {
-	return file_common_proto_rawDescGZIP(), []int{18, 0}
+	return file_common_proto_rawDescGZIP(), []int{29, 0}
 }
 
 func (x *AptosNFTNodeV2_Properties) GetProp1() string {
@@ -1997,7 +3082,7 @@ var file_common_proto_rawDesc = []byte{
 	0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x22, 0x27, 0x0a, 0x0b, 0x41, 0x64,
 	0x6d, 0x69, 0x6e, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63,
 	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x22, 0xb2, 0x01, 0x0a, 0x0b, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49,
+	0x75, 0x6e, 0x74, 0x22, 0xe4, 0x02, 0x0a, 0x0b, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49,
 	0x6e, 0x66, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x17, 0x0a,
 	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06,
@@ -2008,168 +3093,300 @@ var file_common_proto_rawDesc = []byte{
 	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x2a, 0x0a, 0x11,
 	0x61, 0x70, 0x74, 0x6f, 0x73, 0x5f, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x61, 0x64, 0x64,
 	0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x61, 0x70, 0x74, 0x6f, 0x73, 0x57, 0x61,
-	0x6c, 0x6c, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x22, 0x82, 0x02, 0x0a, 0x0b, 0x55, 0x73, 0x65,
-	0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
-	0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6e,
-	0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e,
-	0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x63, 0x6f, 0x6e, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x69, 0x63, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x69,
-	0x63, 0x6f, 0x6e, 0x5f, 0x62, 0x6f, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x69,
-	0x63, 0x6f, 0x6e, 0x42, 0x6f, 0x78, 0x12, 0x16, 0x0a, 0x06, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x10,
-	0x0a, 0x03, 0x76, 0x69, 0x70, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x76, 0x69, 0x70,
-	0x12, 0x33, 0x0a, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x08,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x45, 0x55, 0x73, 0x65, 0x72,
-	0x2e, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x09, 0x75, 0x73, 0x65, 0x72,
-	0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18,
-	0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x22, 0x5a, 0x0a,
-	0x08, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65,
-	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
-	0x49, 0x64, 0x12, 0x35, 0x0a, 0x0d, 0x62, 0x61, 0x73, 0x69, 0x63, 0x5f, 0x70, 0x72, 0x6f, 0x66,
-	0x69, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d, 0x70, 0x62, 0x2e,
-	0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x0c, 0x62, 0x61, 0x73,
-	0x69, 0x63, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x22, 0x24, 0x0a, 0x09, 0x52, 0x65, 0x71,
-	0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22,
-	0x4f, 0x0a, 0x0f, 0x52, 0x65, 0x71, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x52, 0x65, 0x67, 0x69,
-	0x6f, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x06, 0x72,
-	0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x6d, 0x70,
-	0x62, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e,
-	0x22, 0xbf, 0x02, 0x0a, 0x05, 0x45, 0x49, 0x74, 0x65, 0x6d, 0x22, 0x6a, 0x0a, 0x08, 0x49, 0x74,
-	0x65, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x12, 0x11, 0x0a, 0x0d, 0x49, 0x74, 0x65, 0x6d, 0x54, 0x79,
-	0x70, 0x65, 0x5f, 0x4e, 0x6f, 0x6e, 0x65, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x49, 0x74, 0x65,
-	0x6d, 0x54, 0x79, 0x70, 0x65, 0x5f, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x10, 0x01, 0x12,
-	0x10, 0x0a, 0x0c, 0x49, 0x74, 0x65, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x5f, 0x42, 0x6f, 0x78, 0x10,
-	0x0a, 0x12, 0x11, 0x0a, 0x0d, 0x49, 0x74, 0x65, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x5f, 0x43, 0x6f,
-	0x69, 0x6e, 0x10, 0x5b, 0x12, 0x10, 0x0a, 0x0c, 0x49, 0x74, 0x65, 0x6d, 0x54, 0x79, 0x70, 0x65,
-	0x5f, 0x47, 0x65, 0x6d, 0x10, 0x5c, 0x22, 0x40, 0x0a, 0x06, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x64,
-	0x12, 0x0f, 0x0a, 0x0b, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x5f, 0x4e, 0x6f, 0x6e, 0x65, 0x10,
-	0x00, 0x12, 0x12, 0x0a, 0x0b, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x5f, 0x43, 0x6f, 0x69, 0x6e,
-	0x10, 0xc1, 0x99, 0xb2, 0x2b, 0x12, 0x11, 0x0a, 0x0a, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x5f,
-	0x47, 0x65, 0x6d, 0x10, 0x81, 0x9e, 0xef, 0x2b, 0x22, 0x4d, 0x0a, 0x08, 0x44, 0x72, 0x6f, 0x70,
-	0x54, 0x79, 0x70, 0x65, 0x12, 0x11, 0x0a, 0x0d, 0x44, 0x72, 0x6f, 0x70, 0x54, 0x79, 0x70, 0x65,
-	0x5f, 0x4e, 0x6f, 0x6e, 0x65, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x44, 0x72, 0x6f, 0x70, 0x54,
-	0x79, 0x70, 0x65, 0x5f, 0x31, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x44, 0x72, 0x6f, 0x70, 0x54,
-	0x79, 0x70, 0x65, 0x5f, 0x32, 0x10, 0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x44, 0x72, 0x6f, 0x70, 0x54,
-	0x79, 0x70, 0x65, 0x5f, 0x33, 0x10, 0x03, 0x22, 0x39, 0x0a, 0x0b, 0x54, 0x72, 0x61, 0x6e, 0x73,
-	0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x10, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x52,
-	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x5f, 0x4e, 0x6f, 0x6e, 0x65, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10,
-	0x54, 0x72, 0x61, 0x6e, 0x73, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x5f, 0x4d, 0x61, 0x69, 0x6c,
-	0x10, 0x01, 0x22, 0x74, 0x0a, 0x04, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x6e, 0x75,
-	0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6e, 0x75, 0x6d, 0x12, 0x12, 0x0a, 0x04,
-	0x75, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64,
-	0x12, 0x19, 0x0a, 0x08, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x07, 0x62, 0x61, 0x74, 0x63, 0x68, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x65,
-	0x78, 0x70, 0x69, 0x72, 0x65, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
-	0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x41, 0x74, 0x22, 0x8d, 0x03, 0x0a, 0x05, 0x45, 0x4d, 0x61,
-	0x69, 0x6c, 0x22, 0x33, 0x0a, 0x08, 0x4d, 0x61, 0x69, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x12, 0x11,
-	0x0a, 0x0d, 0x4d, 0x61, 0x69, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x5f, 0x4e, 0x6f, 0x6e, 0x65, 0x10,
-	0x00, 0x12, 0x14, 0x0a, 0x10, 0x4d, 0x61, 0x69, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x5f, 0x47, 0x65,
-	0x6e, 0x65, 0x72, 0x61, 0x6c, 0x10, 0x01, 0x22, 0x4a, 0x0a, 0x09, 0x4d, 0x61, 0x69, 0x6c, 0x53,
-	0x74, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x0e, 0x4d, 0x61, 0x69, 0x6c, 0x53, 0x74, 0x61, 0x74,
-	0x65, 0x5f, 0x49, 0x6e, 0x69, 0x74, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x4d, 0x61, 0x69, 0x6c,
-	0x53, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x52, 0x65, 0x61, 0x64, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11,
-	0x4d, 0x61, 0x69, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x41, 0x77, 0x61, 0x72, 0x64, 0x65,
-	0x64, 0x10, 0x02, 0x22, 0x38, 0x0a, 0x0e, 0x4d, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x61, 0x64, 0x4f,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x10, 0x4d, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x61,
-	0x64, 0x5f, 0x4d, 0x61, 0x69, 0x6c, 0x49, 0x64, 0x73, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x4d,
-	0x61, 0x69, 0x6c, 0x52, 0x65, 0x61, 0x64, 0x5f, 0x41, 0x6c, 0x6c, 0x10, 0x01, 0x22, 0x4b, 0x0a,
-	0x0d, 0x4d, 0x61, 0x69, 0x6c, 0x44, 0x65, 0x6c, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x13,
-	0x0a, 0x0f, 0x4d, 0x61, 0x69, 0x6c, 0x44, 0x65, 0x6c, 0x5f, 0x4d, 0x61, 0x69, 0x6c, 0x49, 0x64,
-	0x73, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x4d, 0x61, 0x69, 0x6c, 0x44, 0x65, 0x6c, 0x5f, 0x41,
-	0x6c, 0x6c, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x4d, 0x61, 0x69, 0x6c, 0x44, 0x65, 0x6c, 0x5f,
-	0x42, 0x65, 0x65, 0x6e, 0x52, 0x65, 0x61, 0x64, 0x10, 0x02, 0x22, 0x43, 0x0a, 0x12, 0x4d, 0x61,
-	0x69, 0x6c, 0x47, 0x65, 0x74, 0x41, 0x77, 0x61, 0x72, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x17, 0x0a, 0x13, 0x4d, 0x61, 0x69, 0x6c, 0x47, 0x65, 0x74, 0x41, 0x77, 0x61, 0x72, 0x64,
-	0x5f, 0x4d, 0x61, 0x69, 0x6c, 0x49, 0x64, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x4d, 0x61, 0x69,
-	0x6c, 0x47, 0x65, 0x74, 0x41, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x41, 0x6c, 0x6c, 0x10, 0x01, 0x22,
-	0x37, 0x0a, 0x09, 0x45, 0x4d, 0x61, 0x69, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x0e,
-	0x45, 0x4d, 0x61, 0x69, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x5f, 0x4e, 0x6f, 0x6e, 0x65, 0x10, 0x00,
-	0x12, 0x16, 0x0a, 0x12, 0x45, 0x4d, 0x61, 0x69, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x5f, 0x42, 0x69,
-	0x6e, 0x64, 0x43, 0x6f, 0x64, 0x65, 0x10, 0x01, 0x22, 0xed, 0x02, 0x0a, 0x04, 0x4d, 0x61, 0x69,
-	0x6c, 0x12, 0x17, 0x0a, 0x07, 0x6d, 0x61, 0x69, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x06, 0x6d, 0x61, 0x69, 0x6c, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65,
-	0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x72, 0x65,
-	0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x1b,
-	0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x30, 0x0a, 0x09, 0x6d,
-	0x61, 0x69, 0x6c, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13,
-	0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x45, 0x4d, 0x61, 0x69, 0x6c, 0x2e, 0x4d, 0x61, 0x69, 0x6c, 0x54,
-	0x79, 0x70, 0x65, 0x52, 0x08, 0x6d, 0x61, 0x69, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a,
-	0x0a, 0x6d, 0x61, 0x69, 0x6c, 0x5f, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x6d, 0x61, 0x69, 0x6c, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x34, 0x0a, 0x09,
-	0x6d, 0x61, 0x70, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x17, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x4d, 0x61, 0x69, 0x6c, 0x2e, 0x4d, 0x61, 0x70, 0x44, 0x61,
-	0x74, 0x61, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x6d, 0x61, 0x70, 0x44, 0x61, 0x74,
-	0x61, 0x73, 0x12, 0x21, 0x0a, 0x06, 0x61, 0x77, 0x61, 0x72, 0x64, 0x73, 0x18, 0x08, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x09, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x06, 0x61,
-	0x77, 0x61, 0x72, 0x64, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x09,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x1a, 0x3b, 0x0a, 0x0d, 0x4d,
-	0x61, 0x70, 0x44, 0x61, 0x74, 0x61, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
-	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
-	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x7c, 0x0a, 0x07, 0x45, 0x46, 0x72, 0x69,
-	0x65, 0x6e, 0x64, 0x22, 0x31, 0x0a, 0x0b, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x4f, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x0c, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x5f, 0x41, 0x63, 0x63, 0x65,
-	0x70, 0x74, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x5f, 0x52, 0x65,
-	0x6a, 0x65, 0x63, 0x74, 0x10, 0x01, 0x22, 0x3e, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x6f,
-	0x72, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x53, 0x6f, 0x72, 0x74, 0x54, 0x79,
-	0x70, 0x65, 0x5f, 0x55, 0x73, 0x65, 0x72, 0x5f, 0x49, 0x64, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14,
-	0x53, 0x6f, 0x72, 0x74, 0x54, 0x79, 0x70, 0x65, 0x5f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f,
-	0x54, 0x69, 0x6d, 0x65, 0x10, 0x01, 0x22, 0x37, 0x0a, 0x04, 0x45, 0x4e, 0x46, 0x54, 0x22, 0x2f,
-	0x0a, 0x07, 0x4e, 0x46, 0x54, 0x54, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x0c, 0x4e, 0x46, 0x54,
-	0x54, 0x79, 0x70, 0x65, 0x5f, 0x4e, 0x6f, 0x6e, 0x65, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x4e,
-	0x46, 0x54, 0x54, 0x79, 0x70, 0x65, 0x5f, 0x57, 0x65, 0x61, 0x70, 0x6f, 0x6e, 0x10, 0x01, 0x22,
-	0x5c, 0x0a, 0x0c, 0x41, 0x70, 0x74, 0x6f, 0x73, 0x4e, 0x46, 0x54, 0x4e, 0x6f, 0x64, 0x65, 0x12,
-	0x19, 0x0a, 0x08, 0x6e, 0x66, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x07, 0x6e, 0x66, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x6e, 0x66,
-	0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6e, 0x66, 0x74, 0x49,
-	0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x46, 0x0a,
-	0x10, 0x41, 0x70, 0x74, 0x6f, 0x73, 0x4e, 0x46, 0x54, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0x12, 0x15, 0x0a, 0x06, 0x6e, 0x66, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x05, 0x6e, 0x66, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x65, 0x74, 0x61,
-	0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x65, 0x74,
-	0x61, 0x44, 0x61, 0x74, 0x61, 0x22, 0x8c, 0x04, 0x0a, 0x0e, 0x41, 0x70, 0x74, 0x6f, 0x73, 0x4e,
-	0x46, 0x54, 0x4e, 0x6f, 0x64, 0x65, 0x56, 0x32, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6c, 0x6c,
-	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0c, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x22, 0x0a,
-	0x0d, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x69, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x49,
-	0x64, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x4e, 0x61,
-	0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x12, 0x49, 0x0a,
-	0x10, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65,
-	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x41, 0x70,
-	0x74, 0x6f, 0x73, 0x4e, 0x46, 0x54, 0x4e, 0x6f, 0x64, 0x65, 0x56, 0x32, 0x2e, 0x50, 0x72, 0x6f,
-	0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x52, 0x0f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x72,
-	0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x6f, 0x6b, 0x65,
-	0x6e, 0x5f, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x61, 0x72, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0d, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x61, 0x72, 0x64, 0x12,
-	0x1b, 0x0a, 0x09, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x75, 0x72, 0x69, 0x18, 0x08, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x55, 0x72, 0x69, 0x12, 0x33, 0x0a, 0x15,
-	0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x74, 0x72, 0x61,
-	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x1a, 0x90, 0x01, 0x0a, 0x0a, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73,
-	0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x6f, 0x70, 0x31, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x70, 0x72, 0x6f, 0x70, 0x31, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x6f, 0x70, 0x32, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x72, 0x6f, 0x70, 0x32, 0x12, 0x18, 0x0a, 0x07,
-	0x71, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x71,
-	0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x77, 0x65, 0x61, 0x70, 0x6f, 0x6e,
-	0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x77, 0x65, 0x61, 0x70, 0x6f,
-	0x6e, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x77, 0x65, 0x61, 0x70, 0x6f, 0x6e, 0x5f, 0x74, 0x79,
-	0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x77, 0x65, 0x61, 0x70, 0x6f, 0x6e,
-	0x54, 0x79, 0x70, 0x65, 0x42, 0x07, 0x5a, 0x05, 0x2e, 0x2f, 0x6d, 0x70, 0x62, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6c, 0x6c, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x70,
+	0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b,
+	0x74, 0x6f, 0x74, 0x70, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x64,
+	0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0c, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x67, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x05, 0x67, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x04, 0x6d, 0x75, 0x74, 0x65, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x42, 0x61, 0x6e, 0x52, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x52, 0x04, 0x6d, 0x75, 0x74, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x6c,
+	0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x70,
+	0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x65, 0x6c, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x65, 0x6c, 0x22, 0x64, 0x0a, 0x0a, 0x57, 0x61,
+	0x6c, 0x6c, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1f, 0x0a, 0x0b, 0x77, 0x61, 0x6c, 0x6c,
+	0x65, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x77,
+	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x69,
+	0x6d, 0x61, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x70, 0x72, 0x69, 0x6d,
+	0x61, 0x72, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6c, 0x69, 0x6e, 0x6b, 0x54, 0x69, 0x6d, 0x65,
+	0x22, 0x95, 0x01, 0x0a, 0x0c, 0x45, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f,
+	0x64, 0x22, 0x84, 0x01, 0x0a, 0x06, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x0f, 0x0a, 0x0b,
+	0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x5f, 0x4e, 0x6f, 0x6e, 0x65, 0x10, 0x00, 0x12, 0x13, 0x0a,
+	0x0f, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x5f, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
+	0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x5f, 0x57, 0x61, 0x6c,
+	0x6c, 0x65, 0x74, 0x10, 0x02, 0x12, 0x10, 0x0a, 0x0c, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x5f,
+	0x47, 0x75, 0x65, 0x73, 0x74, 0x10, 0x03, 0x12, 0x13, 0x0a, 0x0f, 0x4d, 0x65, 0x74, 0x68, 0x6f,
+	0x64, 0x5f, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x10, 0x04, 0x12, 0x1a, 0x0a, 0x16,
+	0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x5f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x10, 0x05, 0x22, 0x4c, 0x0a, 0x04, 0x45, 0x42, 0x61, 0x6e,
+	0x22, 0x44, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0d, 0x0a, 0x09, 0x54, 0x79, 0x70, 0x65,
+	0x5f, 0x4e, 0x6f, 0x6e, 0x65, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x54, 0x79, 0x70, 0x65, 0x5f,
+	0x42, 0x61, 0x6e, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x54, 0x79, 0x70, 0x65, 0x5f, 0x53, 0x75,
+	0x73, 0x70, 0x65, 0x6e, 0x64, 0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09, 0x54, 0x79, 0x70, 0x65, 0x5f,
+	0x4d, 0x75, 0x74, 0x65, 0x10, 0x03, 0x22, 0xb8, 0x01, 0x0a, 0x09, 0x42, 0x61, 0x6e, 0x52, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x22, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x6d, 0x70,
+	0x62, 0x2e, 0x45, 0x42, 0x61, 0x6e, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x61, 0x6e, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x62, 0x61, 0x6e, 0x54, 0x69, 0x6d, 0x65,
+	0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x54, 0x69, 0x6d,
+	0x65, 0x22, 0xce, 0x01, 0x0a, 0x0b, 0x42, 0x61, 0x6e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f,
+	0x67, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x45,
+	0x42, 0x61, 0x6e, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x75, 0x6e, 0x62, 0x61, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x75,
+	0x6e, 0x62, 0x61, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08,
+	0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x6f, 0x70, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6f, 0x70, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x54, 0x69,
+	0x6d, 0x65, 0x22, 0xfa, 0x01, 0x0a, 0x0f, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x65,
+	0x72, 0x67, 0x65, 0x4c, 0x6f, 0x67, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x24, 0x0a, 0x0e, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x64, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x64, 0x55,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f,
+	0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x65, 0x72,
+	0x67, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6d,
+	0x65, 0x72, 0x67, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x77, 0x61, 0x6c, 0x6c,
+	0x65, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b,
+	0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65,
+	0x6d, 0x61, 0x69, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69,
+	0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x73, 0x18, 0x08,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x73, 0x22,
+	0xbc, 0x01, 0x0a, 0x0f, 0x45, 0x52, 0x65, 0x66, 0x65, 0x72, 0x72, 0x61, 0x6c, 0x52, 0x65, 0x6a,
+	0x65, 0x63, 0x74, 0x22, 0xa8, 0x01, 0x0a, 0x06, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x0f,
+	0x0a, 0x0b, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x5f, 0x4e, 0x6f, 0x6e, 0x65, 0x10, 0x00, 0x12,
+	0x17, 0x0a, 0x13, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x5f, 0x53, 0x65, 0x6c, 0x66, 0x52, 0x65,
+	0x66, 0x65, 0x72, 0x72, 0x61, 0x6c, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x52, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x5f, 0x53, 0x61, 0x6d, 0x65, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x10, 0x02, 0x12,
+	0x15, 0x0a, 0x11, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x5f, 0x53, 0x61, 0x6d, 0x65, 0x53, 0x75,
+	0x62, 0x6e, 0x65, 0x74, 0x10, 0x03, 0x12, 0x15, 0x0a, 0x11, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x5f, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x55, 0x73, 0x65, 0x64, 0x10, 0x04, 0x12, 0x18, 0x0a,
+	0x14, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x5f, 0x52, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x72,
+	0x4d, 0x75, 0x74, 0x65, 0x64, 0x10, 0x05, 0x12, 0x15, 0x0a, 0x11, 0x52, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x5f, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x55, 0x73, 0x65, 0x64, 0x10, 0x06, 0x22, 0x78,
+	0x0a, 0x08, 0x52, 0x65, 0x66, 0x65, 0x72, 0x72, 0x61, 0x6c, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x69, 0x63, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69,
+	0x63, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x61, 0x6c, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x72, 0x65, 0x66, 0x65,
+	0x72, 0x72, 0x61, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x5f, 0x0a, 0x0c, 0x52, 0x65, 0x66, 0x65,
+	0x72, 0x72, 0x65, 0x72, 0x52, 0x61, 0x6e, 0x6b, 0x12, 0x2a, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d, 0x70, 0x62, 0x2e,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x64, 0x5f,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x69, 0x6e, 0x76,
+	0x69, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xab, 0x01, 0x0a, 0x0b, 0x4c, 0x6f,
+	0x67, 0x69, 0x6e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x6f, 0x67,
+	0x69, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6c,
+	0x6f, 0x67, 0x69, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x6d, 0x6f,
+	0x74, 0x65, 0x5f, 0x69, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x6d,
+	0x6f, 0x74, 0x65, 0x49, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x30, 0x0a,
+	0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e,
+	0x6d, 0x70, 0x62, 0x2e, 0x45, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64,
+	0x2e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x22, 0xf7, 0x01, 0x0a, 0x0b, 0x53, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x1b,
+	0x0a, 0x09, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x72,
+	0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x69, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x49, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69,
+	0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e,
+	0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x24, 0x0a, 0x0e, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x65, 0x65, 0x6e, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x53,
+	0x65, 0x65, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x74, 0x22, 0x82, 0x02, 0x0a, 0x0b, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c,
+	0x65, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x69, 0x63, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04,
+	0x69, 0x63, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x63, 0x6f, 0x6e, 0x5f, 0x62, 0x6f, 0x78,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x69, 0x63, 0x6f, 0x6e, 0x42, 0x6f, 0x78, 0x12,
+	0x16, 0x0a, 0x06, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x06, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x76, 0x69, 0x70, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x76, 0x69, 0x70, 0x12, 0x33, 0x0a, 0x0a, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e,
+	0x6d, 0x70, 0x62, 0x2e, 0x45, 0x55, 0x73, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x52, 0x09, 0x75, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x22, 0x5a, 0x0a, 0x08, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x35, 0x0a, 0x0d, 0x62,
+	0x61, 0x73, 0x69, 0x63, 0x5f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x52, 0x0c, 0x62, 0x61, 0x73, 0x69, 0x63, 0x50, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x22, 0x24, 0x0a, 0x09, 0x52, 0x65, 0x71, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x4f, 0x0a, 0x0f, 0x52, 0x65, 0x71, 0x55,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x6f,
+	0x6e, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x22, 0xbf, 0x02, 0x0a, 0x05, 0x45, 0x49,
+	0x74, 0x65, 0x6d, 0x22, 0x6a, 0x0a, 0x08, 0x49, 0x74, 0x65, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x11, 0x0a, 0x0d, 0x49, 0x74, 0x65, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x5f, 0x4e, 0x6f, 0x6e, 0x65,
+	0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x49, 0x74, 0x65, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x5f, 0x47,
+	0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x49, 0x74, 0x65, 0x6d,
+	0x54, 0x79, 0x70, 0x65, 0x5f, 0x42, 0x6f, 0x78, 0x10, 0x0a, 0x12, 0x11, 0x0a, 0x0d, 0x49, 0x74,
+	0x65, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x5f, 0x43, 0x6f, 0x69, 0x6e, 0x10, 0x5b, 0x12, 0x10, 0x0a,
+	0x0c, 0x49, 0x74, 0x65, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x5f, 0x47, 0x65, 0x6d, 0x10, 0x5c, 0x22,
+	0x40, 0x0a, 0x06, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x0f, 0x0a, 0x0b, 0x49, 0x74, 0x65,
+	0x6d, 0x49, 0x64, 0x5f, 0x4e, 0x6f, 0x6e, 0x65, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0b, 0x49, 0x74,
+	0x65, 0x6d, 0x49, 0x64, 0x5f, 0x43, 0x6f, 0x69, 0x6e, 0x10, 0xc1, 0x99, 0xb2, 0x2b, 0x12, 0x11,
+	0x0a, 0x0a, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x5f, 0x47, 0x65, 0x6d, 0x10, 0x81, 0x9e, 0xef,
+	0x2b, 0x22, 0x4d, 0x0a, 0x08, 0x44, 0x72, 0x6f, 0x70, 0x54, 0x79, 0x70, 0x65, 0x12, 0x11, 0x0a,
+	0x0d, 0x44, 0x72, 0x6f, 0x70, 0x54, 0x79, 0x70, 0x65, 0x5f, 0x4e, 0x6f, 0x6e, 0x65, 0x10, 0x00,
+	0x12, 0x0e, 0x0a, 0x0a, 0x44, 0x72, 0x6f, 0x70, 0x54, 0x79, 0x70, 0x65, 0x5f, 0x31, 0x10, 0x01,
+	0x12, 0x0e, 0x0a, 0x0a, 0x44, 0x72, 0x6f, 0x70, 0x54, 0x79, 0x70, 0x65, 0x5f, 0x32, 0x10, 0x02,
+	0x12, 0x0e, 0x0a, 0x0a, 0x44, 0x72, 0x6f, 0x70, 0x54, 0x79, 0x70, 0x65, 0x5f, 0x33, 0x10, 0x03,
+	0x22, 0x39, 0x0a, 0x0b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12,
+	0x14, 0x0a, 0x10, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x5f, 0x4e,
+	0x6f, 0x6e, 0x65, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x52, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x5f, 0x4d, 0x61, 0x69, 0x6c, 0x10, 0x01, 0x22, 0x74, 0x0a, 0x04, 0x49,
+	0x74, 0x65, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x6e, 0x75, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x03, 0x6e, 0x75, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x61, 0x74,
+	0x63, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x62, 0x61, 0x74,
+	0x63, 0x68, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x5f, 0x61,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x41,
+	0x74, 0x22, 0x8d, 0x03, 0x0a, 0x05, 0x45, 0x4d, 0x61, 0x69, 0x6c, 0x22, 0x33, 0x0a, 0x08, 0x4d,
+	0x61, 0x69, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x12, 0x11, 0x0a, 0x0d, 0x4d, 0x61, 0x69, 0x6c, 0x54,
+	0x79, 0x70, 0x65, 0x5f, 0x4e, 0x6f, 0x6e, 0x65, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x4d, 0x61,
+	0x69, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x5f, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x10, 0x01,
+	0x22, 0x4a, 0x0a, 0x09, 0x4d, 0x61, 0x69, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a,
+	0x0e, 0x4d, 0x61, 0x69, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x49, 0x6e, 0x69, 0x74, 0x10,
+	0x00, 0x12, 0x12, 0x0a, 0x0e, 0x4d, 0x61, 0x69, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x52,
+	0x65, 0x61, 0x64, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x4d, 0x61, 0x69, 0x6c, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x5f, 0x41, 0x77, 0x61, 0x72, 0x64, 0x65, 0x64, 0x10, 0x02, 0x22, 0x38, 0x0a, 0x0e,
+	0x4d, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x61, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14,
+	0x0a, 0x10, 0x4d, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x61, 0x64, 0x5f, 0x4d, 0x61, 0x69, 0x6c, 0x49,
+	0x64, 0x73, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x4d, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x61, 0x64,
+	0x5f, 0x41, 0x6c, 0x6c, 0x10, 0x01, 0x22, 0x4b, 0x0a, 0x0d, 0x4d, 0x61, 0x69, 0x6c, 0x44, 0x65,
+	0x6c, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x13, 0x0a, 0x0f, 0x4d, 0x61, 0x69, 0x6c, 0x44,
+	0x65, 0x6c, 0x5f, 0x4d, 0x61, 0x69, 0x6c, 0x49, 0x64, 0x73, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b,
+	0x4d, 0x61, 0x69, 0x6c, 0x44, 0x65, 0x6c, 0x5f, 0x41, 0x6c, 0x6c, 0x10, 0x01, 0x12, 0x14, 0x0a,
+	0x10, 0x4d, 0x61, 0x69, 0x6c, 0x44, 0x65, 0x6c, 0x5f, 0x42, 0x65, 0x65, 0x6e, 0x52, 0x65, 0x61,
+	0x64, 0x10, 0x02, 0x22, 0x43, 0x0a, 0x12, 0x4d, 0x61, 0x69, 0x6c, 0x47, 0x65, 0x74, 0x41, 0x77,
+	0x61, 0x72, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x17, 0x0a, 0x13, 0x4d, 0x61, 0x69,
+	0x6c, 0x47, 0x65, 0x74, 0x41, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x4d, 0x61, 0x69, 0x6c, 0x49, 0x64,
+	0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x4d, 0x61, 0x69, 0x6c, 0x47, 0x65, 0x74, 0x41, 0x77, 0x61,
+	0x72, 0x64, 0x5f, 0x41, 0x6c, 0x6c, 0x10, 0x01, 0x22, 0x37, 0x0a, 0x09, 0x45, 0x4d, 0x61, 0x69,
+	0x6c, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x0e, 0x45, 0x4d, 0x61, 0x69, 0x6c, 0x54, 0x79,
+	0x70, 0x65, 0x5f, 0x4e, 0x6f, 0x6e, 0x65, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x45, 0x4d, 0x61,
+	0x69, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x5f, 0x42, 0x69, 0x6e, 0x64, 0x43, 0x6f, 0x64, 0x65, 0x10,
+	0x01, 0x22, 0xed, 0x02, 0x0a, 0x04, 0x4d, 0x61, 0x69, 0x6c, 0x12, 0x17, 0x0a, 0x07, 0x6d, 0x61,
+	0x69, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6d, 0x61, 0x69,
+	0x6c, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x30, 0x0a, 0x09, 0x6d, 0x61, 0x69, 0x6c, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x45, 0x4d,
+	0x61, 0x69, 0x6c, 0x2e, 0x4d, 0x61, 0x69, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x6d, 0x61,
+	0x69, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x69, 0x6c, 0x5f, 0x74,
+	0x69, 0x74, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x61, 0x69, 0x6c,
+	0x54, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x34, 0x0a, 0x09, 0x6d, 0x61, 0x70, 0x5f, 0x64, 0x61, 0x74,
+	0x61, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x4d,
+	0x61, 0x69, 0x6c, 0x2e, 0x4d, 0x61, 0x70, 0x44, 0x61, 0x74, 0x61, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x08, 0x6d, 0x61, 0x70, 0x44, 0x61, 0x74, 0x61, 0x73, 0x12, 0x21, 0x0a, 0x06, 0x61,
+	0x77, 0x61, 0x72, 0x64, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x6d, 0x70,
+	0x62, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x06, 0x61, 0x77, 0x61, 0x72, 0x64, 0x73, 0x12, 0x14,
+	0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x1a, 0x3b, 0x0a, 0x0d, 0x4d, 0x61, 0x70, 0x44, 0x61, 0x74, 0x61, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x22, 0x7c, 0x0a, 0x07, 0x45, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x22, 0x31, 0x0a, 0x0b,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x0c, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x5f, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x10, 0x00, 0x12, 0x10, 0x0a,
+	0x0c, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x5f, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x10, 0x01, 0x22,
+	0x3e, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x6f, 0x72, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x14, 0x0a, 0x10, 0x53, 0x6f, 0x72, 0x74, 0x54, 0x79, 0x70, 0x65, 0x5f, 0x55, 0x73, 0x65, 0x72,
+	0x5f, 0x49, 0x64, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x53, 0x6f, 0x72, 0x74, 0x54, 0x79, 0x70,
+	0x65, 0x5f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x54, 0x69, 0x6d, 0x65, 0x10, 0x01, 0x22,
+	0x37, 0x0a, 0x04, 0x45, 0x4e, 0x46, 0x54, 0x22, 0x2f, 0x0a, 0x07, 0x4e, 0x46, 0x54, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x10, 0x0a, 0x0c, 0x4e, 0x46, 0x54, 0x54, 0x79, 0x70, 0x65, 0x5f, 0x4e, 0x6f,
+	0x6e, 0x65, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x4e, 0x46, 0x54, 0x54, 0x79, 0x70, 0x65, 0x5f,
+	0x57, 0x65, 0x61, 0x70, 0x6f, 0x6e, 0x10, 0x01, 0x22, 0x5c, 0x0a, 0x0c, 0x41, 0x70, 0x74, 0x6f,
+	0x73, 0x4e, 0x46, 0x54, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x66, 0x74, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x6e, 0x66, 0x74, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x6e, 0x66, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x05, 0x6e, 0x66, 0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x46, 0x0a, 0x10, 0x41, 0x70, 0x74, 0x6f, 0x73, 0x4e,
+	0x46, 0x54, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x15, 0x0a, 0x06, 0x6e, 0x66,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6e, 0x66, 0x74, 0x49,
+	0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x65, 0x74, 0x61, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x22, 0xab,
+	0x04, 0x0a, 0x0e, 0x41, 0x70, 0x74, 0x6f, 0x73, 0x4e, 0x46, 0x54, 0x4e, 0x6f, 0x64, 0x65, 0x56,
+	0x32, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0d, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f,
+	0x64, 0x61, 0x74, 0x61, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x12, 0x49, 0x0a, 0x10, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f,
+	0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1e, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x41, 0x70, 0x74, 0x6f, 0x73, 0x4e, 0x46, 0x54, 0x4e,
+	0x6f, 0x64, 0x65, 0x56, 0x32, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73,
+	0x52, 0x0f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65,
+	0x73, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x6e, 0x64,
+	0x61, 0x72, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x53, 0x74, 0x61, 0x6e, 0x64, 0x61, 0x72, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x5f, 0x75, 0x72, 0x69, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x55, 0x72, 0x69, 0x12, 0x33, 0x0a, 0x15, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1d, 0x0a, 0x0a, 0x6f, 0x77,
+	0x6e, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x6f, 0x77, 0x6e, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x1a, 0x90, 0x01, 0x0a, 0x0a, 0x50, 0x72,
+	0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x6f, 0x70,
+	0x31, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x72, 0x6f, 0x70, 0x31, 0x12, 0x14,
+	0x0a, 0x05, 0x70, 0x72, 0x6f, 0x70, 0x32, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70,
+	0x72, 0x6f, 0x70, 0x32, 0x12, 0x18, 0x0a, 0x07, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x1b,
+	0x0a, 0x09, 0x77, 0x65, 0x61, 0x70, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x77, 0x65, 0x61, 0x70, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x77,
+	0x65, 0x61, 0x70, 0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x77, 0x65, 0x61, 0x70, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x22, 0x59, 0x0a, 0x08,
+	0x45, 0x53, 0x4d, 0x53, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x4d, 0x0a, 0x07, 0x50, 0x75, 0x72, 0x70,
+	0x6f, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x0c, 0x50, 0x75, 0x72, 0x70, 0x6f, 0x73, 0x65, 0x5f, 0x4e,
+	0x6f, 0x6e, 0x65, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x50, 0x75, 0x72, 0x70, 0x6f, 0x73, 0x65,
+	0x5f, 0x42, 0x69, 0x6e, 0x64, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x10, 0x01, 0x12, 0x19, 0x0a, 0x15,
+	0x50, 0x75, 0x72, 0x70, 0x6f, 0x73, 0x65, 0x5f, 0x52, 0x65, 0x73, 0x65, 0x74, 0x50, 0x61, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0x10, 0x02, 0x42, 0x07, 0x5a, 0x05, 0x2e, 0x2f, 0x6d, 0x70, 0x62,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2184,59 +3401,80 @@ func file_common_proto_rawDescGZIP() []byte {
 	return file_common_proto_rawDescData
 }
 
-var file_common_proto_enumTypes = make([]protoimpl.EnumInfo, 14)
-var file_common_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
+var file_common_proto_enumTypes = make([]protoimpl.EnumInfo, 18)
+var file_common_proto_msgTypes = make([]protoimpl.MessageInfo, 33)
 var file_common_proto_goTypes = []interface{}{
 	(EUser_UserState)(0),              // 0: mpb.EUser.UserState
-	(EItem_ItemType)(0),               // 1: mpb.EItem.ItemType
-	(EItem_ItemId)(0),                 // 2: mpb.EItem.ItemId
-	(EItem_DropType)(0),               // 3: mpb.EItem.DropType
-	(EItem_TransReason)(0),            // 4: mpb.EItem.TransReason
-	(EMail_MailType)(0),               // 5: mpb.EMail.MailType
-	(EMail_MailState)(0),              // 6: mpb.EMail.MailState
-	(EMail_MailReadOption)(0),         // 7: mpb.EMail.MailReadOption
-	(EMail_MailDelOption)(0),          // 8: mpb.EMail.MailDelOption
-	(EMail_MailGetAwardOption)(0),     // 9: mpb.EMail.MailGetAwardOption
-	(EMail_EMailType)(0),              // 10: mpb.EMail.EMailType
-	(EFriend_ReplyOption)(0),          // 11: mpb.EFriend.ReplyOption
-	(EFriend_ListSortType)(0),         // 12: mpb.EFriend.ListSortType
-	(ENFT_NFTType)(0),                 // 13: mpb.ENFT.NFTType
-	(*EUser)(nil),                     // 14: mpb.EUser
-	(*Empty)(nil),                     // 15: mpb.Empty
-	(*Region)(nil),                    // 16: mpb.Region
-	(*Claims)(nil),                    // 17: mpb.Claims
-	(*AdminClaims)(nil),               // 18: mpb.AdminClaims
-	(*AccountInfo)(nil),               // 19: mpb.AccountInfo
-	(*UserProfile)(nil),               // 20: mpb.UserProfile
-	(*UserInfo)(nil),                  // 21: mpb.UserInfo
-	(*ReqUserId)(nil),                 // 22: mpb.ReqUserId
-	(*ReqUserIdRegion)(nil),           // 23: mpb.ReqUserIdRegion
-	(*EItem)(nil),                     // 24: mpb.EItem
-	(*Item)(nil),                      // 25: mpb.Item
-	(*EMail)(nil),                     // 26: mpb.EMail
-	(*Mail)(nil),                      // 27: mpb.Mail
-	(*EFriend)(nil),                   // 28: mpb.EFriend
-	(*ENFT)(nil),                      // 29: mpb.ENFT
-	(*AptosNFTNode)(nil),              // 30: mpb.AptosNFTNode
-	(*AptosNFTMetadata)(nil),          // 31: mpb.AptosNFTMetadata
-	(*AptosNFTNodeV2)(nil),            // 32: mpb.AptosNFTNodeV2
-	nil,                               // 33: mpb.Mail.MapDatasEntry
-	(*AptosNFTNodeV2_Properties)(nil), // 34: mpb.AptosNFTNodeV2.Properties
+	(ELoginMethod_Method)(0),          // 1: mpb.ELoginMethod.Method
+	(EBan_Type)(0),                    // 2: mpb.EBan.Type
+	(EReferralReject_Reason)(0),       // 3: mpb.EReferralReject.Reason
+	(EItem_ItemType)(0),               // 4: mpb.EItem.ItemType
+	(EItem_ItemId)(0),                 // 5: mpb.EItem.ItemId
+	(EItem_DropType)(0),               // 6: mpb.EItem.DropType
+	(EItem_TransReason)(0),            // 7: mpb.EItem.TransReason
+	(EMail_MailType)(0),               // 8: mpb.EMail.MailType
+	(EMail_MailState)(0),              // 9: mpb.EMail.MailState
+	(EMail_MailReadOption)(0),         // 10: mpb.EMail.MailReadOption
+	(EMail_MailDelOption)(0),          // 11: mpb.EMail.MailDelOption
+	(EMail_MailGetAwardOption)(0),     // 12: mpb.EMail.MailGetAwardOption
+	(EMail_EMailType)(0),              // 13: mpb.EMail.EMailType
+	(EFriend_ReplyOption)(0),          // 14: mpb.EFriend.ReplyOption
+	(EFriend_ListSortType)(0),         // 15: mpb.EFriend.ListSortType
+	(ENFT_NFTType)(0),                 // 16: mpb.ENFT.NFTType
+	(ESMSCode_Purpose)(0),             // 17: mpb.ESMSCode.Purpose
+	(*EUser)(nil),                     // 18: mpb.EUser
+	(*Empty)(nil),                     // 19: mpb.Empty
+	(*Region)(nil),                    // 20: mpb.Region
+	(*Claims)(nil),                    // 21: mpb.Claims
+	(*AdminClaims)(nil),               // 22: mpb.AdminClaims
+	(*AccountInfo)(nil),               // 23: mpb.AccountInfo
+	(*WalletInfo)(nil),                // 24: mpb.WalletInfo
+	(*ELoginMethod)(nil),              // 25: mpb.ELoginMethod
+	(*EBan)(nil),                      // 26: mpb.EBan
+	(*BanRecord)(nil),                 // 27: mpb.BanRecord
+	(*BanAuditLog)(nil),               // 28: mpb.BanAuditLog
+	(*AccountMergeLog)(nil),           // 29: mpb.AccountMergeLog
+	(*EReferralReject)(nil),           // 30: mpb.EReferralReject
+	(*Referral)(nil),                  // 31: mpb.Referral
+	(*ReferrerRank)(nil),              // 32: mpb.ReferrerRank
+	(*LoginRecord)(nil),               // 33: mpb.LoginRecord
+	(*SessionInfo)(nil),               // 34: mpb.SessionInfo
+	(*UserProfile)(nil),               // 35: mpb.UserProfile
+	(*UserInfo)(nil),                  // 36: mpb.UserInfo
+	(*ReqUserId)(nil),                 // 37: mpb.ReqUserId
+	(*ReqUserIdRegion)(nil),           // 38: mpb.ReqUserIdRegion
+	(*EItem)(nil),                     // 39: mpb.EItem
+	(*Item)(nil),                      // 40: mpb.Item
+	(*EMail)(nil),                     // 41: mpb.EMail
+	(*Mail)(nil),                      // 42: mpb.Mail
+	(*EFriend)(nil),                   // 43: mpb.EFriend
+	(*ENFT)(nil),                      // 44: mpb.ENFT
+	(*AptosNFTNode)(nil),              // 45: mpb.AptosNFTNode
+	(*AptosNFTMetadata)(nil),          // 46: mpb.AptosNFTMetadata
+	(*AptosNFTNodeV2)(nil),            // 47: mpb.AptosNFTNodeV2
+	(*ESMSCode)(nil),                  // 48: mpb.ESMSCode
+	nil,                               // 49: mpb.Mail.MapDatasEntry
+	(*AptosNFTNodeV2_Properties)(nil), // 50: mpb.AptosNFTNodeV2.Properties
 }
 var file_common_proto_depIdxs = []int32{
-	16, // 0: mpb.Claims.region:type_name -> mpb.Region
-	0,  // 1: mpb.UserProfile.user_state:type_name -> mpb.EUser.UserState
-	20, // 2: mpb.UserInfo.basic_profile:type_name -> mpb.UserProfile
-	16, // 3: mpb.ReqUserIdRegion.region:type_name -> mpb.Region
-	5,  // 4: mpb.Mail.mail_type:type_name -> mpb.EMail.MailType
-	33, // 5: mpb.Mail.map_datas:type_name -> mpb.Mail.MapDatasEntry
-	25, // 6: mpb.Mail.awards:type_name -> mpb.Item
-	34, // 7: mpb.AptosNFTNodeV2.token_properties:type_name -> mpb.AptosNFTNodeV2.Properties
-	8,  // [8:8] is the sub-list for method output_type
-	8,  // [8:8] is the sub-list for method input_type
-	8,  // [8:8] is the sub-list for extension type_name
-	8,  // [8:8] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
+	20, // 0: mpb.Claims.region:type_name -> mpb.Region
+	27, // 1: mpb.AccountInfo.mute:type_name -> mpb.BanRecord
+	2,  // 2: mpb.BanRecord.type:type_name -> mpb.EBan.Type
+	2,  // 3: mpb.BanAuditLog.type:type_name -> mpb.EBan.Type
+	23, // 4: mpb.ReferrerRank.account:type_name -> mpb.AccountInfo
+	1,  // 5: mpb.LoginRecord.method:type_name -> mpb.ELoginMethod.Method
+	0,  // 6: mpb.UserProfile.user_state:type_name -> mpb.EUser.UserState
+	35, // 7: mpb.UserInfo.basic_profile:type_name -> mpb.UserProfile
+	20, // 8: mpb.ReqUserIdRegion.region:type_name -> mpb.Region
+	8,  // 9: mpb.Mail.mail_type:type_name -> mpb.EMail.MailType
+	49, // 10: mpb.Mail.map_datas:type_name -> mpb.Mail.MapDatasEntry
+	40, // 11: mpb.Mail.awards:type_name -> mpb.Item
+	50, // 12: mpb.AptosNFTNodeV2.token_properties:type_name -> mpb.AptosNFTNodeV2.Properties
+	13, // [13:13] is the sub-list for method output_type
+	13, // [13:13] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
 }
 
 func init() { file_common_proto_init() }
@@ -2318,7 +3556,7 @@ func file_common_proto_init() {
 			}
 		}
 		file_common_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserProfile); i {
+			switch v := v.(*WalletInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2330,7 +3568,7 @@ func file_common_proto_init() {
 			}
 		}
 		file_common_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserInfo); i {
+			switch v := v.(*ELoginMethod); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2342,7 +3580,7 @@ func file_common_proto_init() {
 			}
 		}
 		file_common_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReqUserId); i {
+			switch v := v.(*EBan); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2354,7 +3592,7 @@ func file_common_proto_init() {
 			}
 		}
 		file_common_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReqUserIdRegion); i {
+			switch v := v.(*BanRecord); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2366,7 +3604,7 @@ func file_common_proto_init() {
 			}
 		}
 		file_common_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EItem); i {
+			switch v := v.(*BanAuditLog); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2378,7 +3616,7 @@ func file_common_proto_init() {
 			}
 		}
 		file_common_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Item); i {
+			switch v := v.(*AccountMergeLog); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2390,7 +3628,7 @@ func file_common_proto_init() {
 			}
 		}
 		file_common_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EMail); i {
+			switch v := v.(*EReferralReject); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2402,7 +3640,7 @@ func file_common_proto_init() {
 			}
 		}
 		file_common_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Mail); i {
+			switch v := v.(*Referral); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2414,7 +3652,7 @@ func file_common_proto_init() {
 			}
 		}
 		file_common_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EFriend); i {
+			switch v := v.(*ReferrerRank); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2426,7 +3664,7 @@ func file_common_proto_init() {
 			}
 		}
 		file_common_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ENFT); i {
+			switch v := v.(*LoginRecord); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2438,7 +3676,7 @@ func file_common_proto_init() {
 			}
 		}
 		file_common_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AptosNFTNode); i {
+			switch v := v.(*SessionInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2450,7 +3688,7 @@ func file_common_proto_init() {
 			}
 		}
 		file_common_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AptosNFTMetadata); i {
+			switch v := v.(*UserProfile); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2462,7 +3700,19 @@ func file_common_proto_init() {
 			}
 		}
 		file_common_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AptosNFTNodeV2); i {
+			switch v := v.(*UserInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_common_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReqUserId); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2474,6 +3724,138 @@ func file_common_proto_init() {
 			}
 		}
 		file_common_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReqUserIdRegion); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_common_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_common_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Item); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_common_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EMail); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_common_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Mail); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_common_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EFriend); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_common_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ENFT); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_common_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AptosNFTNode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_common_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AptosNFTMetadata); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_common_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AptosNFTNodeV2); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_common_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ESMSCode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_common_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AptosNFTNodeV2_Properties); i {
 			case 0:
 				return &v.state
@@ -2491,8 +3873,8 @@ func file_common_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_common_proto_rawDesc,
-			NumEnums:      14,
-			NumMessages:   21,
+			NumEnums:      18,
+			NumMessages:   33,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
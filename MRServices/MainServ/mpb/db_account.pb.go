@@ -24,26 +24,38 @@ const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
 // Account
 type DBAccountInfo struct {
-	Account              string   `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
-	UserId               uint64   `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	DeviceId             string   `protobuf:"bytes,3,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
-	Device               string   `protobuf:"bytes,4,opt,name=device,proto3" json:"device,omitempty"`
-	Os                   string   `protobuf:"bytes,5,opt,name=os,proto3" json:"os,omitempty"`
-	Region               string   `protobuf:"bytes,6,opt,name=region,proto3" json:"region,omitempty"`
-	Password             string   `protobuf:"bytes,7,opt,name=password,proto3" json:"password,omitempty"`
-	IsVerified           uint32   `protobuf:"varint,8,opt,name=is_verified,json=isVerified,proto3" json:"is_verified,omitempty"`
-	Guest                bool     `protobuf:"varint,9,opt,name=guest,proto3" json:"guest,omitempty"`
-	RegisterTime         int64    `protobuf:"varint,10,opt,name=register_time,json=registerTime,proto3" json:"register_time,omitempty"`
-	Tel                  string   `protobuf:"bytes,11,opt,name=tel,proto3" json:"tel,omitempty"`
-	Email                string   `protobuf:"bytes,12,opt,name=email,proto3" json:"email,omitempty"`
-	Platform             string   `protobuf:"bytes,13,opt,name=platform,proto3" json:"platform,omitempty"`
-	AptosAccAddr         string   `protobuf:"bytes,14,opt,name=aptos_acc_addr,json=aptosAccAddr,proto3" json:"aptos_acc_addr,omitempty"`
-	PublicKey            []byte   `protobuf:"bytes,15,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
-	Nickname             string   `protobuf:"bytes,16,opt,name=nickname,proto3" json:"nickname,omitempty"`
-	Icon                 string   `protobuf:"bytes,17,opt,name=icon,proto3" json:"icon,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Account              string            `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	UserId               uint64            `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	DeviceId             string            `protobuf:"bytes,3,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	Device               string            `protobuf:"bytes,4,opt,name=device,proto3" json:"device,omitempty"`
+	Os                   string            `protobuf:"bytes,5,opt,name=os,proto3" json:"os,omitempty"`
+	Region               string            `protobuf:"bytes,6,opt,name=region,proto3" json:"region,omitempty"`
+	Password             string            `protobuf:"bytes,7,opt,name=password,proto3" json:"password,omitempty"`
+	IsVerified           uint32            `protobuf:"varint,8,opt,name=is_verified,json=isVerified,proto3" json:"is_verified,omitempty"`
+	Guest                bool              `protobuf:"varint,9,opt,name=guest,proto3" json:"guest,omitempty"`
+	RegisterTime         int64             `protobuf:"varint,10,opt,name=register_time,json=registerTime,proto3" json:"register_time,omitempty"`
+	Tel                  string            `protobuf:"bytes,11,opt,name=tel,proto3" json:"tel,omitempty"`
+	Email                string            `protobuf:"bytes,12,opt,name=email,proto3" json:"email,omitempty"`
+	Platform             string            `protobuf:"bytes,13,opt,name=platform,proto3" json:"platform,omitempty"`
+	AptosAccAddr         string            `protobuf:"bytes,14,opt,name=aptos_acc_addr,json=aptosAccAddr,proto3" json:"aptos_acc_addr,omitempty"`
+	PublicKey            []byte            `protobuf:"bytes,15,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	Nickname             string            `protobuf:"bytes,16,opt,name=nickname,proto3" json:"nickname,omitempty"`
+	Icon                 string            `protobuf:"bytes,17,opt,name=icon,proto3" json:"icon,omitempty"`
+	TotpSecret           string            `protobuf:"bytes,18,opt,name=totp_secret,json=totpSecret,proto3" json:"totp_secret,omitempty"`
+	TotpEnabled          bool              `protobuf:"varint,19,opt,name=totp_enabled,json=totpEnabled,proto3" json:"totp_enabled,omitempty"`
+	TotpRecoveryCodes    []string          `protobuf:"bytes,20,rep,name=totp_recovery_codes,json=totpRecoveryCodes,proto3" json:"totp_recovery_codes,omitempty"`
+	MergedInto           uint64            `protobuf:"varint,21,opt,name=merged_into,json=mergedInto,proto3" json:"merged_into,omitempty"`
+	DeletionTime         int64             `protobuf:"varint,22,opt,name=deletion_time,json=deletionTime,proto3" json:"deletion_time,omitempty"`
+	NicknameUpdateTime   int64             `protobuf:"varint,23,opt,name=nickname_update_time,json=nicknameUpdateTime,proto3" json:"nickname_update_time,omitempty"`
+	IconUpdateTime       int64             `protobuf:"varint,24,opt,name=icon_update_time,json=iconUpdateTime,proto3" json:"icon_update_time,omitempty"`
+	Ban                  *DBBanRecord      `protobuf:"bytes,25,opt,name=ban,proto3" json:"ban,omitempty"`
+	Mute                 *DBBanRecord      `protobuf:"bytes,26,opt,name=mute,proto3" json:"mute,omitempty"`
+	InviteCode           string            `protobuf:"bytes,27,opt,name=invite_code,json=inviteCode,proto3" json:"invite_code,omitempty"`
+	ReferrerId           uint64            `protobuf:"varint,28,opt,name=referrer_id,json=referrerId,proto3" json:"referrer_id,omitempty"`
+	PlatformLinks        []*DBPlatformLink `protobuf:"bytes,29,rep,name=platform_links,json=platformLinks,proto3" json:"platform_links,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
 func (m *DBAccountInfo) Reset()         { *m = DBAccountInfo{} }
@@ -198,26 +210,112 @@ func (m *DBAccountInfo) GetIcon() string {
 	return ""
 }
 
-type DBWalletAcc struct {
-	Account              string   `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
-	UserId               uint64   `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+func (m *DBAccountInfo) GetTotpSecret() string {
+	if m != nil {
+		return m.TotpSecret
+	}
+	return ""
+}
+
+func (m *DBAccountInfo) GetTotpEnabled() bool {
+	if m != nil {
+		return m.TotpEnabled
+	}
+	return false
+}
+
+func (m *DBAccountInfo) GetTotpRecoveryCodes() []string {
+	if m != nil {
+		return m.TotpRecoveryCodes
+	}
+	return nil
+}
+
+func (m *DBAccountInfo) GetMergedInto() uint64 {
+	if m != nil {
+		return m.MergedInto
+	}
+	return 0
+}
+
+func (m *DBAccountInfo) GetDeletionTime() int64 {
+	if m != nil {
+		return m.DeletionTime
+	}
+	return 0
+}
+
+func (m *DBAccountInfo) GetNicknameUpdateTime() int64 {
+	if m != nil {
+		return m.NicknameUpdateTime
+	}
+	return 0
+}
+
+func (m *DBAccountInfo) GetIconUpdateTime() int64 {
+	if m != nil {
+		return m.IconUpdateTime
+	}
+	return 0
+}
+
+func (m *DBAccountInfo) GetBan() *DBBanRecord {
+	if m != nil {
+		return m.Ban
+	}
+	return nil
+}
+
+func (m *DBAccountInfo) GetMute() *DBBanRecord {
+	if m != nil {
+		return m.Mute
+	}
+	return nil
+}
+
+func (m *DBAccountInfo) GetInviteCode() string {
+	if m != nil {
+		return m.InviteCode
+	}
+	return ""
+}
+
+func (m *DBAccountInfo) GetReferrerId() uint64 {
+	if m != nil {
+		return m.ReferrerId
+	}
+	return 0
+}
+
+func (m *DBAccountInfo) GetPlatformLinks() []*DBPlatformLink {
+	if m != nil {
+		return m.PlatformLinks
+	}
+	return nil
+}
+
+type DBPlatformLink struct {
+	Provider             string   `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Subject              string   `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
+	Email                string   `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	LinkTime             int64    `protobuf:"varint,4,opt,name=link_time,json=linkTime,proto3" json:"link_time,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DBWalletAcc) Reset()         { *m = DBWalletAcc{} }
-func (m *DBWalletAcc) String() string { return proto.CompactTextString(m) }
-func (*DBWalletAcc) ProtoMessage()    {}
-func (*DBWalletAcc) Descriptor() ([]byte, []int) {
+func (m *DBPlatformLink) Reset()         { *m = DBPlatformLink{} }
+func (m *DBPlatformLink) String() string { return proto.CompactTextString(m) }
+func (*DBPlatformLink) ProtoMessage()    {}
+func (*DBPlatformLink) Descriptor() ([]byte, []int) {
 	return fileDescriptor_893ddd182b186dba, []int{1}
 }
-func (m *DBWalletAcc) XXX_Unmarshal(b []byte) error {
+func (m *DBPlatformLink) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DBWalletAcc) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DBPlatformLink) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DBWalletAcc.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DBPlatformLink.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -227,53 +325,65 @@ func (m *DBWalletAcc) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *DBWalletAcc) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DBWalletAcc.Merge(m, src)
+func (m *DBPlatformLink) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DBPlatformLink.Merge(m, src)
 }
-func (m *DBWalletAcc) XXX_Size() int {
+func (m *DBPlatformLink) XXX_Size() int {
 	return m.Size()
 }
-func (m *DBWalletAcc) XXX_DiscardUnknown() {
-	xxx_messageInfo_DBWalletAcc.DiscardUnknown(m)
+func (m *DBPlatformLink) XXX_DiscardUnknown() {
+	xxx_messageInfo_DBPlatformLink.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DBWalletAcc proto.InternalMessageInfo
+var xxx_messageInfo_DBPlatformLink proto.InternalMessageInfo
 
-func (m *DBWalletAcc) GetAccount() string {
+func (m *DBPlatformLink) GetProvider() string {
 	if m != nil {
-		return m.Account
+		return m.Provider
 	}
 	return ""
 }
 
-func (m *DBWalletAcc) GetUserId() uint64 {
+func (m *DBPlatformLink) GetSubject() string {
 	if m != nil {
-		return m.UserId
+		return m.Subject
+	}
+	return ""
+}
+
+func (m *DBPlatformLink) GetEmail() string {
+	if m != nil {
+		return m.Email
+	}
+	return ""
+}
+
+func (m *DBPlatformLink) GetLinkTime() int64 {
+	if m != nil {
+		return m.LinkTime
 	}
 	return 0
 }
 
-type DBTokenInfo struct {
-	Account              string   `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
-	Device               string   `protobuf:"bytes,2,opt,name=device,proto3" json:"device,omitempty"`
-	DeviceId             string   `protobuf:"bytes,3,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+type DBPlatformAcc struct {
+	UserId               uint64   `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DBTokenInfo) Reset()         { *m = DBTokenInfo{} }
-func (m *DBTokenInfo) String() string { return proto.CompactTextString(m) }
-func (*DBTokenInfo) ProtoMessage()    {}
-func (*DBTokenInfo) Descriptor() ([]byte, []int) {
+func (m *DBPlatformAcc) Reset()         { *m = DBPlatformAcc{} }
+func (m *DBPlatformAcc) String() string { return proto.CompactTextString(m) }
+func (*DBPlatformAcc) ProtoMessage()    {}
+func (*DBPlatformAcc) Descriptor() ([]byte, []int) {
 	return fileDescriptor_893ddd182b186dba, []int{2}
 }
-func (m *DBTokenInfo) XXX_Unmarshal(b []byte) error {
+func (m *DBPlatformAcc) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DBTokenInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DBPlatformAcc) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DBTokenInfo.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DBPlatformAcc.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -283,479 +393,5600 @@ func (m *DBTokenInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *DBTokenInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DBTokenInfo.Merge(m, src)
+func (m *DBPlatformAcc) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DBPlatformAcc.Merge(m, src)
 }
-func (m *DBTokenInfo) XXX_Size() int {
+func (m *DBPlatformAcc) XXX_Size() int {
 	return m.Size()
 }
-func (m *DBTokenInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_DBTokenInfo.DiscardUnknown(m)
+func (m *DBPlatformAcc) XXX_DiscardUnknown() {
+	xxx_messageInfo_DBPlatformAcc.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DBTokenInfo proto.InternalMessageInfo
+var xxx_messageInfo_DBPlatformAcc proto.InternalMessageInfo
 
-func (m *DBTokenInfo) GetAccount() string {
+func (m *DBPlatformAcc) GetUserId() uint64 {
 	if m != nil {
-		return m.Account
+		return m.UserId
 	}
-	return ""
+	return 0
 }
 
-func (m *DBTokenInfo) GetDevice() string {
+type DBBanRecord struct {
+	Type                 uint32   `protobuf:"varint,1,opt,name=type,proto3" json:"type,omitempty"`
+	Reason               string   `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	Operator             string   `protobuf:"bytes,3,opt,name=operator,proto3" json:"operator,omitempty"`
+	BanTime              int64    `protobuf:"varint,4,opt,name=ban_time,json=banTime,proto3" json:"ban_time,omitempty"`
+	ExpireTime           int64    `protobuf:"varint,5,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DBBanRecord) Reset()         { *m = DBBanRecord{} }
+func (m *DBBanRecord) String() string { return proto.CompactTextString(m) }
+func (*DBBanRecord) ProtoMessage()    {}
+func (*DBBanRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_893ddd182b186dba, []int{3}
+}
+func (m *DBBanRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DBBanRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DBBanRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DBBanRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DBBanRecord.Merge(m, src)
+}
+func (m *DBBanRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *DBBanRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_DBBanRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DBBanRecord proto.InternalMessageInfo
+
+func (m *DBBanRecord) GetType() uint32 {
 	if m != nil {
-		return m.Device
+		return m.Type
+	}
+	return 0
+}
+
+func (m *DBBanRecord) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
 	return ""
 }
 
-func (m *DBTokenInfo) GetDeviceId() string {
+func (m *DBBanRecord) GetOperator() string {
 	if m != nil {
-		return m.DeviceId
+		return m.Operator
 	}
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*DBAccountInfo)(nil), "mpb.DBAccountInfo")
-	proto.RegisterType((*DBWalletAcc)(nil), "mpb.DBWalletAcc")
-	proto.RegisterType((*DBTokenInfo)(nil), "mpb.DBTokenInfo")
+func (m *DBBanRecord) GetBanTime() int64 {
+	if m != nil {
+		return m.BanTime
+	}
+	return 0
 }
 
-func init() { proto.RegisterFile("db_account.proto", fileDescriptor_893ddd182b186dba) }
+func (m *DBBanRecord) GetExpireTime() int64 {
+	if m != nil {
+		return m.ExpireTime
+	}
+	return 0
+}
 
-var fileDescriptor_893ddd182b186dba = []byte{
-	// 411 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0xcd, 0x8e, 0xd3, 0x30,
-	0x10, 0x80, 0x71, 0xd3, 0xdf, 0xe9, 0x0f, 0xc5, 0x42, 0xec, 0x08, 0x44, 0x89, 0x0a, 0x87, 0x9c,
-	0x96, 0x03, 0x2f, 0x40, 0xab, 0xbd, 0x54, 0xdc, 0xa2, 0x15, 0x48, 0x08, 0x29, 0x4a, 0xec, 0xe9,
-	0xca, 0x6a, 0x12, 0x47, 0xb1, 0xbb, 0x68, 0xdf, 0x84, 0x47, 0xe2, 0xd8, 0x47, 0x40, 0xe5, 0x45,
-	0x90, 0xed, 0x54, 0x88, 0x0b, 0x48, 0xdc, 0xe6, 0xfb, 0x66, 0x32, 0x63, 0x65, 0x06, 0x96, 0xb2,
-	0xc8, 0x72, 0x21, 0xf4, 0xb1, 0xb6, 0xd7, 0x4d, 0xab, 0xad, 0xe6, 0x51, 0xd5, 0x14, 0xeb, 0x53,
-	0x04, 0xf3, 0x9b, 0xed, 0x26, 0x24, 0x76, 0xf5, 0x5e, 0x73, 0x84, 0x51, 0x57, 0x87, 0x2c, 0x66,
-	0xc9, 0x24, 0xbd, 0x20, 0xbf, 0x82, 0xd1, 0xd1, 0x50, 0x9b, 0x29, 0x89, 0xbd, 0x98, 0x25, 0xfd,
-	0x74, 0xe8, 0x70, 0x27, 0xf9, 0x0b, 0x98, 0x48, 0xba, 0x57, 0x82, 0x5c, 0x2a, 0xf2, 0x1f, 0x8d,
-	0x83, 0xd8, 0x49, 0xfe, 0x0c, 0x86, 0x21, 0xc6, 0xbe, 0xcf, 0x74, 0xc4, 0x17, 0xd0, 0xd3, 0x06,
-	0x07, 0xde, 0xf5, 0xb4, 0x71, 0x75, 0x2d, 0xdd, 0x29, 0x5d, 0xe3, 0x30, 0xd4, 0x05, 0xe2, 0xcf,
-	0x61, 0xdc, 0xe4, 0xc6, 0x7c, 0xd5, 0xad, 0xc4, 0x51, 0xe8, 0x7d, 0x61, 0xfe, 0x0a, 0xa6, 0xca,
-	0x64, 0xf7, 0xd4, 0xaa, 0xbd, 0x22, 0x89, 0xe3, 0x98, 0x25, 0xf3, 0x14, 0x94, 0xf9, 0xd8, 0x19,
-	0xfe, 0x14, 0x06, 0x77, 0x47, 0x32, 0x16, 0x27, 0x31, 0x4b, 0xc6, 0x69, 0x00, 0xfe, 0x1a, 0xe6,
-	0xae, 0xb9, 0xb1, 0xd4, 0x66, 0x56, 0x55, 0x84, 0x10, 0xb3, 0x24, 0x4a, 0x67, 0x17, 0x79, 0xab,
-	0x2a, 0xe2, 0x4b, 0x88, 0x2c, 0x95, 0x38, 0xf5, 0x23, 0x5d, 0xe8, 0x9a, 0x51, 0x95, 0xab, 0x12,
-	0x67, 0xde, 0x05, 0xf0, 0xef, 0x2b, 0x73, 0xbb, 0xd7, 0x6d, 0x85, 0xf3, 0xee, 0x7d, 0x1d, 0xf3,
-	0x37, 0xb0, 0xc8, 0x1b, 0xab, 0x8d, 0xfb, 0xf3, 0x59, 0x2e, 0x65, 0x8b, 0x0b, 0x5f, 0x31, 0xf3,
-	0x76, 0x23, 0xc4, 0x46, 0xca, 0x96, 0xbf, 0x04, 0x68, 0x8e, 0x45, 0xa9, 0x44, 0x76, 0xa0, 0x07,
-	0x7c, 0x1c, 0xb3, 0x64, 0x96, 0x4e, 0x82, 0xf9, 0x40, 0x0f, 0x6e, 0x40, 0xad, 0xc4, 0xa1, 0xce,
-	0x2b, 0xc2, 0x65, 0x18, 0x70, 0x61, 0xce, 0xa1, 0xaf, 0x84, 0xae, 0xf1, 0x89, 0xf7, 0x3e, 0x5e,
-	0xbf, 0x87, 0xe9, 0xcd, 0xf6, 0x53, 0x5e, 0x96, 0x64, 0x37, 0x42, 0xfc, 0xc7, 0x3e, 0xd7, 0x5f,
-	0x5c, 0x87, 0x5b, 0x7d, 0xa0, 0xfa, 0x1f, 0x17, 0xf1, 0x7b, 0xb7, 0xbd, 0x3f, 0x76, 0xfb, 0xb7,
-	0x83, 0xd8, 0x5e, 0x7d, 0x3f, 0xaf, 0xd8, 0xe9, 0xbc, 0x62, 0x3f, 0xce, 0x2b, 0xf6, 0xed, 0xe7,
-	0xea, 0xd1, 0xe7, 0xc1, 0xf5, 0xdb, 0xaa, 0x29, 0x8a, 0xa1, 0xbf, 0xcb, 0x77, 0xbf, 0x02, 0x00,
-	0x00, 0xff, 0xff, 0x6c, 0x20, 0xf0, 0x6f, 0xab, 0x02, 0x00, 0x00,
+type DBBanAuditLog struct {
+	Type                 uint32   `protobuf:"varint,1,opt,name=type,proto3" json:"type,omitempty"`
+	Unban                bool     `protobuf:"varint,2,opt,name=unban,proto3" json:"unban,omitempty"`
+	Reason               string   `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	Operator             string   `protobuf:"bytes,4,opt,name=operator,proto3" json:"operator,omitempty"`
+	OpTime               int64    `protobuf:"varint,5,opt,name=op_time,json=opTime,proto3" json:"op_time,omitempty"`
+	ExpireTime           int64    `protobuf:"varint,6,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DBAccountInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *DBBanAuditLog) Reset()         { *m = DBBanAuditLog{} }
+func (m *DBBanAuditLog) String() string { return proto.CompactTextString(m) }
+func (*DBBanAuditLog) ProtoMessage()    {}
+func (*DBBanAuditLog) Descriptor() ([]byte, []int) {
+	return fileDescriptor_893ddd182b186dba, []int{4}
+}
+func (m *DBBanAuditLog) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DBBanAuditLog) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DBBanAuditLog.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *DBBanAuditLog) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DBBanAuditLog.Merge(m, src)
+}
+func (m *DBBanAuditLog) XXX_Size() int {
+	return m.Size()
+}
+func (m *DBBanAuditLog) XXX_DiscardUnknown() {
+	xxx_messageInfo_DBBanAuditLog.DiscardUnknown(m)
 }
 
-func (m *DBAccountInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_DBBanAuditLog proto.InternalMessageInfo
+
+func (m *DBBanAuditLog) GetType() uint32 {
+	if m != nil {
+		return m.Type
+	}
+	return 0
 }
 
-func (m *DBAccountInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *DBBanAuditLog) GetUnban() bool {
+	if m != nil {
+		return m.Unban
 	}
-	if len(m.Icon) > 0 {
-		i -= len(m.Icon)
-		copy(dAtA[i:], m.Icon)
-		i = encodeVarintDbAccount(dAtA, i, uint64(len(m.Icon)))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x8a
+	return false
+}
+
+func (m *DBBanAuditLog) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	if len(m.Nickname) > 0 {
-		i -= len(m.Nickname)
-		copy(dAtA[i:], m.Nickname)
-		i = encodeVarintDbAccount(dAtA, i, uint64(len(m.Nickname)))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x82
+	return ""
+}
+
+func (m *DBBanAuditLog) GetOperator() string {
+	if m != nil {
+		return m.Operator
 	}
-	if len(m.PublicKey) > 0 {
-		i -= len(m.PublicKey)
-		copy(dAtA[i:], m.PublicKey)
-		i = encodeVarintDbAccount(dAtA, i, uint64(len(m.PublicKey)))
-		i--
-		dAtA[i] = 0x7a
+	return ""
+}
+
+func (m *DBBanAuditLog) GetOpTime() int64 {
+	if m != nil {
+		return m.OpTime
 	}
-	if len(m.AptosAccAddr) > 0 {
-		i -= len(m.AptosAccAddr)
-		copy(dAtA[i:], m.AptosAccAddr)
-		i = encodeVarintDbAccount(dAtA, i, uint64(len(m.AptosAccAddr)))
-		i--
-		dAtA[i] = 0x72
+	return 0
+}
+
+func (m *DBBanAuditLog) GetExpireTime() int64 {
+	if m != nil {
+		return m.ExpireTime
 	}
-	if len(m.Platform) > 0 {
-		i -= len(m.Platform)
-		copy(dAtA[i:], m.Platform)
-		i = encodeVarintDbAccount(dAtA, i, uint64(len(m.Platform)))
-		i--
-		dAtA[i] = 0x6a
-	}
-	if len(m.Email) > 0 {
-		i -= len(m.Email)
-		copy(dAtA[i:], m.Email)
-		i = encodeVarintDbAccount(dAtA, i, uint64(len(m.Email)))
-		i--
-		dAtA[i] = 0x62
-	}
-	if len(m.Tel) > 0 {
-		i -= len(m.Tel)
-		copy(dAtA[i:], m.Tel)
-		i = encodeVarintDbAccount(dAtA, i, uint64(len(m.Tel)))
-		i--
-		dAtA[i] = 0x5a
-	}
-	if m.RegisterTime != 0 {
-		i = encodeVarintDbAccount(dAtA, i, uint64(m.RegisterTime))
-		i--
-		dAtA[i] = 0x50
-	}
-	if m.Guest {
-		i--
-		if m.Guest {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	return 0
+}
+
+type DBBanAuditLogs struct {
+	Logs                 []*DBBanAuditLog `protobuf:"bytes,1,rep,name=logs,proto3" json:"logs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *DBBanAuditLogs) Reset()         { *m = DBBanAuditLogs{} }
+func (m *DBBanAuditLogs) String() string { return proto.CompactTextString(m) }
+func (*DBBanAuditLogs) ProtoMessage()    {}
+func (*DBBanAuditLogs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_893ddd182b186dba, []int{5}
+}
+func (m *DBBanAuditLogs) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DBBanAuditLogs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DBBanAuditLogs.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x48
-	}
-	if m.IsVerified != 0 {
-		i = encodeVarintDbAccount(dAtA, i, uint64(m.IsVerified))
-		i--
-		dAtA[i] = 0x40
+		return b[:n], nil
 	}
-	if len(m.Password) > 0 {
-		i -= len(m.Password)
-		copy(dAtA[i:], m.Password)
-		i = encodeVarintDbAccount(dAtA, i, uint64(len(m.Password)))
-		i--
-		dAtA[i] = 0x3a
+}
+func (m *DBBanAuditLogs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DBBanAuditLogs.Merge(m, src)
+}
+func (m *DBBanAuditLogs) XXX_Size() int {
+	return m.Size()
+}
+func (m *DBBanAuditLogs) XXX_DiscardUnknown() {
+	xxx_messageInfo_DBBanAuditLogs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DBBanAuditLogs proto.InternalMessageInfo
+
+func (m *DBBanAuditLogs) GetLogs() []*DBBanAuditLog {
+	if m != nil {
+		return m.Logs
 	}
-	if len(m.Region) > 0 {
-		i -= len(m.Region)
-		copy(dAtA[i:], m.Region)
-		i = encodeVarintDbAccount(dAtA, i, uint64(len(m.Region)))
-		i--
-		dAtA[i] = 0x32
+	return nil
+}
+
+type DBLinkedWallet struct {
+	WalletAddr           string   `protobuf:"bytes,1,opt,name=wallet_addr,json=walletAddr,proto3" json:"wallet_addr,omitempty"`
+	PublicKey            []byte   `protobuf:"bytes,2,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	LinkTime             int64    `protobuf:"varint,3,opt,name=link_time,json=linkTime,proto3" json:"link_time,omitempty"`
+	Legacy               bool     `protobuf:"varint,4,opt,name=legacy,proto3" json:"legacy,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DBLinkedWallet) Reset()         { *m = DBLinkedWallet{} }
+func (m *DBLinkedWallet) String() string { return proto.CompactTextString(m) }
+func (*DBLinkedWallet) ProtoMessage()    {}
+func (*DBLinkedWallet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_893ddd182b186dba, []int{6}
+}
+func (m *DBLinkedWallet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DBLinkedWallet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DBLinkedWallet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Os) > 0 {
-		i -= len(m.Os)
-		copy(dAtA[i:], m.Os)
-		i = encodeVarintDbAccount(dAtA, i, uint64(len(m.Os)))
-		i--
-		dAtA[i] = 0x2a
+}
+func (m *DBLinkedWallet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DBLinkedWallet.Merge(m, src)
+}
+func (m *DBLinkedWallet) XXX_Size() int {
+	return m.Size()
+}
+func (m *DBLinkedWallet) XXX_DiscardUnknown() {
+	xxx_messageInfo_DBLinkedWallet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DBLinkedWallet proto.InternalMessageInfo
+
+func (m *DBLinkedWallet) GetWalletAddr() string {
+	if m != nil {
+		return m.WalletAddr
 	}
-	if len(m.Device) > 0 {
-		i -= len(m.Device)
-		copy(dAtA[i:], m.Device)
-		i = encodeVarintDbAccount(dAtA, i, uint64(len(m.Device)))
-		i--
-		dAtA[i] = 0x22
+	return ""
+}
+
+func (m *DBLinkedWallet) GetPublicKey() []byte {
+	if m != nil {
+		return m.PublicKey
 	}
-	if len(m.DeviceId) > 0 {
-		i -= len(m.DeviceId)
-		copy(dAtA[i:], m.DeviceId)
-		i = encodeVarintDbAccount(dAtA, i, uint64(len(m.DeviceId)))
-		i--
-		dAtA[i] = 0x1a
+	return nil
+}
+
+func (m *DBLinkedWallet) GetLinkTime() int64 {
+	if m != nil {
+		return m.LinkTime
 	}
-	if m.UserId != 0 {
-		i = encodeVarintDbAccount(dAtA, i, uint64(m.UserId))
-		i--
-		dAtA[i] = 0x10
+	return 0
+}
+
+func (m *DBLinkedWallet) GetLegacy() bool {
+	if m != nil {
+		return m.Legacy
 	}
-	if len(m.Account) > 0 {
-		i -= len(m.Account)
-		copy(dAtA[i:], m.Account)
-		i = encodeVarintDbAccount(dAtA, i, uint64(len(m.Account)))
-		i--
-		dAtA[i] = 0xa
+	return false
+}
+
+type DBDeviceAccounts struct {
+	UserIds              []uint64 `protobuf:"varint,1,rep,packed,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DBDeviceAccounts) Reset()         { *m = DBDeviceAccounts{} }
+func (m *DBDeviceAccounts) String() string { return proto.CompactTextString(m) }
+func (*DBDeviceAccounts) ProtoMessage()    {}
+func (*DBDeviceAccounts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_893ddd182b186dba, []int{7}
+}
+func (m *DBDeviceAccounts) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DBDeviceAccounts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DBDeviceAccounts.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *DBDeviceAccounts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DBDeviceAccounts.Merge(m, src)
+}
+func (m *DBDeviceAccounts) XXX_Size() int {
+	return m.Size()
+}
+func (m *DBDeviceAccounts) XXX_DiscardUnknown() {
+	xxx_messageInfo_DBDeviceAccounts.DiscardUnknown(m)
 }
 
-func (m *DBWalletAcc) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_DBDeviceAccounts proto.InternalMessageInfo
+
+func (m *DBDeviceAccounts) GetUserIds() []uint64 {
+	if m != nil {
+		return m.UserIds
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *DBWalletAcc) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type DBWalletAcc struct {
+	Account              string   `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	UserId               uint64   `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DBWalletAcc) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.UserId != 0 {
-		i = encodeVarintDbAccount(dAtA, i, uint64(m.UserId))
-		i--
-		dAtA[i] = 0x10
+func (m *DBWalletAcc) Reset()         { *m = DBWalletAcc{} }
+func (m *DBWalletAcc) String() string { return proto.CompactTextString(m) }
+func (*DBWalletAcc) ProtoMessage()    {}
+func (*DBWalletAcc) Descriptor() ([]byte, []int) {
+	return fileDescriptor_893ddd182b186dba, []int{8}
+}
+func (m *DBWalletAcc) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DBWalletAcc) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DBWalletAcc.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Account) > 0 {
-		i -= len(m.Account)
-		copy(dAtA[i:], m.Account)
-		i = encodeVarintDbAccount(dAtA, i, uint64(len(m.Account)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *DBWalletAcc) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DBWalletAcc.Merge(m, src)
+}
+func (m *DBWalletAcc) XXX_Size() int {
+	return m.Size()
+}
+func (m *DBWalletAcc) XXX_DiscardUnknown() {
+	xxx_messageInfo_DBWalletAcc.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DBWalletAcc proto.InternalMessageInfo
+
+func (m *DBWalletAcc) GetAccount() string {
+	if m != nil {
+		return m.Account
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *DBTokenInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *DBWalletAcc) GetUserId() uint64 {
+	if m != nil {
+		return m.UserId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *DBTokenInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type DBTokenInfo struct {
+	Account              string   `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Device               string   `protobuf:"bytes,2,opt,name=device,proto3" json:"device,omitempty"`
+	DeviceId             string   `protobuf:"bytes,3,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	UserId               uint64   `protobuf:"varint,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	RefreshToken         string   `protobuf:"bytes,5,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	SessionId            string   `protobuf:"bytes,6,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DBTokenInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.DeviceId) > 0 {
-		i -= len(m.DeviceId)
-		copy(dAtA[i:], m.DeviceId)
-		i = encodeVarintDbAccount(dAtA, i, uint64(len(m.DeviceId)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Device) > 0 {
-		i -= len(m.Device)
-		copy(dAtA[i:], m.Device)
-		i = encodeVarintDbAccount(dAtA, i, uint64(len(m.Device)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Account) > 0 {
-		i -= len(m.Account)
-		copy(dAtA[i:], m.Account)
-		i = encodeVarintDbAccount(dAtA, i, uint64(len(m.Account)))
-		i--
-		dAtA[i] = 0xa
+func (m *DBTokenInfo) Reset()         { *m = DBTokenInfo{} }
+func (m *DBTokenInfo) String() string { return proto.CompactTextString(m) }
+func (*DBTokenInfo) ProtoMessage()    {}
+func (*DBTokenInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_893ddd182b186dba, []int{9}
+}
+func (m *DBTokenInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DBTokenInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DBTokenInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *DBTokenInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DBTokenInfo.Merge(m, src)
+}
+func (m *DBTokenInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *DBTokenInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_DBTokenInfo.DiscardUnknown(m)
 }
 
-func encodeVarintDbAccount(dAtA []byte, offset int, v uint64) int {
-	offset -= sovDbAccount(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_DBTokenInfo proto.InternalMessageInfo
+
+func (m *DBTokenInfo) GetAccount() string {
+	if m != nil {
+		return m.Account
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *DBAccountInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Account)
-	if l > 0 {
-		n += 1 + l + sovDbAccount(uint64(l))
+
+func (m *DBTokenInfo) GetDevice() string {
+	if m != nil {
+		return m.Device
 	}
-	if m.UserId != 0 {
-		n += 1 + sovDbAccount(uint64(m.UserId))
+	return ""
+}
+
+func (m *DBTokenInfo) GetDeviceId() string {
+	if m != nil {
+		return m.DeviceId
 	}
-	l = len(m.DeviceId)
-	if l > 0 {
-		n += 1 + l + sovDbAccount(uint64(l))
+	return ""
+}
+
+func (m *DBTokenInfo) GetUserId() uint64 {
+	if m != nil {
+		return m.UserId
 	}
-	l = len(m.Device)
-	if l > 0 {
-		n += 1 + l + sovDbAccount(uint64(l))
+	return 0
+}
+
+func (m *DBTokenInfo) GetRefreshToken() string {
+	if m != nil {
+		return m.RefreshToken
 	}
-	l = len(m.Os)
-	if l > 0 {
-		n += 1 + l + sovDbAccount(uint64(l))
+	return ""
+}
+
+func (m *DBTokenInfo) GetSessionId() string {
+	if m != nil {
+		return m.SessionId
 	}
-	l = len(m.Region)
-	if l > 0 {
-		n += 1 + l + sovDbAccount(uint64(l))
+	return ""
+}
+
+type DBRefreshTokenInfo struct {
+	UserId               uint64   `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Account              string   `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	Device               string   `protobuf:"bytes,3,opt,name=device,proto3" json:"device,omitempty"`
+	DeviceId             string   `protobuf:"bytes,4,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	Token                string   `protobuf:"bytes,5,opt,name=token,proto3" json:"token,omitempty"`
+	SessionId            string   `protobuf:"bytes,6,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DBRefreshTokenInfo) Reset()         { *m = DBRefreshTokenInfo{} }
+func (m *DBRefreshTokenInfo) String() string { return proto.CompactTextString(m) }
+func (*DBRefreshTokenInfo) ProtoMessage()    {}
+func (*DBRefreshTokenInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_893ddd182b186dba, []int{10}
+}
+func (m *DBRefreshTokenInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DBRefreshTokenInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DBRefreshTokenInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.Password)
-	if l > 0 {
-		n += 1 + l + sovDbAccount(uint64(l))
+}
+func (m *DBRefreshTokenInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DBRefreshTokenInfo.Merge(m, src)
+}
+func (m *DBRefreshTokenInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *DBRefreshTokenInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_DBRefreshTokenInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DBRefreshTokenInfo proto.InternalMessageInfo
+
+func (m *DBRefreshTokenInfo) GetUserId() uint64 {
+	if m != nil {
+		return m.UserId
 	}
-	if m.IsVerified != 0 {
-		n += 1 + sovDbAccount(uint64(m.IsVerified))
+	return 0
+}
+
+func (m *DBRefreshTokenInfo) GetAccount() string {
+	if m != nil {
+		return m.Account
 	}
-	if m.Guest {
-		n += 2
+	return ""
+}
+
+func (m *DBRefreshTokenInfo) GetDevice() string {
+	if m != nil {
+		return m.Device
 	}
-	if m.RegisterTime != 0 {
-		n += 1 + sovDbAccount(uint64(m.RegisterTime))
+	return ""
+}
+
+func (m *DBRefreshTokenInfo) GetDeviceId() string {
+	if m != nil {
+		return m.DeviceId
 	}
-	l = len(m.Tel)
-	if l > 0 {
-		n += 1 + l + sovDbAccount(uint64(l))
+	return ""
+}
+
+func (m *DBRefreshTokenInfo) GetToken() string {
+	if m != nil {
+		return m.Token
 	}
-	l = len(m.Email)
-	if l > 0 {
-		n += 1 + l + sovDbAccount(uint64(l))
+	return ""
+}
+
+func (m *DBRefreshTokenInfo) GetSessionId() string {
+	if m != nil {
+		return m.SessionId
 	}
-	l = len(m.Platform)
-	if l > 0 {
-		n += 1 + l + sovDbAccount(uint64(l))
+	return ""
+}
+
+type DBSession struct {
+	SessionId            string   `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	UserId               uint64   `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Device               string   `protobuf:"bytes,3,opt,name=device,proto3" json:"device,omitempty"`
+	DeviceId             string   `protobuf:"bytes,4,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	RemoteIp             string   `protobuf:"bytes,5,opt,name=remote_ip,json=remoteIp,proto3" json:"remote_ip,omitempty"`
+	Region               string   `protobuf:"bytes,6,opt,name=region,proto3" json:"region,omitempty"`
+	CreateTime           int64    `protobuf:"varint,7,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	LastSeenTime         int64    `protobuf:"varint,8,opt,name=last_seen_time,json=lastSeenTime,proto3" json:"last_seen_time,omitempty"`
+	ExpireTime           int64    `protobuf:"varint,9,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
+	Token                string   `protobuf:"bytes,10,opt,name=token,proto3" json:"token,omitempty"`
+	RefreshToken         string   `protobuf:"bytes,11,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DBSession) Reset()         { *m = DBSession{} }
+func (m *DBSession) String() string { return proto.CompactTextString(m) }
+func (*DBSession) ProtoMessage()    {}
+func (*DBSession) Descriptor() ([]byte, []int) {
+	return fileDescriptor_893ddd182b186dba, []int{11}
+}
+func (m *DBSession) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DBSession) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DBSession.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.AptosAccAddr)
-	if l > 0 {
-		n += 1 + l + sovDbAccount(uint64(l))
+}
+func (m *DBSession) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DBSession.Merge(m, src)
+}
+func (m *DBSession) XXX_Size() int {
+	return m.Size()
+}
+func (m *DBSession) XXX_DiscardUnknown() {
+	xxx_messageInfo_DBSession.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DBSession proto.InternalMessageInfo
+
+func (m *DBSession) GetSessionId() string {
+	if m != nil {
+		return m.SessionId
 	}
-	l = len(m.PublicKey)
-	if l > 0 {
-		n += 1 + l + sovDbAccount(uint64(l))
+	return ""
+}
+
+func (m *DBSession) GetUserId() uint64 {
+	if m != nil {
+		return m.UserId
 	}
-	l = len(m.Nickname)
-	if l > 0 {
-		n += 2 + l + sovDbAccount(uint64(l))
+	return 0
+}
+
+func (m *DBSession) GetDevice() string {
+	if m != nil {
+		return m.Device
 	}
-	l = len(m.Icon)
-	if l > 0 {
-		n += 2 + l + sovDbAccount(uint64(l))
+	return ""
+}
+
+func (m *DBSession) GetDeviceId() string {
+	if m != nil {
+		return m.DeviceId
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return ""
+}
+
+func (m *DBSession) GetRemoteIp() string {
+	if m != nil {
+		return m.RemoteIp
 	}
-	return n
+	return ""
 }
 
-func (m *DBWalletAcc) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DBSession) GetRegion() string {
+	if m != nil {
+		return m.Region
 	}
-	var l int
-	_ = l
-	l = len(m.Account)
-	if l > 0 {
-		n += 1 + l + sovDbAccount(uint64(l))
+	return ""
+}
+
+func (m *DBSession) GetCreateTime() int64 {
+	if m != nil {
+		return m.CreateTime
 	}
-	if m.UserId != 0 {
-		n += 1 + sovDbAccount(uint64(m.UserId))
+	return 0
+}
+
+func (m *DBSession) GetLastSeenTime() int64 {
+	if m != nil {
+		return m.LastSeenTime
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return 0
+}
+
+func (m *DBSession) GetExpireTime() int64 {
+	if m != nil {
+		return m.ExpireTime
 	}
-	return n
+	return 0
 }
 
-func (m *DBTokenInfo) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DBSession) GetToken() string {
+	if m != nil {
+		return m.Token
 	}
-	var l int
-	_ = l
-	l = len(m.Account)
-	if l > 0 {
-		n += 1 + l + sovDbAccount(uint64(l))
+	return ""
+}
+
+func (m *DBSession) GetRefreshToken() string {
+	if m != nil {
+		return m.RefreshToken
 	}
-	l = len(m.Device)
-	if l > 0 {
-		n += 1 + l + sovDbAccount(uint64(l))
+	return ""
+}
+
+type DBLoginRecord struct {
+	LoginTime            int64    `protobuf:"varint,1,opt,name=login_time,json=loginTime,proto3" json:"login_time,omitempty"`
+	RemoteIp             string   `protobuf:"bytes,2,opt,name=remote_ip,json=remoteIp,proto3" json:"remote_ip,omitempty"`
+	Region               string   `protobuf:"bytes,3,opt,name=region,proto3" json:"region,omitempty"`
+	Method               uint32   `protobuf:"varint,4,opt,name=method,proto3" json:"method,omitempty"`
+	Device               string   `protobuf:"bytes,5,opt,name=device,proto3" json:"device,omitempty"`
+	DeviceId             string   `protobuf:"bytes,6,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DBLoginRecord) Reset()         { *m = DBLoginRecord{} }
+func (m *DBLoginRecord) String() string { return proto.CompactTextString(m) }
+func (*DBLoginRecord) ProtoMessage()    {}
+func (*DBLoginRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_893ddd182b186dba, []int{12}
+}
+func (m *DBLoginRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DBLoginRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DBLoginRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.DeviceId)
-	if l > 0 {
-		n += 1 + l + sovDbAccount(uint64(l))
+}
+func (m *DBLoginRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DBLoginRecord.Merge(m, src)
+}
+func (m *DBLoginRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *DBLoginRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_DBLoginRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DBLoginRecord proto.InternalMessageInfo
+
+func (m *DBLoginRecord) GetLoginTime() int64 {
+	if m != nil {
+		return m.LoginTime
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return 0
+}
+
+func (m *DBLoginRecord) GetRemoteIp() string {
+	if m != nil {
+		return m.RemoteIp
 	}
-	return n
+	return ""
 }
 
-func sovDbAccount(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *DBLoginRecord) GetRegion() string {
+	if m != nil {
+		return m.Region
+	}
+	return ""
 }
-func sozDbAccount(x uint64) (n int) {
-	return sovDbAccount(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *DBLoginRecord) GetMethod() uint32 {
+	if m != nil {
+		return m.Method
+	}
+	return 0
 }
-func (m *DBAccountInfo) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowDbAccount
+
+func (m *DBLoginRecord) GetDevice() string {
+	if m != nil {
+		return m.Device
+	}
+	return ""
+}
+
+func (m *DBLoginRecord) GetDeviceId() string {
+	if m != nil {
+		return m.DeviceId
+	}
+	return ""
+}
+
+type DBLoginHistory struct {
+	Records              []*DBLoginRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *DBLoginHistory) Reset()         { *m = DBLoginHistory{} }
+func (m *DBLoginHistory) String() string { return proto.CompactTextString(m) }
+func (*DBLoginHistory) ProtoMessage()    {}
+func (*DBLoginHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_893ddd182b186dba, []int{13}
+}
+func (m *DBLoginHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DBLoginHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DBLoginHistory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DBLoginHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DBLoginHistory.Merge(m, src)
+}
+func (m *DBLoginHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *DBLoginHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_DBLoginHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DBLoginHistory proto.InternalMessageInfo
+
+func (m *DBLoginHistory) GetRecords() []*DBLoginRecord {
+	if m != nil {
+		return m.Records
+	}
+	return nil
+}
+
+type DBTOTPLoginTicket struct {
+	UserId               uint64   `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Device               string   `protobuf:"bytes,2,opt,name=device,proto3" json:"device,omitempty"`
+	DeviceId             string   `protobuf:"bytes,3,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DBTOTPLoginTicket) Reset()         { *m = DBTOTPLoginTicket{} }
+func (m *DBTOTPLoginTicket) String() string { return proto.CompactTextString(m) }
+func (*DBTOTPLoginTicket) ProtoMessage()    {}
+func (*DBTOTPLoginTicket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_893ddd182b186dba, []int{14}
+}
+func (m *DBTOTPLoginTicket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DBTOTPLoginTicket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DBTOTPLoginTicket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DBTOTPLoginTicket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DBTOTPLoginTicket.Merge(m, src)
+}
+func (m *DBTOTPLoginTicket) XXX_Size() int {
+	return m.Size()
+}
+func (m *DBTOTPLoginTicket) XXX_DiscardUnknown() {
+	xxx_messageInfo_DBTOTPLoginTicket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DBTOTPLoginTicket proto.InternalMessageInfo
+
+func (m *DBTOTPLoginTicket) GetUserId() uint64 {
+	if m != nil {
+		return m.UserId
+	}
+	return 0
+}
+
+func (m *DBTOTPLoginTicket) GetDevice() string {
+	if m != nil {
+		return m.Device
+	}
+	return ""
+}
+
+func (m *DBTOTPLoginTicket) GetDeviceId() string {
+	if m != nil {
+		return m.DeviceId
+	}
+	return ""
+}
+
+type DBReferral struct {
+	UserId               uint64   `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ReferralTime         int64    `protobuf:"varint,2,opt,name=referral_time,json=referralTime,proto3" json:"referral_time,omitempty"`
+	DeviceId             string   `protobuf:"bytes,3,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	RemoteIp             string   `protobuf:"bytes,4,opt,name=remote_ip,json=remoteIp,proto3" json:"remote_ip,omitempty"`
+	RejectReason         uint32   `protobuf:"varint,5,opt,name=reject_reason,json=rejectReason,proto3" json:"reject_reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DBReferral) Reset()         { *m = DBReferral{} }
+func (m *DBReferral) String() string { return proto.CompactTextString(m) }
+func (*DBReferral) ProtoMessage()    {}
+func (*DBReferral) Descriptor() ([]byte, []int) {
+	return fileDescriptor_893ddd182b186dba, []int{15}
+}
+func (m *DBReferral) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DBReferral) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DBReferral.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DBReferral) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DBReferral.Merge(m, src)
+}
+func (m *DBReferral) XXX_Size() int {
+	return m.Size()
+}
+func (m *DBReferral) XXX_DiscardUnknown() {
+	xxx_messageInfo_DBReferral.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DBReferral proto.InternalMessageInfo
+
+func (m *DBReferral) GetUserId() uint64 {
+	if m != nil {
+		return m.UserId
+	}
+	return 0
+}
+
+func (m *DBReferral) GetReferralTime() int64 {
+	if m != nil {
+		return m.ReferralTime
+	}
+	return 0
+}
+
+func (m *DBReferral) GetDeviceId() string {
+	if m != nil {
+		return m.DeviceId
+	}
+	return ""
+}
+
+func (m *DBReferral) GetRemoteIp() string {
+	if m != nil {
+		return m.RemoteIp
+	}
+	return ""
+}
+
+func (m *DBReferral) GetRejectReason() uint32 {
+	if m != nil {
+		return m.RejectReason
+	}
+	return 0
+}
+
+type DBAccountMergeLog struct {
+	MergedUserId         uint64   `protobuf:"varint,1,opt,name=merged_user_id,json=mergedUserId,proto3" json:"merged_user_id,omitempty"`
+	Operator             string   `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+	Reason               string   `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	MergeTime            int64    `protobuf:"varint,4,opt,name=merge_time,json=mergeTime,proto3" json:"merge_time,omitempty"`
+	WalletAddrs          []string `protobuf:"bytes,5,rep,name=wallet_addrs,json=walletAddrs,proto3" json:"wallet_addrs,omitempty"`
+	Email                string   `protobuf:"bytes,6,opt,name=email,proto3" json:"email,omitempty"`
+	Platforms            []string `protobuf:"bytes,7,rep,name=platforms,proto3" json:"platforms,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DBAccountMergeLog) Reset()         { *m = DBAccountMergeLog{} }
+func (m *DBAccountMergeLog) String() string { return proto.CompactTextString(m) }
+func (*DBAccountMergeLog) ProtoMessage()    {}
+func (*DBAccountMergeLog) Descriptor() ([]byte, []int) {
+	return fileDescriptor_893ddd182b186dba, []int{16}
+}
+func (m *DBAccountMergeLog) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DBAccountMergeLog) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DBAccountMergeLog.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DBAccountMergeLog) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DBAccountMergeLog.Merge(m, src)
+}
+func (m *DBAccountMergeLog) XXX_Size() int {
+	return m.Size()
+}
+func (m *DBAccountMergeLog) XXX_DiscardUnknown() {
+	xxx_messageInfo_DBAccountMergeLog.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DBAccountMergeLog proto.InternalMessageInfo
+
+func (m *DBAccountMergeLog) GetMergedUserId() uint64 {
+	if m != nil {
+		return m.MergedUserId
+	}
+	return 0
+}
+
+func (m *DBAccountMergeLog) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
+}
+
+func (m *DBAccountMergeLog) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *DBAccountMergeLog) GetMergeTime() int64 {
+	if m != nil {
+		return m.MergeTime
+	}
+	return 0
+}
+
+func (m *DBAccountMergeLog) GetWalletAddrs() []string {
+	if m != nil {
+		return m.WalletAddrs
+	}
+	return nil
+}
+
+func (m *DBAccountMergeLog) GetEmail() string {
+	if m != nil {
+		return m.Email
+	}
+	return ""
+}
+
+func (m *DBAccountMergeLog) GetPlatforms() []string {
+	if m != nil {
+		return m.Platforms
+	}
+	return nil
+}
+
+type DBAccountMergeLogs struct {
+	Logs                 []*DBAccountMergeLog `protobuf:"bytes,1,rep,name=logs,proto3" json:"logs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *DBAccountMergeLogs) Reset()         { *m = DBAccountMergeLogs{} }
+func (m *DBAccountMergeLogs) String() string { return proto.CompactTextString(m) }
+func (*DBAccountMergeLogs) ProtoMessage()    {}
+func (*DBAccountMergeLogs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_893ddd182b186dba, []int{17}
+}
+func (m *DBAccountMergeLogs) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DBAccountMergeLogs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DBAccountMergeLogs.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DBAccountMergeLogs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DBAccountMergeLogs.Merge(m, src)
+}
+func (m *DBAccountMergeLogs) XXX_Size() int {
+	return m.Size()
+}
+func (m *DBAccountMergeLogs) XXX_DiscardUnknown() {
+	xxx_messageInfo_DBAccountMergeLogs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DBAccountMergeLogs proto.InternalMessageInfo
+
+func (m *DBAccountMergeLogs) GetLogs() []*DBAccountMergeLog {
+	if m != nil {
+		return m.Logs
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*DBAccountInfo)(nil), "mpb.DBAccountInfo")
+	proto.RegisterType((*DBPlatformLink)(nil), "mpb.DBPlatformLink")
+	proto.RegisterType((*DBPlatformAcc)(nil), "mpb.DBPlatformAcc")
+	proto.RegisterType((*DBBanRecord)(nil), "mpb.DBBanRecord")
+	proto.RegisterType((*DBBanAuditLog)(nil), "mpb.DBBanAuditLog")
+	proto.RegisterType((*DBBanAuditLogs)(nil), "mpb.DBBanAuditLogs")
+	proto.RegisterType((*DBLinkedWallet)(nil), "mpb.DBLinkedWallet")
+	proto.RegisterType((*DBDeviceAccounts)(nil), "mpb.DBDeviceAccounts")
+	proto.RegisterType((*DBWalletAcc)(nil), "mpb.DBWalletAcc")
+	proto.RegisterType((*DBTokenInfo)(nil), "mpb.DBTokenInfo")
+	proto.RegisterType((*DBRefreshTokenInfo)(nil), "mpb.DBRefreshTokenInfo")
+	proto.RegisterType((*DBSession)(nil), "mpb.DBSession")
+	proto.RegisterType((*DBLoginRecord)(nil), "mpb.DBLoginRecord")
+	proto.RegisterType((*DBLoginHistory)(nil), "mpb.DBLoginHistory")
+	proto.RegisterType((*DBTOTPLoginTicket)(nil), "mpb.DBTOTPLoginTicket")
+	proto.RegisterType((*DBReferral)(nil), "mpb.DBReferral")
+	proto.RegisterType((*DBAccountMergeLog)(nil), "mpb.DBAccountMergeLog")
+	proto.RegisterType((*DBAccountMergeLogs)(nil), "mpb.DBAccountMergeLogs")
+}
+
+func init() { proto.RegisterFile("db_account.proto", fileDescriptor_893ddd182b186dba) }
+
+var fileDescriptor_893ddd182b186dba = []byte{
+	// 1316 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0xdd, 0x6e, 0xdb, 0xc6,
+	0x12, 0x3e, 0xd4, 0x3f, 0x47, 0x3f, 0xc7, 0xd9, 0xf8, 0xd8, 0x9b, 0x38, 0x76, 0x14, 0x1e, 0xe3,
+	0x40, 0x38, 0x68, 0xdd, 0x22, 0xbd, 0x29, 0x7a, 0x51, 0xc4, 0xaa, 0x0a, 0x54, 0xa8, 0x8b, 0x06,
+	0x8c, 0xd3, 0x02, 0xbd, 0x21, 0x28, 0x72, 0xad, 0xb0, 0xa6, 0xb8, 0xc4, 0xee, 0xca, 0xa9, 0x1e,
+	0xa0, 0x0f, 0xd0, 0xbb, 0xbe, 0x41, 0xd1, 0x9b, 0xa2, 0xbd, 0xec, 0x1b, 0xf4, 0x32, 0x8f, 0x50,
+	0x24, 0x2f, 0x52, 0xec, 0xec, 0x32, 0xa2, 0x64, 0xb3, 0x3f, 0xb9, 0xd3, 0x7c, 0x33, 0xcb, 0xfd,
+	0xe6, 0xdb, 0xd9, 0x99, 0x15, 0xec, 0xc4, 0xb3, 0x20, 0x8c, 0x22, 0xbe, 0xcc, 0xd4, 0x49, 0x2e,
+	0xb8, 0xe2, 0xa4, 0xbe, 0xc8, 0x67, 0xde, 0x4f, 0x6d, 0xe8, 0x4f, 0xc6, 0xa7, 0xc6, 0x31, 0xcd,
+	0x2e, 0x38, 0xa1, 0xd0, 0xb6, 0x71, 0xd4, 0x19, 0x3a, 0x23, 0xd7, 0x2f, 0x4c, 0xb2, 0x0f, 0xed,
+	0xa5, 0x64, 0x22, 0x48, 0x62, 0x5a, 0x1b, 0x3a, 0xa3, 0x86, 0xdf, 0xd2, 0xe6, 0x34, 0x26, 0x07,
+	0xe0, 0xc6, 0xec, 0x2a, 0x89, 0x98, 0x76, 0xd5, 0x71, 0x51, 0xc7, 0x00, 0xd3, 0x98, 0xec, 0x41,
+	0xcb, 0xfc, 0xa6, 0x0d, 0xf4, 0x58, 0x8b, 0x0c, 0xa0, 0xc6, 0x25, 0x6d, 0x22, 0x56, 0xe3, 0x52,
+	0xc7, 0x09, 0x36, 0x4f, 0x78, 0x46, 0x5b, 0x26, 0xce, 0x58, 0xe4, 0x2e, 0x74, 0xf2, 0x50, 0xca,
+	0xe7, 0x5c, 0xc4, 0xb4, 0x6d, 0xbe, 0x5d, 0xd8, 0xe4, 0x3e, 0x74, 0x13, 0x19, 0x5c, 0x31, 0x91,
+	0x5c, 0x24, 0x2c, 0xa6, 0x9d, 0xa1, 0x33, 0xea, 0xfb, 0x90, 0xc8, 0x2f, 0x2c, 0x42, 0x76, 0xa1,
+	0x39, 0x5f, 0x32, 0xa9, 0xa8, 0x3b, 0x74, 0x46, 0x1d, 0xdf, 0x18, 0xe4, 0xbf, 0xd0, 0xd7, 0x1f,
+	0x97, 0x8a, 0x89, 0x40, 0x25, 0x0b, 0x46, 0x61, 0xe8, 0x8c, 0xea, 0x7e, 0xaf, 0x00, 0xcf, 0x93,
+	0x05, 0x23, 0x3b, 0x50, 0x57, 0x2c, 0xa5, 0x5d, 0xdc, 0x52, 0xff, 0xd4, 0x1f, 0x63, 0x8b, 0x30,
+	0x49, 0x69, 0x0f, 0x31, 0x63, 0x20, 0xbf, 0x34, 0x54, 0x17, 0x5c, 0x2c, 0x68, 0xdf, 0xf2, 0xb3,
+	0x36, 0x39, 0x86, 0x41, 0x98, 0x2b, 0x2e, 0xb5, 0xf2, 0x41, 0x18, 0xc7, 0x82, 0x0e, 0x30, 0xa2,
+	0x87, 0xe8, 0x69, 0x14, 0x9d, 0xc6, 0xb1, 0x20, 0x87, 0x00, 0xf9, 0x72, 0x96, 0x26, 0x51, 0x70,
+	0xc9, 0x56, 0xf4, 0xdf, 0x43, 0x67, 0xd4, 0xf3, 0x5d, 0x83, 0x7c, 0xca, 0x56, 0x7a, 0x83, 0x2c,
+	0x89, 0x2e, 0xb3, 0x70, 0xc1, 0xe8, 0x8e, 0xd9, 0xa0, 0xb0, 0x09, 0x81, 0x46, 0x12, 0xf1, 0x8c,
+	0xde, 0x42, 0x1c, 0x7f, 0x6b, 0x51, 0x14, 0x57, 0x79, 0x20, 0x59, 0x24, 0x98, 0xa2, 0x04, 0x5d,
+	0xa0, 0xa1, 0x27, 0x88, 0x90, 0x07, 0xd0, 0xc3, 0x00, 0x96, 0x85, 0xb3, 0x94, 0xc5, 0xf4, 0x36,
+	0x6a, 0x83, 0x8b, 0x3e, 0x36, 0x10, 0x39, 0x81, 0xdb, 0x18, 0x22, 0x58, 0xc4, 0xaf, 0x98, 0x58,
+	0x05, 0x11, 0x8f, 0x99, 0xa4, 0xbb, 0xc3, 0xfa, 0xc8, 0xf5, 0x6f, 0x69, 0x97, 0x6f, 0x3d, 0x1f,
+	0x69, 0x87, 0xde, 0x73, 0xc1, 0xc4, 0x9c, 0xc5, 0x41, 0x92, 0x29, 0x4e, 0xff, 0x83, 0xe5, 0x01,
+	0x06, 0x9a, 0x66, 0x8a, 0x6b, 0xc9, 0x63, 0x96, 0x32, 0x95, 0xf0, 0xcc, 0x48, 0xbe, 0x67, 0x24,
+	0x2f, 0x40, 0x94, 0xfc, 0x5d, 0xd8, 0x2d, 0x32, 0x0b, 0x96, 0x79, 0x1c, 0x2a, 0x66, 0x62, 0xf7,
+	0x31, 0x96, 0x14, 0xbe, 0xa7, 0xe8, 0xc2, 0x15, 0x23, 0xd8, 0xd1, 0x39, 0x6f, 0x44, 0x53, 0x8c,
+	0x1e, 0x68, 0xbc, 0x14, 0xe9, 0x41, 0x7d, 0x16, 0x66, 0xf4, 0xce, 0xd0, 0x19, 0x75, 0x1f, 0xee,
+	0x9c, 0x2c, 0xf2, 0xd9, 0xc9, 0x64, 0x3c, 0x0e, 0x33, 0x9d, 0x87, 0x88, 0x7d, 0xed, 0x24, 0xc7,
+	0xd0, 0x58, 0x2c, 0x15, 0xa3, 0x77, 0x2b, 0x82, 0xd0, 0x8b, 0x45, 0x97, 0x5d, 0x25, 0x8a, 0xa1,
+	0x28, 0xf4, 0xc0, 0xe8, 0x6b, 0x20, 0xad, 0x86, 0x0e, 0x10, 0xec, 0x82, 0x09, 0x61, 0xee, 0xca,
+	0x3d, 0x23, 0x46, 0x01, 0x4d, 0x63, 0xf2, 0x01, 0x0c, 0x8a, 0x12, 0x09, 0xd2, 0x24, 0xbb, 0x94,
+	0xf4, 0x70, 0x58, 0x1f, 0x75, 0x1f, 0xde, 0xb6, 0x3b, 0x3e, 0xb6, 0xce, 0xb3, 0x24, 0xbb, 0xf4,
+	0xfb, 0x79, 0xc9, 0x92, 0xde, 0x0a, 0x06, 0x9b, 0x01, 0x58, 0x80, 0x82, 0x5f, 0x25, 0x31, 0x13,
+	0xf6, 0xc6, 0xbe, 0xb6, 0xf5, 0x65, 0x96, 0xcb, 0xd9, 0xd7, 0x2c, 0x52, 0x78, 0x65, 0x5d, 0xbf,
+	0x30, 0xd7, 0xc5, 0x5c, 0x2f, 0x17, 0xf3, 0x01, 0xb8, 0x9a, 0x90, 0x11, 0xb2, 0x81, 0x42, 0x76,
+	0x34, 0xa0, 0x25, 0xf4, 0x46, 0xd0, 0x5f, 0x6f, 0x7d, 0x1a, 0x45, 0xe5, 0x86, 0xe0, 0x94, 0x1b,
+	0x82, 0xf7, 0x9d, 0x03, 0xdd, 0x92, 0x70, 0xba, 0x4c, 0xd5, 0x2a, 0x67, 0x18, 0xd5, 0xf7, 0xf1,
+	0xb7, 0xb9, 0xef, 0xa1, 0xe4, 0x99, 0x65, 0x66, 0x2d, 0x9d, 0x0e, 0xcf, 0x99, 0x08, 0x15, 0x17,
+	0x45, 0x2f, 0x29, 0x6c, 0x72, 0x07, 0x3a, 0xb3, 0x30, 0x2b, 0xb3, 0x6b, 0xcf, 0x42, 0x53, 0x3b,
+	0xf7, 0xa1, 0xcb, 0xbe, 0xc9, 0x13, 0x61, 0x8b, 0xa0, 0x89, 0x5e, 0x30, 0x10, 0xb2, 0xff, 0xc1,
+	0xd1, 0xf4, 0xc7, 0x61, 0x76, 0xba, 0x8c, 0x13, 0x75, 0xc6, 0xe7, 0x37, 0xb2, 0xda, 0x85, 0xe6,
+	0x32, 0x9b, 0x85, 0x86, 0x54, 0xc7, 0x37, 0x46, 0x89, 0x6b, 0xbd, 0x92, 0x6b, 0x63, 0x8b, 0xeb,
+	0x3e, 0xb4, 0x79, 0x5e, 0x26, 0xd3, 0xe2, 0xf9, 0x4d, 0x4c, 0x5b, 0xd7, 0x98, 0xbe, 0x0f, 0x83,
+	0x0d, 0xa2, 0x92, 0xfc, 0x0f, 0x1a, 0x29, 0x9f, 0x4b, 0xea, 0x60, 0x99, 0x90, 0x75, 0x61, 0x16,
+	0x21, 0x3e, 0xfa, 0xbd, 0x6f, 0x1d, 0xbd, 0x54, 0x57, 0x05, 0x8b, 0xbf, 0x0c, 0xd3, 0x94, 0x29,
+	0xbd, 0xdb, 0x73, 0xfc, 0x65, 0xfa, 0x8f, 0x29, 0x10, 0x30, 0xd0, 0x0d, 0xdd, 0xa7, 0xb6, 0xdd,
+	0x7d, 0x36, 0x2a, 0xa2, 0xbe, 0x59, 0x11, 0x5a, 0x97, 0x94, 0xcd, 0xc3, 0x68, 0x85, 0xd9, 0x77,
+	0x7c, 0x6b, 0x79, 0x6f, 0xc3, 0xce, 0x64, 0x3c, 0xc1, 0x3e, 0x6f, 0x47, 0x8b, 0xd4, 0x67, 0x67,
+	0x8b, 0xc5, 0xe4, 0xd1, 0xf0, 0xdb, 0xa6, 0x5a, 0xa4, 0xf7, 0x48, 0x57, 0x8b, 0xe1, 0xab, 0xcb,
+	0xea, 0x9f, 0x4f, 0x20, 0xef, 0x57, 0x2c, 0xb8, 0x73, 0x7e, 0xc9, 0xb2, 0xbf, 0x18, 0x62, 0xeb,
+	0x71, 0x54, 0xdb, 0x18, 0x47, 0x7f, 0x3a, 0xc3, 0x4a, 0xfb, 0x36, 0x36, 0x26, 0x1f, 0x4e, 0x92,
+	0x0b, 0xc1, 0xe4, 0xb3, 0x40, 0xe9, 0xcd, 0xed, 0x3c, 0xeb, 0x59, 0x10, 0x09, 0x69, 0x85, 0x25,
+	0x93, 0x52, 0xb7, 0xbe, 0x24, 0xb6, 0xd3, 0xcd, 0xb5, 0xc8, 0x34, 0xf6, 0x7e, 0x71, 0x80, 0x4c,
+	0xc6, 0x7e, 0x69, 0x05, 0xa6, 0x50, 0x75, 0xb9, 0xca, 0xb9, 0xd5, 0xaa, 0x72, 0xab, 0x57, 0xe7,
+	0xd6, 0xd8, 0xca, 0x6d, 0x17, 0x9a, 0x65, 0xea, 0x4d, 0xf5, 0x77, 0x38, 0xbf, 0xa8, 0x81, 0x3b,
+	0x19, 0x3f, 0x31, 0xf6, 0x56, 0xb0, 0xb3, 0x15, 0x5c, 0xfd, 0x6e, 0x78, 0x23, 0xbe, 0x07, 0xe0,
+	0x0a, 0xb6, 0xe0, 0x8a, 0x05, 0x49, 0x6e, 0x39, 0x77, 0x0c, 0x30, 0xcd, 0x2b, 0x1f, 0x11, 0xf7,
+	0xa1, 0x1b, 0x09, 0xf6, 0x7a, 0x44, 0xb4, 0xcd, 0x9d, 0x33, 0x10, 0x56, 0xf2, 0x31, 0x0c, 0xd2,
+	0x50, 0xaa, 0x40, 0x32, 0x66, 0xfb, 0x4b, 0xc7, 0x0c, 0x28, 0x8d, 0x3e, 0x61, 0xec, 0xc6, 0x26,
+	0xe3, 0x6e, 0x5f, 0xdd, 0xb5, 0x98, 0x50, 0x16, 0xf3, 0x5a, 0x95, 0x74, 0xaf, 0x57, 0x89, 0xf7,
+	0x33, 0xf6, 0xa7, 0x33, 0x3e, 0x4f, 0x8a, 0xae, 0x79, 0x08, 0x90, 0x6a, 0xd3, 0x6c, 0xe6, 0xe0,
+	0x66, 0x2e, 0x22, 0xb8, 0xd7, 0x86, 0x10, 0xb5, 0x4a, 0x21, 0xea, 0x1b, 0x42, 0xec, 0x41, 0x6b,
+	0xc1, 0xd4, 0x33, 0x6e, 0x74, 0xed, 0xfb, 0xd6, 0x2a, 0x1d, 0x45, 0xb3, 0xfa, 0x28, 0x5a, 0x9b,
+	0x47, 0xe1, 0x7d, 0x88, 0xdd, 0x46, 0x13, 0xfa, 0x24, 0x91, 0x8a, 0x8b, 0x15, 0x79, 0x0b, 0xda,
+	0x02, 0xc9, 0x6f, 0xf7, 0xaa, 0x52, 0x5e, 0x7e, 0x11, 0xe2, 0x85, 0x70, 0x6b, 0x32, 0x3e, 0xff,
+	0xfc, 0xfc, 0xf1, 0x99, 0x49, 0x2a, 0xba, 0x64, 0xaa, 0xba, 0xee, 0xdf, 0xe4, 0xe6, 0x7a, 0x3f,
+	0x3a, 0x00, 0x78, 0xb9, 0x98, 0x10, 0x61, 0x5a, 0xfd, 0x71, 0x73, 0x44, 0x18, 0x64, 0xe4, 0xae,
+	0x15, 0x4f, 0x42, 0x03, 0x16, 0x8a, 0x57, 0xf7, 0x88, 0x8d, 0xe3, 0x68, 0x6c, 0x1d, 0x07, 0x7e,
+	0x5e, 0xcf, 0xdd, 0xc0, 0xce, 0x91, 0x26, 0xaa, 0xdf, 0x33, 0xa0, 0x8f, 0x98, 0xf7, 0xca, 0xd1,
+	0x7a, 0xd8, 0x86, 0xf9, 0x99, 0x7e, 0x3b, 0xe9, 0x29, 0x75, 0x0c, 0x03, 0xfb, 0xb4, 0xda, 0x64,
+	0xde, 0x33, 0xe8, 0x53, 0xc3, 0xbf, 0x3c, 0x89, 0x6a, 0x5b, 0x93, 0xa8, 0x6a, 0x7a, 0x1d, 0x82,
+	0x79, 0xa1, 0x95, 0xe7, 0xa9, 0x8b, 0x08, 0x66, 0xfb, 0x00, 0x7a, 0xa5, 0xc9, 0xa1, 0x9f, 0xea,
+	0xfa, 0xf1, 0xd7, 0x5d, 0x8f, 0x0e, 0xb9, 0x7e, 0x44, 0xb4, 0xca, 0x8f, 0x88, 0x7b, 0xe0, 0x16,
+	0x6f, 0x16, 0x49, 0xdb, 0xb8, 0x6a, 0x0d, 0x78, 0x8f, 0x80, 0x5c, 0x4b, 0x52, 0x92, 0xff, 0x6f,
+	0x4c, 0xb8, 0x3d, 0x5b, 0x35, 0x5b, 0x61, 0x66, 0xca, 0x8d, 0xf7, 0x7f, 0x7b, 0x79, 0xe4, 0xbc,
+	0x78, 0x79, 0xe4, 0xfc, 0xfe, 0xf2, 0xc8, 0xf9, 0xfe, 0xd5, 0xd1, 0xbf, 0xbe, 0x6a, 0x9e, 0xbc,
+	0xb3, 0xc8, 0x67, 0xb3, 0x16, 0xfe, 0xb1, 0x79, 0xef, 0x8f, 0x01, 0x00, 0x15, 0x53, 0x2f, 0x73,
+	0xec, 0x0c, 0x00, 0x00,
+}
+
+func (m *DBAccountInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DBAccountInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DBAccountInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.PlatformLinks) > 0 {
+		for iNdEx := len(m.PlatformLinks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PlatformLinks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDbAccount(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xea
+		}
+	}
+	if m.ReferrerId != 0 {
+		i = encodeVarintDbAccount(dAtA, i, uint64(m.ReferrerId))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe0
+	}
+	if len(m.InviteCode) > 0 {
+		i -= len(m.InviteCode)
+		copy(dAtA[i:], m.InviteCode)
+		i = encodeVarintDbAccount(dAtA, i, uint64(len(m.InviteCode)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xda
+	}
+	if m.Mute != nil {
+		{
+			size, err := m.Mute.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDbAccount(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd2
+	}
+	if m.Ban != nil {
+		{
+			size, err := m.Ban.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDbAccount(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xca
+	}
+	if m.IconUpdateTime != 0 {
+		i = encodeVarintDbAccount(dAtA, i, uint64(m.IconUpdateTime))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc0
+	}
+	if m.NicknameUpdateTime != 0 {
+		i = encodeVarintDbAccount(dAtA, i, uint64(m.NicknameUpdateTime))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb8
+	}
+	if m.DeletionTime != 0 {
+		i = encodeVarintDbAccount(dAtA, i, uint64(m.DeletionTime))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
+	if m.MergedInto != 0 {
+		i = encodeVarintDbAccount(dAtA, i, uint64(m.MergedInto))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	if len(m.TotpRecoveryCodes) > 0 {
+		for iNdEx := len(m.TotpRecoveryCodes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TotpRecoveryCodes[iNdEx])
+			copy(dAtA[i:], m.TotpRecoveryCodes[iNdEx])
+			i = encodeVarintDbAccount(dAtA, i, uint64(len(m.TotpRecoveryCodes[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if m.TotpEnabled {
+		i--
+		if m.TotpEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if len(m.TotpSecret) > 0 {
+		i -= len(m.TotpSecret)
+		copy(dAtA[i:], m.TotpSecret)
+		i = encodeVarintDbAccount(dAtA, i, uint64(len(m.TotpSecret)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.Icon) > 0 {
+		i -= len(m.Icon)
+		copy(dAtA[i:], m.Icon)
+		i = encodeVarintDbAccount(dAtA, i, uint64(len(m.Icon)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if len(m.Nickname) > 0 {
+		i -= len(m.Nickname)
+		copy(dAtA[i:], m.Nickname)
+		i = encodeVarintDbAccount(dAtA, i, uint64(len(m.Nickname)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if len(m.PublicKey) > 0 {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintDbAccount(dAtA, i, uint64(len(m.PublicKey)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if len(m.AptosAccAddr) > 0 {
+		i -= len(m.AptosAccAddr)
+		copy(dAtA[i:], m.AptosAccAddr)
+		i = encodeVarintDbAccount(dAtA, i, uint64(len(m.AptosAccAddr)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.Platform) > 0 {
+		i -= len(m.Platform)
+		copy(dAtA[i:], m.Platform)
+		i = encodeVarintDbAccount(dAtA, i, uint64(len(m.Platform)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.Email) > 0 {
+		i -= len(m.Email)
+		copy(dAtA[i:], m.Email)
+		i = encodeVarintDbAccount(dAtA, i, uint64(len(m.Email)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.Tel) > 0 {
+		i -= len(m.Tel)
+		copy(dAtA[i:], m.Tel)
+		i = encodeVarintDbAccount(dAtA, i, uint64(len(m.Tel)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.RegisterTime != 0 {
+		i = encodeVarintDbAccount(dAtA, i, uint64(m.RegisterTime))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.Guest {
+		i--
+		if m.Guest {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.IsVerified != 0 {
+		i = encodeVarintDbAccount(dAtA, i, uint64(m.IsVerified))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Password) > 0 {
+		i -= len(m.Password)
+		copy(dAtA[i:], m.Password)
+		i = encodeVarintDbAccount(dAtA, i, uint64(len(m.Password)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Region) > 0 {
+		i -= len(m.Region)
+		copy(dAtA[i:], m.Region)
+		i = encodeVarintDbAccount(dAtA, i, uint64(len(m.Region)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Os) > 0 {
+		i -= len(m.Os)
+		copy(dAtA[i:], m.Os)
+		i = encodeVarintDbAccount(dAtA, i, uint64(len(m.Os)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Device) > 0 {
+		i -= len(m.Device)
+		copy(dAtA[i:], m.Device)
+		i = encodeVarintDbAccount(dAtA, i, uint64(len(m.Device)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.DeviceId) > 0 {
+		i -= len(m.DeviceId)
+		copy(dAtA[i:], m.DeviceId)
+		i = encodeVarintDbAccount(dAtA, i, uint64(len(m.DeviceId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.UserId != 0 {
+		i = encodeVarintDbAccount(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintDbAccount(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DBPlatformLink) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DBPlatformLink) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DBPlatformLink) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.LinkTime != 0 {
+		i = encodeVarintDbAccount(dAtA, i, uint64(m.LinkTime))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Email) > 0 {
+		i -= len(m.Email)
+		copy(dAtA[i:], m.Email)
+		i = encodeVarintDbAccount(dAtA, i, uint64(len(m.Email)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Subject) > 0 {
+		i -= len(m.Subject)
+		copy(dAtA[i:], m.Subject)
+		i = encodeVarintDbAccount(dAtA, i, uint64(len(m.Subject)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintDbAccount(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DBPlatformAcc) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DBPlatformAcc) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DBPlatformAcc) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.UserId != 0 {
+		i = encodeVarintDbAccount(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DBBanRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DBBanRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DBBanRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ExpireTime != 0 {
+		i = encodeVarintDbAccount(dAtA, i, uint64(m.ExpireTime))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.BanTime != 0 {
+		i = encodeVarintDbAccount(dAtA, i, uint64(m.BanTime))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintDbAccount(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintDbAccount(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Type != 0 {
+		i = encodeVarintDbAccount(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DBBanAuditLog) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DBBanAuditLog) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DBBanAuditLog) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ExpireTime != 0 {
+		i = encodeVarintDbAccount(dAtA, i, uint64(m.ExpireTime))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.OpTime != 0 {
+		i = encodeVarintDbAccount(dAtA, i, uint64(m.OpTime))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintDbAccount(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintDbAccount(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Unban {
+		i--
+		if m.Unban {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Type != 0 {
+		i = encodeVarintDbAccount(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DBBanAuditLogs) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DBBanAuditLogs) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DBBanAuditLogs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Logs) > 0 {
+		for iNdEx := len(m.Logs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Logs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDbAccount(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DBLinkedWallet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DBLinkedWallet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DBLinkedWallet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Legacy {
+		i--
+		if m.Legacy {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.LinkTime != 0 {
+		i = encodeVarintDbAccount(dAtA, i, uint64(m.LinkTime))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.PublicKey) > 0 {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintDbAccount(dAtA, i, uint64(len(m.PublicKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.WalletAddr) > 0 {
+		i -= len(m.WalletAddr)
+		copy(dAtA[i:], m.WalletAddr)
+		i = encodeVarintDbAccount(dAtA, i, uint64(len(m.WalletAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DBDeviceAccounts) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DBDeviceAccounts) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DBDeviceAccounts) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.UserIds) > 0 {
+		dAtA4 := make([]byte, len(m.UserIds)*10)
+		var j3 int
+		for _, num := range m.UserIds {
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintDbAccount(dAtA, i, uint64(j3))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DBWalletAcc) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DBWalletAcc) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DBWalletAcc) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.UserId != 0 {
+		i = encodeVarintDbAccount(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintDbAccount(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DBTokenInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DBTokenInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DBTokenInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.SessionId) > 0 {
+		i -= len(m.SessionId)
+		copy(dAtA[i:], m.SessionId)
+		i = encodeVarintDbAccount(dAtA, i, uint64(len(m.SessionId)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.RefreshToken) > 0 {
+		i -= len(m.RefreshToken)
+		copy(dAtA[i:], m.RefreshToken)
+		i = encodeVarintDbAccount(dAtA, i, uint64(len(m.RefreshToken)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.UserId != 0 {
+		i = encodeVarintDbAccount(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.DeviceId) > 0 {
+		i -= len(m.DeviceId)
+		copy(dAtA[i:], m.DeviceId)
+		i = encodeVarintDbAccount(dAtA, i, uint64(len(m.DeviceId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Device) > 0 {
+		i -= len(m.Device)
+		copy(dAtA[i:], m.Device)
+		i = encodeVarintDbAccount(dAtA, i, uint64(len(m.Device)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintDbAccount(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DBRefreshTokenInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DBRefreshTokenInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DBRefreshTokenInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.SessionId) > 0 {
+		i -= len(m.SessionId)
+		copy(dAtA[i:], m.SessionId)
+		i = encodeVarintDbAccount(dAtA, i, uint64(len(m.SessionId)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintDbAccount(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.DeviceId) > 0 {
+		i -= len(m.DeviceId)
+		copy(dAtA[i:], m.DeviceId)
+		i = encodeVarintDbAccount(dAtA, i, uint64(len(m.DeviceId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Device) > 0 {
+		i -= len(m.Device)
+		copy(dAtA[i:], m.Device)
+		i = encodeVarintDbAccount(dAtA, i, uint64(len(m.Device)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintDbAccount(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.UserId != 0 {
+		i = encodeVarintDbAccount(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DBSession) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DBSession) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DBSession) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.RefreshToken) > 0 {
+		i -= len(m.RefreshToken)
+		copy(dAtA[i:], m.RefreshToken)
+		i = encodeVarintDbAccount(dAtA, i, uint64(len(m.RefreshToken)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintDbAccount(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.ExpireTime != 0 {
+		i = encodeVarintDbAccount(dAtA, i, uint64(m.ExpireTime))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.LastSeenTime != 0 {
+		i = encodeVarintDbAccount(dAtA, i, uint64(m.LastSeenTime))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.CreateTime != 0 {
+		i = encodeVarintDbAccount(dAtA, i, uint64(m.CreateTime))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Region) > 0 {
+		i -= len(m.Region)
+		copy(dAtA[i:], m.Region)
+		i = encodeVarintDbAccount(dAtA, i, uint64(len(m.Region)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.RemoteIp) > 0 {
+		i -= len(m.RemoteIp)
+		copy(dAtA[i:], m.RemoteIp)
+		i = encodeVarintDbAccount(dAtA, i, uint64(len(m.RemoteIp)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.DeviceId) > 0 {
+		i -= len(m.DeviceId)
+		copy(dAtA[i:], m.DeviceId)
+		i = encodeVarintDbAccount(dAtA, i, uint64(len(m.DeviceId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Device) > 0 {
+		i -= len(m.Device)
+		copy(dAtA[i:], m.Device)
+		i = encodeVarintDbAccount(dAtA, i, uint64(len(m.Device)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.UserId != 0 {
+		i = encodeVarintDbAccount(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.SessionId) > 0 {
+		i -= len(m.SessionId)
+		copy(dAtA[i:], m.SessionId)
+		i = encodeVarintDbAccount(dAtA, i, uint64(len(m.SessionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DBLoginRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DBLoginRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DBLoginRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.DeviceId) > 0 {
+		i -= len(m.DeviceId)
+		copy(dAtA[i:], m.DeviceId)
+		i = encodeVarintDbAccount(dAtA, i, uint64(len(m.DeviceId)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Device) > 0 {
+		i -= len(m.Device)
+		copy(dAtA[i:], m.Device)
+		i = encodeVarintDbAccount(dAtA, i, uint64(len(m.Device)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Method != 0 {
+		i = encodeVarintDbAccount(dAtA, i, uint64(m.Method))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Region) > 0 {
+		i -= len(m.Region)
+		copy(dAtA[i:], m.Region)
+		i = encodeVarintDbAccount(dAtA, i, uint64(len(m.Region)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.RemoteIp) > 0 {
+		i -= len(m.RemoteIp)
+		copy(dAtA[i:], m.RemoteIp)
+		i = encodeVarintDbAccount(dAtA, i, uint64(len(m.RemoteIp)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.LoginTime != 0 {
+		i = encodeVarintDbAccount(dAtA, i, uint64(m.LoginTime))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DBLoginHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DBLoginHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DBLoginHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDbAccount(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DBTOTPLoginTicket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DBTOTPLoginTicket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DBTOTPLoginTicket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.DeviceId) > 0 {
+		i -= len(m.DeviceId)
+		copy(dAtA[i:], m.DeviceId)
+		i = encodeVarintDbAccount(dAtA, i, uint64(len(m.DeviceId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Device) > 0 {
+		i -= len(m.Device)
+		copy(dAtA[i:], m.Device)
+		i = encodeVarintDbAccount(dAtA, i, uint64(len(m.Device)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.UserId != 0 {
+		i = encodeVarintDbAccount(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DBReferral) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DBReferral) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DBReferral) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.RejectReason != 0 {
+		i = encodeVarintDbAccount(dAtA, i, uint64(m.RejectReason))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.RemoteIp) > 0 {
+		i -= len(m.RemoteIp)
+		copy(dAtA[i:], m.RemoteIp)
+		i = encodeVarintDbAccount(dAtA, i, uint64(len(m.RemoteIp)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.DeviceId) > 0 {
+		i -= len(m.DeviceId)
+		copy(dAtA[i:], m.DeviceId)
+		i = encodeVarintDbAccount(dAtA, i, uint64(len(m.DeviceId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ReferralTime != 0 {
+		i = encodeVarintDbAccount(dAtA, i, uint64(m.ReferralTime))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.UserId != 0 {
+		i = encodeVarintDbAccount(dAtA, i, uint64(m.UserId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DBAccountMergeLog) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DBAccountMergeLog) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DBAccountMergeLog) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Platforms) > 0 {
+		for iNdEx := len(m.Platforms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Platforms[iNdEx])
+			copy(dAtA[i:], m.Platforms[iNdEx])
+			i = encodeVarintDbAccount(dAtA, i, uint64(len(m.Platforms[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Email) > 0 {
+		i -= len(m.Email)
+		copy(dAtA[i:], m.Email)
+		i = encodeVarintDbAccount(dAtA, i, uint64(len(m.Email)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.WalletAddrs) > 0 {
+		for iNdEx := len(m.WalletAddrs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.WalletAddrs[iNdEx])
+			copy(dAtA[i:], m.WalletAddrs[iNdEx])
+			i = encodeVarintDbAccount(dAtA, i, uint64(len(m.WalletAddrs[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.MergeTime != 0 {
+		i = encodeVarintDbAccount(dAtA, i, uint64(m.MergeTime))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintDbAccount(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintDbAccount(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MergedUserId != 0 {
+		i = encodeVarintDbAccount(dAtA, i, uint64(m.MergedUserId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DBAccountMergeLogs) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DBAccountMergeLogs) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DBAccountMergeLogs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Logs) > 0 {
+		for iNdEx := len(m.Logs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Logs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDbAccount(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDbAccount(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDbAccount(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DBAccountInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovDbAccount(uint64(l))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovDbAccount(uint64(m.UserId))
+	}
+	l = len(m.DeviceId)
+	if l > 0 {
+		n += 1 + l + sovDbAccount(uint64(l))
+	}
+	l = len(m.Device)
+	if l > 0 {
+		n += 1 + l + sovDbAccount(uint64(l))
+	}
+	l = len(m.Os)
+	if l > 0 {
+		n += 1 + l + sovDbAccount(uint64(l))
+	}
+	l = len(m.Region)
+	if l > 0 {
+		n += 1 + l + sovDbAccount(uint64(l))
+	}
+	l = len(m.Password)
+	if l > 0 {
+		n += 1 + l + sovDbAccount(uint64(l))
+	}
+	if m.IsVerified != 0 {
+		n += 1 + sovDbAccount(uint64(m.IsVerified))
+	}
+	if m.Guest {
+		n += 2
+	}
+	if m.RegisterTime != 0 {
+		n += 1 + sovDbAccount(uint64(m.RegisterTime))
+	}
+	l = len(m.Tel)
+	if l > 0 {
+		n += 1 + l + sovDbAccount(uint64(l))
+	}
+	l = len(m.Email)
+	if l > 0 {
+		n += 1 + l + sovDbAccount(uint64(l))
+	}
+	l = len(m.Platform)
+	if l > 0 {
+		n += 1 + l + sovDbAccount(uint64(l))
+	}
+	l = len(m.AptosAccAddr)
+	if l > 0 {
+		n += 1 + l + sovDbAccount(uint64(l))
+	}
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovDbAccount(uint64(l))
+	}
+	l = len(m.Nickname)
+	if l > 0 {
+		n += 2 + l + sovDbAccount(uint64(l))
+	}
+	l = len(m.Icon)
+	if l > 0 {
+		n += 2 + l + sovDbAccount(uint64(l))
+	}
+	l = len(m.TotpSecret)
+	if l > 0 {
+		n += 2 + l + sovDbAccount(uint64(l))
+	}
+	if m.TotpEnabled {
+		n += 3
+	}
+	if len(m.TotpRecoveryCodes) > 0 {
+		for _, s := range m.TotpRecoveryCodes {
+			l = len(s)
+			n += 2 + l + sovDbAccount(uint64(l))
+		}
+	}
+	if m.MergedInto != 0 {
+		n += 2 + sovDbAccount(uint64(m.MergedInto))
+	}
+	if m.DeletionTime != 0 {
+		n += 2 + sovDbAccount(uint64(m.DeletionTime))
+	}
+	if m.NicknameUpdateTime != 0 {
+		n += 2 + sovDbAccount(uint64(m.NicknameUpdateTime))
+	}
+	if m.IconUpdateTime != 0 {
+		n += 2 + sovDbAccount(uint64(m.IconUpdateTime))
+	}
+	if m.Ban != nil {
+		l = m.Ban.Size()
+		n += 2 + l + sovDbAccount(uint64(l))
+	}
+	if m.Mute != nil {
+		l = m.Mute.Size()
+		n += 2 + l + sovDbAccount(uint64(l))
+	}
+	l = len(m.InviteCode)
+	if l > 0 {
+		n += 2 + l + sovDbAccount(uint64(l))
+	}
+	if m.ReferrerId != 0 {
+		n += 2 + sovDbAccount(uint64(m.ReferrerId))
+	}
+	if len(m.PlatformLinks) > 0 {
+		for _, e := range m.PlatformLinks {
+			l = e.Size()
+			n += 2 + l + sovDbAccount(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DBPlatformLink) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovDbAccount(uint64(l))
+	}
+	l = len(m.Subject)
+	if l > 0 {
+		n += 1 + l + sovDbAccount(uint64(l))
+	}
+	l = len(m.Email)
+	if l > 0 {
+		n += 1 + l + sovDbAccount(uint64(l))
+	}
+	if m.LinkTime != 0 {
+		n += 1 + sovDbAccount(uint64(m.LinkTime))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DBPlatformAcc) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UserId != 0 {
+		n += 1 + sovDbAccount(uint64(m.UserId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DBBanRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovDbAccount(uint64(m.Type))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovDbAccount(uint64(l))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovDbAccount(uint64(l))
+	}
+	if m.BanTime != 0 {
+		n += 1 + sovDbAccount(uint64(m.BanTime))
+	}
+	if m.ExpireTime != 0 {
+		n += 1 + sovDbAccount(uint64(m.ExpireTime))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DBBanAuditLog) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovDbAccount(uint64(m.Type))
+	}
+	if m.Unban {
+		n += 2
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovDbAccount(uint64(l))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovDbAccount(uint64(l))
+	}
+	if m.OpTime != 0 {
+		n += 1 + sovDbAccount(uint64(m.OpTime))
+	}
+	if m.ExpireTime != 0 {
+		n += 1 + sovDbAccount(uint64(m.ExpireTime))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DBBanAuditLogs) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Logs) > 0 {
+		for _, e := range m.Logs {
+			l = e.Size()
+			n += 1 + l + sovDbAccount(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DBLinkedWallet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.WalletAddr)
+	if l > 0 {
+		n += 1 + l + sovDbAccount(uint64(l))
+	}
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovDbAccount(uint64(l))
+	}
+	if m.LinkTime != 0 {
+		n += 1 + sovDbAccount(uint64(m.LinkTime))
+	}
+	if m.Legacy {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DBDeviceAccounts) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.UserIds) > 0 {
+		l = 0
+		for _, e := range m.UserIds {
+			l += sovDbAccount(uint64(e))
+		}
+		n += 1 + sovDbAccount(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DBWalletAcc) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovDbAccount(uint64(l))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovDbAccount(uint64(m.UserId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DBTokenInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovDbAccount(uint64(l))
+	}
+	l = len(m.Device)
+	if l > 0 {
+		n += 1 + l + sovDbAccount(uint64(l))
+	}
+	l = len(m.DeviceId)
+	if l > 0 {
+		n += 1 + l + sovDbAccount(uint64(l))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovDbAccount(uint64(m.UserId))
+	}
+	l = len(m.RefreshToken)
+	if l > 0 {
+		n += 1 + l + sovDbAccount(uint64(l))
+	}
+	l = len(m.SessionId)
+	if l > 0 {
+		n += 1 + l + sovDbAccount(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DBRefreshTokenInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UserId != 0 {
+		n += 1 + sovDbAccount(uint64(m.UserId))
+	}
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovDbAccount(uint64(l))
+	}
+	l = len(m.Device)
+	if l > 0 {
+		n += 1 + l + sovDbAccount(uint64(l))
+	}
+	l = len(m.DeviceId)
+	if l > 0 {
+		n += 1 + l + sovDbAccount(uint64(l))
+	}
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovDbAccount(uint64(l))
+	}
+	l = len(m.SessionId)
+	if l > 0 {
+		n += 1 + l + sovDbAccount(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DBSession) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SessionId)
+	if l > 0 {
+		n += 1 + l + sovDbAccount(uint64(l))
+	}
+	if m.UserId != 0 {
+		n += 1 + sovDbAccount(uint64(m.UserId))
+	}
+	l = len(m.Device)
+	if l > 0 {
+		n += 1 + l + sovDbAccount(uint64(l))
+	}
+	l = len(m.DeviceId)
+	if l > 0 {
+		n += 1 + l + sovDbAccount(uint64(l))
+	}
+	l = len(m.RemoteIp)
+	if l > 0 {
+		n += 1 + l + sovDbAccount(uint64(l))
+	}
+	l = len(m.Region)
+	if l > 0 {
+		n += 1 + l + sovDbAccount(uint64(l))
+	}
+	if m.CreateTime != 0 {
+		n += 1 + sovDbAccount(uint64(m.CreateTime))
+	}
+	if m.LastSeenTime != 0 {
+		n += 1 + sovDbAccount(uint64(m.LastSeenTime))
+	}
+	if m.ExpireTime != 0 {
+		n += 1 + sovDbAccount(uint64(m.ExpireTime))
+	}
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovDbAccount(uint64(l))
+	}
+	l = len(m.RefreshToken)
+	if l > 0 {
+		n += 1 + l + sovDbAccount(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DBLoginRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LoginTime != 0 {
+		n += 1 + sovDbAccount(uint64(m.LoginTime))
+	}
+	l = len(m.RemoteIp)
+	if l > 0 {
+		n += 1 + l + sovDbAccount(uint64(l))
+	}
+	l = len(m.Region)
+	if l > 0 {
+		n += 1 + l + sovDbAccount(uint64(l))
+	}
+	if m.Method != 0 {
+		n += 1 + sovDbAccount(uint64(m.Method))
+	}
+	l = len(m.Device)
+	if l > 0 {
+		n += 1 + l + sovDbAccount(uint64(l))
+	}
+	l = len(m.DeviceId)
+	if l > 0 {
+		n += 1 + l + sovDbAccount(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DBLoginHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovDbAccount(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DBTOTPLoginTicket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UserId != 0 {
+		n += 1 + sovDbAccount(uint64(m.UserId))
+	}
+	l = len(m.Device)
+	if l > 0 {
+		n += 1 + l + sovDbAccount(uint64(l))
+	}
+	l = len(m.DeviceId)
+	if l > 0 {
+		n += 1 + l + sovDbAccount(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DBReferral) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UserId != 0 {
+		n += 1 + sovDbAccount(uint64(m.UserId))
+	}
+	if m.ReferralTime != 0 {
+		n += 1 + sovDbAccount(uint64(m.ReferralTime))
+	}
+	l = len(m.DeviceId)
+	if l > 0 {
+		n += 1 + l + sovDbAccount(uint64(l))
+	}
+	l = len(m.RemoteIp)
+	if l > 0 {
+		n += 1 + l + sovDbAccount(uint64(l))
+	}
+	if m.RejectReason != 0 {
+		n += 1 + sovDbAccount(uint64(m.RejectReason))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DBAccountMergeLog) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MergedUserId != 0 {
+		n += 1 + sovDbAccount(uint64(m.MergedUserId))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovDbAccount(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovDbAccount(uint64(l))
+	}
+	if m.MergeTime != 0 {
+		n += 1 + sovDbAccount(uint64(m.MergeTime))
+	}
+	if len(m.WalletAddrs) > 0 {
+		for _, s := range m.WalletAddrs {
+			l = len(s)
+			n += 1 + l + sovDbAccount(uint64(l))
+		}
+	}
+	l = len(m.Email)
+	if l > 0 {
+		n += 1 + l + sovDbAccount(uint64(l))
+	}
+	if len(m.Platforms) > 0 {
+		for _, s := range m.Platforms {
+			l = len(s)
+			n += 1 + l + sovDbAccount(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DBAccountMergeLogs) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Logs) > 0 {
+		for _, e := range m.Logs {
+			l = e.Size()
+			n += 1 + l + sovDbAccount(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovDbAccount(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDbAccount(x uint64) (n int) {
+	return sovDbAccount(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DBAccountInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDbAccount
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DBAccountInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DBAccountInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDbAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDbAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDbAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			m.UserId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDbAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UserId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeviceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDbAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDbAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDbAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeviceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Device", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDbAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDbAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDbAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Device = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Os", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDbAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDbAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDbAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Os = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDbAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDbAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDbAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Region = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDbAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDbAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDbAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Password = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsVerified", wireType)
+			}
+			m.IsVerified = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDbAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IsVerified |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Guest", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDbAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Guest = bool(v != 0)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegisterTime", wireType)
+			}
+			m.RegisterTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDbAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RegisterTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDbAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDbAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDbAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Email", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDbAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDbAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDbAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Email = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Platform", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDbAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDbAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDbAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Platform = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AptosAccAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDbAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDbAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDbAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AptosAccAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDbAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDbAccount
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDbAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PublicKey == nil {
+				m.PublicKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nickname", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDbAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDbAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDbAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Nickname = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Icon", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDbAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDbAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDbAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Icon = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotpSecret", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDbAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDbAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDbAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotpSecret = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotpEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDbAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TotpEnabled = bool(v != 0)
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotpRecoveryCodes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDbAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDbAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDbAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotpRecoveryCodes = append(m.TotpRecoveryCodes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MergedInto", wireType)
+			}
+			m.MergedInto = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDbAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MergedInto |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeletionTime", wireType)
+			}
+			m.DeletionTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDbAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeletionTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NicknameUpdateTime", wireType)
+			}
+			m.NicknameUpdateTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDbAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NicknameUpdateTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IconUpdateTime", wireType)
+			}
+			m.IconUpdateTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDbAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IconUpdateTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ban", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDbAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDbAccount
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDbAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Ban == nil {
+				m.Ban = &DBBanRecord{}
+			}
+			if err := m.Ban.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mute", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDbAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDbAccount
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDbAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Mute == nil {
+				m.Mute = &DBBanRecord{}
+			}
+			if err := m.Mute.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InviteCode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDbAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDbAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDbAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InviteCode = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 28:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferrerId", wireType)
+			}
+			m.ReferrerId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDbAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReferrerId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 29:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlatformLinks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDbAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDbAccount
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDbAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PlatformLinks = append(m.PlatformLinks, &DBPlatformLink{})
+			if err := m.PlatformLinks[len(m.PlatformLinks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDbAccount(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDbAccount
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DBPlatformLink) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDbAccount
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DBPlatformLink: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DBPlatformLink: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDbAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDbAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDbAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDbAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDbAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDbAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subject = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Email", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDbAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDbAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDbAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Email = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LinkTime", wireType)
+			}
+			m.LinkTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDbAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LinkTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDbAccount(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDbAccount
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DBPlatformAcc) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDbAccount
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DBPlatformAcc: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DBPlatformAcc: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			m.UserId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDbAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UserId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDbAccount(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDbAccount
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DBBanRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDbAccount
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DBBanRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DBBanRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDbAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDbAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDbAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDbAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDbAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDbAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDbAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BanTime", wireType)
+			}
+			m.BanTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDbAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BanTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpireTime", wireType)
+			}
+			m.ExpireTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDbAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpireTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDbAccount(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDbAccount
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DBBanAuditLog) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDbAccount
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DBBanAuditLog: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DBBanAuditLog: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDbAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unban", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDbAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Unban = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDbAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDbAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDbAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDbAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDbAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDbAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OpTime", wireType)
+			}
+			m.OpTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDbAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OpTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpireTime", wireType)
+			}
+			m.ExpireTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDbAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpireTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDbAccount(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDbAccount
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DBBanAuditLogs) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDbAccount
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DBBanAuditLogs: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DBBanAuditLogs: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Logs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDbAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDbAccount
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDbAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Logs = append(m.Logs, &DBBanAuditLog{})
+			if err := m.Logs[len(m.Logs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDbAccount(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDbAccount
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DBLinkedWallet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDbAccount
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DBLinkedWallet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DBLinkedWallet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WalletAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDbAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDbAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDbAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WalletAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDbAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDbAccount
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDbAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PublicKey == nil {
+				m.PublicKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LinkTime", wireType)
+			}
+			m.LinkTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDbAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LinkTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Legacy", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDbAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Legacy = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDbAccount(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDbAccount
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DBDeviceAccounts) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDbAccount
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DBDeviceAccounts: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DBDeviceAccounts: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowDbAccount
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.UserIds = append(m.UserIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowDbAccount
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthDbAccount
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthDbAccount
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.UserIds) == 0 {
+					m.UserIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowDbAccount
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.UserIds = append(m.UserIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserIds", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDbAccount(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDbAccount
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DBWalletAcc) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDbAccount
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DBWalletAcc: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DBWalletAcc: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDbAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDbAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDbAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			m.UserId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDbAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UserId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDbAccount(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDbAccount
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DBTokenInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDbAccount
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DBTokenInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DBTokenInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDbAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDbAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDbAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Device", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDbAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDbAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDbAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Device = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeviceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDbAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDbAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDbAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeviceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			m.UserId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDbAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UserId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefreshToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDbAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDbAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDbAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RefreshToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDbAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDbAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDbAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SessionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDbAccount(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDbAccount
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DBRefreshTokenInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDbAccount
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DBRefreshTokenInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DBRefreshTokenInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			m.UserId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDbAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UserId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDbAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDbAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDbAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Device", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDbAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDbAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDbAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Device = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeviceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDbAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDbAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDbAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeviceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDbAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDbAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDbAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Token = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDbAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDbAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDbAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SessionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDbAccount(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDbAccount
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DBSession) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDbAccount
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DBSession: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DBSession: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDbAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDbAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDbAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SessionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			m.UserId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDbAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UserId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Device", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDbAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDbAccount
 			}
-			if iNdEx >= l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDbAccount
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Device = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeviceId", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DBAccountInfo: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DBAccountInfo: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDbAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDbAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDbAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeviceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoteIp", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -783,13 +6014,45 @@ func (m *DBAccountInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Account = string(dAtA[iNdEx:postIndex])
+			m.RemoteIp = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDbAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDbAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDbAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Region = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateTime", wireType)
 			}
-			m.UserId = 0
+			m.CreateTime = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDbAccount
@@ -799,14 +6062,186 @@ func (m *DBAccountInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UserId |= uint64(b&0x7F) << shift
+				m.CreateTime |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSeenTime", wireType)
+			}
+			m.LastSeenTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDbAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastSeenTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpireTime", wireType)
+			}
+			m.ExpireTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDbAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpireTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeviceId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDbAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDbAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDbAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Token = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefreshToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDbAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDbAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDbAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RefreshToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDbAccount(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDbAccount
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DBLoginRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDbAccount
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DBLoginRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DBLoginRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LoginTime", wireType)
+			}
+			m.LoginTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDbAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LoginTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoteIp", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -834,11 +6269,11 @@ func (m *DBAccountInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DeviceId = string(dAtA[iNdEx:postIndex])
+			m.RemoteIp = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Device", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -866,13 +6301,13 @@ func (m *DBAccountInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Device = string(dAtA[iNdEx:postIndex])
+			m.Region = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Os", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
 			}
-			var stringLen uint64
+			m.Method = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDbAccount
@@ -882,27 +6317,14 @@ func (m *DBAccountInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Method |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthDbAccount
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthDbAccount
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Os = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Device", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -930,11 +6352,11 @@ func (m *DBAccountInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Region = string(dAtA[iNdEx:postIndex])
+			m.Device = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeviceId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -962,32 +6384,64 @@ func (m *DBAccountInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Password = string(dAtA[iNdEx:postIndex])
+			m.DeviceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsVerified", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDbAccount(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.IsVerified = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDbAccount
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.IsVerified |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDbAccount
 			}
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Guest", wireType)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			var v int
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DBLoginHistory) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDbAccount
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DBLoginHistory: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DBLoginHistory: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDbAccount
@@ -997,17 +6451,82 @@ func (m *DBAccountInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Guest = bool(v != 0)
-		case 10:
+			if msglen < 0 {
+				return ErrInvalidLengthDbAccount
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDbAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, &DBLoginRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDbAccount(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDbAccount
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DBTOTPLoginTicket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDbAccount
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DBTOTPLoginTicket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DBTOTPLoginTicket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RegisterTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
 			}
-			m.RegisterTime = 0
+			m.UserId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDbAccount
@@ -1017,14 +6536,14 @@ func (m *DBAccountInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RegisterTime |= int64(b&0x7F) << shift
+				m.UserId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 11:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tel", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Device", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1052,11 +6571,11 @@ func (m *DBAccountInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tel = string(dAtA[iNdEx:postIndex])
+			m.Device = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Email", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeviceId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1084,45 +6603,64 @@ func (m *DBAccountInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Email = string(dAtA[iNdEx:postIndex])
+			m.DeviceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Platform", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDbAccount
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDbAccount(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthDbAccount
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthDbAccount
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DBReferral) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDbAccount
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Platform = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 14:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AptosAccAddr", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DBReferral: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DBReferral: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
 			}
-			var stringLen uint64
+			m.UserId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDbAccount
@@ -1132,29 +6670,16 @@ func (m *DBAccountInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.UserId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthDbAccount
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthDbAccount
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.AptosAccAddr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 15:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferralTime", wireType)
 			}
-			var byteLen int
+			m.ReferralTime = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDbAccount
@@ -1164,29 +6689,14 @@ func (m *DBAccountInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.ReferralTime |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthDbAccount
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthDbAccount
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.PublicKey == nil {
-				m.PublicKey = []byte{}
-			}
-			iNdEx = postIndex
-		case 16:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nickname", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeviceId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1214,11 +6724,11 @@ func (m *DBAccountInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Nickname = string(dAtA[iNdEx:postIndex])
+			m.DeviceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 17:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Icon", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoteIp", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1246,8 +6756,27 @@ func (m *DBAccountInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Icon = string(dAtA[iNdEx:postIndex])
+			m.RemoteIp = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RejectReason", wireType)
+			}
+			m.RejectReason = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDbAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RejectReason |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDbAccount(dAtA[iNdEx:])
@@ -1270,7 +6799,7 @@ func (m *DBAccountInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DBWalletAcc) Unmarshal(dAtA []byte) error {
+func (m *DBAccountMergeLog) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1293,15 +6822,34 @@ func (m *DBWalletAcc) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DBWalletAcc: wiretype end group for non-group")
+			return fmt.Errorf("proto: DBAccountMergeLog: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DBWalletAcc: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DBAccountMergeLog: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MergedUserId", wireType)
+			}
+			m.MergedUserId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDbAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MergedUserId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1329,13 +6877,13 @@ func (m *DBWalletAcc) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Account = string(dAtA[iNdEx:postIndex])
+			m.Operator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			m.UserId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDbAccount
@@ -1345,65 +6893,46 @@ func (m *DBWalletAcc) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UserId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipDbAccount(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDbAccount
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthDbAccount
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DBTokenInfo) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowDbAccount
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MergeTime", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.MergeTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDbAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MergeTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DBTokenInfo: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DBTokenInfo: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WalletAddrs", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1431,11 +6960,11 @@ func (m *DBTokenInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Account = string(dAtA[iNdEx:postIndex])
+			m.WalletAddrs = append(m.WalletAddrs, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Device", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Email", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1463,11 +6992,11 @@ func (m *DBTokenInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Device = string(dAtA[iNdEx:postIndex])
+			m.Email = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeviceId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Platforms", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1495,7 +7024,92 @@ func (m *DBTokenInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DeviceId = string(dAtA[iNdEx:postIndex])
+			m.Platforms = append(m.Platforms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDbAccount(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDbAccount
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DBAccountMergeLogs) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDbAccount
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DBAccountMergeLogs: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DBAccountMergeLogs: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Logs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDbAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDbAccount
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDbAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Logs = append(m.Logs, &DBAccountMergeLog{})
+			if err := m.Logs[len(m.Logs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -33,6 +33,7 @@ type DBAptosNFTNodeV2 struct {
 	TokenUri                string                       `protobuf:"bytes,8,opt,name=token_uri,json=tokenUri,proto3" json:"token_uri,omitempty"`
 	TransactionTimestamp    string                       `protobuf:"bytes,9,opt,name=transaction_timestamp,json=transactionTimestamp,proto3" json:"transaction_timestamp,omitempty"`
 	TransactionTimestampInt int64                        `protobuf:"varint,10,opt,name=transaction_timestamp_int,json=transactionTimestampInt,proto3" json:"transaction_timestamp_int,omitempty"`
+	OwnerAddr               string                       `protobuf:"bytes,11,opt,name=owner_addr,json=ownerAddr,proto3" json:"owner_addr,omitempty"`
 	XXX_NoUnkeyedLiteral    struct{}                     `json:"-"`
 	XXX_unrecognized        []byte                       `json:"-"`
 	XXX_sizecache           int32                        `json:"-"`
@@ -141,6 +142,13 @@ func (m *DBAptosNFTNodeV2) GetTransactionTimestampInt() int64 {
 	return 0
 }
 
+func (m *DBAptosNFTNodeV2) GetOwnerAddr() string {
+	if m != nil {
+		return m.OwnerAddr
+	}
+	return ""
+}
+
 type DBAptosNFTNodeV2_Properties struct {
 	Prop1                string   `protobuf:"bytes,1,opt,name=prop1,proto3" json:"prop1,omitempty"`
 	Prop2                string   `protobuf:"bytes,2,opt,name=prop2,proto3" json:"prop2,omitempty"`
@@ -420,44 +428,45 @@ func init() {
 func init() { proto.RegisterFile("db_nft.proto", fileDescriptor_5c189d9007c69c61) }
 
 var fileDescriptor_5c189d9007c69c61 = []byte{
-	// 594 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x4d, 0x6f, 0xd3, 0x30,
-	0x18, 0x26, 0x4b, 0xba, 0x35, 0x6f, 0x37, 0x18, 0xde, 0x60, 0x19, 0x88, 0x12, 0x8a, 0x90, 0x7a,
-	0xea, 0x20, 0x93, 0x38, 0x70, 0x5b, 0x29, 0x48, 0x15, 0x52, 0x41, 0xa1, 0xf4, 0xc0, 0x25, 0x72,
-	0x6b, 0x57, 0xb2, 0xc8, 0x87, 0x71, 0xbc, 0xa2, 0xfe, 0x8b, 0x1d, 0xf9, 0x49, 0x1c, 0x38, 0xec,
-	0x27, 0xa0, 0xf2, 0x47, 0x90, 0xed, 0xa4, 0x4b, 0x3f, 0xe0, 0xc0, 0xa5, 0xaa, 0x9f, 0x8f, 0xe8,
-	0xf5, 0xfb, 0x3c, 0x09, 0xec, 0x93, 0x71, 0x94, 0x4e, 0x65, 0x87, 0x8b, 0x4c, 0x66, 0xc8, 0x4e,
-	0xf8, 0xb8, 0xf5, 0xd3, 0x81, 0xc3, 0x5e, 0xf7, 0x82, 0xcb, 0x2c, 0x1f, 0xbc, 0x1d, 0x0e, 0x32,
-	0x42, 0x47, 0x01, 0x7a, 0x0a, 0x07, 0x93, 0x2c, 0x8e, 0xe9, 0x44, 0xb2, 0x2c, 0x8d, 0x18, 0xf1,
-	0x2c, 0xdf, 0x6a, 0xbb, 0xe1, 0xfe, 0x0d, 0xd8, 0x27, 0xa8, 0x05, 0x07, 0x32, 0xfb, 0x42, 0xd3,
-	0x88, 0x60, 0x89, 0x95, 0x68, 0x47, 0x8b, 0x1a, 0x1a, 0xec, 0x61, 0x89, 0xfb, 0x04, 0xf9, 0xd0,
-	0x20, 0x34, 0x9f, 0x08, 0xc6, 0x95, 0xc9, 0xb3, 0x8d, 0xa2, 0x02, 0xa1, 0x47, 0x00, 0xe6, 0x29,
-	0x29, 0x4e, 0xa8, 0xe7, 0x68, 0x81, 0xab, 0x91, 0x01, 0x4e, 0x28, 0x3a, 0x85, 0xba, 0xa1, 0x19,
-	0xf1, 0x6a, 0xbe, 0xd5, 0x3e, 0x08, 0xf7, 0xf4, 0xb9, 0x4f, 0xd0, 0x3b, 0x38, 0x34, 0x14, 0x17,
-	0x19, 0xa7, 0x42, 0x32, 0x9a, 0x7b, 0xbb, 0xbe, 0xd5, 0x6e, 0x04, 0x7e, 0x27, 0xe1, 0xe3, 0xce,
-	0xfa, 0xad, 0x3a, 0x1f, 0x96, 0xba, 0xf0, 0x8e, 0x76, 0xde, 0x00, 0xe8, 0x19, 0xdc, 0x36, 0x0f,
-	0xcb, 0x25, 0x4e, 0x09, 0x16, 0xc4, 0xdb, 0xd3, 0xa3, 0x98, 0x2b, 0x7e, 0x2c, 0x40, 0xf4, 0x10,
-	0xcc, 0x6c, 0xd1, 0xa5, 0x60, 0x5e, 0x5d, 0x2b, 0xcc, 0x7c, 0x9f, 0x04, 0x43, 0xe7, 0x70, 0x4f,
-	0x0a, 0x9c, 0xe6, 0xd8, 0xac, 0x4d, 0xb2, 0x84, 0xe6, 0x12, 0x27, 0xdc, 0x73, 0xb5, 0xf0, 0xb8,
-	0x42, 0x0e, 0x4b, 0x0e, 0xbd, 0x82, 0xd3, 0xad, 0xa6, 0x88, 0xa5, 0xd2, 0x03, 0xdf, 0x6a, 0xdb,
-	0xe1, 0xc9, 0x36, 0x63, 0x3f, 0x95, 0x0f, 0xae, 0x2c, 0x80, 0xca, 0x1d, 0x8e, 0xa1, 0xa6, 0x56,
-	0xf1, 0xa2, 0x48, 0xcb, 0x1c, 0x4a, 0x34, 0x28, 0xe2, 0x31, 0x07, 0xe4, 0xc1, 0xde, 0xd7, 0x4b,
-	0x1c, 0x33, 0x39, 0x2f, 0x42, 0x29, 0x8f, 0xea, 0x8a, 0xdf, 0x28, 0xe6, 0x26, 0x77, 0x93, 0x47,
-	0xdd, 0x00, 0x7d, 0x82, 0x1e, 0x43, 0xa3, 0x20, 0xe5, 0x9c, 0x53, 0x9d, 0x88, 0x1b, 0x82, 0x81,
-	0x86, 0x73, 0x4e, 0x5b, 0x57, 0x36, 0xdc, 0xed, 0x75, 0x87, 0x6a, 0x25, 0x17, 0x13, 0xc9, 0x66,
-	0x4c, 0xce, 0x47, 0x01, 0x42, 0xe0, 0x68, 0xbd, 0x19, 0x4c, 0xff, 0x47, 0x4f, 0x60, 0x7f, 0x2a,
-	0xb2, 0x24, 0xc2, 0x84, 0x08, 0x9a, 0xe7, 0x65, 0x7b, 0x14, 0x76, 0x61, 0x20, 0xd3, 0x8d, 0xa5,
-	0xc0, 0x2e, 0xbb, 0x51, 0xd2, 0x1b, 0x2d, 0x75, 0xb6, 0xb4, 0x74, 0xb5, 0x5f, 0xb5, 0xf5, 0x7e,
-	0x6d, 0x94, 0x78, 0x77, 0xb3, 0xc4, 0x95, 0xd0, 0xe3, 0xa2, 0x16, 0x65, 0xe8, 0xf1, 0xdf, 0x43,
-	0xaf, 0xff, 0x6f, 0xe8, 0xee, 0x3f, 0x43, 0x47, 0x67, 0x70, 0x54, 0xf5, 0xce, 0xa8, 0xc8, 0xd5,
-	0xab, 0xa5, 0xaa, 0xe2, 0x84, 0xa8, 0x42, 0x8d, 0x0c, 0xd3, 0xba, 0xb6, 0xe0, 0x68, 0x35, 0x12,
-	0x46, 0xf3, 0x51, 0xa0, 0x36, 0x93, 0xb0, 0x54, 0x46, 0x74, 0x46, 0x53, 0xa9, 0xa3, 0xa9, 0x87,
-	0xae, 0x42, 0xde, 0x28, 0x00, 0x3d, 0x07, 0x33, 0xfb, 0x94, 0x8a, 0x28, 0x9b, 0xaa, 0x5f, 0x23,
-	0xdc, 0xd1, 0x42, 0x54, 0x72, 0xef, 0x15, 0xb5, 0xe9, 0x98, 0xc4, 0x98, 0x25, 0x85, 0xc3, 0x5e,
-	0x75, 0xbc, 0x56, 0x94, 0x71, 0xbc, 0x04, 0xc0, 0xcb, 0x91, 0x3c, 0xc7, 0xb7, 0xdb, 0x8d, 0xe0,
-	0x7e, 0xf1, 0xf2, 0xae, 0x75, 0x28, 0xac, 0x28, 0xbb, 0x27, 0x3f, 0x16, 0x4d, 0xeb, 0x7a, 0xd1,
-	0xb4, 0x7e, 0x2d, 0x9a, 0xd6, 0xf7, 0xdf, 0xcd, 0x5b, 0x9f, 0x6b, 0x9d, 0xb3, 0x84, 0x8f, 0xc7,
-	0xbb, 0xfa, 0xcb, 0x76, 0xfe, 0x27, 0x00, 0x00, 0xff, 0xff, 0x23, 0x48, 0xea, 0xee, 0xe9, 0x04,
+	// 610 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xcf, 0x6e, 0xd3, 0x4c,
+	0x14, 0xc5, 0x3f, 0xd7, 0x4e, 0x13, 0x5f, 0xb7, 0x1f, 0x65, 0x5a, 0xe8, 0x14, 0x44, 0x30, 0x41,
+	0x48, 0x59, 0xa5, 0xe0, 0x4a, 0x2c, 0xd8, 0xa5, 0x14, 0xa4, 0x08, 0xa9, 0x20, 0x13, 0xb2, 0x60,
+	0x63, 0x4d, 0x32, 0x13, 0x69, 0x84, 0xff, 0x0c, 0xe3, 0x69, 0xab, 0xbc, 0x45, 0x97, 0x3c, 0x12,
+	0xcb, 0x3e, 0x02, 0x2a, 0x4b, 0x5e, 0x02, 0xcd, 0x8c, 0xdd, 0x3a, 0x4d, 0x61, 0xc1, 0x26, 0xca,
+	0x9c, 0x73, 0xae, 0x75, 0xe7, 0xde, 0x9f, 0x0d, 0x1b, 0x74, 0x9a, 0xe4, 0x73, 0x35, 0x10, 0xb2,
+	0x50, 0x05, 0x72, 0x33, 0x31, 0xed, 0xfd, 0xf2, 0x60, 0xeb, 0xe8, 0x70, 0x28, 0x54, 0x51, 0x1e,
+	0xbf, 0x1d, 0x1f, 0x17, 0x94, 0x4d, 0x22, 0xf4, 0x14, 0x36, 0x67, 0x45, 0x9a, 0xb2, 0x99, 0xe2,
+	0x45, 0x9e, 0x70, 0x8a, 0x9d, 0xd0, 0xe9, 0xfb, 0xf1, 0xc6, 0xb5, 0x38, 0xa2, 0xa8, 0x07, 0x9b,
+	0xaa, 0xf8, 0xc2, 0xf2, 0x84, 0x12, 0x45, 0x74, 0x68, 0xcd, 0x84, 0x02, 0x23, 0x1e, 0x11, 0x45,
+	0x46, 0x14, 0x85, 0x10, 0x50, 0x56, 0xce, 0x24, 0x17, 0xba, 0x08, 0xbb, 0x36, 0xd1, 0x90, 0xd0,
+	0x23, 0x00, 0xfb, 0x94, 0x9c, 0x64, 0x0c, 0x7b, 0x26, 0xe0, 0x1b, 0xe5, 0x98, 0x64, 0x0c, 0xed,
+	0x41, 0xc7, 0xda, 0x9c, 0xe2, 0x56, 0xe8, 0xf4, 0x37, 0xe3, 0xb6, 0x39, 0x8f, 0x28, 0x7a, 0x07,
+	0x5b, 0xd6, 0x12, 0xb2, 0x10, 0x4c, 0x2a, 0xce, 0x4a, 0xbc, 0x1e, 0x3a, 0xfd, 0x20, 0x0a, 0x07,
+	0x99, 0x98, 0x0e, 0x6e, 0xde, 0x6a, 0xf0, 0xe1, 0x2a, 0x17, 0xdf, 0x31, 0x95, 0xd7, 0x02, 0x7a,
+	0x06, 0xff, 0xdb, 0x87, 0x95, 0x8a, 0xe4, 0x94, 0x48, 0x8a, 0xdb, 0xa6, 0x15, 0x7b, 0xc5, 0x8f,
+	0x95, 0x88, 0x1e, 0x82, 0xed, 0x2d, 0x39, 0x91, 0x1c, 0x77, 0x4c, 0xc2, 0xf6, 0xf7, 0x49, 0x72,
+	0x74, 0x00, 0xf7, 0x94, 0x24, 0x79, 0x49, 0xec, 0xd8, 0x14, 0xcf, 0x58, 0xa9, 0x48, 0x26, 0xb0,
+	0x6f, 0x82, 0x3b, 0x0d, 0x73, 0x5c, 0x7b, 0xe8, 0x15, 0xec, 0xdd, 0x5a, 0x94, 0xf0, 0x5c, 0x61,
+	0x08, 0x9d, 0xbe, 0x1b, 0xef, 0xde, 0x56, 0x38, 0xca, 0x95, 0x9e, 0x5d, 0x71, 0x96, 0x33, 0x99,
+	0x10, 0x4a, 0x25, 0x0e, 0xec, 0xec, 0x8c, 0x32, 0xa4, 0x54, 0x3e, 0x38, 0x77, 0x00, 0x1a, 0x57,
+	0xdc, 0x81, 0x96, 0x9e, 0xd4, 0x8b, 0x6a, 0x99, 0xf6, 0x50, 0xab, 0x51, 0xb5, 0x3d, 0x7b, 0x40,
+	0x18, 0xda, 0x5f, 0x4f, 0x48, 0xca, 0xd5, 0xa2, 0xda, 0x59, 0x7d, 0xd4, 0x13, 0x38, 0x63, 0x44,
+	0x58, 0x2c, 0xec, 0xba, 0x3a, 0x56, 0x18, 0x51, 0xf4, 0x18, 0x82, 0xca, 0x54, 0x0b, 0xc1, 0xcc,
+	0xc2, 0xfc, 0x18, 0xac, 0x34, 0x5e, 0x08, 0xd6, 0x3b, 0x77, 0xe1, 0xee, 0xd1, 0xe1, 0x58, 0x4f,
+	0x6c, 0x38, 0x53, 0xfc, 0x94, 0xab, 0xc5, 0x24, 0x42, 0x08, 0x3c, 0x93, 0xb7, 0x8d, 0x99, 0xff,
+	0xe8, 0x09, 0x6c, 0xcc, 0x65, 0x91, 0x99, 0xab, 0xb1, 0xb2, 0xac, 0xe1, 0xd2, 0xda, 0xd0, 0x4a,
+	0x16, 0x9d, 0xab, 0x80, 0x5b, 0xa3, 0x53, 0xdb, 0x2b, 0x10, 0x7b, 0xb7, 0x40, 0xbc, 0x8c, 0x5f,
+	0xeb, 0x26, 0x7e, 0x2b, 0x8c, 0xaf, 0xaf, 0x32, 0xde, 0x60, 0x22, 0xc5, 0xed, 0x25, 0x26, 0xd2,
+	0x3f, 0x33, 0xd1, 0xf9, 0x57, 0x26, 0xfc, 0xbf, 0x33, 0xb1, 0x0f, 0xdb, 0xcd, 0xda, 0x53, 0x26,
+	0x4b, 0xfd, 0xe6, 0x69, 0x92, 0xbc, 0x18, 0x35, 0xac, 0x89, 0x75, 0x7a, 0x17, 0x0e, 0x6c, 0x2f,
+	0xaf, 0x84, 0xb3, 0x72, 0x12, 0xe9, 0xc9, 0x64, 0x3c, 0x57, 0x09, 0x3b, 0x65, 0xb9, 0x32, 0xab,
+	0xe9, 0xc4, 0xbe, 0x56, 0xde, 0x68, 0x01, 0x3d, 0x07, 0xdb, 0xfb, 0x9c, 0xc9, 0xa4, 0x98, 0xeb,
+	0x5f, 0x1b, 0x5c, 0x33, 0x41, 0x54, 0x7b, 0xef, 0xb5, 0xb5, 0x5a, 0x31, 0x4b, 0x09, 0xcf, 0xaa,
+	0x0a, 0x77, 0xb9, 0xe2, 0xb5, 0xb6, 0x6c, 0xc5, 0x4b, 0x00, 0x72, 0xd5, 0x12, 0xf6, 0x42, 0xb7,
+	0x1f, 0x44, 0xf7, 0xab, 0x77, 0xfb, 0x06, 0x43, 0x71, 0x23, 0x79, 0xb8, 0xfb, 0xfd, 0xb2, 0xeb,
+	0x5c, 0x5c, 0x76, 0x9d, 0x1f, 0x97, 0x5d, 0xe7, 0xdb, 0xcf, 0xee, 0x7f, 0x9f, 0x5b, 0x83, 0xfd,
+	0x4c, 0x4c, 0xa7, 0xeb, 0xe6, 0xc3, 0x77, 0xf0, 0x7b, 0x00, 0xb2, 0x31, 0xda, 0x4a, 0x08, 0x05,
 	0x00, 0x00,
 }
 
@@ -485,6 +494,13 @@ func (m *DBAptosNFTNodeV2) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.OwnerAddr) > 0 {
+		i -= len(m.OwnerAddr)
+		copy(dAtA[i:], m.OwnerAddr)
+		i = encodeVarintDbNft(dAtA, i, uint64(len(m.OwnerAddr)))
+		i--
+		dAtA[i] = 0x5a
+	}
 	if m.TransactionTimestampInt != 0 {
 		i = encodeVarintDbNft(dAtA, i, uint64(m.TransactionTimestampInt))
 		i--
@@ -840,6 +856,10 @@ func (m *DBAptosNFTNodeV2) Size() (n int) {
 	if m.TransactionTimestampInt != 0 {
 		n += 1 + sovDbNft(uint64(m.TransactionTimestampInt))
 	}
+	l = len(m.OwnerAddr)
+	if l > 0 {
+		n += 1 + l + sovDbNft(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1288,6 +1308,38 @@ func (m *DBAptosNFTNodeV2) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDbNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDbNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDbNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OwnerAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDbNft(dAtA[iNdEx:])
@@ -46,11 +46,54 @@ const (
 	ErrCode_ERR_NEW_PASSWD_SAME_WITH_OLD_PASSWD ErrCode = 111
 	ErrCode_ERR_EMAIL_VERIFICATION_CODE         ErrCode = 112
 	ErrCode_ERR_EMAIL_NOT_EXIST                 ErrCode = 113
+	ErrCode_ERR_SESSION_NOT_EXIST               ErrCode = 114
+	ErrCode_ERR_TOTP_ENABLED                    ErrCode = 115
+	ErrCode_ERR_TOTP_NOT_ENABLED                ErrCode = 116
+	ErrCode_ERR_TOTP_REQUIRED                   ErrCode = 117
+	ErrCode_ERR_TOTP_CODE                       ErrCode = 118
+	ErrCode_ERR_TOTP_TOO_MANY_ATTEMPTS          ErrCode = 119
+	ErrCode_ERR_WALLET_BOUND                    ErrCode = 120
+	ErrCode_ERR_ACC_BOUND_WALLET                ErrCode = 121
+	ErrCode_ERR_WALLET_LIMIT                    ErrCode = 122
+	ErrCode_ERR_WALLET_NOT_LINKED               ErrCode = 123
+	ErrCode_ERR_WALLET_LAST_LOGIN_METHOD        ErrCode = 124
+	ErrCode_ERR_APTOS_AUTH_KEY                  ErrCode = 125
+	ErrCode_ERR_APTOS_MESSAGE                   ErrCode = 126
+	ErrCode_ERR_APTOS_MESSAGE_EXPIRED           ErrCode = 127
+	ErrCode_ERR_NICKNAME_INVALID                ErrCode = 128
+	ErrCode_ERR_NICKNAME_SENSITIVE              ErrCode = 129
+	ErrCode_ERR_NICKNAME_EXIST                  ErrCode = 130
+	ErrCode_ERR_PROFILE_COOLDOWN                ErrCode = 131
+	ErrCode_ERR_ICON_INVALID                    ErrCode = 132
+	ErrCode_ERR_GUEST_LIMIT                     ErrCode = 133
+	ErrCode_ERR_NOT_GUEST                       ErrCode = 134
+	ErrCode_ERR_LOGIN_LOCKED                    ErrCode = 135
+	ErrCode_ERR_ACCOUNT_BANNED                  ErrCode = 136
+	ErrCode_ERR_ACCOUNT_MUTED                   ErrCode = 137
+	ErrCode_ERR_REFERRER_CODE                   ErrCode = 138
+	ErrCode_ERR_PLATFORM_TOKEN                  ErrCode = 139
+	ErrCode_ERR_PLATFORM_NOT_SUPPORTED          ErrCode = 140
+	ErrCode_ERR_PLATFORM_LINKED                 ErrCode = 141
+	ErrCode_ERR_ACCOUNT_MERGE_CONFLICT          ErrCode = 142
+	ErrCode_ERR_PHONE_NUMBER                    ErrCode = 143
+	ErrCode_ERR_SMS_SEND_MAX                    ErrCode = 144
+	ErrCode_ERR_SMS_VERIFICATION_CODE           ErrCode = 145
+	ErrCode_ERR_PHONE_BOUND                     ErrCode = 146
+	ErrCode_ERR_ACC_BOUND_PHONE                 ErrCode = 147
+	ErrCode_ERR_PHONE_NOT_EXIST                 ErrCode = 148
 	// nft
 	ErrCode_ERR_PARSE_NFT_ID ErrCode = 301
 	ErrCode_ERR_NFT_TOKEN_ID ErrCode = 302
 	ErrCode_ERR_NFT_NO_OWNER ErrCode = 303
 	ErrCode_ERR_NFT_HASH_ID  ErrCode = 304
+	// apiproxy
+	ErrCode_ERR_EMAIL_MESSAGE_NOT_EXIST ErrCode = 401
+	ErrCode_ERR_SMS_SEND                ErrCode = 402
+	ErrCode_ERR_UPSTREAM_UNAVAILABLE    ErrCode = 403
+	ErrCode_ERR_UPSTREAM_TIMEOUT        ErrCode = 404
+	ErrCode_ERR_UPSTREAM_RATE_LIMITED   ErrCode = 405
+	ErrCode_ERR_UPSTREAM_NOT_FOUND      ErrCode = 406
+	ErrCode_ERR_UPSTREAM_BAD_RESPONSE   ErrCode = 407
 	// gm
 	ErrCode_ERR_ADMIN_ACCOUNT_OR_PASSWD ErrCode = 501
 	ErrCode_ERR_UNKNOWN                 ErrCode = 9999
@@ -81,10 +124,52 @@ var (
 		111:  "ERR_NEW_PASSWD_SAME_WITH_OLD_PASSWD",
 		112:  "ERR_EMAIL_VERIFICATION_CODE",
 		113:  "ERR_EMAIL_NOT_EXIST",
+		114:  "ERR_SESSION_NOT_EXIST",
+		115:  "ERR_TOTP_ENABLED",
+		116:  "ERR_TOTP_NOT_ENABLED",
+		117:  "ERR_TOTP_REQUIRED",
+		118:  "ERR_TOTP_CODE",
+		119:  "ERR_TOTP_TOO_MANY_ATTEMPTS",
+		120:  "ERR_WALLET_BOUND",
+		121:  "ERR_ACC_BOUND_WALLET",
+		122:  "ERR_WALLET_LIMIT",
+		123:  "ERR_WALLET_NOT_LINKED",
+		124:  "ERR_WALLET_LAST_LOGIN_METHOD",
+		125:  "ERR_APTOS_AUTH_KEY",
+		126:  "ERR_APTOS_MESSAGE",
+		127:  "ERR_APTOS_MESSAGE_EXPIRED",
+		128:  "ERR_NICKNAME_INVALID",
+		129:  "ERR_NICKNAME_SENSITIVE",
+		130:  "ERR_NICKNAME_EXIST",
+		131:  "ERR_PROFILE_COOLDOWN",
+		132:  "ERR_ICON_INVALID",
+		133:  "ERR_GUEST_LIMIT",
+		134:  "ERR_NOT_GUEST",
+		135:  "ERR_LOGIN_LOCKED",
+		136:  "ERR_ACCOUNT_BANNED",
+		137:  "ERR_ACCOUNT_MUTED",
+		138:  "ERR_REFERRER_CODE",
+		139:  "ERR_PLATFORM_TOKEN",
+		140:  "ERR_PLATFORM_NOT_SUPPORTED",
+		141:  "ERR_PLATFORM_LINKED",
+		142:  "ERR_ACCOUNT_MERGE_CONFLICT",
+		143:  "ERR_PHONE_NUMBER",
+		144:  "ERR_SMS_SEND_MAX",
+		145:  "ERR_SMS_VERIFICATION_CODE",
+		146:  "ERR_PHONE_BOUND",
+		147:  "ERR_ACC_BOUND_PHONE",
+		148:  "ERR_PHONE_NOT_EXIST",
 		301:  "ERR_PARSE_NFT_ID",
 		302:  "ERR_NFT_TOKEN_ID",
 		303:  "ERR_NFT_NO_OWNER",
 		304:  "ERR_NFT_HASH_ID",
+		401:  "ERR_EMAIL_MESSAGE_NOT_EXIST",
+		402:  "ERR_SMS_SEND",
+		403:  "ERR_UPSTREAM_UNAVAILABLE",
+		404:  "ERR_UPSTREAM_TIMEOUT",
+		405:  "ERR_UPSTREAM_RATE_LIMITED",
+		406:  "ERR_UPSTREAM_NOT_FOUND",
+		407:  "ERR_UPSTREAM_BAD_RESPONSE",
 		501:  "ERR_ADMIN_ACCOUNT_OR_PASSWD",
 		9999: "ERR_UNKNOWN",
 	}
@@ -111,10 +196,52 @@ var (
 		"ERR_NEW_PASSWD_SAME_WITH_OLD_PASSWD": 111,
 		"ERR_EMAIL_VERIFICATION_CODE":         112,
 		"ERR_EMAIL_NOT_EXIST":                 113,
+		"ERR_SESSION_NOT_EXIST":               114,
+		"ERR_TOTP_ENABLED":                    115,
+		"ERR_TOTP_NOT_ENABLED":                116,
+		"ERR_TOTP_REQUIRED":                   117,
+		"ERR_TOTP_CODE":                       118,
+		"ERR_TOTP_TOO_MANY_ATTEMPTS":          119,
+		"ERR_WALLET_BOUND":                    120,
+		"ERR_ACC_BOUND_WALLET":                121,
+		"ERR_WALLET_LIMIT":                    122,
+		"ERR_WALLET_NOT_LINKED":               123,
+		"ERR_WALLET_LAST_LOGIN_METHOD":        124,
+		"ERR_APTOS_AUTH_KEY":                  125,
+		"ERR_APTOS_MESSAGE":                   126,
+		"ERR_APTOS_MESSAGE_EXPIRED":           127,
+		"ERR_NICKNAME_INVALID":                128,
+		"ERR_NICKNAME_SENSITIVE":              129,
+		"ERR_NICKNAME_EXIST":                  130,
+		"ERR_PROFILE_COOLDOWN":                131,
+		"ERR_ICON_INVALID":                    132,
+		"ERR_GUEST_LIMIT":                     133,
+		"ERR_NOT_GUEST":                       134,
+		"ERR_LOGIN_LOCKED":                    135,
+		"ERR_ACCOUNT_BANNED":                  136,
+		"ERR_ACCOUNT_MUTED":                   137,
+		"ERR_REFERRER_CODE":                   138,
+		"ERR_PLATFORM_TOKEN":                  139,
+		"ERR_PLATFORM_NOT_SUPPORTED":          140,
+		"ERR_PLATFORM_LINKED":                 141,
+		"ERR_ACCOUNT_MERGE_CONFLICT":          142,
+		"ERR_PHONE_NUMBER":                    143,
+		"ERR_SMS_SEND_MAX":                    144,
+		"ERR_SMS_VERIFICATION_CODE":           145,
+		"ERR_PHONE_BOUND":                     146,
+		"ERR_ACC_BOUND_PHONE":                 147,
+		"ERR_PHONE_NOT_EXIST":                 148,
 		"ERR_PARSE_NFT_ID":                    301,
 		"ERR_NFT_TOKEN_ID":                    302,
 		"ERR_NFT_NO_OWNER":                    303,
 		"ERR_NFT_HASH_ID":                     304,
+		"ERR_EMAIL_MESSAGE_NOT_EXIST":         401,
+		"ERR_SMS_SEND":                        402,
+		"ERR_UPSTREAM_UNAVAILABLE":            403,
+		"ERR_UPSTREAM_TIMEOUT":                404,
+		"ERR_UPSTREAM_RATE_LIMITED":           405,
+		"ERR_UPSTREAM_NOT_FOUND":              406,
+		"ERR_UPSTREAM_BAD_RESPONSE":           407,
 		"ERR_ADMIN_ACCOUNT_OR_PASSWD":         501,
 		"ERR_UNKNOWN":                         9999,
 	}
@@ -217,7 +344,7 @@ var file_errors_proto_rawDesc = []byte{
 	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
 	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
 	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x2a,
-	0x8e, 0x05, 0x0a, 0x07, 0x45, 0x72, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x0a, 0x0a, 0x06, 0x45,
+	0xda, 0x0d, 0x0a, 0x07, 0x45, 0x72, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x0a, 0x0a, 0x06, 0x45,
 	0x52, 0x52, 0x5f, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x45, 0x52, 0x52, 0x5f, 0x43,
 	0x4d, 0x44, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x45, 0x52, 0x52, 0x5f, 0x50, 0x41, 0x52, 0x41,
 	0x4d, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x45, 0x52, 0x52, 0x5f, 0x44, 0x42, 0x10, 0x04, 0x12,
@@ -249,17 +376,85 @@ var file_errors_proto_rawDesc = []byte{
 	0x4d, 0x41, 0x49, 0x4c, 0x5f, 0x56, 0x45, 0x52, 0x49, 0x46, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f,
 	0x4e, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x10, 0x70, 0x12, 0x17, 0x0a, 0x13, 0x45, 0x52, 0x52, 0x5f,
 	0x45, 0x4d, 0x41, 0x49, 0x4c, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x45, 0x58, 0x49, 0x53, 0x54, 0x10,
-	0x71, 0x12, 0x15, 0x0a, 0x10, 0x45, 0x52, 0x52, 0x5f, 0x50, 0x41, 0x52, 0x53, 0x45, 0x5f, 0x4e,
+	0x71, 0x12, 0x19, 0x0a, 0x15, 0x45, 0x52, 0x52, 0x5f, 0x53, 0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e,
+	0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x45, 0x58, 0x49, 0x53, 0x54, 0x10, 0x72, 0x12, 0x14, 0x0a, 0x10,
+	0x45, 0x52, 0x52, 0x5f, 0x54, 0x4f, 0x54, 0x50, 0x5f, 0x45, 0x4e, 0x41, 0x42, 0x4c, 0x45, 0x44,
+	0x10, 0x73, 0x12, 0x18, 0x0a, 0x14, 0x45, 0x52, 0x52, 0x5f, 0x54, 0x4f, 0x54, 0x50, 0x5f, 0x4e,
+	0x4f, 0x54, 0x5f, 0x45, 0x4e, 0x41, 0x42, 0x4c, 0x45, 0x44, 0x10, 0x74, 0x12, 0x15, 0x0a, 0x11,
+	0x45, 0x52, 0x52, 0x5f, 0x54, 0x4f, 0x54, 0x50, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45,
+	0x44, 0x10, 0x75, 0x12, 0x11, 0x0a, 0x0d, 0x45, 0x52, 0x52, 0x5f, 0x54, 0x4f, 0x54, 0x50, 0x5f,
+	0x43, 0x4f, 0x44, 0x45, 0x10, 0x76, 0x12, 0x1e, 0x0a, 0x1a, 0x45, 0x52, 0x52, 0x5f, 0x54, 0x4f,
+	0x54, 0x50, 0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x4d, 0x41, 0x4e, 0x59, 0x5f, 0x41, 0x54, 0x54, 0x45,
+	0x4d, 0x50, 0x54, 0x53, 0x10, 0x77, 0x12, 0x14, 0x0a, 0x10, 0x45, 0x52, 0x52, 0x5f, 0x57, 0x41,
+	0x4c, 0x4c, 0x45, 0x54, 0x5f, 0x42, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x78, 0x12, 0x18, 0x0a, 0x14,
+	0x45, 0x52, 0x52, 0x5f, 0x41, 0x43, 0x43, 0x5f, 0x42, 0x4f, 0x55, 0x4e, 0x44, 0x5f, 0x57, 0x41,
+	0x4c, 0x4c, 0x45, 0x54, 0x10, 0x79, 0x12, 0x14, 0x0a, 0x10, 0x45, 0x52, 0x52, 0x5f, 0x57, 0x41,
+	0x4c, 0x4c, 0x45, 0x54, 0x5f, 0x4c, 0x49, 0x4d, 0x49, 0x54, 0x10, 0x7a, 0x12, 0x19, 0x0a, 0x15,
+	0x45, 0x52, 0x52, 0x5f, 0x57, 0x41, 0x4c, 0x4c, 0x45, 0x54, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x4c,
+	0x49, 0x4e, 0x4b, 0x45, 0x44, 0x10, 0x7b, 0x12, 0x20, 0x0a, 0x1c, 0x45, 0x52, 0x52, 0x5f, 0x57,
+	0x41, 0x4c, 0x4c, 0x45, 0x54, 0x5f, 0x4c, 0x41, 0x53, 0x54, 0x5f, 0x4c, 0x4f, 0x47, 0x49, 0x4e,
+	0x5f, 0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44, 0x10, 0x7c, 0x12, 0x16, 0x0a, 0x12, 0x45, 0x52, 0x52,
+	0x5f, 0x41, 0x50, 0x54, 0x4f, 0x53, 0x5f, 0x41, 0x55, 0x54, 0x48, 0x5f, 0x4b, 0x45, 0x59, 0x10,
+	0x7d, 0x12, 0x15, 0x0a, 0x11, 0x45, 0x52, 0x52, 0x5f, 0x41, 0x50, 0x54, 0x4f, 0x53, 0x5f, 0x4d,
+	0x45, 0x53, 0x53, 0x41, 0x47, 0x45, 0x10, 0x7e, 0x12, 0x1d, 0x0a, 0x19, 0x45, 0x52, 0x52, 0x5f,
+	0x41, 0x50, 0x54, 0x4f, 0x53, 0x5f, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47, 0x45, 0x5f, 0x45, 0x58,
+	0x50, 0x49, 0x52, 0x45, 0x44, 0x10, 0x7f, 0x12, 0x19, 0x0a, 0x14, 0x45, 0x52, 0x52, 0x5f, 0x4e,
+	0x49, 0x43, 0x4b, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x10,
+	0x80, 0x01, 0x12, 0x1b, 0x0a, 0x16, 0x45, 0x52, 0x52, 0x5f, 0x4e, 0x49, 0x43, 0x4b, 0x4e, 0x41,
+	0x4d, 0x45, 0x5f, 0x53, 0x45, 0x4e, 0x53, 0x49, 0x54, 0x49, 0x56, 0x45, 0x10, 0x81, 0x01, 0x12,
+	0x17, 0x0a, 0x12, 0x45, 0x52, 0x52, 0x5f, 0x4e, 0x49, 0x43, 0x4b, 0x4e, 0x41, 0x4d, 0x45, 0x5f,
+	0x45, 0x58, 0x49, 0x53, 0x54, 0x10, 0x82, 0x01, 0x12, 0x19, 0x0a, 0x14, 0x45, 0x52, 0x52, 0x5f,
+	0x50, 0x52, 0x4f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x43, 0x4f, 0x4f, 0x4c, 0x44, 0x4f, 0x57, 0x4e,
+	0x10, 0x83, 0x01, 0x12, 0x15, 0x0a, 0x10, 0x45, 0x52, 0x52, 0x5f, 0x49, 0x43, 0x4f, 0x4e, 0x5f,
+	0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x10, 0x84, 0x01, 0x12, 0x14, 0x0a, 0x0f, 0x45, 0x52,
+	0x52, 0x5f, 0x47, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x4c, 0x49, 0x4d, 0x49, 0x54, 0x10, 0x85, 0x01,
+	0x12, 0x12, 0x0a, 0x0d, 0x45, 0x52, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x47, 0x55, 0x45, 0x53,
+	0x54, 0x10, 0x86, 0x01, 0x12, 0x15, 0x0a, 0x10, 0x45, 0x52, 0x52, 0x5f, 0x4c, 0x4f, 0x47, 0x49,
+	0x4e, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x45, 0x44, 0x10, 0x87, 0x01, 0x12, 0x17, 0x0a, 0x12, 0x45,
+	0x52, 0x52, 0x5f, 0x41, 0x43, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x5f, 0x42, 0x41, 0x4e, 0x4e, 0x45,
+	0x44, 0x10, 0x88, 0x01, 0x12, 0x16, 0x0a, 0x11, 0x45, 0x52, 0x52, 0x5f, 0x41, 0x43, 0x43, 0x4f,
+	0x55, 0x4e, 0x54, 0x5f, 0x4d, 0x55, 0x54, 0x45, 0x44, 0x10, 0x89, 0x01, 0x12, 0x16, 0x0a, 0x11,
+	0x45, 0x52, 0x52, 0x5f, 0x52, 0x45, 0x46, 0x45, 0x52, 0x52, 0x45, 0x52, 0x5f, 0x43, 0x4f, 0x44,
+	0x45, 0x10, 0x8a, 0x01, 0x12, 0x17, 0x0a, 0x12, 0x45, 0x52, 0x52, 0x5f, 0x50, 0x4c, 0x41, 0x54,
+	0x46, 0x4f, 0x52, 0x4d, 0x5f, 0x54, 0x4f, 0x4b, 0x45, 0x4e, 0x10, 0x8b, 0x01, 0x12, 0x1f, 0x0a,
+	0x1a, 0x45, 0x52, 0x52, 0x5f, 0x50, 0x4c, 0x41, 0x54, 0x46, 0x4f, 0x52, 0x4d, 0x5f, 0x4e, 0x4f,
+	0x54, 0x5f, 0x53, 0x55, 0x50, 0x50, 0x4f, 0x52, 0x54, 0x45, 0x44, 0x10, 0x8c, 0x01, 0x12, 0x18,
+	0x0a, 0x13, 0x45, 0x52, 0x52, 0x5f, 0x50, 0x4c, 0x41, 0x54, 0x46, 0x4f, 0x52, 0x4d, 0x5f, 0x4c,
+	0x49, 0x4e, 0x4b, 0x45, 0x44, 0x10, 0x8d, 0x01, 0x12, 0x1f, 0x0a, 0x1a, 0x45, 0x52, 0x52, 0x5f,
+	0x41, 0x43, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x5f, 0x4d, 0x45, 0x52, 0x47, 0x45, 0x5f, 0x43, 0x4f,
+	0x4e, 0x46, 0x4c, 0x49, 0x43, 0x54, 0x10, 0x8e, 0x01, 0x12, 0x15, 0x0a, 0x10, 0x45, 0x52, 0x52,
+	0x5f, 0x50, 0x48, 0x4f, 0x4e, 0x45, 0x5f, 0x4e, 0x55, 0x4d, 0x42, 0x45, 0x52, 0x10, 0x8f, 0x01,
+	0x12, 0x15, 0x0a, 0x10, 0x45, 0x52, 0x52, 0x5f, 0x53, 0x4d, 0x53, 0x5f, 0x53, 0x45, 0x4e, 0x44,
+	0x5f, 0x4d, 0x41, 0x58, 0x10, 0x90, 0x01, 0x12, 0x1e, 0x0a, 0x19, 0x45, 0x52, 0x52, 0x5f, 0x53,
+	0x4d, 0x53, 0x5f, 0x56, 0x45, 0x52, 0x49, 0x46, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x43, 0x4f, 0x44, 0x45, 0x10, 0x91, 0x01, 0x12, 0x14, 0x0a, 0x0f, 0x45, 0x52, 0x52, 0x5f, 0x50,
+	0x48, 0x4f, 0x4e, 0x45, 0x5f, 0x42, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x92, 0x01, 0x12, 0x18, 0x0a,
+	0x13, 0x45, 0x52, 0x52, 0x5f, 0x41, 0x43, 0x43, 0x5f, 0x42, 0x4f, 0x55, 0x4e, 0x44, 0x5f, 0x50,
+	0x48, 0x4f, 0x4e, 0x45, 0x10, 0x93, 0x01, 0x12, 0x18, 0x0a, 0x13, 0x45, 0x52, 0x52, 0x5f, 0x50,
+	0x48, 0x4f, 0x4e, 0x45, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x45, 0x58, 0x49, 0x53, 0x54, 0x10, 0x94,
+	0x01, 0x12, 0x15, 0x0a, 0x10, 0x45, 0x52, 0x52, 0x5f, 0x50, 0x41, 0x52, 0x53, 0x45, 0x5f, 0x4e,
 	0x46, 0x54, 0x5f, 0x49, 0x44, 0x10, 0xad, 0x02, 0x12, 0x15, 0x0a, 0x10, 0x45, 0x52, 0x52, 0x5f,
 	0x4e, 0x46, 0x54, 0x5f, 0x54, 0x4f, 0x4b, 0x45, 0x4e, 0x5f, 0x49, 0x44, 0x10, 0xae, 0x02, 0x12,
 	0x15, 0x0a, 0x10, 0x45, 0x52, 0x52, 0x5f, 0x4e, 0x46, 0x54, 0x5f, 0x4e, 0x4f, 0x5f, 0x4f, 0x57,
 	0x4e, 0x45, 0x52, 0x10, 0xaf, 0x02, 0x12, 0x14, 0x0a, 0x0f, 0x45, 0x52, 0x52, 0x5f, 0x4e, 0x46,
 	0x54, 0x5f, 0x48, 0x41, 0x53, 0x48, 0x5f, 0x49, 0x44, 0x10, 0xb0, 0x02, 0x12, 0x20, 0x0a, 0x1b,
-	0x45, 0x52, 0x52, 0x5f, 0x41, 0x44, 0x4d, 0x49, 0x4e, 0x5f, 0x41, 0x43, 0x43, 0x4f, 0x55, 0x4e,
-	0x54, 0x5f, 0x4f, 0x52, 0x5f, 0x50, 0x41, 0x53, 0x53, 0x57, 0x44, 0x10, 0xf5, 0x03, 0x12, 0x10,
-	0x0a, 0x0b, 0x45, 0x52, 0x52, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x8f, 0x4e,
-	0x42, 0x07, 0x5a, 0x05, 0x2e, 0x2f, 0x6d, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+	0x45, 0x52, 0x52, 0x5f, 0x45, 0x4d, 0x41, 0x49, 0x4c, 0x5f, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47,
+	0x45, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x45, 0x58, 0x49, 0x53, 0x54, 0x10, 0x91, 0x03, 0x12, 0x11,
+	0x0a, 0x0c, 0x45, 0x52, 0x52, 0x5f, 0x53, 0x4d, 0x53, 0x5f, 0x53, 0x45, 0x4e, 0x44, 0x10, 0x92,
+	0x03, 0x12, 0x1d, 0x0a, 0x18, 0x45, 0x52, 0x52, 0x5f, 0x55, 0x50, 0x53, 0x54, 0x52, 0x45, 0x41,
+	0x4d, 0x5f, 0x55, 0x4e, 0x41, 0x56, 0x41, 0x49, 0x4c, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x93, 0x03,
+	0x12, 0x19, 0x0a, 0x14, 0x45, 0x52, 0x52, 0x5f, 0x55, 0x50, 0x53, 0x54, 0x52, 0x45, 0x41, 0x4d,
+	0x5f, 0x54, 0x49, 0x4d, 0x45, 0x4f, 0x55, 0x54, 0x10, 0x94, 0x03, 0x12, 0x1e, 0x0a, 0x19, 0x45,
+	0x52, 0x52, 0x5f, 0x55, 0x50, 0x53, 0x54, 0x52, 0x45, 0x41, 0x4d, 0x5f, 0x52, 0x41, 0x54, 0x45,
+	0x5f, 0x4c, 0x49, 0x4d, 0x49, 0x54, 0x45, 0x44, 0x10, 0x95, 0x03, 0x12, 0x1b, 0x0a, 0x16, 0x45,
+	0x52, 0x52, 0x5f, 0x55, 0x50, 0x53, 0x54, 0x52, 0x45, 0x41, 0x4d, 0x5f, 0x4e, 0x4f, 0x54, 0x5f,
+	0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x96, 0x03, 0x12, 0x1e, 0x0a, 0x19, 0x45, 0x52, 0x52, 0x5f,
+	0x55, 0x50, 0x53, 0x54, 0x52, 0x45, 0x41, 0x4d, 0x5f, 0x42, 0x41, 0x44, 0x5f, 0x52, 0x45, 0x53,
+	0x50, 0x4f, 0x4e, 0x53, 0x45, 0x10, 0x97, 0x03, 0x12, 0x20, 0x0a, 0x1b, 0x45, 0x52, 0x52, 0x5f,
+	0x41, 0x44, 0x4d, 0x49, 0x4e, 0x5f, 0x41, 0x43, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x5f, 0x4f, 0x52,
+	0x5f, 0x50, 0x41, 0x53, 0x53, 0x57, 0x44, 0x10, 0xf5, 0x03, 0x12, 0x10, 0x0a, 0x0b, 0x45, 0x52,
+	0x52, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x8f, 0x4e, 0x42, 0x07, 0x5a, 0x05,
+	0x2e, 0x2f, 0x6d, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -20,6 +20,110 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type EAccountRepair_Action int32
+
+const (
+	EAccountRepair_Action_None        EAccountRepair_Action = 0
+	EAccountRepair_Action_AdoptWallet EAccountRepair_Action = 1 // the wallet index was lost, it is pointed back to the account
+	EAccountRepair_Action_MergeOrphan EAccountRepair_Action = 2 // a duplicated account of the wallet, merged into the account of the wallet index
+	EAccountRepair_Action_Conflict    EAccountRepair_Action = 3 // a duplicated account with its own email or wallets, needs to be merged manually
+)
+
+// Enum value maps for EAccountRepair_Action.
+var (
+	EAccountRepair_Action_name = map[int32]string{
+		0: "Action_None",
+		1: "Action_AdoptWallet",
+		2: "Action_MergeOrphan",
+		3: "Action_Conflict",
+	}
+	EAccountRepair_Action_value = map[string]int32{
+		"Action_None":        0,
+		"Action_AdoptWallet": 1,
+		"Action_MergeOrphan": 2,
+		"Action_Conflict":    3,
+	}
+)
+
+func (x EAccountRepair_Action) Enum() *EAccountRepair_Action {
+	p := new(EAccountRepair_Action)
+	*p = x
+	return p
+}
+
+func (x EAccountRepair_Action) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EAccountRepair_Action) Descriptor() protoreflect.EnumDescriptor {
+	return file_grpc_account_proto_enumTypes[0].Descriptor()
+}
+
+func (EAccountRepair_Action) Type() protoreflect.EnumType {
+	return &file_grpc_account_proto_enumTypes[0]
+}
+
+func (x EAccountRepair_Action) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EAccountRepair_Action.Descriptor instead.
+func (EAccountRepair_Action) EnumDescriptor() ([]byte, []int) {
+	return file_grpc_account_proto_rawDescGZIP(), []int{37, 0}
+}
+
+type ELoginLock_Type int32
+
+const (
+	ELoginLock_Type_None      ELoginLock_Type = 0
+	ELoginLock_Type_Account   ELoginLock_Type = 1 // every ip, a higher threshold and a short lock to slow down password sprays
+	ELoginLock_Type_IP        ELoginLock_Type = 2
+	ELoginLock_Type_AccountIP ELoginLock_Type = 3 // the target is account|ip
+)
+
+// Enum value maps for ELoginLock_Type.
+var (
+	ELoginLock_Type_name = map[int32]string{
+		0: "Type_None",
+		1: "Type_Account",
+		2: "Type_IP",
+		3: "Type_AccountIP",
+	}
+	ELoginLock_Type_value = map[string]int32{
+		"Type_None":      0,
+		"Type_Account":   1,
+		"Type_IP":        2,
+		"Type_AccountIP": 3,
+	}
+)
+
+func (x ELoginLock_Type) Enum() *ELoginLock_Type {
+	p := new(ELoginLock_Type)
+	*p = x
+	return p
+}
+
+func (x ELoginLock_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ELoginLock_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_grpc_account_proto_enumTypes[1].Descriptor()
+}
+
+func (ELoginLock_Type) Type() protoreflect.EnumType {
+	return &file_grpc_account_proto_enumTypes[1]
+}
+
+func (x ELoginLock_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ELoginLock_Type.Descriptor instead.
+func (ELoginLock_Type) EnumDescriptor() ([]byte, []int) {
+	return file_grpc_account_proto_rawDescGZIP(), []int{48, 0}
+}
+
 type ReqLoginByPassword struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -29,6 +133,8 @@ type ReqLoginByPassword struct {
 	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
 	RemoteIp string `protobuf:"bytes,3,opt,name=remote_ip,json=remoteIp,proto3" json:"remote_ip,omitempty"`
 	Region   string `protobuf:"bytes,4,opt,name=region,proto3" json:"region,omitempty"`
+	Device   string `protobuf:"bytes,5,opt,name=device,proto3" json:"device,omitempty"`
+	DeviceId string `protobuf:"bytes,6,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
 }
 
 func (x *ReqLoginByPassword) Reset() {
@@ -91,14 +197,31 @@ func (x *ReqLoginByPassword) GetRegion() string {
 	return ""
 }
 
+func (x *ReqLoginByPassword) GetDevice() string {
+	if x != nil {
+		return x.Device
+	}
+	return ""
+}
+
+func (x *ReqLoginByPassword) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
 type ResLoginByPassword struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Account   *AccountInfo `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
-	Resources string       `protobuf:"bytes,2,opt,name=resources,proto3" json:"resources,omitempty"`
-	Token     string       `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+	Account      *AccountInfo `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Resources    string       `protobuf:"bytes,2,opt,name=resources,proto3" json:"resources,omitempty"`
+	Token        string       `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+	RefreshToken string       `protobuf:"bytes,4,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	TotpRequired bool         `protobuf:"varint,5,opt,name=totp_required,json=totpRequired,proto3" json:"totp_required,omitempty"` // password is right, call LoginByTOTP with login_ticket to finish login
+	LoginTicket  string       `protobuf:"bytes,6,opt,name=login_ticket,json=loginTicket,proto3" json:"login_ticket,omitempty"`
 }
 
 func (x *ResLoginByPassword) Reset() {
@@ -154,6 +277,27 @@ func (x *ResLoginByPassword) GetToken() string {
 	return ""
 }
 
+func (x *ResLoginByPassword) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+func (x *ResLoginByPassword) GetTotpRequired() bool {
+	if x != nil {
+		return x.TotpRequired
+	}
+	return false
+}
+
+func (x *ResLoginByPassword) GetLoginTicket() string {
+	if x != nil {
+		return x.LoginTicket
+	}
+	return ""
+}
+
 type ReqGetAccountInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -206,7 +350,7 @@ type ReqRegisterAccount struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Account       string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Account       string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"` // email address
 	Password      string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
 	Device        string `protobuf:"bytes,3,opt,name=device,proto3" json:"device,omitempty"`
 	Os            string `protobuf:"bytes,4,opt,name=os,proto3" json:"os,omitempty"`
@@ -215,6 +359,8 @@ type ReqRegisterAccount struct {
 	Region        string `protobuf:"bytes,7,opt,name=region,proto3" json:"region,omitempty"`
 	RemoteIp      string `protobuf:"bytes,8,opt,name=remote_ip,json=remoteIp,proto3" json:"remote_ip,omitempty"`
 	Platform      string `protobuf:"bytes,9,opt,name=platform,proto3" json:"platform,omitempty"`
+	Code          string `protobuf:"bytes,10,opt,name=code,proto3" json:"code,omitempty"`                                     // email bind code
+	ReferrerCode  string `protobuf:"bytes,11,opt,name=referrer_code,json=referrerCode,proto3" json:"referrer_code,omitempty"` // invite code of the referrer, optional
 }
 
 func (x *ReqRegisterAccount) Reset() {
@@ -312,6 +458,130 @@ func (x *ReqRegisterAccount) GetPlatform() string {
 	return ""
 }
 
+func (x *ReqRegisterAccount) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *ReqRegisterAccount) GetReferrerCode() string {
+	if x != nil {
+		return x.ReferrerCode
+	}
+	return ""
+}
+
+type ResRegisterAccount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Account      *AccountInfo `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Token        string       `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	RefreshToken string       `protobuf:"bytes,3,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+}
+
+func (x *ResRegisterAccount) Reset() {
+	*x = ResRegisterAccount{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_account_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResRegisterAccount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResRegisterAccount) ProtoMessage() {}
+
+func (x *ResRegisterAccount) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_account_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResRegisterAccount.ProtoReflect.Descriptor instead.
+func (*ResRegisterAccount) Descriptor() ([]byte, []int) {
+	return file_grpc_account_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ResRegisterAccount) GetAccount() *AccountInfo {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *ResRegisterAccount) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ResRegisterAccount) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+type ReqGenerateNonce struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WalletAddr string `protobuf:"bytes,1,opt,name=wallet_addr,json=walletAddr,proto3" json:"wallet_addr,omitempty"`
+}
+
+func (x *ReqGenerateNonce) Reset() {
+	*x = ReqGenerateNonce{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_account_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReqGenerateNonce) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReqGenerateNonce) ProtoMessage() {}
+
+func (x *ReqGenerateNonce) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_account_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReqGenerateNonce.ProtoReflect.Descriptor instead.
+func (*ReqGenerateNonce) Descriptor() ([]byte, []int) {
+	return file_grpc_account_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ReqGenerateNonce) GetWalletAddr() string {
+	if x != nil {
+		return x.WalletAddr
+	}
+	return ""
+}
+
 type ResGenerateNonce struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -323,7 +593,7 @@ type ResGenerateNonce struct {
 func (x *ResGenerateNonce) Reset() {
 	*x = ResGenerateNonce{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_grpc_account_proto_msgTypes[4]
+		mi := &file_grpc_account_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -336,7 +606,7 @@ func (x *ResGenerateNonce) String() string {
 func (*ResGenerateNonce) ProtoMessage() {}
 
 func (x *ResGenerateNonce) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_account_proto_msgTypes[4]
+	mi := &file_grpc_account_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -349,7 +619,7 @@ func (x *ResGenerateNonce) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResGenerateNonce.ProtoReflect.Descriptor instead.
 func (*ResGenerateNonce) Descriptor() ([]byte, []int) {
-	return file_grpc_account_proto_rawDescGZIP(), []int{4}
+	return file_grpc_account_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *ResGenerateNonce) GetNonce() string {
@@ -366,17 +636,19 @@ type ReqWebLoginByWallet struct {
 
 	WalletAddr     string `protobuf:"bytes,1,opt,name=wallet_addr,json=walletAddr,proto3" json:"wallet_addr,omitempty"`
 	PubKey         []byte `protobuf:"bytes,2,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
-	Nonce          string `protobuf:"bytes,3,opt,name=nonce,proto3" json:"nonce,omitempty"`
 	RemoteIp       string `protobuf:"bytes,4,opt,name=remote_ip,json=remoteIp,proto3" json:"remote_ip,omitempty"`
 	Region         string `protobuf:"bytes,5,opt,name=region,proto3" json:"region,omitempty"`
 	AptosFullMsg   string `protobuf:"bytes,6,opt,name=aptos_full_msg,json=aptosFullMsg,proto3" json:"aptos_full_msg,omitempty"`
 	AptosSignature string `protobuf:"bytes,7,opt,name=aptos_signature,json=aptosSignature,proto3" json:"aptos_signature,omitempty"`
+	Device         string `protobuf:"bytes,8,opt,name=device,proto3" json:"device,omitempty"`
+	DeviceId       string `protobuf:"bytes,9,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	ReferrerCode   string `protobuf:"bytes,10,opt,name=referrer_code,json=referrerCode,proto3" json:"referrer_code,omitempty"` // invite code of the referrer, only used if the account is created
 }
 
 func (x *ReqWebLoginByWallet) Reset() {
 	*x = ReqWebLoginByWallet{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_grpc_account_proto_msgTypes[5]
+		mi := &file_grpc_account_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -389,7 +661,7 @@ func (x *ReqWebLoginByWallet) String() string {
 func (*ReqWebLoginByWallet) ProtoMessage() {}
 
 func (x *ReqWebLoginByWallet) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_account_proto_msgTypes[5]
+	mi := &file_grpc_account_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -402,7 +674,7 @@ func (x *ReqWebLoginByWallet) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReqWebLoginByWallet.ProtoReflect.Descriptor instead.
 func (*ReqWebLoginByWallet) Descriptor() ([]byte, []int) {
-	return file_grpc_account_proto_rawDescGZIP(), []int{5}
+	return file_grpc_account_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *ReqWebLoginByWallet) GetWalletAddr() string {
@@ -419,13 +691,6 @@ func (x *ReqWebLoginByWallet) GetPubKey() []byte {
 	return nil
 }
 
-func (x *ReqWebLoginByWallet) GetNonce() string {
-	if x != nil {
-		return x.Nonce
-	}
-	return ""
-}
-
 func (x *ReqWebLoginByWallet) GetRemoteIp() string {
 	if x != nil {
 		return x.RemoteIp
@@ -454,20 +719,42 @@ func (x *ReqWebLoginByWallet) GetAptosSignature() string {
 	return ""
 }
 
+func (x *ReqWebLoginByWallet) GetDevice() string {
+	if x != nil {
+		return x.Device
+	}
+	return ""
+}
+
+func (x *ReqWebLoginByWallet) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+func (x *ReqWebLoginByWallet) GetReferrerCode() string {
+	if x != nil {
+		return x.ReferrerCode
+	}
+	return ""
+}
+
 type ResWebLoginByWallet struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Account   *AccountInfo `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
-	Resources string       `protobuf:"bytes,2,opt,name=resources,proto3" json:"resources,omitempty"`
-	Token     string       `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+	Account      *AccountInfo `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Resources    string       `protobuf:"bytes,2,opt,name=resources,proto3" json:"resources,omitempty"`
+	Token        string       `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+	RefreshToken string       `protobuf:"bytes,4,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
 }
 
 func (x *ResWebLoginByWallet) Reset() {
 	*x = ResWebLoginByWallet{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_grpc_account_proto_msgTypes[6]
+		mi := &file_grpc_account_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -480,7 +767,7 @@ func (x *ResWebLoginByWallet) String() string {
 func (*ResWebLoginByWallet) ProtoMessage() {}
 
 func (x *ResWebLoginByWallet) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_account_proto_msgTypes[6]
+	mi := &file_grpc_account_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -493,7 +780,7 @@ func (x *ResWebLoginByWallet) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResWebLoginByWallet.ProtoReflect.Descriptor instead.
 func (*ResWebLoginByWallet) Descriptor() ([]byte, []int) {
-	return file_grpc_account_proto_rawDescGZIP(), []int{6}
+	return file_grpc_account_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *ResWebLoginByWallet) GetAccount() *AccountInfo {
@@ -517,6 +804,13 @@ func (x *ResWebLoginByWallet) GetToken() string {
 	return ""
 }
 
+func (x *ResWebLoginByWallet) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
 type ReqGetAccountInfoByAccount struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -528,7 +822,7 @@ type ReqGetAccountInfoByAccount struct {
 func (x *ReqGetAccountInfoByAccount) Reset() {
 	*x = ReqGetAccountInfoByAccount{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_grpc_account_proto_msgTypes[7]
+		mi := &file_grpc_account_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -541,7 +835,7 @@ func (x *ReqGetAccountInfoByAccount) String() string {
 func (*ReqGetAccountInfoByAccount) ProtoMessage() {}
 
 func (x *ReqGetAccountInfoByAccount) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_account_proto_msgTypes[7]
+	mi := &file_grpc_account_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -554,7 +848,7 @@ func (x *ReqGetAccountInfoByAccount) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReqGetAccountInfoByAccount.ProtoReflect.Descriptor instead.
 func (*ReqGetAccountInfoByAccount) Descriptor() ([]byte, []int) {
-	return file_grpc_account_proto_rawDescGZIP(), []int{7}
+	return file_grpc_account_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *ReqGetAccountInfoByAccount) GetAccount() string {
@@ -569,13 +863,14 @@ type ReqGenerateAndSendEmailBindCode struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Email string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Email    string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Language string `protobuf:"bytes,2,opt,name=language,proto3" json:"language,omitempty"`
 }
 
 func (x *ReqGenerateAndSendEmailBindCode) Reset() {
 	*x = ReqGenerateAndSendEmailBindCode{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_grpc_account_proto_msgTypes[8]
+		mi := &file_grpc_account_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -588,7 +883,7 @@ func (x *ReqGenerateAndSendEmailBindCode) String() string {
 func (*ReqGenerateAndSendEmailBindCode) ProtoMessage() {}
 
 func (x *ReqGenerateAndSendEmailBindCode) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_account_proto_msgTypes[8]
+	mi := &file_grpc_account_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -601,7 +896,7 @@ func (x *ReqGenerateAndSendEmailBindCode) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReqGenerateAndSendEmailBindCode.ProtoReflect.Descriptor instead.
 func (*ReqGenerateAndSendEmailBindCode) Descriptor() ([]byte, []int) {
-	return file_grpc_account_proto_rawDescGZIP(), []int{8}
+	return file_grpc_account_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *ReqGenerateAndSendEmailBindCode) GetEmail() string {
@@ -611,20 +906,31 @@ func (x *ReqGenerateAndSendEmailBindCode) GetEmail() string {
 	return ""
 }
 
+func (x *ReqGenerateAndSendEmailBindCode) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
 type ReqWebBindEmail struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId uint64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Email  string `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
-	Code   string `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
+	UserId   uint64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Email    string `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Code     string `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
+	Device   string `protobuf:"bytes,4,opt,name=device,proto3" json:"device,omitempty"`
+	DeviceId string `protobuf:"bytes,5,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	RemoteIp string `protobuf:"bytes,6,opt,name=remote_ip,json=remoteIp,proto3" json:"remote_ip,omitempty"`
+	Region   string `protobuf:"bytes,7,opt,name=region,proto3" json:"region,omitempty"`
 }
 
 func (x *ReqWebBindEmail) Reset() {
 	*x = ReqWebBindEmail{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_grpc_account_proto_msgTypes[9]
+		mi := &file_grpc_account_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -637,7 +943,7 @@ func (x *ReqWebBindEmail) String() string {
 func (*ReqWebBindEmail) ProtoMessage() {}
 
 func (x *ReqWebBindEmail) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_account_proto_msgTypes[9]
+	mi := &file_grpc_account_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -650,7 +956,7 @@ func (x *ReqWebBindEmail) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReqWebBindEmail.ProtoReflect.Descriptor instead.
 func (*ReqWebBindEmail) Descriptor() ([]byte, []int) {
-	return file_grpc_account_proto_rawDescGZIP(), []int{9}
+	return file_grpc_account_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *ReqWebBindEmail) GetUserId() uint64 {
@@ -674,33 +980,62 @@ func (x *ReqWebBindEmail) GetCode() string {
 	return ""
 }
 
-type ResWebBindEmail struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Account   *AccountInfo `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
-	Resources string       `protobuf:"bytes,2,opt,name=resources,proto3" json:"resources,omitempty"`
-	Token     string       `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+func (x *ReqWebBindEmail) GetDevice() string {
+	if x != nil {
+		return x.Device
+	}
+	return ""
 }
 
-func (x *ResWebBindEmail) Reset() {
-	*x = ResWebBindEmail{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_grpc_account_proto_msgTypes[10]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *ReqWebBindEmail) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
 	}
+	return ""
 }
 
-func (x *ResWebBindEmail) String() string {
+func (x *ReqWebBindEmail) GetRemoteIp() string {
+	if x != nil {
+		return x.RemoteIp
+	}
+	return ""
+}
+
+func (x *ReqWebBindEmail) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+type ResWebBindEmail struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Account      *AccountInfo `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Resources    string       `protobuf:"bytes,2,opt,name=resources,proto3" json:"resources,omitempty"`
+	Token        string       `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+	RefreshToken string       `protobuf:"bytes,4,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+}
+
+func (x *ResWebBindEmail) Reset() {
+	*x = ResWebBindEmail{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_account_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResWebBindEmail) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
 func (*ResWebBindEmail) ProtoMessage() {}
 
 func (x *ResWebBindEmail) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_account_proto_msgTypes[10]
+	mi := &file_grpc_account_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -713,7 +1048,7 @@ func (x *ResWebBindEmail) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResWebBindEmail.ProtoReflect.Descriptor instead.
 func (*ResWebBindEmail) Descriptor() ([]byte, []int) {
-	return file_grpc_account_proto_rawDescGZIP(), []int{10}
+	return file_grpc_account_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *ResWebBindEmail) GetAccount() *AccountInfo {
@@ -737,6 +1072,13 @@ func (x *ResWebBindEmail) GetToken() string {
 	return ""
 }
 
+func (x *ResWebBindEmail) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
 type ResGetAptosAccount struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -749,7 +1091,7 @@ type ResGetAptosAccount struct {
 func (x *ResGetAptosAccount) Reset() {
 	*x = ResGetAptosAccount{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_grpc_account_proto_msgTypes[11]
+		mi := &file_grpc_account_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -762,7 +1104,7 @@ func (x *ResGetAptosAccount) String() string {
 func (*ResGetAptosAccount) ProtoMessage() {}
 
 func (x *ResGetAptosAccount) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_account_proto_msgTypes[11]
+	mi := &file_grpc_account_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -775,7 +1117,7 @@ func (x *ResGetAptosAccount) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResGetAptosAccount.ProtoReflect.Descriptor instead.
 func (*ResGetAptosAccount) Descriptor() ([]byte, []int) {
-	return file_grpc_account_proto_rawDescGZIP(), []int{11}
+	return file_grpc_account_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *ResGetAptosAccount) GetAptosAccAddr() string {
@@ -800,12 +1142,13 @@ type ReqChangePassword struct {
 	UserId      uint64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	OldPassword string `protobuf:"bytes,2,opt,name=old_password,json=oldPassword,proto3" json:"old_password,omitempty"`
 	NewPassword string `protobuf:"bytes,3,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`
+	TotpCode    string `protobuf:"bytes,4,opt,name=totp_code,json=totpCode,proto3" json:"totp_code,omitempty"`
 }
 
 func (x *ReqChangePassword) Reset() {
 	*x = ReqChangePassword{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_grpc_account_proto_msgTypes[12]
+		mi := &file_grpc_account_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -818,7 +1161,7 @@ func (x *ReqChangePassword) String() string {
 func (*ReqChangePassword) ProtoMessage() {}
 
 func (x *ReqChangePassword) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_account_proto_msgTypes[12]
+	mi := &file_grpc_account_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -831,7 +1174,7 @@ func (x *ReqChangePassword) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReqChangePassword.ProtoReflect.Descriptor instead.
 func (*ReqChangePassword) Descriptor() ([]byte, []int) {
-	return file_grpc_account_proto_rawDescGZIP(), []int{12}
+	return file_grpc_account_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *ReqChangePassword) GetUserId() uint64 {
@@ -855,18 +1198,26 @@ func (x *ReqChangePassword) GetNewPassword() string {
 	return ""
 }
 
+func (x *ReqChangePassword) GetTotpCode() string {
+	if x != nil {
+		return x.TotpCode
+	}
+	return ""
+}
+
 type ReqSendEmailResetPasswordCode struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Email string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Email    string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Language string `protobuf:"bytes,2,opt,name=language,proto3" json:"language,omitempty"`
 }
 
 func (x *ReqSendEmailResetPasswordCode) Reset() {
 	*x = ReqSendEmailResetPasswordCode{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_grpc_account_proto_msgTypes[13]
+		mi := &file_grpc_account_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -879,7 +1230,7 @@ func (x *ReqSendEmailResetPasswordCode) String() string {
 func (*ReqSendEmailResetPasswordCode) ProtoMessage() {}
 
 func (x *ReqSendEmailResetPasswordCode) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_account_proto_msgTypes[13]
+	mi := &file_grpc_account_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -892,7 +1243,7 @@ func (x *ReqSendEmailResetPasswordCode) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReqSendEmailResetPasswordCode.ProtoReflect.Descriptor instead.
 func (*ReqSendEmailResetPasswordCode) Descriptor() ([]byte, []int) {
-	return file_grpc_account_proto_rawDescGZIP(), []int{13}
+	return file_grpc_account_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *ReqSendEmailResetPasswordCode) GetEmail() string {
@@ -902,19 +1253,27 @@ func (x *ReqSendEmailResetPasswordCode) GetEmail() string {
 	return ""
 }
 
+func (x *ReqSendEmailResetPasswordCode) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
 type ReqCheckEmailResetPasswordCode struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Email string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
-	Code  string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Email    string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Code     string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	RemoteIp string `protobuf:"bytes,3,opt,name=remote_ip,json=remoteIp,proto3" json:"remote_ip,omitempty"`
 }
 
 func (x *ReqCheckEmailResetPasswordCode) Reset() {
 	*x = ReqCheckEmailResetPasswordCode{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_grpc_account_proto_msgTypes[14]
+		mi := &file_grpc_account_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -927,7 +1286,7 @@ func (x *ReqCheckEmailResetPasswordCode) String() string {
 func (*ReqCheckEmailResetPasswordCode) ProtoMessage() {}
 
 func (x *ReqCheckEmailResetPasswordCode) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_account_proto_msgTypes[14]
+	mi := &file_grpc_account_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -940,7 +1299,7 @@ func (x *ReqCheckEmailResetPasswordCode) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReqCheckEmailResetPasswordCode.ProtoReflect.Descriptor instead.
 func (*ReqCheckEmailResetPasswordCode) Descriptor() ([]byte, []int) {
-	return file_grpc_account_proto_rawDescGZIP(), []int{14}
+	return file_grpc_account_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *ReqCheckEmailResetPasswordCode) GetEmail() string {
@@ -957,6 +1316,13 @@ func (x *ReqCheckEmailResetPasswordCode) GetCode() string {
 	return ""
 }
 
+func (x *ReqCheckEmailResetPasswordCode) GetRemoteIp() string {
+	if x != nil {
+		return x.RemoteIp
+	}
+	return ""
+}
+
 type ResCheckEmailResetPasswordCode struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -968,7 +1334,7 @@ type ResCheckEmailResetPasswordCode struct {
 func (x *ResCheckEmailResetPasswordCode) Reset() {
 	*x = ResCheckEmailResetPasswordCode{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_grpc_account_proto_msgTypes[15]
+		mi := &file_grpc_account_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -981,7 +1347,7 @@ func (x *ResCheckEmailResetPasswordCode) String() string {
 func (*ResCheckEmailResetPasswordCode) ProtoMessage() {}
 
 func (x *ResCheckEmailResetPasswordCode) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_account_proto_msgTypes[15]
+	mi := &file_grpc_account_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -994,7 +1360,7 @@ func (x *ResCheckEmailResetPasswordCode) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResCheckEmailResetPasswordCode.ProtoReflect.Descriptor instead.
 func (*ResCheckEmailResetPasswordCode) Descriptor() ([]byte, []int) {
-	return file_grpc_account_proto_rawDescGZIP(), []int{15}
+	return file_grpc_account_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *ResCheckEmailResetPasswordCode) GetNonce() string {
@@ -1012,12 +1378,14 @@ type ReqResetPasswordByEmail struct {
 	Email    string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
 	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
 	Nonce    string `protobuf:"bytes,3,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	TotpCode string `protobuf:"bytes,4,opt,name=totp_code,json=totpCode,proto3" json:"totp_code,omitempty"`
+	RemoteIp string `protobuf:"bytes,5,opt,name=remote_ip,json=remoteIp,proto3" json:"remote_ip,omitempty"`
 }
 
 func (x *ReqResetPasswordByEmail) Reset() {
 	*x = ReqResetPasswordByEmail{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_grpc_account_proto_msgTypes[16]
+		mi := &file_grpc_account_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1030,7 +1398,7 @@ func (x *ReqResetPasswordByEmail) String() string {
 func (*ReqResetPasswordByEmail) ProtoMessage() {}
 
 func (x *ReqResetPasswordByEmail) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_account_proto_msgTypes[16]
+	mi := &file_grpc_account_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1043,7 +1411,7 @@ func (x *ReqResetPasswordByEmail) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReqResetPasswordByEmail.ProtoReflect.Descriptor instead.
 func (*ReqResetPasswordByEmail) Descriptor() ([]byte, []int) {
-	return file_grpc_account_proto_rawDescGZIP(), []int{16}
+	return file_grpc_account_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *ReqResetPasswordByEmail) GetEmail() string {
@@ -1067,6 +1435,20 @@ func (x *ReqResetPasswordByEmail) GetNonce() string {
 	return ""
 }
 
+func (x *ReqResetPasswordByEmail) GetTotpCode() string {
+	if x != nil {
+		return x.TotpCode
+	}
+	return ""
+}
+
+func (x *ReqResetPasswordByEmail) GetRemoteIp() string {
+	if x != nil {
+		return x.RemoteIp
+	}
+	return ""
+}
+
 type ReqResetPasswordByEmailAndVCode struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1075,12 +1457,14 @@ type ReqResetPasswordByEmailAndVCode struct {
 	Email    string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
 	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
 	Code     string `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
+	TotpCode string `protobuf:"bytes,4,opt,name=totp_code,json=totpCode,proto3" json:"totp_code,omitempty"`
+	RemoteIp string `protobuf:"bytes,5,opt,name=remote_ip,json=remoteIp,proto3" json:"remote_ip,omitempty"`
 }
 
 func (x *ReqResetPasswordByEmailAndVCode) Reset() {
 	*x = ReqResetPasswordByEmailAndVCode{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_grpc_account_proto_msgTypes[17]
+		mi := &file_grpc_account_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1093,7 +1477,7 @@ func (x *ReqResetPasswordByEmailAndVCode) String() string {
 func (*ReqResetPasswordByEmailAndVCode) ProtoMessage() {}
 
 func (x *ReqResetPasswordByEmailAndVCode) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_account_proto_msgTypes[17]
+	mi := &file_grpc_account_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1106,7 +1490,7 @@ func (x *ReqResetPasswordByEmailAndVCode) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReqResetPasswordByEmailAndVCode.ProtoReflect.Descriptor instead.
 func (*ReqResetPasswordByEmailAndVCode) Descriptor() ([]byte, []int) {
-	return file_grpc_account_proto_rawDescGZIP(), []int{17}
+	return file_grpc_account_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *ReqResetPasswordByEmailAndVCode) GetEmail() string {
@@ -1130,6 +1514,20 @@ func (x *ReqResetPasswordByEmailAndVCode) GetCode() string {
 	return ""
 }
 
+func (x *ReqResetPasswordByEmailAndVCode) GetTotpCode() string {
+	if x != nil {
+		return x.TotpCode
+	}
+	return ""
+}
+
+func (x *ReqResetPasswordByEmailAndVCode) GetRemoteIp() string {
+	if x != nil {
+		return x.RemoteIp
+	}
+	return ""
+}
+
 type ReqBatchGetAccountsByWalletAddrs struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1141,7 +1539,7 @@ type ReqBatchGetAccountsByWalletAddrs struct {
 func (x *ReqBatchGetAccountsByWalletAddrs) Reset() {
 	*x = ReqBatchGetAccountsByWalletAddrs{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_grpc_account_proto_msgTypes[18]
+		mi := &file_grpc_account_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1154,7 +1552,7 @@ func (x *ReqBatchGetAccountsByWalletAddrs) String() string {
 func (*ReqBatchGetAccountsByWalletAddrs) ProtoMessage() {}
 
 func (x *ReqBatchGetAccountsByWalletAddrs) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_account_proto_msgTypes[18]
+	mi := &file_grpc_account_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1167,7 +1565,7 @@ func (x *ReqBatchGetAccountsByWalletAddrs) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReqBatchGetAccountsByWalletAddrs.ProtoReflect.Descriptor instead.
 func (*ReqBatchGetAccountsByWalletAddrs) Descriptor() ([]byte, []int) {
-	return file_grpc_account_proto_rawDescGZIP(), []int{18}
+	return file_grpc_account_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *ReqBatchGetAccountsByWalletAddrs) GetAddrs() []string {
@@ -1188,7 +1586,7 @@ type ResBatchGetAccountsByWalletAddrs struct {
 func (x *ResBatchGetAccountsByWalletAddrs) Reset() {
 	*x = ResBatchGetAccountsByWalletAddrs{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_grpc_account_proto_msgTypes[19]
+		mi := &file_grpc_account_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1201,7 +1599,7 @@ func (x *ResBatchGetAccountsByWalletAddrs) String() string {
 func (*ResBatchGetAccountsByWalletAddrs) ProtoMessage() {}
 
 func (x *ResBatchGetAccountsByWalletAddrs) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_account_proto_msgTypes[19]
+	mi := &file_grpc_account_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1214,7 +1612,7 @@ func (x *ResBatchGetAccountsByWalletAddrs) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResBatchGetAccountsByWalletAddrs.ProtoReflect.Descriptor instead.
 func (*ResBatchGetAccountsByWalletAddrs) Descriptor() ([]byte, []int) {
-	return file_grpc_account_proto_rawDescGZIP(), []int{19}
+	return file_grpc_account_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *ResBatchGetAccountsByWalletAddrs) GetAccounts() []*AccountInfo {
@@ -1224,340 +1622,4427 @@ func (x *ResBatchGetAccountsByWalletAddrs) GetAccounts() []*AccountInfo {
 	return nil
 }
 
-var File_grpc_account_proto protoreflect.FileDescriptor
+type ReqRefreshToken struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_grpc_account_proto_rawDesc = []byte{
-	0x0a, 0x12, 0x67, 0x72, 0x70, 0x63, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x03, 0x6d, 0x70, 0x62, 0x1a, 0x0c, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
-	0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x7f, 0x0a, 0x12, 0x52, 0x65, 0x71, 0x4c, 0x6f,
-	0x67, 0x69, 0x6e, 0x42, 0x79, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x18, 0x0a,
-	0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77,
-	0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77,
-	0x6f, 0x72, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x69, 0x70,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x49, 0x70,
-	0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x22, 0x74, 0x0a, 0x12, 0x52, 0x65, 0x73, 0x4c,
-	0x6f, 0x67, 0x69, 0x6e, 0x42, 0x79, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x2a,
-	0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x10, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x66,
-	0x6f, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72,
-	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65,
-	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x2d,
-	0x0a, 0x11, 0x52, 0x65, 0x71, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49,
-	0x6e, 0x66, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x87, 0x02,
-	0x0a, 0x12, 0x52, 0x65, 0x71, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x41, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a,
-	0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65,
-	0x76, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x65, 0x76, 0x69,
-	0x63, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x6f, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
-	0x6f, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12,
-	0x25, 0x0a, 0x0e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x56,
-	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x1b,
-	0x0a, 0x09, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x69, 0x70, 0x18, 0x08, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x49, 0x70, 0x12, 0x1a, 0x0a, 0x08, 0x70,
-	0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70,
-	0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x22, 0x28, 0x0a, 0x10, 0x52, 0x65, 0x73, 0x47, 0x65,
-	0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6e,
-	0x6f, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63,
-	0x65, 0x22, 0xe9, 0x01, 0x0a, 0x13, 0x52, 0x65, 0x71, 0x57, 0x65, 0x62, 0x4c, 0x6f, 0x67, 0x69,
-	0x6e, 0x42, 0x79, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x77, 0x61, 0x6c,
-	0x6c, 0x65, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
-	0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x75,
-	0x62, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x70, 0x75, 0x62,
-	0x4b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x6d,
-	0x6f, 0x74, 0x65, 0x5f, 0x69, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65,
-	0x6d, 0x6f, 0x74, 0x65, 0x49, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x24,
-	0x0a, 0x0e, 0x61, 0x70, 0x74, 0x6f, 0x73, 0x5f, 0x66, 0x75, 0x6c, 0x6c, 0x5f, 0x6d, 0x73, 0x67,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x70, 0x74, 0x6f, 0x73, 0x46, 0x75, 0x6c,
-	0x6c, 0x4d, 0x73, 0x67, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x70, 0x74, 0x6f, 0x73, 0x5f, 0x73, 0x69,
-	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x61,
-	0x70, 0x74, 0x6f, 0x73, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x75, 0x0a,
-	0x13, 0x52, 0x65, 0x73, 0x57, 0x65, 0x62, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x42, 0x79, 0x57, 0x61,
-	0x6c, 0x6c, 0x65, 0x74, 0x12, 0x2a, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x41, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x14,
-	0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74,
-	0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x36, 0x0a, 0x1a, 0x52, 0x65, 0x71, 0x47, 0x65, 0x74, 0x41, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0x79, 0x41, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x37, 0x0a, 0x1f,
-	0x52, 0x65, 0x71, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x41, 0x6e, 0x64, 0x53, 0x65,
-	0x6e, 0x64, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x42, 0x69, 0x6e, 0x64, 0x43, 0x6f, 0x64, 0x65, 0x12,
-	0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x65, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0x54, 0x0a, 0x0f, 0x52, 0x65, 0x71, 0x57, 0x65, 0x62, 0x42,
-	0x69, 0x6e, 0x64, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
-	0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x71, 0x0a, 0x0f, 0x52,
-	0x65, 0x73, 0x57, 0x65, 0x62, 0x42, 0x69, 0x6e, 0x64, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x2a,
-	0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x10, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x66,
-	0x6f, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72,
-	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65,
-	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x53,
-	0x0a, 0x12, 0x52, 0x65, 0x73, 0x47, 0x65, 0x74, 0x41, 0x70, 0x74, 0x6f, 0x73, 0x41, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x61, 0x70, 0x74, 0x6f, 0x73, 0x5f, 0x61, 0x63,
-	0x63, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x70,
-	0x74, 0x6f, 0x73, 0x41, 0x63, 0x63, 0x41, 0x64, 0x64, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x75,
-	0x62, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x75, 0x62,
-	0x4b, 0x65, 0x79, 0x22, 0x72, 0x0a, 0x11, 0x52, 0x65, 0x71, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
-	0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
-	0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x6c, 0x64, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x6c, 0x64, 0x50, 0x61, 0x73, 0x73,
-	0x77, 0x6f, 0x72, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x65, 0x77, 0x5f, 0x70, 0x61, 0x73, 0x73,
-	0x77, 0x6f, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x65, 0x77, 0x50,
-	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x35, 0x0a, 0x1d, 0x52, 0x65, 0x71, 0x53, 0x65,
-	0x6e, 0x64, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x73, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73,
-	0x77, 0x6f, 0x72, 0x64, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69,
-	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0x4a,
-	0x0a, 0x1e, 0x52, 0x65, 0x71, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x52,
-	0x65, 0x73, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x43, 0x6f, 0x64, 0x65,
-	0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x36, 0x0a, 0x1e, 0x52, 0x65,
-	0x73, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x73, 0x65, 0x74,
-	0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05,
-	0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x6e,
-	0x63, 0x65, 0x22, 0x61, 0x0a, 0x17, 0x52, 0x65, 0x71, 0x52, 0x65, 0x73, 0x65, 0x74, 0x50, 0x61,
-	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x42, 0x79, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x14, 0x0a,
-	0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d,
-	0x61, 0x69, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12,
-	0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x22, 0x67, 0x0a, 0x1f, 0x52, 0x65, 0x71, 0x52, 0x65, 0x73, 0x65,
-	0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x42, 0x79, 0x45, 0x6d, 0x61, 0x69, 0x6c,
-	0x41, 0x6e, 0x64, 0x56, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69,
-	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1a,
-	0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f,
-	0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x38,
-	0x0a, 0x20, 0x52, 0x65, 0x71, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x73, 0x42, 0x79, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x41, 0x64, 0x64,
-	0x72, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x64, 0x64, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x05, 0x61, 0x64, 0x64, 0x72, 0x73, 0x22, 0x50, 0x0a, 0x20, 0x52, 0x65, 0x73, 0x42,
-	0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x42,
-	0x79, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x73, 0x12, 0x2c, 0x0a, 0x08,
-	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10,
-	0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f,
-	0x52, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x32, 0x8d, 0x08, 0x0a, 0x0e, 0x41,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x43, 0x0a,
-	0x0f, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x42, 0x79, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
-	0x12, 0x17, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x42,
-	0x79, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x1a, 0x17, 0x2e, 0x6d, 0x70, 0x62, 0x2e,
-	0x52, 0x65, 0x73, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x42, 0x79, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f,
-	0x72, 0x64, 0x12, 0x32, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x55, 0x73,
-	0x65, 0x72, 0x49, 0x64, 0x1a, 0x10, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x4c, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0x79, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x12, 0x1f, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x47, 0x65, 0x74, 0x41, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0x79, 0x41, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x1a, 0x10, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x32, 0x0a, 0x0d, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65,
-	0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x0a, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x1a, 0x15, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x47, 0x65, 0x6e, 0x65, 0x72,
-	0x61, 0x74, 0x65, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x46, 0x0a, 0x10, 0x57, 0x65, 0x62, 0x4c,
-	0x6f, 0x67, 0x69, 0x6e, 0x42, 0x79, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x12, 0x18, 0x2e, 0x6d,
-	0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x57, 0x65, 0x62, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x42, 0x79,
-	0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x1a, 0x18, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73,
-	0x57, 0x65, 0x62, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x42, 0x79, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74,
-	0x12, 0x50, 0x0a, 0x1c, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x41, 0x6e, 0x64, 0x53,
-	0x65, 0x6e, 0x64, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x42, 0x69, 0x6e, 0x64, 0x43, 0x6f, 0x64, 0x65,
-	0x12, 0x24, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61,
-	0x74, 0x65, 0x41, 0x6e, 0x64, 0x53, 0x65, 0x6e, 0x64, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x42, 0x69,
-	0x6e, 0x64, 0x43, 0x6f, 0x64, 0x65, 0x1a, 0x0a, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70,
-	0x74, 0x79, 0x12, 0x3a, 0x0a, 0x0c, 0x57, 0x65, 0x62, 0x42, 0x69, 0x6e, 0x64, 0x45, 0x6d, 0x61,
-	0x69, 0x6c, 0x12, 0x14, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x57, 0x65, 0x62, 0x42,
-	0x69, 0x6e, 0x64, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x1a, 0x14, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x52,
-	0x65, 0x73, 0x57, 0x65, 0x62, 0x42, 0x69, 0x6e, 0x64, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x3a,
-	0x0a, 0x0f, 0x47, 0x65, 0x74, 0x41, 0x70, 0x74, 0x6f, 0x73, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x12, 0x0e, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x55, 0x73, 0x65, 0x72, 0x49,
-	0x64, 0x1a, 0x17, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x47, 0x65, 0x74, 0x41, 0x70,
-	0x74, 0x6f, 0x73, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x34, 0x0a, 0x0e, 0x43, 0x68,
-	0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x16, 0x2e, 0x6d,
-	0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x73, 0x73,
-	0x77, 0x6f, 0x72, 0x64, 0x1a, 0x0a, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
-	0x12, 0x4c, 0x0a, 0x1a, 0x53, 0x65, 0x6e, 0x64, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x73,
-	0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x22,
-	0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x53, 0x65, 0x6e, 0x64, 0x45, 0x6d, 0x61, 0x69,
-	0x6c, 0x52, 0x65, 0x73, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x43, 0x6f,
-	0x64, 0x65, 0x1a, 0x0a, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x67,
-	0x0a, 0x1b, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x73, 0x65,
-	0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x23, 0x2e,
-	0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x45, 0x6d, 0x61, 0x69,
-	0x6c, 0x52, 0x65, 0x73, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x43, 0x6f,
-	0x64, 0x65, 0x1a, 0x23, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x43, 0x68, 0x65, 0x63,
-	0x6b, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x73, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77,
-	0x6f, 0x72, 0x64, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x40, 0x0a, 0x14, 0x52, 0x65, 0x73, 0x65, 0x74,
-	0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x42, 0x79, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12,
-	0x1c, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x52, 0x65, 0x73, 0x65, 0x74, 0x50, 0x61,
-	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x42, 0x79, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x1a, 0x0a, 0x2e,
-	0x6d, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x50, 0x0a, 0x1c, 0x52, 0x65, 0x73,
-	0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x42, 0x79, 0x45, 0x6d, 0x61, 0x69,
-	0x6c, 0x41, 0x6e, 0x64, 0x56, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x24, 0x2e, 0x6d, 0x70, 0x62, 0x2e,
-	0x52, 0x65, 0x71, 0x52, 0x65, 0x73, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
-	0x42, 0x79, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x41, 0x6e, 0x64, 0x56, 0x43, 0x6f, 0x64, 0x65, 0x1a,
-	0x0a, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x6d, 0x0a, 0x1d, 0x42,
-	0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x42,
-	0x79, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x73, 0x12, 0x25, 0x2e, 0x6d,
-	0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x41, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x42, 0x79, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x41, 0x64,
-	0x64, 0x72, 0x73, 0x1a, 0x25, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x42, 0x61, 0x74,
-	0x63, 0x68, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x42, 0x79, 0x57,
-	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x73, 0x42, 0x07, 0x5a, 0x05, 0x2e, 0x2f,
-	0x6d, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	RefreshToken string `protobuf:"bytes,1,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	RemoteIp     string `protobuf:"bytes,2,opt,name=remote_ip,json=remoteIp,proto3" json:"remote_ip,omitempty"`
+	Region       string `protobuf:"bytes,3,opt,name=region,proto3" json:"region,omitempty"`
 }
 
-var (
-	file_grpc_account_proto_rawDescOnce sync.Once
-	file_grpc_account_proto_rawDescData = file_grpc_account_proto_rawDesc
-)
+func (x *ReqRefreshToken) Reset() {
+	*x = ReqRefreshToken{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_account_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_grpc_account_proto_rawDescGZIP() []byte {
-	file_grpc_account_proto_rawDescOnce.Do(func() {
-		file_grpc_account_proto_rawDescData = protoimpl.X.CompressGZIP(file_grpc_account_proto_rawDescData)
-	})
-	return file_grpc_account_proto_rawDescData
+func (x *ReqRefreshToken) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_grpc_account_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
-var file_grpc_account_proto_goTypes = []interface{}{
-	(*ReqLoginByPassword)(nil),               // 0: mpb.ReqLoginByPassword
-	(*ResLoginByPassword)(nil),               // 1: mpb.ResLoginByPassword
-	(*ReqGetAccountInfo)(nil),                // 2: mpb.ReqGetAccountInfo
-	(*ReqRegisterAccount)(nil),               // 3: mpb.ReqRegisterAccount
-	(*ResGenerateNonce)(nil),                 // 4: mpb.ResGenerateNonce
-	(*ReqWebLoginByWallet)(nil),              // 5: mpb.ReqWebLoginByWallet
-	(*ResWebLoginByWallet)(nil),              // 6: mpb.ResWebLoginByWallet
-	(*ReqGetAccountInfoByAccount)(nil),       // 7: mpb.ReqGetAccountInfoByAccount
-	(*ReqGenerateAndSendEmailBindCode)(nil),  // 8: mpb.ReqGenerateAndSendEmailBindCode
-	(*ReqWebBindEmail)(nil),                  // 9: mpb.ReqWebBindEmail
-	(*ResWebBindEmail)(nil),                  // 10: mpb.ResWebBindEmail
-	(*ResGetAptosAccount)(nil),               // 11: mpb.ResGetAptosAccount
-	(*ReqChangePassword)(nil),                // 12: mpb.ReqChangePassword
-	(*ReqSendEmailResetPasswordCode)(nil),    // 13: mpb.ReqSendEmailResetPasswordCode
-	(*ReqCheckEmailResetPasswordCode)(nil),   // 14: mpb.ReqCheckEmailResetPasswordCode
-	(*ResCheckEmailResetPasswordCode)(nil),   // 15: mpb.ResCheckEmailResetPasswordCode
-	(*ReqResetPasswordByEmail)(nil),          // 16: mpb.ReqResetPasswordByEmail
-	(*ReqResetPasswordByEmailAndVCode)(nil),  // 17: mpb.ReqResetPasswordByEmailAndVCode
-	(*ReqBatchGetAccountsByWalletAddrs)(nil), // 18: mpb.ReqBatchGetAccountsByWalletAddrs
-	(*ResBatchGetAccountsByWalletAddrs)(nil), // 19: mpb.ResBatchGetAccountsByWalletAddrs
-	(*AccountInfo)(nil),                      // 20: mpb.AccountInfo
-	(*ReqUserId)(nil),                        // 21: mpb.ReqUserId
-	(*Empty)(nil),                            // 22: mpb.Empty
+func (*ReqRefreshToken) ProtoMessage() {}
+
+func (x *ReqRefreshToken) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_account_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_grpc_account_proto_depIdxs = []int32{
-	20, // 0: mpb.ResLoginByPassword.account:type_name -> mpb.AccountInfo
-	20, // 1: mpb.ResWebLoginByWallet.account:type_name -> mpb.AccountInfo
-	20, // 2: mpb.ResWebBindEmail.account:type_name -> mpb.AccountInfo
-	20, // 3: mpb.ResBatchGetAccountsByWalletAddrs.accounts:type_name -> mpb.AccountInfo
-	0,  // 4: mpb.AccountService.LoginByPassword:input_type -> mpb.ReqLoginByPassword
-	21, // 5: mpb.AccountService.GetAccountInfo:input_type -> mpb.ReqUserId
-	7,  // 6: mpb.AccountService.GetAccountInfoByAccount:input_type -> mpb.ReqGetAccountInfoByAccount
-	22, // 7: mpb.AccountService.GenerateNonce:input_type -> mpb.Empty
-	5,  // 8: mpb.AccountService.WebLoginByWallet:input_type -> mpb.ReqWebLoginByWallet
-	8,  // 9: mpb.AccountService.GenerateAndSendEmailBindCode:input_type -> mpb.ReqGenerateAndSendEmailBindCode
-	9,  // 10: mpb.AccountService.WebBindEmail:input_type -> mpb.ReqWebBindEmail
-	21, // 11: mpb.AccountService.GetAptosAccount:input_type -> mpb.ReqUserId
-	12, // 12: mpb.AccountService.ChangePassword:input_type -> mpb.ReqChangePassword
-	13, // 13: mpb.AccountService.SendEmailResetPasswordCode:input_type -> mpb.ReqSendEmailResetPasswordCode
-	14, // 14: mpb.AccountService.CheckEmailResetPasswordCode:input_type -> mpb.ReqCheckEmailResetPasswordCode
-	16, // 15: mpb.AccountService.ResetPasswordByEmail:input_type -> mpb.ReqResetPasswordByEmail
-	17, // 16: mpb.AccountService.ResetPasswordByEmailAndVCode:input_type -> mpb.ReqResetPasswordByEmailAndVCode
-	18, // 17: mpb.AccountService.BatchGetAccountsByWalletAddrs:input_type -> mpb.ReqBatchGetAccountsByWalletAddrs
-	1,  // 18: mpb.AccountService.LoginByPassword:output_type -> mpb.ResLoginByPassword
-	20, // 19: mpb.AccountService.GetAccountInfo:output_type -> mpb.AccountInfo
-	20, // 20: mpb.AccountService.GetAccountInfoByAccount:output_type -> mpb.AccountInfo
-	4,  // 21: mpb.AccountService.GenerateNonce:output_type -> mpb.ResGenerateNonce
-	6,  // 22: mpb.AccountService.WebLoginByWallet:output_type -> mpb.ResWebLoginByWallet
-	22, // 23: mpb.AccountService.GenerateAndSendEmailBindCode:output_type -> mpb.Empty
-	10, // 24: mpb.AccountService.WebBindEmail:output_type -> mpb.ResWebBindEmail
-	11, // 25: mpb.AccountService.GetAptosAccount:output_type -> mpb.ResGetAptosAccount
-	22, // 26: mpb.AccountService.ChangePassword:output_type -> mpb.Empty
-	22, // 27: mpb.AccountService.SendEmailResetPasswordCode:output_type -> mpb.Empty
-	15, // 28: mpb.AccountService.CheckEmailResetPasswordCode:output_type -> mpb.ResCheckEmailResetPasswordCode
-	22, // 29: mpb.AccountService.ResetPasswordByEmail:output_type -> mpb.Empty
-	22, // 30: mpb.AccountService.ResetPasswordByEmailAndVCode:output_type -> mpb.Empty
-	19, // 31: mpb.AccountService.BatchGetAccountsByWalletAddrs:output_type -> mpb.ResBatchGetAccountsByWalletAddrs
-	18, // [18:32] is the sub-list for method output_type
-	4,  // [4:18] is the sub-list for method input_type
-	4,  // [4:4] is the sub-list for extension type_name
-	4,  // [4:4] is the sub-list for extension extendee
-	0,  // [0:4] is the sub-list for field type_name
+
+// Deprecated: Use ReqRefreshToken.ProtoReflect.Descriptor instead.
+func (*ReqRefreshToken) Descriptor() ([]byte, []int) {
+	return file_grpc_account_proto_rawDescGZIP(), []int{22}
 }
 
-func init() { file_grpc_account_proto_init() }
-func file_grpc_account_proto_init() {
-	if File_grpc_account_proto != nil {
-		return
+func (x *ReqRefreshToken) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
 	}
-	file_common_proto_init()
-	if !protoimpl.UnsafeEnabled {
-		file_grpc_account_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReqLoginByPassword); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_grpc_account_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ResLoginByPassword); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_grpc_account_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReqGetAccountInfo); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+	return ""
+}
+
+func (x *ReqRefreshToken) GetRemoteIp() string {
+	if x != nil {
+		return x.RemoteIp
+	}
+	return ""
+}
+
+func (x *ReqRefreshToken) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+type ResRefreshToken struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token        string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	RefreshToken string `protobuf:"bytes,2,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+}
+
+func (x *ResRefreshToken) Reset() {
+	*x = ResRefreshToken{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_account_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResRefreshToken) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResRefreshToken) ProtoMessage() {}
+
+func (x *ResRefreshToken) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_account_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_grpc_account_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReqRegisterAccount); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResRefreshToken.ProtoReflect.Descriptor instead.
+func (*ResRefreshToken) Descriptor() ([]byte, []int) {
+	return file_grpc_account_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ResRefreshToken) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ResRefreshToken) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+type ReqLogout struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId uint64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Token  string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+}
+
+func (x *ReqLogout) Reset() {
+	*x = ReqLogout{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_account_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReqLogout) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReqLogout) ProtoMessage() {}
+
+func (x *ReqLogout) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_account_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReqLogout.ProtoReflect.Descriptor instead.
+func (*ReqLogout) Descriptor() ([]byte, []int) {
+	return file_grpc_account_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ReqLogout) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ReqLogout) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type ReqGetSessions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId uint64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Token  string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+}
+
+func (x *ReqGetSessions) Reset() {
+	*x = ReqGetSessions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_account_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReqGetSessions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReqGetSessions) ProtoMessage() {}
+
+func (x *ReqGetSessions) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_account_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReqGetSessions.ProtoReflect.Descriptor instead.
+func (*ReqGetSessions) Descriptor() ([]byte, []int) {
+	return file_grpc_account_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ReqGetSessions) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ReqGetSessions) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type ResGetSessions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sessions []*SessionInfo `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"`
+}
+
+func (x *ResGetSessions) Reset() {
+	*x = ResGetSessions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_account_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResGetSessions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResGetSessions) ProtoMessage() {}
+
+func (x *ResGetSessions) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_account_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResGetSessions.ProtoReflect.Descriptor instead.
+func (*ResGetSessions) Descriptor() ([]byte, []int) {
+	return file_grpc_account_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ResGetSessions) GetSessions() []*SessionInfo {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
+type ReqRevokeSession struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId    uint64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	SessionId string `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+}
+
+func (x *ReqRevokeSession) Reset() {
+	*x = ReqRevokeSession{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_account_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReqRevokeSession) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReqRevokeSession) ProtoMessage() {}
+
+func (x *ReqRevokeSession) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_account_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReqRevokeSession.ProtoReflect.Descriptor instead.
+func (*ReqRevokeSession) Descriptor() ([]byte, []int) {
+	return file_grpc_account_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ReqRevokeSession) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ReqRevokeSession) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+type ResEnrollTOTP struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Secret     string `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	OtpauthUri string `protobuf:"bytes,2,opt,name=otpauth_uri,json=otpauthUri,proto3" json:"otpauth_uri,omitempty"`
+}
+
+func (x *ResEnrollTOTP) Reset() {
+	*x = ResEnrollTOTP{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_account_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResEnrollTOTP) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResEnrollTOTP) ProtoMessage() {}
+
+func (x *ResEnrollTOTP) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_account_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResEnrollTOTP.ProtoReflect.Descriptor instead.
+func (*ResEnrollTOTP) Descriptor() ([]byte, []int) {
+	return file_grpc_account_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ResEnrollTOTP) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *ResEnrollTOTP) GetOtpauthUri() string {
+	if x != nil {
+		return x.OtpauthUri
+	}
+	return ""
+}
+
+type ReqTOTPCode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId uint64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Code   string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"` // totp code or recovery code
+}
+
+func (x *ReqTOTPCode) Reset() {
+	*x = ReqTOTPCode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_account_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReqTOTPCode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReqTOTPCode) ProtoMessage() {}
+
+func (x *ReqTOTPCode) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_account_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReqTOTPCode.ProtoReflect.Descriptor instead.
+func (*ReqTOTPCode) Descriptor() ([]byte, []int) {
+	return file_grpc_account_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ReqTOTPCode) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ReqTOTPCode) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type ResConfirmTOTP struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RecoveryCodes []string `protobuf:"bytes,1,rep,name=recovery_codes,json=recoveryCodes,proto3" json:"recovery_codes,omitempty"`
+}
+
+func (x *ResConfirmTOTP) Reset() {
+	*x = ResConfirmTOTP{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_account_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResConfirmTOTP) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResConfirmTOTP) ProtoMessage() {}
+
+func (x *ResConfirmTOTP) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_account_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResConfirmTOTP.ProtoReflect.Descriptor instead.
+func (*ResConfirmTOTP) Descriptor() ([]byte, []int) {
+	return file_grpc_account_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ResConfirmTOTP) GetRecoveryCodes() []string {
+	if x != nil {
+		return x.RecoveryCodes
+	}
+	return nil
+}
+
+type ReqLoginByTOTP struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LoginTicket string `protobuf:"bytes,1,opt,name=login_ticket,json=loginTicket,proto3" json:"login_ticket,omitempty"`
+	Code        string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	RemoteIp    string `protobuf:"bytes,3,opt,name=remote_ip,json=remoteIp,proto3" json:"remote_ip,omitempty"`
+	Region      string `protobuf:"bytes,4,opt,name=region,proto3" json:"region,omitempty"`
+}
+
+func (x *ReqLoginByTOTP) Reset() {
+	*x = ReqLoginByTOTP{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_account_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReqLoginByTOTP) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReqLoginByTOTP) ProtoMessage() {}
+
+func (x *ReqLoginByTOTP) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_account_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReqLoginByTOTP.ProtoReflect.Descriptor instead.
+func (*ReqLoginByTOTP) Descriptor() ([]byte, []int) {
+	return file_grpc_account_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ReqLoginByTOTP) GetLoginTicket() string {
+	if x != nil {
+		return x.LoginTicket
+	}
+	return ""
+}
+
+func (x *ReqLoginByTOTP) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *ReqLoginByTOTP) GetRemoteIp() string {
+	if x != nil {
+		return x.RemoteIp
+	}
+	return ""
+}
+
+func (x *ReqLoginByTOTP) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+type ReqLinkWallet struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId       uint64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	WalletAddr   string `protobuf:"bytes,2,opt,name=wallet_addr,json=walletAddr,proto3" json:"wallet_addr,omitempty"`
+	PubKey       []byte `protobuf:"bytes,3,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+	AptosFullMsg string `protobuf:"bytes,4,opt,name=aptos_full_msg,json=aptosFullMsg,proto3" json:"aptos_full_msg,omitempty"`
+}
+
+func (x *ReqLinkWallet) Reset() {
+	*x = ReqLinkWallet{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_account_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReqLinkWallet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReqLinkWallet) ProtoMessage() {}
+
+func (x *ReqLinkWallet) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_account_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReqLinkWallet.ProtoReflect.Descriptor instead.
+func (*ReqLinkWallet) Descriptor() ([]byte, []int) {
+	return file_grpc_account_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ReqLinkWallet) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ReqLinkWallet) GetWalletAddr() string {
+	if x != nil {
+		return x.WalletAddr
+	}
+	return ""
+}
+
+func (x *ReqLinkWallet) GetPubKey() []byte {
+	if x != nil {
+		return x.PubKey
+	}
+	return nil
+}
+
+func (x *ReqLinkWallet) GetAptosFullMsg() string {
+	if x != nil {
+		return x.AptosFullMsg
+	}
+	return ""
+}
+
+type ReqWalletAddr struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId     uint64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	WalletAddr string `protobuf:"bytes,2,opt,name=wallet_addr,json=walletAddr,proto3" json:"wallet_addr,omitempty"`
+}
+
+func (x *ReqWalletAddr) Reset() {
+	*x = ReqWalletAddr{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_account_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReqWalletAddr) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReqWalletAddr) ProtoMessage() {}
+
+func (x *ReqWalletAddr) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_account_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReqWalletAddr.ProtoReflect.Descriptor instead.
+func (*ReqWalletAddr) Descriptor() ([]byte, []int) {
+	return file_grpc_account_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ReqWalletAddr) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ReqWalletAddr) GetWalletAddr() string {
+	if x != nil {
+		return x.WalletAddr
+	}
+	return ""
+}
+
+type ResListWallets struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Wallets []*WalletInfo `protobuf:"bytes,1,rep,name=wallets,proto3" json:"wallets,omitempty"`
+}
+
+func (x *ResListWallets) Reset() {
+	*x = ResListWallets{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_account_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResListWallets) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResListWallets) ProtoMessage() {}
+
+func (x *ResListWallets) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_account_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResListWallets.ProtoReflect.Descriptor instead.
+func (*ResListWallets) Descriptor() ([]byte, []int) {
+	return file_grpc_account_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ResListWallets) GetWallets() []*WalletInfo {
+	if x != nil {
+		return x.Wallets
+	}
+	return nil
+}
+
+type ReqSendEmailChangeCode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId       uint64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	NewEmail     string `protobuf:"bytes,2,opt,name=new_email,json=newEmail,proto3" json:"new_email,omitempty"`
+	SkipOldEmail bool   `protobuf:"varint,3,opt,name=skip_old_email,json=skipOldEmail,proto3" json:"skip_old_email,omitempty"` // the old inbox is lost, the change will be verified by wallet signature
+}
+
+func (x *ReqSendEmailChangeCode) Reset() {
+	*x = ReqSendEmailChangeCode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_account_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReqSendEmailChangeCode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReqSendEmailChangeCode) ProtoMessage() {}
+
+func (x *ReqSendEmailChangeCode) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_account_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReqSendEmailChangeCode.ProtoReflect.Descriptor instead.
+func (*ReqSendEmailChangeCode) Descriptor() ([]byte, []int) {
+	return file_grpc_account_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ReqSendEmailChangeCode) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ReqSendEmailChangeCode) GetNewEmail() string {
+	if x != nil {
+		return x.NewEmail
+	}
+	return ""
+}
+
+func (x *ReqSendEmailChangeCode) GetSkipOldEmail() bool {
+	if x != nil {
+		return x.SkipOldEmail
+	}
+	return false
+}
+
+// the new email is verified by new_code, the old one by old_code or the signature of a wallet linked to the account
+type ReqChangeEmail struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId       uint64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	NewEmail     string `protobuf:"bytes,2,opt,name=new_email,json=newEmail,proto3" json:"new_email,omitempty"`
+	NewCode      string `protobuf:"bytes,3,opt,name=new_code,json=newCode,proto3" json:"new_code,omitempty"`
+	OldCode      string `protobuf:"bytes,4,opt,name=old_code,json=oldCode,proto3" json:"old_code,omitempty"`
+	WalletAddr   string `protobuf:"bytes,5,opt,name=wallet_addr,json=walletAddr,proto3" json:"wallet_addr,omitempty"`
+	AptosFullMsg string `protobuf:"bytes,6,opt,name=aptos_full_msg,json=aptosFullMsg,proto3" json:"aptos_full_msg,omitempty"`
+	TotpCode     string `protobuf:"bytes,7,opt,name=totp_code,json=totpCode,proto3" json:"totp_code,omitempty"`
+}
+
+func (x *ReqChangeEmail) Reset() {
+	*x = ReqChangeEmail{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_account_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReqChangeEmail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReqChangeEmail) ProtoMessage() {}
+
+func (x *ReqChangeEmail) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_account_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReqChangeEmail.ProtoReflect.Descriptor instead.
+func (*ReqChangeEmail) Descriptor() ([]byte, []int) {
+	return file_grpc_account_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ReqChangeEmail) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ReqChangeEmail) GetNewEmail() string {
+	if x != nil {
+		return x.NewEmail
+	}
+	return ""
+}
+
+func (x *ReqChangeEmail) GetNewCode() string {
+	if x != nil {
+		return x.NewCode
+	}
+	return ""
+}
+
+func (x *ReqChangeEmail) GetOldCode() string {
+	if x != nil {
+		return x.OldCode
+	}
+	return ""
+}
+
+func (x *ReqChangeEmail) GetWalletAddr() string {
+	if x != nil {
+		return x.WalletAddr
+	}
+	return ""
+}
+
+func (x *ReqChangeEmail) GetAptosFullMsg() string {
+	if x != nil {
+		return x.AptosFullMsg
+	}
+	return ""
+}
+
+func (x *ReqChangeEmail) GetTotpCode() string {
+	if x != nil {
+		return x.TotpCode
+	}
+	return ""
+}
+
+type EAccountRepair struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *EAccountRepair) Reset() {
+	*x = EAccountRepair{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_account_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EAccountRepair) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EAccountRepair) ProtoMessage() {}
+
+func (x *EAccountRepair) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_account_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EAccountRepair.ProtoReflect.Descriptor instead.
+func (*EAccountRepair) Descriptor() ([]byte, []int) {
+	return file_grpc_account_proto_rawDescGZIP(), []int{37}
+}
+
+type AccountRepair struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId     uint64                `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	WalletAddr string                `protobuf:"bytes,2,opt,name=wallet_addr,json=walletAddr,proto3" json:"wallet_addr,omitempty"`
+	Action     EAccountRepair_Action `protobuf:"varint,3,opt,name=action,proto3,enum=mpb.EAccountRepair_Action" json:"action,omitempty"`
+	LiveUserId uint64                `protobuf:"varint,4,opt,name=live_user_id,json=liveUserId,proto3" json:"live_user_id,omitempty"`
+}
+
+func (x *AccountRepair) Reset() {
+	*x = AccountRepair{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_account_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccountRepair) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountRepair) ProtoMessage() {}
+
+func (x *AccountRepair) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_account_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountRepair.ProtoReflect.Descriptor instead.
+func (*AccountRepair) Descriptor() ([]byte, []int) {
+	return file_grpc_account_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *AccountRepair) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *AccountRepair) GetWalletAddr() string {
+	if x != nil {
+		return x.WalletAddr
+	}
+	return ""
+}
+
+func (x *AccountRepair) GetAction() EAccountRepair_Action {
+	if x != nil {
+		return x.Action
+	}
+	return EAccountRepair_Action_None
+}
+
+func (x *AccountRepair) GetLiveUserId() uint64 {
+	if x != nil {
+		return x.LiveUserId
+	}
+	return 0
+}
+
+type ReqAdminRepairAccounts struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StartUserId uint64 `protobuf:"varint,1,opt,name=start_user_id,json=startUserId,proto3" json:"start_user_id,omitempty"`
+	Limit       uint64 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	DryRun      bool   `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+}
+
+func (x *ReqAdminRepairAccounts) Reset() {
+	*x = ReqAdminRepairAccounts{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_account_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReqAdminRepairAccounts) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReqAdminRepairAccounts) ProtoMessage() {}
+
+func (x *ReqAdminRepairAccounts) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_account_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReqAdminRepairAccounts.ProtoReflect.Descriptor instead.
+func (*ReqAdminRepairAccounts) Descriptor() ([]byte, []int) {
+	return file_grpc_account_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ReqAdminRepairAccounts) GetStartUserId() uint64 {
+	if x != nil {
+		return x.StartUserId
+	}
+	return 0
+}
+
+func (x *ReqAdminRepairAccounts) GetLimit() uint64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ReqAdminRepairAccounts) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type ResAdminRepairAccounts struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Repairs    []*AccountRepair `protobuf:"bytes,1,rep,name=repairs,proto3" json:"repairs,omitempty"`
+	NextUserId uint64           `protobuf:"varint,2,opt,name=next_user_id,json=nextUserId,proto3" json:"next_user_id,omitempty"` // 0 if all accounts have been checked
+}
+
+func (x *ResAdminRepairAccounts) Reset() {
+	*x = ResAdminRepairAccounts{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_account_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResAdminRepairAccounts) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResAdminRepairAccounts) ProtoMessage() {}
+
+func (x *ResAdminRepairAccounts) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_account_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResAdminRepairAccounts.ProtoReflect.Descriptor instead.
+func (*ResAdminRepairAccounts) Descriptor() ([]byte, []int) {
+	return file_grpc_account_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ResAdminRepairAccounts) GetRepairs() []*AccountRepair {
+	if x != nil {
+		return x.Repairs
+	}
+	return nil
+}
+
+func (x *ResAdminRepairAccounts) GetNextUserId() uint64 {
+	if x != nil {
+		return x.NextUserId
+	}
+	return 0
+}
+
+// ReqRequestAccountDeletion the user proves the ownership again by one of the password, a linked wallet, a linked
+// platform or the device of the guest account
+type ReqRequestAccountDeletion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId       uint64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TotpCode     string `protobuf:"bytes,2,opt,name=totp_code,json=totpCode,proto3" json:"totp_code,omitempty"`
+	Password     string `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	WalletAddr   string `protobuf:"bytes,4,opt,name=wallet_addr,json=walletAddr,proto3" json:"wallet_addr,omitempty"`
+	AptosFullMsg string `protobuf:"bytes,5,opt,name=aptos_full_msg,json=aptosFullMsg,proto3" json:"aptos_full_msg,omitempty"` // the signature has been verified by gateway
+	Provider     string `protobuf:"bytes,6,opt,name=provider,proto3" json:"provider,omitempty"`
+	IdToken      string `protobuf:"bytes,7,opt,name=id_token,json=idToken,proto3" json:"id_token,omitempty"`
+	Nonce        string `protobuf:"bytes,8,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	DeviceId     string `protobuf:"bytes,9,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"` // of the guest account
+	Device       string `protobuf:"bytes,10,opt,name=device,proto3" json:"device,omitempty"`
+	RemoteIp     string `protobuf:"bytes,11,opt,name=remote_ip,json=remoteIp,proto3" json:"remote_ip,omitempty"`
+	Region       string `protobuf:"bytes,12,opt,name=region,proto3" json:"region,omitempty"`
+}
+
+func (x *ReqRequestAccountDeletion) Reset() {
+	*x = ReqRequestAccountDeletion{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_account_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReqRequestAccountDeletion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReqRequestAccountDeletion) ProtoMessage() {}
+
+func (x *ReqRequestAccountDeletion) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_account_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReqRequestAccountDeletion.ProtoReflect.Descriptor instead.
+func (*ReqRequestAccountDeletion) Descriptor() ([]byte, []int) {
+	return file_grpc_account_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ReqRequestAccountDeletion) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ReqRequestAccountDeletion) GetTotpCode() string {
+	if x != nil {
+		return x.TotpCode
+	}
+	return ""
+}
+
+func (x *ReqRequestAccountDeletion) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *ReqRequestAccountDeletion) GetWalletAddr() string {
+	if x != nil {
+		return x.WalletAddr
+	}
+	return ""
+}
+
+func (x *ReqRequestAccountDeletion) GetAptosFullMsg() string {
+	if x != nil {
+		return x.AptosFullMsg
+	}
+	return ""
+}
+
+func (x *ReqRequestAccountDeletion) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *ReqRequestAccountDeletion) GetIdToken() string {
+	if x != nil {
+		return x.IdToken
+	}
+	return ""
+}
+
+func (x *ReqRequestAccountDeletion) GetNonce() string {
+	if x != nil {
+		return x.Nonce
+	}
+	return ""
+}
+
+func (x *ReqRequestAccountDeletion) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+func (x *ReqRequestAccountDeletion) GetDevice() string {
+	if x != nil {
+		return x.Device
+	}
+	return ""
+}
+
+func (x *ReqRequestAccountDeletion) GetRemoteIp() string {
+	if x != nil {
+		return x.RemoteIp
+	}
+	return ""
+}
+
+func (x *ReqRequestAccountDeletion) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+type ResRequestAccountDeletion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DeletionTime int64 `protobuf:"varint,1,opt,name=deletion_time,json=deletionTime,proto3" json:"deletion_time,omitempty"`
+}
+
+func (x *ResRequestAccountDeletion) Reset() {
+	*x = ResRequestAccountDeletion{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_account_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResRequestAccountDeletion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResRequestAccountDeletion) ProtoMessage() {}
+
+func (x *ResRequestAccountDeletion) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_account_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResRequestAccountDeletion.ProtoReflect.Descriptor instead.
+func (*ResRequestAccountDeletion) Descriptor() ([]byte, []int) {
+	return file_grpc_account_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ResRequestAccountDeletion) GetDeletionTime() int64 {
+	if x != nil {
+		return x.DeletionTime
+	}
+	return 0
+}
+
+type AccountDataArchive struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExportTime   int64             `protobuf:"varint,1,opt,name=export_time,json=exportTime,proto3" json:"export_time,omitempty"`
+	Account      *AccountInfo      `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	RegisterTime int64             `protobuf:"varint,3,opt,name=register_time,json=registerTime,proto3" json:"register_time,omitempty"`
+	Device       string            `protobuf:"bytes,4,opt,name=device,proto3" json:"device,omitempty"`
+	Os           string            `protobuf:"bytes,5,opt,name=os,proto3" json:"os,omitempty"`
+	Region       string            `protobuf:"bytes,6,opt,name=region,proto3" json:"region,omitempty"`
+	Platform     string            `protobuf:"bytes,7,opt,name=platform,proto3" json:"platform,omitempty"`
+	Wallets      []*WalletInfo     `protobuf:"bytes,8,rep,name=wallets,proto3" json:"wallets,omitempty"`
+	Sessions     []*SessionInfo    `protobuf:"bytes,9,rep,name=sessions,proto3" json:"sessions,omitempty"`
+	Nfts         []*AptosNFTNodeV2 `protobuf:"bytes,10,rep,name=nfts,proto3" json:"nfts,omitempty"`
+	Logins       []*LoginRecord    `protobuf:"bytes,11,rep,name=logins,proto3" json:"logins,omitempty"`
+}
+
+func (x *AccountDataArchive) Reset() {
+	*x = AccountDataArchive{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_account_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccountDataArchive) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountDataArchive) ProtoMessage() {}
+
+func (x *AccountDataArchive) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_account_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountDataArchive.ProtoReflect.Descriptor instead.
+func (*AccountDataArchive) Descriptor() ([]byte, []int) {
+	return file_grpc_account_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *AccountDataArchive) GetExportTime() int64 {
+	if x != nil {
+		return x.ExportTime
+	}
+	return 0
+}
+
+func (x *AccountDataArchive) GetAccount() *AccountInfo {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *AccountDataArchive) GetRegisterTime() int64 {
+	if x != nil {
+		return x.RegisterTime
+	}
+	return 0
+}
+
+func (x *AccountDataArchive) GetDevice() string {
+	if x != nil {
+		return x.Device
+	}
+	return ""
+}
+
+func (x *AccountDataArchive) GetOs() string {
+	if x != nil {
+		return x.Os
+	}
+	return ""
+}
+
+func (x *AccountDataArchive) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *AccountDataArchive) GetPlatform() string {
+	if x != nil {
+		return x.Platform
+	}
+	return ""
+}
+
+func (x *AccountDataArchive) GetWallets() []*WalletInfo {
+	if x != nil {
+		return x.Wallets
+	}
+	return nil
+}
+
+func (x *AccountDataArchive) GetSessions() []*SessionInfo {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
+func (x *AccountDataArchive) GetNfts() []*AptosNFTNodeV2 {
+	if x != nil {
+		return x.Nfts
+	}
+	return nil
+}
+
+func (x *AccountDataArchive) GetLogins() []*LoginRecord {
+	if x != nil {
+		return x.Logins
+	}
+	return nil
+}
+
+type ResExportAccountData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Archive string `protobuf:"bytes,1,opt,name=archive,proto3" json:"archive,omitempty"` // json of AccountDataArchive
+}
+
+func (x *ResExportAccountData) Reset() {
+	*x = ResExportAccountData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_account_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResExportAccountData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResExportAccountData) ProtoMessage() {}
+
+func (x *ResExportAccountData) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_account_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResExportAccountData.ProtoReflect.Descriptor instead.
+func (*ResExportAccountData) Descriptor() ([]byte, []int) {
+	return file_grpc_account_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ResExportAccountData) GetArchive() string {
+	if x != nil {
+		return x.Archive
+	}
+	return ""
+}
+
+type ReqUpdateProfile struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId   uint64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Nickname string `protobuf:"bytes,2,opt,name=nickname,proto3" json:"nickname,omitempty"` // empty if not changed
+	Icon     string `protobuf:"bytes,3,opt,name=icon,proto3" json:"icon,omitempty"`         // empty if not changed
+}
+
+func (x *ReqUpdateProfile) Reset() {
+	*x = ReqUpdateProfile{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_account_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReqUpdateProfile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReqUpdateProfile) ProtoMessage() {}
+
+func (x *ReqUpdateProfile) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_account_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReqUpdateProfile.ProtoReflect.Descriptor instead.
+func (*ReqUpdateProfile) Descriptor() ([]byte, []int) {
+	return file_grpc_account_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ReqUpdateProfile) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ReqUpdateProfile) GetNickname() string {
+	if x != nil {
+		return x.Nickname
+	}
+	return ""
+}
+
+func (x *ReqUpdateProfile) GetIcon() string {
+	if x != nil {
+		return x.Icon
+	}
+	return ""
+}
+
+type ReqLoginAsGuest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DeviceId      string `protobuf:"bytes,1,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	Device        string `protobuf:"bytes,2,opt,name=device,proto3" json:"device,omitempty"`
+	Os            string `protobuf:"bytes,3,opt,name=os,proto3" json:"os,omitempty"`
+	Platform      string `protobuf:"bytes,4,opt,name=platform,proto3" json:"platform,omitempty"`
+	ClientVersion string `protobuf:"bytes,5,opt,name=client_version,json=clientVersion,proto3" json:"client_version,omitempty"`
+	RemoteIp      string `protobuf:"bytes,6,opt,name=remote_ip,json=remoteIp,proto3" json:"remote_ip,omitempty"`
+	Region        string `protobuf:"bytes,7,opt,name=region,proto3" json:"region,omitempty"`
+	NewAccount    bool   `protobuf:"varint,8,opt,name=new_account,json=newAccount,proto3" json:"new_account,omitempty"` // create another guest account instead of logging in the last one of the device
+}
+
+func (x *ReqLoginAsGuest) Reset() {
+	*x = ReqLoginAsGuest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_account_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReqLoginAsGuest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReqLoginAsGuest) ProtoMessage() {}
+
+func (x *ReqLoginAsGuest) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_account_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReqLoginAsGuest.ProtoReflect.Descriptor instead.
+func (*ReqLoginAsGuest) Descriptor() ([]byte, []int) {
+	return file_grpc_account_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ReqLoginAsGuest) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+func (x *ReqLoginAsGuest) GetDevice() string {
+	if x != nil {
+		return x.Device
+	}
+	return ""
+}
+
+func (x *ReqLoginAsGuest) GetOs() string {
+	if x != nil {
+		return x.Os
+	}
+	return ""
+}
+
+func (x *ReqLoginAsGuest) GetPlatform() string {
+	if x != nil {
+		return x.Platform
+	}
+	return ""
+}
+
+func (x *ReqLoginAsGuest) GetClientVersion() string {
+	if x != nil {
+		return x.ClientVersion
+	}
+	return ""
+}
+
+func (x *ReqLoginAsGuest) GetRemoteIp() string {
+	if x != nil {
+		return x.RemoteIp
+	}
+	return ""
+}
+
+func (x *ReqLoginAsGuest) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *ReqLoginAsGuest) GetNewAccount() bool {
+	if x != nil {
+		return x.NewAccount
+	}
+	return false
+}
+
+type ReqUpgradeGuestByEmail struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId   uint64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Email    string `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Password string `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	Code     string `protobuf:"bytes,4,opt,name=code,proto3" json:"code,omitempty"` // email bind code
+}
+
+func (x *ReqUpgradeGuestByEmail) Reset() {
+	*x = ReqUpgradeGuestByEmail{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_account_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReqUpgradeGuestByEmail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReqUpgradeGuestByEmail) ProtoMessage() {}
+
+func (x *ReqUpgradeGuestByEmail) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_account_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReqUpgradeGuestByEmail.ProtoReflect.Descriptor instead.
+func (*ReqUpgradeGuestByEmail) Descriptor() ([]byte, []int) {
+	return file_grpc_account_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ReqUpgradeGuestByEmail) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ReqUpgradeGuestByEmail) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *ReqUpgradeGuestByEmail) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *ReqUpgradeGuestByEmail) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type ELoginLock struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ELoginLock) Reset() {
+	*x = ELoginLock{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_account_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ELoginLock) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ELoginLock) ProtoMessage() {}
+
+func (x *ELoginLock) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_account_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ELoginLock.ProtoReflect.Descriptor instead.
+func (*ELoginLock) Descriptor() ([]byte, []int) {
+	return file_grpc_account_proto_rawDescGZIP(), []int{48}
+}
+
+type LoginLock struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type       ELoginLock_Type `protobuf:"varint,1,opt,name=type,proto3,enum=mpb.ELoginLock_Type" json:"type,omitempty"`
+	Target     string          `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"` // account, ip or account|ip
+	Failures   uint64          `protobuf:"varint,3,opt,name=failures,proto3" json:"failures,omitempty"`
+	UnlockTime int64           `protobuf:"varint,4,opt,name=unlock_time,json=unlockTime,proto3" json:"unlock_time,omitempty"` // 0 if not locked
+}
+
+func (x *LoginLock) Reset() {
+	*x = LoginLock{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_account_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LoginLock) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginLock) ProtoMessage() {}
+
+func (x *LoginLock) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_account_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginLock.ProtoReflect.Descriptor instead.
+func (*LoginLock) Descriptor() ([]byte, []int) {
+	return file_grpc_account_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *LoginLock) GetType() ELoginLock_Type {
+	if x != nil {
+		return x.Type
+	}
+	return ELoginLock_Type_None
+}
+
+func (x *LoginLock) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *LoginLock) GetFailures() uint64 {
+	if x != nil {
+		return x.Failures
+	}
+	return 0
+}
+
+func (x *LoginLock) GetUnlockTime() int64 {
+	if x != nil {
+		return x.UnlockTime
+	}
+	return 0
+}
+
+type ReqAdminGetLoginLocks struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type   ELoginLock_Type `protobuf:"varint,1,opt,name=type,proto3,enum=mpb.ELoginLock_Type" json:"type,omitempty"` // none to list every locked target
+	Target string          `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+}
+
+func (x *ReqAdminGetLoginLocks) Reset() {
+	*x = ReqAdminGetLoginLocks{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_account_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReqAdminGetLoginLocks) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReqAdminGetLoginLocks) ProtoMessage() {}
+
+func (x *ReqAdminGetLoginLocks) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_account_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReqAdminGetLoginLocks.ProtoReflect.Descriptor instead.
+func (*ReqAdminGetLoginLocks) Descriptor() ([]byte, []int) {
+	return file_grpc_account_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ReqAdminGetLoginLocks) GetType() ELoginLock_Type {
+	if x != nil {
+		return x.Type
+	}
+	return ELoginLock_Type_None
+}
+
+func (x *ReqAdminGetLoginLocks) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+type ResAdminGetLoginLocks struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Locks []*LoginLock `protobuf:"bytes,1,rep,name=locks,proto3" json:"locks,omitempty"`
+}
+
+func (x *ResAdminGetLoginLocks) Reset() {
+	*x = ResAdminGetLoginLocks{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_account_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResAdminGetLoginLocks) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResAdminGetLoginLocks) ProtoMessage() {}
+
+func (x *ResAdminGetLoginLocks) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_account_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResAdminGetLoginLocks.ProtoReflect.Descriptor instead.
+func (*ResAdminGetLoginLocks) Descriptor() ([]byte, []int) {
+	return file_grpc_account_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ResAdminGetLoginLocks) GetLocks() []*LoginLock {
+	if x != nil {
+		return x.Locks
+	}
+	return nil
+}
+
+type ReqAdminClearLoginLock struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type   ELoginLock_Type `protobuf:"varint,1,opt,name=type,proto3,enum=mpb.ELoginLock_Type" json:"type,omitempty"`
+	Target string          `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+}
+
+func (x *ReqAdminClearLoginLock) Reset() {
+	*x = ReqAdminClearLoginLock{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_account_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReqAdminClearLoginLock) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReqAdminClearLoginLock) ProtoMessage() {}
+
+func (x *ReqAdminClearLoginLock) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_account_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReqAdminClearLoginLock.ProtoReflect.Descriptor instead.
+func (*ReqAdminClearLoginLock) Descriptor() ([]byte, []int) {
+	return file_grpc_account_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ReqAdminClearLoginLock) GetType() ELoginLock_Type {
+	if x != nil {
+		return x.Type
+	}
+	return ELoginLock_Type_None
+}
+
+func (x *ReqAdminClearLoginLock) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+type ResGetLoginHistory struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Records []*LoginRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"` // the latest one first
+}
+
+func (x *ResGetLoginHistory) Reset() {
+	*x = ResGetLoginHistory{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_account_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResGetLoginHistory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResGetLoginHistory) ProtoMessage() {}
+
+func (x *ResGetLoginHistory) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_account_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResGetLoginHistory.ProtoReflect.Descriptor instead.
+func (*ResGetLoginHistory) Descriptor() ([]byte, []int) {
+	return file_grpc_account_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ResGetLoginHistory) GetRecords() []*LoginRecord {
+	if x != nil {
+		return x.Records
+	}
+	return nil
+}
+
+type ReqAdminBanAccount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId   uint64    `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Type     EBan_Type `protobuf:"varint,2,opt,name=type,proto3,enum=mpb.EBan_Type" json:"type,omitempty"`
+	Reason   string    `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	Operator string    `protobuf:"bytes,4,opt,name=operator,proto3" json:"operator,omitempty"`
+	Duration int64     `protobuf:"varint,5,opt,name=duration,proto3" json:"duration,omitempty"` // seconds, must be 0 for ban and positive for suspension, 0 for a permanent mute
+}
+
+func (x *ReqAdminBanAccount) Reset() {
+	*x = ReqAdminBanAccount{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_account_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReqAdminBanAccount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReqAdminBanAccount) ProtoMessage() {}
+
+func (x *ReqAdminBanAccount) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_account_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReqAdminBanAccount.ProtoReflect.Descriptor instead.
+func (*ReqAdminBanAccount) Descriptor() ([]byte, []int) {
+	return file_grpc_account_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ReqAdminBanAccount) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ReqAdminBanAccount) GetType() EBan_Type {
+	if x != nil {
+		return x.Type
+	}
+	return EBan_Type_None
+}
+
+func (x *ReqAdminBanAccount) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ReqAdminBanAccount) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *ReqAdminBanAccount) GetDuration() int64 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+type ReqAdminUnbanAccount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId   uint64    `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Type     EBan_Type `protobuf:"varint,2,opt,name=type,proto3,enum=mpb.EBan_Type" json:"type,omitempty"` // ban and suspend both lift the login ban
+	Reason   string    `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	Operator string    `protobuf:"bytes,4,opt,name=operator,proto3" json:"operator,omitempty"`
+}
+
+func (x *ReqAdminUnbanAccount) Reset() {
+	*x = ReqAdminUnbanAccount{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_account_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReqAdminUnbanAccount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReqAdminUnbanAccount) ProtoMessage() {}
+
+func (x *ReqAdminUnbanAccount) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_account_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReqAdminUnbanAccount.ProtoReflect.Descriptor instead.
+func (*ReqAdminUnbanAccount) Descriptor() ([]byte, []int) {
+	return file_grpc_account_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ReqAdminUnbanAccount) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ReqAdminUnbanAccount) GetType() EBan_Type {
+	if x != nil {
+		return x.Type
+	}
+	return EBan_Type_None
+}
+
+func (x *ReqAdminUnbanAccount) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ReqAdminUnbanAccount) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+type ReqAdminListBans struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type   EBan_Type `protobuf:"varint,1,opt,name=type,proto3,enum=mpb.EBan_Type" json:"type,omitempty"` // none to list every type
+	UserId uint64    `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`  // 0 to list every banned user
+}
+
+func (x *ReqAdminListBans) Reset() {
+	*x = ReqAdminListBans{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_account_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReqAdminListBans) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReqAdminListBans) ProtoMessage() {}
+
+func (x *ReqAdminListBans) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_account_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReqAdminListBans.ProtoReflect.Descriptor instead.
+func (*ReqAdminListBans) Descriptor() ([]byte, []int) {
+	return file_grpc_account_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ReqAdminListBans) GetType() EBan_Type {
+	if x != nil {
+		return x.Type
+	}
+	return EBan_Type_None
+}
+
+func (x *ReqAdminListBans) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type ResAdminListBans struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Bans []*BanRecord   `protobuf:"bytes,1,rep,name=bans,proto3" json:"bans,omitempty"`
+	Logs []*BanAuditLog `protobuf:"bytes,2,rep,name=logs,proto3" json:"logs,omitempty"` // only if user_id is set, the latest one first
+}
+
+func (x *ResAdminListBans) Reset() {
+	*x = ResAdminListBans{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_account_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResAdminListBans) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResAdminListBans) ProtoMessage() {}
+
+func (x *ResAdminListBans) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_account_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResAdminListBans.ProtoReflect.Descriptor instead.
+func (*ResAdminListBans) Descriptor() ([]byte, []int) {
+	return file_grpc_account_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ResAdminListBans) GetBans() []*BanRecord {
+	if x != nil {
+		return x.Bans
+	}
+	return nil
+}
+
+func (x *ResAdminListBans) GetLogs() []*BanAuditLog {
+	if x != nil {
+		return x.Logs
+	}
+	return nil
+}
+
+type ResGetReferralStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InviteCode    string      `protobuf:"bytes,1,opt,name=invite_code,json=inviteCode,proto3" json:"invite_code,omitempty"`
+	ReferrerId    uint64      `protobuf:"varint,2,opt,name=referrer_id,json=referrerId,proto3" json:"referrer_id,omitempty"`
+	InvitedCount  uint32      `protobuf:"varint,3,opt,name=invited_count,json=invitedCount,proto3" json:"invited_count,omitempty"`
+	RejectedCount uint32      `protobuf:"varint,4,opt,name=rejected_count,json=rejectedCount,proto3" json:"rejected_count,omitempty"`
+	Referrals     []*Referral `protobuf:"bytes,5,rep,name=referrals,proto3" json:"referrals,omitempty"` // the latest one first
+}
+
+func (x *ResGetReferralStats) Reset() {
+	*x = ResGetReferralStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_account_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResGetReferralStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResGetReferralStats) ProtoMessage() {}
+
+func (x *ResGetReferralStats) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_account_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResGetReferralStats.ProtoReflect.Descriptor instead.
+func (*ResGetReferralStats) Descriptor() ([]byte, []int) {
+	return file_grpc_account_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ResGetReferralStats) GetInviteCode() string {
+	if x != nil {
+		return x.InviteCode
+	}
+	return ""
+}
+
+func (x *ResGetReferralStats) GetReferrerId() uint64 {
+	if x != nil {
+		return x.ReferrerId
+	}
+	return 0
+}
+
+func (x *ResGetReferralStats) GetInvitedCount() uint32 {
+	if x != nil {
+		return x.InvitedCount
+	}
+	return 0
+}
+
+func (x *ResGetReferralStats) GetRejectedCount() uint32 {
+	if x != nil {
+		return x.RejectedCount
+	}
+	return 0
+}
+
+func (x *ResGetReferralStats) GetReferrals() []*Referral {
+	if x != nil {
+		return x.Referrals
+	}
+	return nil
+}
+
+type ReqAdminGetTopReferrers struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Limit uint32 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *ReqAdminGetTopReferrers) Reset() {
+	*x = ReqAdminGetTopReferrers{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_account_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReqAdminGetTopReferrers) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReqAdminGetTopReferrers) ProtoMessage() {}
+
+func (x *ReqAdminGetTopReferrers) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_account_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReqAdminGetTopReferrers.ProtoReflect.Descriptor instead.
+func (*ReqAdminGetTopReferrers) Descriptor() ([]byte, []int) {
+	return file_grpc_account_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ReqAdminGetTopReferrers) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ResAdminGetTopReferrers struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Referrers []*ReferrerRank `protobuf:"bytes,1,rep,name=referrers,proto3" json:"referrers,omitempty"`
+}
+
+func (x *ResAdminGetTopReferrers) Reset() {
+	*x = ResAdminGetTopReferrers{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_account_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResAdminGetTopReferrers) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResAdminGetTopReferrers) ProtoMessage() {}
+
+func (x *ResAdminGetTopReferrers) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_account_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResAdminGetTopReferrers.ProtoReflect.Descriptor instead.
+func (*ResAdminGetTopReferrers) Descriptor() ([]byte, []int) {
+	return file_grpc_account_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ResAdminGetTopReferrers) GetReferrers() []*ReferrerRank {
+	if x != nil {
+		return x.Referrers
+	}
+	return nil
+}
+
+type ReqLoginByPlatform struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Provider      string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"` // google, apple or discord
+	IdToken       string `protobuf:"bytes,2,opt,name=id_token,json=idToken,proto3" json:"id_token,omitempty"`
+	Device        string `protobuf:"bytes,4,opt,name=device,proto3" json:"device,omitempty"`
+	DeviceId      string `protobuf:"bytes,5,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	Os            string `protobuf:"bytes,6,opt,name=os,proto3" json:"os,omitempty"`
+	Platform      string `protobuf:"bytes,7,opt,name=platform,proto3" json:"platform,omitempty"`
+	ClientVersion string `protobuf:"bytes,8,opt,name=client_version,json=clientVersion,proto3" json:"client_version,omitempty"`
+	RemoteIp      string `protobuf:"bytes,9,opt,name=remote_ip,json=remoteIp,proto3" json:"remote_ip,omitempty"`
+	Region        string `protobuf:"bytes,10,opt,name=region,proto3" json:"region,omitempty"`
+	ReferrerCode  string `protobuf:"bytes,11,opt,name=referrer_code,json=referrerCode,proto3" json:"referrer_code,omitempty"` // invite code of the referrer, only used if the account is created
+	Nonce         string `protobuf:"bytes,12,opt,name=nonce,proto3" json:"nonce,omitempty"`                                   // passed to the provider by the client, an ID token is accepted once
+}
+
+func (x *ReqLoginByPlatform) Reset() {
+	*x = ReqLoginByPlatform{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_account_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReqLoginByPlatform) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReqLoginByPlatform) ProtoMessage() {}
+
+func (x *ReqLoginByPlatform) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_account_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReqLoginByPlatform.ProtoReflect.Descriptor instead.
+func (*ReqLoginByPlatform) Descriptor() ([]byte, []int) {
+	return file_grpc_account_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *ReqLoginByPlatform) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *ReqLoginByPlatform) GetIdToken() string {
+	if x != nil {
+		return x.IdToken
+	}
+	return ""
+}
+
+func (x *ReqLoginByPlatform) GetDevice() string {
+	if x != nil {
+		return x.Device
+	}
+	return ""
+}
+
+func (x *ReqLoginByPlatform) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+func (x *ReqLoginByPlatform) GetOs() string {
+	if x != nil {
+		return x.Os
+	}
+	return ""
+}
+
+func (x *ReqLoginByPlatform) GetPlatform() string {
+	if x != nil {
+		return x.Platform
+	}
+	return ""
+}
+
+func (x *ReqLoginByPlatform) GetClientVersion() string {
+	if x != nil {
+		return x.ClientVersion
+	}
+	return ""
+}
+
+func (x *ReqLoginByPlatform) GetRemoteIp() string {
+	if x != nil {
+		return x.RemoteIp
+	}
+	return ""
+}
+
+func (x *ReqLoginByPlatform) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *ReqLoginByPlatform) GetReferrerCode() string {
+	if x != nil {
+		return x.ReferrerCode
+	}
+	return ""
+}
+
+func (x *ReqLoginByPlatform) GetNonce() string {
+	if x != nil {
+		return x.Nonce
+	}
+	return ""
+}
+
+type ReqLinkPlatform struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId   uint64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Provider string `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
+	IdToken  string `protobuf:"bytes,3,opt,name=id_token,json=idToken,proto3" json:"id_token,omitempty"`
+	Nonce    string `protobuf:"bytes,4,opt,name=nonce,proto3" json:"nonce,omitempty"`
+}
+
+func (x *ReqLinkPlatform) Reset() {
+	*x = ReqLinkPlatform{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_account_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReqLinkPlatform) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReqLinkPlatform) ProtoMessage() {}
+
+func (x *ReqLinkPlatform) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_account_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReqLinkPlatform.ProtoReflect.Descriptor instead.
+func (*ReqLinkPlatform) Descriptor() ([]byte, []int) {
+	return file_grpc_account_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *ReqLinkPlatform) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ReqLinkPlatform) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *ReqLinkPlatform) GetIdToken() string {
+	if x != nil {
+		return x.IdToken
+	}
+	return ""
+}
+
+func (x *ReqLinkPlatform) GetNonce() string {
+	if x != nil {
+		return x.Nonce
+	}
+	return ""
+}
+
+// ReqMergeAccount merge another account of the user into the logged-in one, the other account is proved by
+// its email and password or by a wallet linked to it
+type ReqMergeAccount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId        uint64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`      // the logged-in account, it is kept
+	TotpCode      string `protobuf:"bytes,2,opt,name=totp_code,json=totpCode,proto3" json:"totp_code,omitempty"` // of the logged-in account, required if its totp is enabled
+	Email         string `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	Password      string `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`
+	WalletAddr    string `protobuf:"bytes,5,opt,name=wallet_addr,json=walletAddr,proto3" json:"wallet_addr,omitempty"`
+	AptosFullMsg  string `protobuf:"bytes,6,opt,name=aptos_full_msg,json=aptosFullMsg,proto3" json:"aptos_full_msg,omitempty"`    // the signature has been verified by gateway
+	OtherTotpCode string `protobuf:"bytes,7,opt,name=other_totp_code,json=otherTotpCode,proto3" json:"other_totp_code,omitempty"` // of the other account, required if its totp is enabled
+	RemoteIp      string `protobuf:"bytes,8,opt,name=remote_ip,json=remoteIp,proto3" json:"remote_ip,omitempty"`
+}
+
+func (x *ReqMergeAccount) Reset() {
+	*x = ReqMergeAccount{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_account_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReqMergeAccount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReqMergeAccount) ProtoMessage() {}
+
+func (x *ReqMergeAccount) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_account_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReqMergeAccount.ProtoReflect.Descriptor instead.
+func (*ReqMergeAccount) Descriptor() ([]byte, []int) {
+	return file_grpc_account_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *ReqMergeAccount) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ReqMergeAccount) GetTotpCode() string {
+	if x != nil {
+		return x.TotpCode
+	}
+	return ""
+}
+
+func (x *ReqMergeAccount) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *ReqMergeAccount) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *ReqMergeAccount) GetWalletAddr() string {
+	if x != nil {
+		return x.WalletAddr
+	}
+	return ""
+}
+
+func (x *ReqMergeAccount) GetAptosFullMsg() string {
+	if x != nil {
+		return x.AptosFullMsg
+	}
+	return ""
+}
+
+func (x *ReqMergeAccount) GetOtherTotpCode() string {
+	if x != nil {
+		return x.OtherTotpCode
+	}
+	return ""
+}
+
+func (x *ReqMergeAccount) GetRemoteIp() string {
+	if x != nil {
+		return x.RemoteIp
+	}
+	return ""
+}
+
+type ReqAdminMergeAccounts struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId       uint64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                     // the account kept
+	MergedUserId uint64 `protobuf:"varint,2,opt,name=merged_user_id,json=mergedUserId,proto3" json:"merged_user_id,omitempty"` // the account tombstoned
+	Reason       string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	Operator     string `protobuf:"bytes,4,opt,name=operator,proto3" json:"operator,omitempty"`
+}
+
+func (x *ReqAdminMergeAccounts) Reset() {
+	*x = ReqAdminMergeAccounts{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_account_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReqAdminMergeAccounts) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReqAdminMergeAccounts) ProtoMessage() {}
+
+func (x *ReqAdminMergeAccounts) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_account_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReqAdminMergeAccounts.ProtoReflect.Descriptor instead.
+func (*ReqAdminMergeAccounts) Descriptor() ([]byte, []int) {
+	return file_grpc_account_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ReqAdminMergeAccounts) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ReqAdminMergeAccounts) GetMergedUserId() uint64 {
+	if x != nil {
+		return x.MergedUserId
+	}
+	return 0
+}
+
+func (x *ReqAdminMergeAccounts) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ReqAdminMergeAccounts) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+type ReqSendSMSCode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId   uint64           `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // the logged-in account, required to bind the phone
+	Tel      string           `protobuf:"bytes,2,opt,name=tel,proto3" json:"tel,omitempty"`                      // E.164, e.g. +6591234567
+	Purpose  ESMSCode_Purpose `protobuf:"varint,3,opt,name=purpose,proto3,enum=mpb.ESMSCode_Purpose" json:"purpose,omitempty"`
+	RemoteIp string           `protobuf:"bytes,4,opt,name=remote_ip,json=remoteIp,proto3" json:"remote_ip,omitempty"`
+}
+
+func (x *ReqSendSMSCode) Reset() {
+	*x = ReqSendSMSCode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_account_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReqSendSMSCode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReqSendSMSCode) ProtoMessage() {}
+
+func (x *ReqSendSMSCode) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_account_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReqSendSMSCode.ProtoReflect.Descriptor instead.
+func (*ReqSendSMSCode) Descriptor() ([]byte, []int) {
+	return file_grpc_account_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *ReqSendSMSCode) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ReqSendSMSCode) GetTel() string {
+	if x != nil {
+		return x.Tel
+	}
+	return ""
+}
+
+func (x *ReqSendSMSCode) GetPurpose() ESMSCode_Purpose {
+	if x != nil {
+		return x.Purpose
+	}
+	return ESMSCode_Purpose_None
+}
+
+func (x *ReqSendSMSCode) GetRemoteIp() string {
+	if x != nil {
+		return x.RemoteIp
+	}
+	return ""
+}
+
+type ReqBindPhone struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId uint64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Tel    string `protobuf:"bytes,2,opt,name=tel,proto3" json:"tel,omitempty"`
+	Code   string `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
+}
+
+func (x *ReqBindPhone) Reset() {
+	*x = ReqBindPhone{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_account_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReqBindPhone) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReqBindPhone) ProtoMessage() {}
+
+func (x *ReqBindPhone) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_account_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReqBindPhone.ProtoReflect.Descriptor instead.
+func (*ReqBindPhone) Descriptor() ([]byte, []int) {
+	return file_grpc_account_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *ReqBindPhone) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ReqBindPhone) GetTel() string {
+	if x != nil {
+		return x.Tel
+	}
+	return ""
+}
+
+func (x *ReqBindPhone) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type ReqResetPasswordByPhone struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tel      string `protobuf:"bytes,1,opt,name=tel,proto3" json:"tel,omitempty"`
+	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	Code     string `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
+	TotpCode string `protobuf:"bytes,4,opt,name=totp_code,json=totpCode,proto3" json:"totp_code,omitempty"`
+	RemoteIp string `protobuf:"bytes,5,opt,name=remote_ip,json=remoteIp,proto3" json:"remote_ip,omitempty"`
+}
+
+func (x *ReqResetPasswordByPhone) Reset() {
+	*x = ReqResetPasswordByPhone{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_account_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReqResetPasswordByPhone) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReqResetPasswordByPhone) ProtoMessage() {}
+
+func (x *ReqResetPasswordByPhone) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_account_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReqResetPasswordByPhone.ProtoReflect.Descriptor instead.
+func (*ReqResetPasswordByPhone) Descriptor() ([]byte, []int) {
+	return file_grpc_account_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *ReqResetPasswordByPhone) GetTel() string {
+	if x != nil {
+		return x.Tel
+	}
+	return ""
+}
+
+func (x *ReqResetPasswordByPhone) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *ReqResetPasswordByPhone) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *ReqResetPasswordByPhone) GetTotpCode() string {
+	if x != nil {
+		return x.TotpCode
+	}
+	return ""
+}
+
+func (x *ReqResetPasswordByPhone) GetRemoteIp() string {
+	if x != nil {
+		return x.RemoteIp
+	}
+	return ""
+}
+
+var File_grpc_account_proto protoreflect.FileDescriptor
+
+var file_grpc_account_proto_rawDesc = []byte{
+	0x0a, 0x12, 0x67, 0x72, 0x70, 0x63, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x03, 0x6d, 0x70, 0x62, 0x1a, 0x0c, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb4, 0x01, 0x0a, 0x12, 0x52, 0x65, 0x71, 0x4c,
+	0x6f, 0x67, 0x69, 0x6e, 0x42, 0x79, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x18,
+	0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x69,
+	0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x49,
+	0x70, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63,
+	0x65, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x22, 0xe1,
+	0x01, 0x0a, 0x12, 0x52, 0x65, 0x73, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x42, 0x79, 0x50, 0x61, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x2a, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12,
+	0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68,
+	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65,
+	0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x6f,
+	0x74, 0x70, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0c, 0x74, 0x6f, 0x74, 0x70, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x12,
+	0x21, 0x0a, 0x0c, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x54, 0x69, 0x63, 0x6b,
+	0x65, 0x74, 0x22, 0x2d, 0x0a, 0x11, 0x52, 0x65, 0x71, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x22, 0xc0, 0x02, 0x0a, 0x12, 0x52, 0x65, 0x71, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
+	0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x6f, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x6f, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65, 0x76, 0x69, 0x63,
+	0x65, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65,
+	0x67, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69,
+	0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x69, 0x70, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x49, 0x70, 0x12,
+	0x1a, 0x0a, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x63,
+	0x6f, 0x64, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12,
+	0x23, 0x0a, 0x0d, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x72,
+	0x43, 0x6f, 0x64, 0x65, 0x22, 0x7b, 0x0a, 0x12, 0x52, 0x65, 0x73, 0x52, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x07, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d, 0x70,
+	0x62, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x23, 0x0a, 0x0d,
+	0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x22, 0x33, 0x0a, 0x10, 0x52, 0x65, 0x71, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65,
+	0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f,
+	0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x77, 0x61, 0x6c, 0x6c,
+	0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x22, 0x28, 0x0a, 0x10, 0x52, 0x65, 0x73, 0x47, 0x65, 0x6e,
+	0x65, 0x72, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f,
+	0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65,
+	0x22, 0xad, 0x02, 0x0a, 0x13, 0x52, 0x65, 0x71, 0x57, 0x65, 0x62, 0x4c, 0x6f, 0x67, 0x69, 0x6e,
+	0x42, 0x79, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x77, 0x61, 0x6c, 0x6c,
+	0x65, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x77,
+	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x75, 0x62,
+	0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x70, 0x75, 0x62, 0x4b,
+	0x65, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x69, 0x70, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x49, 0x70, 0x12,
+	0x16, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x0e, 0x61, 0x70, 0x74, 0x6f, 0x73,
+	0x5f, 0x66, 0x75, 0x6c, 0x6c, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x61, 0x70, 0x74, 0x6f, 0x73, 0x46, 0x75, 0x6c, 0x6c, 0x4d, 0x73, 0x67, 0x12, 0x27, 0x0a,
+	0x0f, 0x61, 0x70, 0x74, 0x6f, 0x73, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x70, 0x74, 0x6f, 0x73, 0x53, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x1b,
+	0x0a, 0x09, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x72,
+	0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x72, 0x43, 0x6f, 0x64, 0x65,
+	0x22, 0x9a, 0x01, 0x0a, 0x13, 0x52, 0x65, 0x73, 0x57, 0x65, 0x62, 0x4c, 0x6f, 0x67, 0x69, 0x6e,
+	0x42, 0x79, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x12, 0x2a, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d, 0x70, 0x62, 0x2e,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x66, 0x72,
+	0x65, 0x73, 0x68, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x36, 0x0a,
+	0x1a, 0x52, 0x65, 0x71, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e,
+	0x66, 0x6f, 0x42, 0x79, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x53, 0x0a, 0x1f, 0x52, 0x65, 0x71, 0x47, 0x65, 0x6e, 0x65,
+	0x72, 0x61, 0x74, 0x65, 0x41, 0x6e, 0x64, 0x53, 0x65, 0x6e, 0x64, 0x45, 0x6d, 0x61, 0x69, 0x6c,
+	0x42, 0x69, 0x6e, 0x64, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69,
+	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1a,
+	0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x22, 0xbe, 0x01, 0x0a, 0x0f, 0x52,
+	0x65, 0x71, 0x57, 0x65, 0x62, 0x42, 0x69, 0x6e, 0x64, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x17,
+	0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x12, 0x0a,
+	0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65,
+	0x5f, 0x69, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x6d, 0x6f, 0x74,
+	0x65, 0x49, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x22, 0x96, 0x01, 0x0a, 0x0f,
+	0x52, 0x65, 0x73, 0x57, 0x65, 0x62, 0x42, 0x69, 0x6e, 0x64, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12,
+	0x2a, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x10, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
+	0x23, 0x0a, 0x0d, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x53, 0x0a, 0x12, 0x52, 0x65, 0x73, 0x47, 0x65, 0x74, 0x41, 0x70,
+	0x74, 0x6f, 0x73, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x61, 0x70,
+	0x74, 0x6f, 0x73, 0x5f, 0x61, 0x63, 0x63, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x61, 0x70, 0x74, 0x6f, 0x73, 0x41, 0x63, 0x63, 0x41, 0x64, 0x64, 0x72,
+	0x12, 0x17, 0x0a, 0x07, 0x70, 0x75, 0x62, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x70, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x22, 0x8f, 0x01, 0x0a, 0x11, 0x52, 0x65,
+	0x71, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12,
+	0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x6c, 0x64, 0x5f,
+	0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x6f, 0x6c, 0x64, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6e,
+	0x65, 0x77, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x6e, 0x65, 0x77, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1b,
+	0x0a, 0x09, 0x74, 0x6f, 0x74, 0x70, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x74, 0x6f, 0x74, 0x70, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x51, 0x0a, 0x1d, 0x52,
+	0x65, 0x71, 0x53, 0x65, 0x6e, 0x64, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x73, 0x65, 0x74,
+	0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61,
+	0x69, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x22, 0x67,
+	0x0a, 0x1e, 0x52, 0x65, 0x71, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x52,
+	0x65, 0x73, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x43, 0x6f, 0x64, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65,
+	0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x69, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72,
+	0x65, 0x6d, 0x6f, 0x74, 0x65, 0x49, 0x70, 0x22, 0x36, 0x0a, 0x1e, 0x52, 0x65, 0x73, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x73, 0x65, 0x74, 0x50, 0x61, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e,
+	0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x22,
+	0x9b, 0x01, 0x0a, 0x17, 0x52, 0x65, 0x71, 0x52, 0x65, 0x73, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x42, 0x79, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x65,
+	0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69,
+	0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f,
+	0x6e, 0x63, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x6f, 0x74, 0x70, 0x5f, 0x63, 0x6f, 0x64, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x6f, 0x74, 0x70, 0x43, 0x6f, 0x64, 0x65,
+	0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x69, 0x70, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x49, 0x70, 0x22, 0xa1, 0x01,
+	0x0a, 0x1f, 0x52, 0x65, 0x71, 0x52, 0x65, 0x73, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x42, 0x79, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x41, 0x6e, 0x64, 0x56, 0x43, 0x6f, 0x64,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x6f, 0x74, 0x70, 0x5f,
+	0x63, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x6f, 0x74, 0x70,
+	0x43, 0x6f, 0x64, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x69,
+	0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x49,
+	0x70, 0x22, 0x38, 0x0a, 0x20, 0x52, 0x65, 0x71, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x42, 0x79, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74,
+	0x41, 0x64, 0x64, 0x72, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x64, 0x64, 0x72, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x61, 0x64, 0x64, 0x72, 0x73, 0x22, 0x50, 0x0a, 0x20, 0x52,
+	0x65, 0x73, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x73, 0x42, 0x79, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x73, 0x12,
+	0x2c, 0x0a, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x10, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x22, 0x6b, 0x0a,
+	0x0f, 0x52, 0x65, 0x71, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f,
+	0x69, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65,
+	0x49, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x22, 0x4c, 0x0a, 0x0f, 0x52, 0x65,
+	0x73, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x5f, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x66, 0x72,
+	0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x3a, 0x0a, 0x09, 0x52, 0x65, 0x71, 0x4c,
+	0x6f, 0x67, 0x6f, 0x75, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x3f, 0x0a, 0x0e, 0x52, 0x65, 0x71, 0x47, 0x65, 0x74, 0x53, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x3e, 0x0a, 0x0e, 0x52, 0x65, 0x73, 0x47, 0x65, 0x74, 0x53,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2c, 0x0a, 0x08, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d, 0x70, 0x62, 0x2e,
+	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x4a, 0x0a, 0x10, 0x52, 0x65, 0x71, 0x52, 0x65, 0x76, 0x6f,
+	0x6b, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49,
+	0x64, 0x22, 0x48, 0x0a, 0x0d, 0x52, 0x65, 0x73, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x54, 0x4f,
+	0x54, 0x50, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x74,
+	0x70, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x75, 0x72, 0x69, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x6f, 0x74, 0x70, 0x61, 0x75, 0x74, 0x68, 0x55, 0x72, 0x69, 0x22, 0x3a, 0x0a, 0x0b, 0x52,
+	0x65, 0x71, 0x54, 0x4f, 0x54, 0x50, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x37, 0x0a, 0x0e, 0x52, 0x65, 0x73, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x72, 0x6d, 0x54, 0x4f, 0x54, 0x50, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x63,
+	0x6f, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0d, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x73,
+	0x22, 0x7c, 0x0a, 0x0e, 0x52, 0x65, 0x71, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x42, 0x79, 0x54, 0x4f,
+	0x54, 0x50, 0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b,
+	0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x54,
+	0x69, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x6d,
+	0x6f, 0x74, 0x65, 0x5f, 0x69, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65,
+	0x6d, 0x6f, 0x74, 0x65, 0x49, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x22, 0x88,
+	0x01, 0x0a, 0x0d, 0x52, 0x65, 0x71, 0x4c, 0x69, 0x6e, 0x6b, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74,
+	0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x77, 0x61, 0x6c,
+	0x6c, 0x65, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x75,
+	0x62, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x70, 0x75, 0x62,
+	0x4b, 0x65, 0x79, 0x12, 0x24, 0x0a, 0x0e, 0x61, 0x70, 0x74, 0x6f, 0x73, 0x5f, 0x66, 0x75, 0x6c,
+	0x6c, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x70, 0x74,
+	0x6f, 0x73, 0x46, 0x75, 0x6c, 0x6c, 0x4d, 0x73, 0x67, 0x22, 0x49, 0x0a, 0x0d, 0x52, 0x65, 0x71,
+	0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x61, 0x64,
+	0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74,
+	0x41, 0x64, 0x64, 0x72, 0x22, 0x3b, 0x0a, 0x0e, 0x52, 0x65, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x57,
+	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x73, 0x12, 0x29, 0x0a, 0x07, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x57, 0x61,
+	0x6c, 0x6c, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74,
+	0x73, 0x22, 0x74, 0x0a, 0x16, 0x52, 0x65, 0x71, 0x53, 0x65, 0x6e, 0x64, 0x45, 0x6d, 0x61, 0x69,
+	0x6c, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x65, 0x77, 0x5f, 0x65, 0x6d, 0x61, 0x69,
+	0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x65, 0x77, 0x45, 0x6d, 0x61, 0x69,
+	0x6c, 0x12, 0x24, 0x0a, 0x0e, 0x73, 0x6b, 0x69, 0x70, 0x5f, 0x6f, 0x6c, 0x64, 0x5f, 0x65, 0x6d,
+	0x61, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x73, 0x6b, 0x69, 0x70, 0x4f,
+	0x6c, 0x64, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0xe0, 0x01, 0x0a, 0x0e, 0x52, 0x65, 0x71, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x65, 0x77, 0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x65, 0x77, 0x45, 0x6d, 0x61, 0x69, 0x6c,
+	0x12, 0x19, 0x0a, 0x08, 0x6e, 0x65, 0x77, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x77, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6f,
+	0x6c, 0x64, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f,
+	0x6c, 0x64, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74,
+	0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x77, 0x61, 0x6c,
+	0x6c, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x12, 0x24, 0x0a, 0x0e, 0x61, 0x70, 0x74, 0x6f, 0x73,
+	0x5f, 0x66, 0x75, 0x6c, 0x6c, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x61, 0x70, 0x74, 0x6f, 0x73, 0x46, 0x75, 0x6c, 0x6c, 0x4d, 0x73, 0x67, 0x12, 0x1b, 0x0a,
+	0x09, 0x74, 0x6f, 0x74, 0x70, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x74, 0x6f, 0x74, 0x70, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x70, 0x0a, 0x0e, 0x45, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x61, 0x69, 0x72, 0x22, 0x5e, 0x0a, 0x06,
+	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0f, 0x0a, 0x0b, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x4e, 0x6f, 0x6e, 0x65, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x41, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x41, 0x64, 0x6f, 0x70, 0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x10, 0x01, 0x12,
+	0x16, 0x0a, 0x12, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x4f,
+	0x72, 0x70, 0x68, 0x61, 0x6e, 0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f, 0x41, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x10, 0x03, 0x22, 0x9f, 0x01, 0x0a,
+	0x0d, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x61, 0x69, 0x72, 0x12, 0x17,
+	0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x77, 0x61, 0x6c, 0x6c, 0x65,
+	0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x77, 0x61,
+	0x6c, 0x6c, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x12, 0x32, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x45,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x61, 0x69, 0x72, 0x2e, 0x41, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0c,
+	0x6c, 0x69, 0x76, 0x65, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0a, 0x6c, 0x69, 0x76, 0x65, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x6b,
+	0x0a, 0x16, 0x52, 0x65, 0x71, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x70, 0x61, 0x69, 0x72,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x22, 0x0a, 0x0d, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x22, 0x68, 0x0a, 0x16, 0x52,
+	0x65, 0x73, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x70, 0x61, 0x69, 0x72, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x2c, 0x0a, 0x07, 0x72, 0x65, 0x70, 0x61, 0x69, 0x72, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x61, 0x69, 0x72, 0x52, 0x07, 0x72, 0x65, 0x70, 0x61,
+	0x69, 0x72, 0x73, 0x12, 0x20, 0x0a, 0x0c, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x55,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0xeb, 0x02, 0x0a, 0x19, 0x52, 0x65, 0x71, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09,
+	0x74, 0x6f, 0x74, 0x70, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x74, 0x6f, 0x74, 0x70, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f,
+	0x61, 0x64, 0x64, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x77, 0x61, 0x6c, 0x6c,
+	0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x12, 0x24, 0x0a, 0x0e, 0x61, 0x70, 0x74, 0x6f, 0x73, 0x5f,
+	0x66, 0x75, 0x6c, 0x6c, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x61, 0x70, 0x74, 0x6f, 0x73, 0x46, 0x75, 0x6c, 0x6c, 0x4d, 0x73, 0x67, 0x12, 0x1a, 0x0a, 0x08,
+	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x64, 0x5f, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x69, 0x64, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x1b,
+	0x0a, 0x09, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x69, 0x70, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x49, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x72,
+	0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x67,
+	0x69, 0x6f, 0x6e, 0x22, 0x40, 0x0a, 0x19, 0x52, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x23, 0x0a, 0x0d, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f,
+	0x6e, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x8e, 0x03, 0x0a, 0x12, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x44, 0x61, 0x74, 0x61, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x12, 0x1f, 0x0a, 0x0b,
+	0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x2a, 0x0a,
+	0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10,
+	0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0c, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x6f, 0x73, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x6f, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x1a,
+	0x0a, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x29, 0x0a, 0x07, 0x77, 0x61,
+	0x6c, 0x6c, 0x65, 0x74, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6d, 0x70,
+	0x62, 0x2e, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x77, 0x61,
+	0x6c, 0x6c, 0x65, 0x74, 0x73, 0x12, 0x2c, 0x0a, 0x08, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x53, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x27, 0x0a, 0x04, 0x6e, 0x66, 0x74, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x41, 0x70, 0x74, 0x6f, 0x73, 0x4e, 0x46, 0x54,
+	0x4e, 0x6f, 0x64, 0x65, 0x56, 0x32, 0x52, 0x04, 0x6e, 0x66, 0x74, 0x73, 0x12, 0x28, 0x0a, 0x06,
+	0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d,
+	0x70, 0x62, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x06,
+	0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x73, 0x22, 0x30, 0x0a, 0x14, 0x52, 0x65, 0x73, 0x45, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x18,
+	0x0a, 0x07, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x22, 0x5b, 0x0a, 0x10, 0x52, 0x65, 0x71, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x17, 0x0a, 0x07,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x63, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x69, 0x63, 0x6f, 0x6e, 0x22, 0xef, 0x01, 0x0a, 0x0f, 0x52, 0x65, 0x71, 0x4c, 0x6f, 0x67,
+	0x69, 0x6e, 0x41, 0x73, 0x47, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x0e,
+	0x0a, 0x02, 0x6f, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x6f, 0x73, 0x12, 0x1a,
+	0x0a, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x69, 0x70, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x49, 0x70, 0x12, 0x16,
+	0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x77, 0x5f, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x6e, 0x65, 0x77,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x77, 0x0a, 0x16, 0x52, 0x65, 0x71, 0x55, 0x70,
+	0x67, 0x72, 0x61, 0x64, 0x65, 0x47, 0x75, 0x65, 0x73, 0x74, 0x42, 0x79, 0x45, 0x6d, 0x61, 0x69,
+	0x6c, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d,
+	0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c,
+	0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x63, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65,
+	0x22, 0x56, 0x0a, 0x0a, 0x45, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x4c, 0x6f, 0x63, 0x6b, 0x22, 0x48,
+	0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0d, 0x0a, 0x09, 0x54, 0x79, 0x70, 0x65, 0x5f, 0x4e,
+	0x6f, 0x6e, 0x65, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x54, 0x79, 0x70, 0x65, 0x5f, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x54, 0x79, 0x70, 0x65, 0x5f,
+	0x49, 0x50, 0x10, 0x02, 0x12, 0x12, 0x0a, 0x0e, 0x54, 0x79, 0x70, 0x65, 0x5f, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x49, 0x50, 0x10, 0x03, 0x22, 0x8a, 0x01, 0x0a, 0x09, 0x4c, 0x6f, 0x67,
+	0x69, 0x6e, 0x4c, 0x6f, 0x63, 0x6b, 0x12, 0x28, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x45, 0x4c, 0x6f, 0x67, 0x69,
+	0x6e, 0x4c, 0x6f, 0x63, 0x6b, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x61, 0x69, 0x6c,
+	0x75, 0x72, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x66, 0x61, 0x69, 0x6c,
+	0x75, 0x72, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x75, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x75, 0x6e, 0x6c, 0x6f, 0x63,
+	0x6b, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x59, 0x0a, 0x15, 0x52, 0x65, 0x71, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x4c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x28,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x6d,
+	0x70, 0x62, 0x2e, 0x45, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x4c, 0x6f, 0x63, 0x6b, 0x2e, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x22, 0x3d, 0x0a, 0x15, 0x52, 0x65, 0x73, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x47, 0x65, 0x74, 0x4c,
+	0x6f, 0x67, 0x69, 0x6e, 0x4c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x24, 0x0a, 0x05, 0x6c, 0x6f, 0x63,
+	0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x4c,
+	0x6f, 0x67, 0x69, 0x6e, 0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x05, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x22,
+	0x5a, 0x0a, 0x16, 0x52, 0x65, 0x71, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x6c, 0x65, 0x61, 0x72,
+	0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x4c, 0x6f, 0x63, 0x6b, 0x12, 0x28, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x45, 0x4c,
+	0x6f, 0x67, 0x69, 0x6e, 0x4c, 0x6f, 0x63, 0x6b, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x22, 0x40, 0x0a, 0x12, 0x52,
+	0x65, 0x73, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x79, 0x12, 0x2a, 0x0a, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x52, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x22, 0xa1, 0x01,
+	0x0a, 0x12, 0x52, 0x65, 0x71, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x42, 0x61, 0x6e, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x22, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x6d, 0x70,
+	0x62, 0x2e, 0x45, 0x42, 0x61, 0x6e, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0x87, 0x01, 0x0a, 0x14, 0x52, 0x65, 0x71, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x6e,
+	0x62, 0x61, 0x6e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x0e, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x45, 0x42, 0x61, 0x6e, 0x2e, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12,
+	0x1a, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x4f, 0x0a, 0x10, 0x52,
+	0x65, 0x71, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x6e, 0x73, 0x12,
+	0x22, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e,
+	0x6d, 0x70, 0x62, 0x2e, 0x45, 0x42, 0x61, 0x6e, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x5c, 0x0a, 0x10,
+	0x52, 0x65, 0x73, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x6e, 0x73,
+	0x12, 0x22, 0x0a, 0x04, 0x62, 0x61, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e,
+	0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x42, 0x61, 0x6e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x04,
+	0x62, 0x61, 0x6e, 0x73, 0x12, 0x24, 0x0a, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x42, 0x61, 0x6e, 0x41, 0x75, 0x64, 0x69,
+	0x74, 0x4c, 0x6f, 0x67, 0x52, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x22, 0xd0, 0x01, 0x0a, 0x13, 0x52,
+	0x65, 0x73, 0x47, 0x65, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x72, 0x61, 0x6c, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x5f, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43,
+	0x6f, 0x64, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x64, 0x5f,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x69, 0x6e, 0x76,
+	0x69, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x6a,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0d, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x2b, 0x0a, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x61, 0x6c, 0x73, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x72,
+	0x61, 0x6c, 0x52, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x61, 0x6c, 0x73, 0x22, 0x2f, 0x0a,
+	0x17, 0x52, 0x65, 0x71, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x70, 0x52,
+	0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x72, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x4a,
+	0x0a, 0x17, 0x52, 0x65, 0x73, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x70,
+	0x52, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x72, 0x73, 0x12, 0x2f, 0x0a, 0x09, 0x72, 0x65, 0x66,
+	0x65, 0x72, 0x72, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6d,
+	0x70, 0x62, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x72, 0x52, 0x61, 0x6e, 0x6b, 0x52,
+	0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x72, 0x73, 0x22, 0xc9, 0x02, 0x0a, 0x12, 0x52,
+	0x65, 0x71, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x42, 0x79, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72,
+	0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x19, 0x0a,
+	0x08, 0x69, 0x64, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x69, 0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x76, 0x69,
+	0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x12, 0x1b, 0x0a, 0x09, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x0e, 0x0a,
+	0x02, 0x6f, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x6f, 0x73, 0x12, 0x1a, 0x0a,
+	0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x69, 0x70, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x49, 0x70, 0x12, 0x16, 0x0a,
+	0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72,
+	0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65,
+	0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65,
+	0x66, 0x65, 0x72, 0x72, 0x65, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f,
+	0x6e, 0x63, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65,
+	0x4a, 0x04, 0x08, 0x03, 0x10, 0x04, 0x22, 0x77, 0x0a, 0x0f, 0x52, 0x65, 0x71, 0x4c, 0x69, 0x6e,
+	0x6b, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x19,
+	0x0a, 0x08, 0x69, 0x64, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x69, 0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e,
+	0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x22,
+	0x85, 0x02, 0x0a, 0x0f, 0x52, 0x65, 0x71, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09,
+	0x74, 0x6f, 0x74, 0x70, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x74, 0x6f, 0x74, 0x70, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61,
+	0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12,
+	0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x77,
+	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x12, 0x24, 0x0a, 0x0e,
+	0x61, 0x70, 0x74, 0x6f, 0x73, 0x5f, 0x66, 0x75, 0x6c, 0x6c, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x70, 0x74, 0x6f, 0x73, 0x46, 0x75, 0x6c, 0x6c, 0x4d,
+	0x73, 0x67, 0x12, 0x26, 0x0a, 0x0f, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x5f, 0x74, 0x6f, 0x74, 0x70,
+	0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6f, 0x74, 0x68,
+	0x65, 0x72, 0x54, 0x6f, 0x74, 0x70, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65,
+	0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x69, 0x70, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72,
+	0x65, 0x6d, 0x6f, 0x74, 0x65, 0x49, 0x70, 0x22, 0x8a, 0x01, 0x0a, 0x15, 0x52, 0x65, 0x71, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x73, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x6d, 0x65,
+	0x72, 0x67, 0x65, 0x64, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0c, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x6f, 0x72, 0x22, 0x89, 0x01, 0x0a, 0x0e, 0x52, 0x65, 0x71, 0x53, 0x65, 0x6e, 0x64,
+	0x53, 0x4d, 0x53, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x10, 0x0a, 0x03, 0x74, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74,
+	0x65, 0x6c, 0x12, 0x2f, 0x0a, 0x07, 0x70, 0x75, 0x72, 0x70, 0x6f, 0x73, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x45, 0x53, 0x4d, 0x53, 0x43, 0x6f,
+	0x64, 0x65, 0x2e, 0x50, 0x75, 0x72, 0x70, 0x6f, 0x73, 0x65, 0x52, 0x07, 0x70, 0x75, 0x72, 0x70,
+	0x6f, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x69, 0x70,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x49, 0x70,
+	0x22, 0x4d, 0x0a, 0x0c, 0x52, 0x65, 0x71, 0x42, 0x69, 0x6e, 0x64, 0x50, 0x68, 0x6f, 0x6e, 0x65,
+	0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x65, 0x6c,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x65, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x63,
+	0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22,
+	0x95, 0x01, 0x0a, 0x17, 0x52, 0x65, 0x71, 0x52, 0x65, 0x73, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x42, 0x79, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x74,
+	0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x65, 0x6c, 0x12, 0x1a, 0x0a,
+	0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x1b, 0x0a,
+	0x09, 0x74, 0x6f, 0x74, 0x70, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x74, 0x6f, 0x74, 0x70, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65,
+	0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x69, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72,
+	0x65, 0x6d, 0x6f, 0x74, 0x65, 0x49, 0x70, 0x32, 0xd6, 0x1a, 0x0a, 0x0e, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x43, 0x0a, 0x0f, 0x52, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x17, 0x2e,
+	0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x17, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73,
+	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x43, 0x0a, 0x0f, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x42, 0x79, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x12, 0x17, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x4c, 0x6f, 0x67, 0x69,
+	0x6e, 0x42, 0x79, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x1a, 0x17, 0x2e, 0x6d, 0x70,
+	0x62, 0x2e, 0x52, 0x65, 0x73, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x42, 0x79, 0x50, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x12, 0x32, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71,
+	0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x1a, 0x10, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x4c, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0x79, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x1f, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x47, 0x65, 0x74,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0x79, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x10, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x3d, 0x0a, 0x0d, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61,
+	0x74, 0x65, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x15, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65,
+	0x71, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x1a, 0x15,
+	0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65,
+	0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x46, 0x0a, 0x10, 0x57, 0x65, 0x62, 0x4c, 0x6f, 0x67, 0x69,
+	0x6e, 0x42, 0x79, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x12, 0x18, 0x2e, 0x6d, 0x70, 0x62, 0x2e,
+	0x52, 0x65, 0x71, 0x57, 0x65, 0x62, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x42, 0x79, 0x57, 0x61, 0x6c,
+	0x6c, 0x65, 0x74, 0x1a, 0x18, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x57, 0x65, 0x62,
+	0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x42, 0x79, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x12, 0x50, 0x0a,
+	0x1c, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x41, 0x6e, 0x64, 0x53, 0x65, 0x6e, 0x64,
+	0x45, 0x6d, 0x61, 0x69, 0x6c, 0x42, 0x69, 0x6e, 0x64, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x24, 0x2e,
+	0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x41,
+	0x6e, 0x64, 0x53, 0x65, 0x6e, 0x64, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x42, 0x69, 0x6e, 0x64, 0x43,
+	0x6f, 0x64, 0x65, 0x1a, 0x0a, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12,
+	0x3a, 0x0a, 0x0c, 0x57, 0x65, 0x62, 0x42, 0x69, 0x6e, 0x64, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12,
+	0x14, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x57, 0x65, 0x62, 0x42, 0x69, 0x6e, 0x64,
+	0x45, 0x6d, 0x61, 0x69, 0x6c, 0x1a, 0x14, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x57,
+	0x65, 0x62, 0x42, 0x69, 0x6e, 0x64, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x3a, 0x0a, 0x0f, 0x47,
+	0x65, 0x74, 0x41, 0x70, 0x74, 0x6f, 0x73, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x0e,
+	0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x1a, 0x17,
+	0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x47, 0x65, 0x74, 0x41, 0x70, 0x74, 0x6f, 0x73,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x34, 0x0a, 0x0e, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x16, 0x2e, 0x6d, 0x70, 0x62, 0x2e,
+	0x52, 0x65, 0x71, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x1a, 0x0a, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x4c, 0x0a,
+	0x1a, 0x53, 0x65, 0x6e, 0x64, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x73, 0x65, 0x74, 0x50,
+	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x22, 0x2e, 0x6d, 0x70,
+	0x62, 0x2e, 0x52, 0x65, 0x71, 0x53, 0x65, 0x6e, 0x64, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x52, 0x65,
+	0x73, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x43, 0x6f, 0x64, 0x65, 0x1a,
+	0x0a, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x67, 0x0a, 0x1b, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x73, 0x65, 0x74, 0x50, 0x61,
+	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x23, 0x2e, 0x6d, 0x70, 0x62,
+	0x2e, 0x52, 0x65, 0x71, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x52, 0x65,
+	0x73, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x43, 0x6f, 0x64, 0x65, 0x1a,
+	0x23, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x45, 0x6d,
+	0x61, 0x69, 0x6c, 0x52, 0x65, 0x73, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
+	0x43, 0x6f, 0x64, 0x65, 0x12, 0x40, 0x0a, 0x14, 0x52, 0x65, 0x73, 0x65, 0x74, 0x50, 0x61, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0x42, 0x79, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1c, 0x2e, 0x6d,
+	0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x52, 0x65, 0x73, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x42, 0x79, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x1a, 0x0a, 0x2e, 0x6d, 0x70, 0x62,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x50, 0x0a, 0x1c, 0x52, 0x65, 0x73, 0x65, 0x74, 0x50,
+	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x42, 0x79, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x41, 0x6e,
+	0x64, 0x56, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x24, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71,
+	0x52, 0x65, 0x73, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x42, 0x79, 0x45,
+	0x6d, 0x61, 0x69, 0x6c, 0x41, 0x6e, 0x64, 0x56, 0x43, 0x6f, 0x64, 0x65, 0x1a, 0x0a, 0x2e, 0x6d,
+	0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x6d, 0x0a, 0x1d, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x42, 0x79, 0x57, 0x61,
+	0x6c, 0x6c, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x73, 0x12, 0x25, 0x2e, 0x6d, 0x70, 0x62, 0x2e,
+	0x52, 0x65, 0x71, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x73, 0x42, 0x79, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x73,
+	0x1a, 0x25, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47,
+	0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x42, 0x79, 0x57, 0x61, 0x6c, 0x6c,
+	0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x73, 0x12, 0x3a, 0x0a, 0x0c, 0x52, 0x65, 0x66, 0x72, 0x65,
+	0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x14, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65,
+	0x71, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x1a, 0x14, 0x2e,
+	0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x12, 0x24, 0x0a, 0x06, 0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x12, 0x0e, 0x2e,
+	0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x1a, 0x0a, 0x2e,
+	0x6d, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x2e, 0x0a, 0x10, 0x4c, 0x6f, 0x67,
+	0x6f, 0x75, 0x74, 0x41, 0x6c, 0x6c, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x0e, 0x2e,
+	0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x1a, 0x0a, 0x2e,
+	0x6d, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x37, 0x0a, 0x0b, 0x47, 0x65, 0x74,
+	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x13, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x52,
+	0x65, 0x71, 0x47, 0x65, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x13, 0x2e,
+	0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x47, 0x65, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x32, 0x0a, 0x0d, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x53, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x15, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x52, 0x65, 0x76,
+	0x6f, 0x6b, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x1a, 0x0a, 0x2e, 0x6d, 0x70, 0x62,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x30, 0x0a, 0x0a, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c,
+	0x54, 0x4f, 0x54, 0x50, 0x12, 0x0e, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x55, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x1a, 0x12, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x45, 0x6e,
+	0x72, 0x6f, 0x6c, 0x6c, 0x54, 0x4f, 0x54, 0x50, 0x12, 0x34, 0x0a, 0x0b, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x72, 0x6d, 0x54, 0x4f, 0x54, 0x50, 0x12, 0x10, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65,
+	0x71, 0x54, 0x4f, 0x54, 0x50, 0x43, 0x6f, 0x64, 0x65, 0x1a, 0x13, 0x2e, 0x6d, 0x70, 0x62, 0x2e,
+	0x52, 0x65, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x54, 0x4f, 0x54, 0x50, 0x12, 0x2b,
+	0x0a, 0x0b, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x4f, 0x54, 0x50, 0x12, 0x10, 0x2e,
+	0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x54, 0x4f, 0x54, 0x50, 0x43, 0x6f, 0x64, 0x65, 0x1a,
+	0x0a, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3b, 0x0a, 0x0b, 0x4c,
+	0x6f, 0x67, 0x69, 0x6e, 0x42, 0x79, 0x54, 0x4f, 0x54, 0x50, 0x12, 0x13, 0x2e, 0x6d, 0x70, 0x62,
+	0x2e, 0x52, 0x65, 0x71, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x42, 0x79, 0x54, 0x4f, 0x54, 0x50, 0x1a,
+	0x17, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x42, 0x79,
+	0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x35, 0x0a, 0x0a, 0x4c, 0x69, 0x6e, 0x6b,
+	0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x12, 0x12, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71,
+	0x4c, 0x69, 0x6e, 0x6b, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x1a, 0x13, 0x2e, 0x6d, 0x70, 0x62,
+	0x2e, 0x52, 0x65, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x73, 0x12,
+	0x37, 0x0a, 0x0c, 0x55, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x12,
+	0x12, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x41,
+	0x64, 0x64, 0x72, 0x1a, 0x13, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x4c, 0x69, 0x73,
+	0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x73, 0x12, 0x3b, 0x0a, 0x10, 0x53, 0x65, 0x74, 0x50,
+	0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x12, 0x12, 0x2e, 0x6d,
+	0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72,
+	0x1a, 0x13, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x61,
+	0x6c, 0x6c, 0x65, 0x74, 0x73, 0x12, 0x32, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x61, 0x6c,
+	0x6c, 0x65, 0x74, 0x73, 0x12, 0x0e, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x55, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x1a, 0x13, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x4c, 0x69,
+	0x73, 0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x73, 0x12, 0x3e, 0x0a, 0x13, 0x53, 0x65, 0x6e,
+	0x64, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x43, 0x6f, 0x64, 0x65,
+	0x12, 0x1b, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x53, 0x65, 0x6e, 0x64, 0x45, 0x6d,
+	0x61, 0x69, 0x6c, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x1a, 0x0a, 0x2e,
+	0x6d, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x34, 0x0a, 0x0b, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x13, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x52,
+	0x65, 0x71, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x1a, 0x10, 0x2e,
+	0x6d, 0x70, 0x62, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12,
+	0x4f, 0x0a, 0x13, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x70, 0x61, 0x69, 0x72, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x1b, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x70, 0x61, 0x69, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x73, 0x1a, 0x1b, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x52, 0x65, 0x70, 0x61, 0x69, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73,
+	0x12, 0x4c, 0x0a, 0x12, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x69,
+	0x6e, 0x4c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x1a, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x4c, 0x6f, 0x63,
+	0x6b, 0x73, 0x1a, 0x1a, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x4c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x3e,
+	0x0a, 0x13, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x4c, 0x6f, 0x67, 0x69,
+	0x6e, 0x4c, 0x6f, 0x63, 0x6b, 0x12, 0x1b, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x4c, 0x6f,
+	0x63, 0x6b, 0x1a, 0x0a, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x58,
+	0x0a, 0x16, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x52,
+	0x65, 0x71, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x1e, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x52,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x33, 0x0a, 0x15, 0x43, 0x61, 0x6e, 0x63,
+	0x65, 0x6c, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x0e, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x55, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x1a, 0x0a, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3e, 0x0a,
+	0x11, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x61,
+	0x74, 0x61, 0x12, 0x0e, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x55, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x1a, 0x19, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x45, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x38, 0x0a,
+	0x0d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x15,
+	0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x1a, 0x10, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x3d, 0x0a, 0x0c, 0x4c, 0x6f, 0x67, 0x69, 0x6e,
+	0x41, 0x73, 0x47, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65,
+	0x71, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x41, 0x73, 0x47, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e,
+	0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x42, 0x79, 0x50, 0x61,
+	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x44, 0x0a, 0x13, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64,
+	0x65, 0x47, 0x75, 0x65, 0x73, 0x74, 0x42, 0x79, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1b, 0x2e,
+	0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x47, 0x75,
+	0x65, 0x73, 0x74, 0x42, 0x79, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x1a, 0x10, 0x2e, 0x6d, 0x70, 0x62,
+	0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x3c, 0x0a, 0x14,
+	0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x47, 0x75, 0x65, 0x73, 0x74, 0x42, 0x79, 0x57, 0x61,
+	0x6c, 0x6c, 0x65, 0x74, 0x12, 0x12, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x4c, 0x69,
+	0x6e, 0x6b, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x1a, 0x10, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x3a, 0x0a, 0x0f, 0x47, 0x65,
+	0x74, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x0e, 0x2e,
+	0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x1a, 0x17, 0x2e,
+	0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x3a, 0x0a, 0x0f, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x42,
+	0x61, 0x6e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x17, 0x2e, 0x6d, 0x70, 0x62, 0x2e,
+	0x52, 0x65, 0x71, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x42, 0x61, 0x6e, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x1a, 0x0e, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x42, 0x61, 0x6e, 0x52, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x12, 0x3a, 0x0a, 0x11, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x6e, 0x62, 0x61, 0x6e,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x19, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65,
+	0x71, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x6e, 0x62, 0x61, 0x6e, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x1a, 0x0a, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3d,
+	0x0a, 0x0d, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x6e, 0x73, 0x12,
+	0x15, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x69,
+	0x73, 0x74, 0x42, 0x61, 0x6e, 0x73, 0x1a, 0x15, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x6e, 0x73, 0x12, 0x3c, 0x0a,
+	0x10, 0x47, 0x65, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x72, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x12, 0x0e, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x55, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x1a, 0x18, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x47, 0x65, 0x74, 0x52, 0x65,
+	0x66, 0x65, 0x72, 0x72, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x52, 0x0a, 0x14, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x70, 0x52, 0x65, 0x66, 0x65, 0x72, 0x72,
+	0x65, 0x72, 0x73, 0x12, 0x1c, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x70, 0x52, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x72,
+	0x73, 0x1a, 0x1c, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x47, 0x65, 0x74, 0x54, 0x6f, 0x70, 0x52, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x72, 0x73, 0x12,
+	0x43, 0x0a, 0x0f, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x42, 0x79, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f,
+	0x72, 0x6d, 0x12, 0x17, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x4c, 0x6f, 0x67, 0x69,
+	0x6e, 0x42, 0x79, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x1a, 0x17, 0x2e, 0x6d, 0x70,
+	0x62, 0x2e, 0x52, 0x65, 0x73, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x42, 0x79, 0x50, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x12, 0x36, 0x0a, 0x0c, 0x4c, 0x69, 0x6e, 0x6b, 0x50, 0x6c, 0x61, 0x74,
+	0x66, 0x6f, 0x72, 0x6d, 0x12, 0x14, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x4c, 0x69,
+	0x6e, 0x6b, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x1a, 0x10, 0x2e, 0x6d, 0x70, 0x62,
+	0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x36, 0x0a, 0x0c,
+	0x4d, 0x65, 0x72, 0x67, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x2e, 0x6d,
+	0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x1a, 0x10, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x46, 0x0a, 0x12, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4d, 0x65, 0x72,
+	0x67, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x1a, 0x2e, 0x6d, 0x70, 0x62,
+	0x2e, 0x52, 0x65, 0x71, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x1a, 0x14, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x4c, 0x6f, 0x67, 0x12, 0x2e, 0x0a, 0x0b,
+	0x53, 0x65, 0x6e, 0x64, 0x53, 0x4d, 0x53, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x13, 0x2e, 0x6d, 0x70,
+	0x62, 0x2e, 0x52, 0x65, 0x71, 0x53, 0x65, 0x6e, 0x64, 0x53, 0x4d, 0x53, 0x43, 0x6f, 0x64, 0x65,
+	0x1a, 0x0a, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x30, 0x0a, 0x09,
+	0x42, 0x69, 0x6e, 0x64, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x12, 0x11, 0x2e, 0x6d, 0x70, 0x62, 0x2e,
+	0x52, 0x65, 0x71, 0x42, 0x69, 0x6e, 0x64, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x1a, 0x10, 0x2e, 0x6d,
+	0x70, 0x62, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x40,
+	0x0a, 0x14, 0x52, 0x65, 0x73, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x42,
+	0x79, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x12, 0x1c, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71,
+	0x52, 0x65, 0x73, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x42, 0x79, 0x50,
+	0x68, 0x6f, 0x6e, 0x65, 0x1a, 0x0a, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x42, 0x07, 0x5a, 0x05, 0x2e, 0x2f, 0x6d, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
+}
+
+var (
+	file_grpc_account_proto_rawDescOnce sync.Once
+	file_grpc_account_proto_rawDescData = file_grpc_account_proto_rawDesc
+)
+
+func file_grpc_account_proto_rawDescGZIP() []byte {
+	file_grpc_account_proto_rawDescOnce.Do(func() {
+		file_grpc_account_proto_rawDescData = protoimpl.X.CompressGZIP(file_grpc_account_proto_rawDescData)
+	})
+	return file_grpc_account_proto_rawDescData
+}
+
+var file_grpc_account_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_grpc_account_proto_msgTypes = make([]protoimpl.MessageInfo, 68)
+var file_grpc_account_proto_goTypes = []interface{}{
+	(EAccountRepair_Action)(0),               // 0: mpb.EAccountRepair.Action
+	(ELoginLock_Type)(0),                     // 1: mpb.ELoginLock.Type
+	(*ReqLoginByPassword)(nil),               // 2: mpb.ReqLoginByPassword
+	(*ResLoginByPassword)(nil),               // 3: mpb.ResLoginByPassword
+	(*ReqGetAccountInfo)(nil),                // 4: mpb.ReqGetAccountInfo
+	(*ReqRegisterAccount)(nil),               // 5: mpb.ReqRegisterAccount
+	(*ResRegisterAccount)(nil),               // 6: mpb.ResRegisterAccount
+	(*ReqGenerateNonce)(nil),                 // 7: mpb.ReqGenerateNonce
+	(*ResGenerateNonce)(nil),                 // 8: mpb.ResGenerateNonce
+	(*ReqWebLoginByWallet)(nil),              // 9: mpb.ReqWebLoginByWallet
+	(*ResWebLoginByWallet)(nil),              // 10: mpb.ResWebLoginByWallet
+	(*ReqGetAccountInfoByAccount)(nil),       // 11: mpb.ReqGetAccountInfoByAccount
+	(*ReqGenerateAndSendEmailBindCode)(nil),  // 12: mpb.ReqGenerateAndSendEmailBindCode
+	(*ReqWebBindEmail)(nil),                  // 13: mpb.ReqWebBindEmail
+	(*ResWebBindEmail)(nil),                  // 14: mpb.ResWebBindEmail
+	(*ResGetAptosAccount)(nil),               // 15: mpb.ResGetAptosAccount
+	(*ReqChangePassword)(nil),                // 16: mpb.ReqChangePassword
+	(*ReqSendEmailResetPasswordCode)(nil),    // 17: mpb.ReqSendEmailResetPasswordCode
+	(*ReqCheckEmailResetPasswordCode)(nil),   // 18: mpb.ReqCheckEmailResetPasswordCode
+	(*ResCheckEmailResetPasswordCode)(nil),   // 19: mpb.ResCheckEmailResetPasswordCode
+	(*ReqResetPasswordByEmail)(nil),          // 20: mpb.ReqResetPasswordByEmail
+	(*ReqResetPasswordByEmailAndVCode)(nil),  // 21: mpb.ReqResetPasswordByEmailAndVCode
+	(*ReqBatchGetAccountsByWalletAddrs)(nil), // 22: mpb.ReqBatchGetAccountsByWalletAddrs
+	(*ResBatchGetAccountsByWalletAddrs)(nil), // 23: mpb.ResBatchGetAccountsByWalletAddrs
+	(*ReqRefreshToken)(nil),                  // 24: mpb.ReqRefreshToken
+	(*ResRefreshToken)(nil),                  // 25: mpb.ResRefreshToken
+	(*ReqLogout)(nil),                        // 26: mpb.ReqLogout
+	(*ReqGetSessions)(nil),                   // 27: mpb.ReqGetSessions
+	(*ResGetSessions)(nil),                   // 28: mpb.ResGetSessions
+	(*ReqRevokeSession)(nil),                 // 29: mpb.ReqRevokeSession
+	(*ResEnrollTOTP)(nil),                    // 30: mpb.ResEnrollTOTP
+	(*ReqTOTPCode)(nil),                      // 31: mpb.ReqTOTPCode
+	(*ResConfirmTOTP)(nil),                   // 32: mpb.ResConfirmTOTP
+	(*ReqLoginByTOTP)(nil),                   // 33: mpb.ReqLoginByTOTP
+	(*ReqLinkWallet)(nil),                    // 34: mpb.ReqLinkWallet
+	(*ReqWalletAddr)(nil),                    // 35: mpb.ReqWalletAddr
+	(*ResListWallets)(nil),                   // 36: mpb.ResListWallets
+	(*ReqSendEmailChangeCode)(nil),           // 37: mpb.ReqSendEmailChangeCode
+	(*ReqChangeEmail)(nil),                   // 38: mpb.ReqChangeEmail
+	(*EAccountRepair)(nil),                   // 39: mpb.EAccountRepair
+	(*AccountRepair)(nil),                    // 40: mpb.AccountRepair
+	(*ReqAdminRepairAccounts)(nil),           // 41: mpb.ReqAdminRepairAccounts
+	(*ResAdminRepairAccounts)(nil),           // 42: mpb.ResAdminRepairAccounts
+	(*ReqRequestAccountDeletion)(nil),        // 43: mpb.ReqRequestAccountDeletion
+	(*ResRequestAccountDeletion)(nil),        // 44: mpb.ResRequestAccountDeletion
+	(*AccountDataArchive)(nil),               // 45: mpb.AccountDataArchive
+	(*ResExportAccountData)(nil),             // 46: mpb.ResExportAccountData
+	(*ReqUpdateProfile)(nil),                 // 47: mpb.ReqUpdateProfile
+	(*ReqLoginAsGuest)(nil),                  // 48: mpb.ReqLoginAsGuest
+	(*ReqUpgradeGuestByEmail)(nil),           // 49: mpb.ReqUpgradeGuestByEmail
+	(*ELoginLock)(nil),                       // 50: mpb.ELoginLock
+	(*LoginLock)(nil),                        // 51: mpb.LoginLock
+	(*ReqAdminGetLoginLocks)(nil),            // 52: mpb.ReqAdminGetLoginLocks
+	(*ResAdminGetLoginLocks)(nil),            // 53: mpb.ResAdminGetLoginLocks
+	(*ReqAdminClearLoginLock)(nil),           // 54: mpb.ReqAdminClearLoginLock
+	(*ResGetLoginHistory)(nil),               // 55: mpb.ResGetLoginHistory
+	(*ReqAdminBanAccount)(nil),               // 56: mpb.ReqAdminBanAccount
+	(*ReqAdminUnbanAccount)(nil),             // 57: mpb.ReqAdminUnbanAccount
+	(*ReqAdminListBans)(nil),                 // 58: mpb.ReqAdminListBans
+	(*ResAdminListBans)(nil),                 // 59: mpb.ResAdminListBans
+	(*ResGetReferralStats)(nil),              // 60: mpb.ResGetReferralStats
+	(*ReqAdminGetTopReferrers)(nil),          // 61: mpb.ReqAdminGetTopReferrers
+	(*ResAdminGetTopReferrers)(nil),          // 62: mpb.ResAdminGetTopReferrers
+	(*ReqLoginByPlatform)(nil),               // 63: mpb.ReqLoginByPlatform
+	(*ReqLinkPlatform)(nil),                  // 64: mpb.ReqLinkPlatform
+	(*ReqMergeAccount)(nil),                  // 65: mpb.ReqMergeAccount
+	(*ReqAdminMergeAccounts)(nil),            // 66: mpb.ReqAdminMergeAccounts
+	(*ReqSendSMSCode)(nil),                   // 67: mpb.ReqSendSMSCode
+	(*ReqBindPhone)(nil),                     // 68: mpb.ReqBindPhone
+	(*ReqResetPasswordByPhone)(nil),          // 69: mpb.ReqResetPasswordByPhone
+	(*AccountInfo)(nil),                      // 70: mpb.AccountInfo
+	(*SessionInfo)(nil),                      // 71: mpb.SessionInfo
+	(*WalletInfo)(nil),                       // 72: mpb.WalletInfo
+	(*AptosNFTNodeV2)(nil),                   // 73: mpb.AptosNFTNodeV2
+	(*LoginRecord)(nil),                      // 74: mpb.LoginRecord
+	(EBan_Type)(0),                           // 75: mpb.EBan.Type
+	(*BanRecord)(nil),                        // 76: mpb.BanRecord
+	(*BanAuditLog)(nil),                      // 77: mpb.BanAuditLog
+	(*Referral)(nil),                         // 78: mpb.Referral
+	(*ReferrerRank)(nil),                     // 79: mpb.ReferrerRank
+	(ESMSCode_Purpose)(0),                    // 80: mpb.ESMSCode.Purpose
+	(*ReqUserId)(nil),                        // 81: mpb.ReqUserId
+	(*Empty)(nil),                            // 82: mpb.Empty
+	(*AccountMergeLog)(nil),                  // 83: mpb.AccountMergeLog
+}
+var file_grpc_account_proto_depIdxs = []int32{
+	70, // 0: mpb.ResLoginByPassword.account:type_name -> mpb.AccountInfo
+	70, // 1: mpb.ResRegisterAccount.account:type_name -> mpb.AccountInfo
+	70, // 2: mpb.ResWebLoginByWallet.account:type_name -> mpb.AccountInfo
+	70, // 3: mpb.ResWebBindEmail.account:type_name -> mpb.AccountInfo
+	70, // 4: mpb.ResBatchGetAccountsByWalletAddrs.accounts:type_name -> mpb.AccountInfo
+	71, // 5: mpb.ResGetSessions.sessions:type_name -> mpb.SessionInfo
+	72, // 6: mpb.ResListWallets.wallets:type_name -> mpb.WalletInfo
+	0,  // 7: mpb.AccountRepair.action:type_name -> mpb.EAccountRepair.Action
+	40, // 8: mpb.ResAdminRepairAccounts.repairs:type_name -> mpb.AccountRepair
+	70, // 9: mpb.AccountDataArchive.account:type_name -> mpb.AccountInfo
+	72, // 10: mpb.AccountDataArchive.wallets:type_name -> mpb.WalletInfo
+	71, // 11: mpb.AccountDataArchive.sessions:type_name -> mpb.SessionInfo
+	73, // 12: mpb.AccountDataArchive.nfts:type_name -> mpb.AptosNFTNodeV2
+	74, // 13: mpb.AccountDataArchive.logins:type_name -> mpb.LoginRecord
+	1,  // 14: mpb.LoginLock.type:type_name -> mpb.ELoginLock.Type
+	1,  // 15: mpb.ReqAdminGetLoginLocks.type:type_name -> mpb.ELoginLock.Type
+	51, // 16: mpb.ResAdminGetLoginLocks.locks:type_name -> mpb.LoginLock
+	1,  // 17: mpb.ReqAdminClearLoginLock.type:type_name -> mpb.ELoginLock.Type
+	74, // 18: mpb.ResGetLoginHistory.records:type_name -> mpb.LoginRecord
+	75, // 19: mpb.ReqAdminBanAccount.type:type_name -> mpb.EBan.Type
+	75, // 20: mpb.ReqAdminUnbanAccount.type:type_name -> mpb.EBan.Type
+	75, // 21: mpb.ReqAdminListBans.type:type_name -> mpb.EBan.Type
+	76, // 22: mpb.ResAdminListBans.bans:type_name -> mpb.BanRecord
+	77, // 23: mpb.ResAdminListBans.logs:type_name -> mpb.BanAuditLog
+	78, // 24: mpb.ResGetReferralStats.referrals:type_name -> mpb.Referral
+	79, // 25: mpb.ResAdminGetTopReferrers.referrers:type_name -> mpb.ReferrerRank
+	80, // 26: mpb.ReqSendSMSCode.purpose:type_name -> mpb.ESMSCode.Purpose
+	5,  // 27: mpb.AccountService.RegisterAccount:input_type -> mpb.ReqRegisterAccount
+	2,  // 28: mpb.AccountService.LoginByPassword:input_type -> mpb.ReqLoginByPassword
+	81, // 29: mpb.AccountService.GetAccountInfo:input_type -> mpb.ReqUserId
+	11, // 30: mpb.AccountService.GetAccountInfoByAccount:input_type -> mpb.ReqGetAccountInfoByAccount
+	7,  // 31: mpb.AccountService.GenerateNonce:input_type -> mpb.ReqGenerateNonce
+	9,  // 32: mpb.AccountService.WebLoginByWallet:input_type -> mpb.ReqWebLoginByWallet
+	12, // 33: mpb.AccountService.GenerateAndSendEmailBindCode:input_type -> mpb.ReqGenerateAndSendEmailBindCode
+	13, // 34: mpb.AccountService.WebBindEmail:input_type -> mpb.ReqWebBindEmail
+	81, // 35: mpb.AccountService.GetAptosAccount:input_type -> mpb.ReqUserId
+	16, // 36: mpb.AccountService.ChangePassword:input_type -> mpb.ReqChangePassword
+	17, // 37: mpb.AccountService.SendEmailResetPasswordCode:input_type -> mpb.ReqSendEmailResetPasswordCode
+	18, // 38: mpb.AccountService.CheckEmailResetPasswordCode:input_type -> mpb.ReqCheckEmailResetPasswordCode
+	20, // 39: mpb.AccountService.ResetPasswordByEmail:input_type -> mpb.ReqResetPasswordByEmail
+	21, // 40: mpb.AccountService.ResetPasswordByEmailAndVCode:input_type -> mpb.ReqResetPasswordByEmailAndVCode
+	22, // 41: mpb.AccountService.BatchGetAccountsByWalletAddrs:input_type -> mpb.ReqBatchGetAccountsByWalletAddrs
+	24, // 42: mpb.AccountService.RefreshToken:input_type -> mpb.ReqRefreshToken
+	26, // 43: mpb.AccountService.Logout:input_type -> mpb.ReqLogout
+	81, // 44: mpb.AccountService.LogoutAllDevices:input_type -> mpb.ReqUserId
+	27, // 45: mpb.AccountService.GetSessions:input_type -> mpb.ReqGetSessions
+	29, // 46: mpb.AccountService.RevokeSession:input_type -> mpb.ReqRevokeSession
+	81, // 47: mpb.AccountService.EnrollTOTP:input_type -> mpb.ReqUserId
+	31, // 48: mpb.AccountService.ConfirmTOTP:input_type -> mpb.ReqTOTPCode
+	31, // 49: mpb.AccountService.DisableTOTP:input_type -> mpb.ReqTOTPCode
+	33, // 50: mpb.AccountService.LoginByTOTP:input_type -> mpb.ReqLoginByTOTP
+	34, // 51: mpb.AccountService.LinkWallet:input_type -> mpb.ReqLinkWallet
+	35, // 52: mpb.AccountService.UnlinkWallet:input_type -> mpb.ReqWalletAddr
+	35, // 53: mpb.AccountService.SetPrimaryWallet:input_type -> mpb.ReqWalletAddr
+	81, // 54: mpb.AccountService.ListWallets:input_type -> mpb.ReqUserId
+	37, // 55: mpb.AccountService.SendEmailChangeCode:input_type -> mpb.ReqSendEmailChangeCode
+	38, // 56: mpb.AccountService.ChangeEmail:input_type -> mpb.ReqChangeEmail
+	41, // 57: mpb.AccountService.AdminRepairAccounts:input_type -> mpb.ReqAdminRepairAccounts
+	52, // 58: mpb.AccountService.AdminGetLoginLocks:input_type -> mpb.ReqAdminGetLoginLocks
+	54, // 59: mpb.AccountService.AdminClearLoginLock:input_type -> mpb.ReqAdminClearLoginLock
+	43, // 60: mpb.AccountService.RequestAccountDeletion:input_type -> mpb.ReqRequestAccountDeletion
+	81, // 61: mpb.AccountService.CancelAccountDeletion:input_type -> mpb.ReqUserId
+	81, // 62: mpb.AccountService.ExportAccountData:input_type -> mpb.ReqUserId
+	47, // 63: mpb.AccountService.UpdateProfile:input_type -> mpb.ReqUpdateProfile
+	48, // 64: mpb.AccountService.LoginAsGuest:input_type -> mpb.ReqLoginAsGuest
+	49, // 65: mpb.AccountService.UpgradeGuestByEmail:input_type -> mpb.ReqUpgradeGuestByEmail
+	34, // 66: mpb.AccountService.UpgradeGuestByWallet:input_type -> mpb.ReqLinkWallet
+	81, // 67: mpb.AccountService.GetLoginHistory:input_type -> mpb.ReqUserId
+	56, // 68: mpb.AccountService.AdminBanAccount:input_type -> mpb.ReqAdminBanAccount
+	57, // 69: mpb.AccountService.AdminUnbanAccount:input_type -> mpb.ReqAdminUnbanAccount
+	58, // 70: mpb.AccountService.AdminListBans:input_type -> mpb.ReqAdminListBans
+	81, // 71: mpb.AccountService.GetReferralStats:input_type -> mpb.ReqUserId
+	61, // 72: mpb.AccountService.AdminGetTopReferrers:input_type -> mpb.ReqAdminGetTopReferrers
+	63, // 73: mpb.AccountService.LoginByPlatform:input_type -> mpb.ReqLoginByPlatform
+	64, // 74: mpb.AccountService.LinkPlatform:input_type -> mpb.ReqLinkPlatform
+	65, // 75: mpb.AccountService.MergeAccount:input_type -> mpb.ReqMergeAccount
+	66, // 76: mpb.AccountService.AdminMergeAccounts:input_type -> mpb.ReqAdminMergeAccounts
+	67, // 77: mpb.AccountService.SendSMSCode:input_type -> mpb.ReqSendSMSCode
+	68, // 78: mpb.AccountService.BindPhone:input_type -> mpb.ReqBindPhone
+	69, // 79: mpb.AccountService.ResetPasswordByPhone:input_type -> mpb.ReqResetPasswordByPhone
+	6,  // 80: mpb.AccountService.RegisterAccount:output_type -> mpb.ResRegisterAccount
+	3,  // 81: mpb.AccountService.LoginByPassword:output_type -> mpb.ResLoginByPassword
+	70, // 82: mpb.AccountService.GetAccountInfo:output_type -> mpb.AccountInfo
+	70, // 83: mpb.AccountService.GetAccountInfoByAccount:output_type -> mpb.AccountInfo
+	8,  // 84: mpb.AccountService.GenerateNonce:output_type -> mpb.ResGenerateNonce
+	10, // 85: mpb.AccountService.WebLoginByWallet:output_type -> mpb.ResWebLoginByWallet
+	82, // 86: mpb.AccountService.GenerateAndSendEmailBindCode:output_type -> mpb.Empty
+	14, // 87: mpb.AccountService.WebBindEmail:output_type -> mpb.ResWebBindEmail
+	15, // 88: mpb.AccountService.GetAptosAccount:output_type -> mpb.ResGetAptosAccount
+	82, // 89: mpb.AccountService.ChangePassword:output_type -> mpb.Empty
+	82, // 90: mpb.AccountService.SendEmailResetPasswordCode:output_type -> mpb.Empty
+	19, // 91: mpb.AccountService.CheckEmailResetPasswordCode:output_type -> mpb.ResCheckEmailResetPasswordCode
+	82, // 92: mpb.AccountService.ResetPasswordByEmail:output_type -> mpb.Empty
+	82, // 93: mpb.AccountService.ResetPasswordByEmailAndVCode:output_type -> mpb.Empty
+	23, // 94: mpb.AccountService.BatchGetAccountsByWalletAddrs:output_type -> mpb.ResBatchGetAccountsByWalletAddrs
+	25, // 95: mpb.AccountService.RefreshToken:output_type -> mpb.ResRefreshToken
+	82, // 96: mpb.AccountService.Logout:output_type -> mpb.Empty
+	82, // 97: mpb.AccountService.LogoutAllDevices:output_type -> mpb.Empty
+	28, // 98: mpb.AccountService.GetSessions:output_type -> mpb.ResGetSessions
+	82, // 99: mpb.AccountService.RevokeSession:output_type -> mpb.Empty
+	30, // 100: mpb.AccountService.EnrollTOTP:output_type -> mpb.ResEnrollTOTP
+	32, // 101: mpb.AccountService.ConfirmTOTP:output_type -> mpb.ResConfirmTOTP
+	82, // 102: mpb.AccountService.DisableTOTP:output_type -> mpb.Empty
+	3,  // 103: mpb.AccountService.LoginByTOTP:output_type -> mpb.ResLoginByPassword
+	36, // 104: mpb.AccountService.LinkWallet:output_type -> mpb.ResListWallets
+	36, // 105: mpb.AccountService.UnlinkWallet:output_type -> mpb.ResListWallets
+	36, // 106: mpb.AccountService.SetPrimaryWallet:output_type -> mpb.ResListWallets
+	36, // 107: mpb.AccountService.ListWallets:output_type -> mpb.ResListWallets
+	82, // 108: mpb.AccountService.SendEmailChangeCode:output_type -> mpb.Empty
+	70, // 109: mpb.AccountService.ChangeEmail:output_type -> mpb.AccountInfo
+	42, // 110: mpb.AccountService.AdminRepairAccounts:output_type -> mpb.ResAdminRepairAccounts
+	53, // 111: mpb.AccountService.AdminGetLoginLocks:output_type -> mpb.ResAdminGetLoginLocks
+	82, // 112: mpb.AccountService.AdminClearLoginLock:output_type -> mpb.Empty
+	44, // 113: mpb.AccountService.RequestAccountDeletion:output_type -> mpb.ResRequestAccountDeletion
+	82, // 114: mpb.AccountService.CancelAccountDeletion:output_type -> mpb.Empty
+	46, // 115: mpb.AccountService.ExportAccountData:output_type -> mpb.ResExportAccountData
+	70, // 116: mpb.AccountService.UpdateProfile:output_type -> mpb.AccountInfo
+	3,  // 117: mpb.AccountService.LoginAsGuest:output_type -> mpb.ResLoginByPassword
+	70, // 118: mpb.AccountService.UpgradeGuestByEmail:output_type -> mpb.AccountInfo
+	70, // 119: mpb.AccountService.UpgradeGuestByWallet:output_type -> mpb.AccountInfo
+	55, // 120: mpb.AccountService.GetLoginHistory:output_type -> mpb.ResGetLoginHistory
+	76, // 121: mpb.AccountService.AdminBanAccount:output_type -> mpb.BanRecord
+	82, // 122: mpb.AccountService.AdminUnbanAccount:output_type -> mpb.Empty
+	59, // 123: mpb.AccountService.AdminListBans:output_type -> mpb.ResAdminListBans
+	60, // 124: mpb.AccountService.GetReferralStats:output_type -> mpb.ResGetReferralStats
+	62, // 125: mpb.AccountService.AdminGetTopReferrers:output_type -> mpb.ResAdminGetTopReferrers
+	3,  // 126: mpb.AccountService.LoginByPlatform:output_type -> mpb.ResLoginByPassword
+	70, // 127: mpb.AccountService.LinkPlatform:output_type -> mpb.AccountInfo
+	70, // 128: mpb.AccountService.MergeAccount:output_type -> mpb.AccountInfo
+	83, // 129: mpb.AccountService.AdminMergeAccounts:output_type -> mpb.AccountMergeLog
+	82, // 130: mpb.AccountService.SendSMSCode:output_type -> mpb.Empty
+	70, // 131: mpb.AccountService.BindPhone:output_type -> mpb.AccountInfo
+	82, // 132: mpb.AccountService.ResetPasswordByPhone:output_type -> mpb.Empty
+	80, // [80:133] is the sub-list for method output_type
+	27, // [27:80] is the sub-list for method input_type
+	27, // [27:27] is the sub-list for extension type_name
+	27, // [27:27] is the sub-list for extension extendee
+	0,  // [0:27] is the sub-list for field type_name
+}
+
+func init() { file_grpc_account_proto_init() }
+func file_grpc_account_proto_init() {
+	if File_grpc_account_proto != nil {
+		return
+	}
+	file_common_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_grpc_account_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReqLoginByPassword); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_account_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResLoginByPassword); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_account_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReqGetAccountInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_account_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReqRegisterAccount); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_account_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResRegisterAccount); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_account_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReqGenerateNonce); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_account_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResGenerateNonce); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_account_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReqWebLoginByWallet); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_account_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResWebLoginByWallet); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_account_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReqGetAccountInfoByAccount); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_account_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReqGenerateAndSendEmailBindCode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_account_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReqWebBindEmail); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_account_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResWebBindEmail); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_account_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResGetAptosAccount); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_account_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReqChangePassword); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_account_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReqSendEmailResetPasswordCode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_account_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReqCheckEmailResetPasswordCode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_account_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResCheckEmailResetPasswordCode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_account_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReqResetPasswordByEmail); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_account_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReqResetPasswordByEmailAndVCode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_account_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReqBatchGetAccountsByWalletAddrs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_account_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResBatchGetAccountsByWalletAddrs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_account_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReqRefreshToken); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_account_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResRefreshToken); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_account_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReqLogout); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_account_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReqGetSessions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_account_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResGetSessions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_account_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReqRevokeSession); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_account_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResEnrollTOTP); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_account_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReqTOTPCode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
 				return &v.unknownFields
 			default:
 				return nil
 			}
 		}
-		file_grpc_account_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ResGenerateNonce); i {
+		file_grpc_account_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResConfirmTOTP); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1568,8 +6053,8 @@ func file_grpc_account_proto_init() {
 				return nil
 			}
 		}
-		file_grpc_account_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReqWebLoginByWallet); i {
+		file_grpc_account_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReqLoginByTOTP); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1580,8 +6065,8 @@ func file_grpc_account_proto_init() {
 				return nil
 			}
 		}
-		file_grpc_account_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ResWebLoginByWallet); i {
+		file_grpc_account_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReqLinkWallet); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1592,8 +6077,8 @@ func file_grpc_account_proto_init() {
 				return nil
 			}
 		}
-		file_grpc_account_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReqGetAccountInfoByAccount); i {
+		file_grpc_account_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReqWalletAddr); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1604,8 +6089,8 @@ func file_grpc_account_proto_init() {
 				return nil
 			}
 		}
-		file_grpc_account_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReqGenerateAndSendEmailBindCode); i {
+		file_grpc_account_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResListWallets); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1616,8 +6101,8 @@ func file_grpc_account_proto_init() {
 				return nil
 			}
 		}
-		file_grpc_account_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReqWebBindEmail); i {
+		file_grpc_account_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReqSendEmailChangeCode); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1628,8 +6113,8 @@ func file_grpc_account_proto_init() {
 				return nil
 			}
 		}
-		file_grpc_account_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ResWebBindEmail); i {
+		file_grpc_account_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReqChangeEmail); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1640,8 +6125,8 @@ func file_grpc_account_proto_init() {
 				return nil
 			}
 		}
-		file_grpc_account_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ResGetAptosAccount); i {
+		file_grpc_account_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EAccountRepair); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1652,8 +6137,8 @@ func file_grpc_account_proto_init() {
 				return nil
 			}
 		}
-		file_grpc_account_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReqChangePassword); i {
+		file_grpc_account_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccountRepair); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1664,8 +6149,8 @@ func file_grpc_account_proto_init() {
 				return nil
 			}
 		}
-		file_grpc_account_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReqSendEmailResetPasswordCode); i {
+		file_grpc_account_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReqAdminRepairAccounts); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1676,8 +6161,8 @@ func file_grpc_account_proto_init() {
 				return nil
 			}
 		}
-		file_grpc_account_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReqCheckEmailResetPasswordCode); i {
+		file_grpc_account_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResAdminRepairAccounts); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1688,8 +6173,8 @@ func file_grpc_account_proto_init() {
 				return nil
 			}
 		}
-		file_grpc_account_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ResCheckEmailResetPasswordCode); i {
+		file_grpc_account_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReqRequestAccountDeletion); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1700,8 +6185,8 @@ func file_grpc_account_proto_init() {
 				return nil
 			}
 		}
-		file_grpc_account_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReqResetPasswordByEmail); i {
+		file_grpc_account_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResRequestAccountDeletion); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1712,8 +6197,8 @@ func file_grpc_account_proto_init() {
 				return nil
 			}
 		}
-		file_grpc_account_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReqResetPasswordByEmailAndVCode); i {
+		file_grpc_account_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccountDataArchive); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1724,8 +6209,8 @@ func file_grpc_account_proto_init() {
 				return nil
 			}
 		}
-		file_grpc_account_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReqBatchGetAccountsByWalletAddrs); i {
+		file_grpc_account_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResExportAccountData); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1736,8 +6221,272 @@ func file_grpc_account_proto_init() {
 				return nil
 			}
 		}
-		file_grpc_account_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ResBatchGetAccountsByWalletAddrs); i {
+		file_grpc_account_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReqUpdateProfile); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_account_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReqLoginAsGuest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_account_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReqUpgradeGuestByEmail); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_account_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ELoginLock); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_account_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoginLock); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_account_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReqAdminGetLoginLocks); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_account_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResAdminGetLoginLocks); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_account_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReqAdminClearLoginLock); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_account_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResGetLoginHistory); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_account_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReqAdminBanAccount); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_account_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReqAdminUnbanAccount); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_account_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReqAdminListBans); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_account_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResAdminListBans); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_account_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResGetReferralStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_account_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReqAdminGetTopReferrers); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_account_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResAdminGetTopReferrers); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_account_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReqLoginByPlatform); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_account_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReqLinkPlatform); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_account_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReqMergeAccount); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_account_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReqAdminMergeAccounts); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_account_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReqSendSMSCode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_account_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReqBindPhone); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_account_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReqResetPasswordByPhone); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1754,13 +6503,14 @@ func file_grpc_account_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_grpc_account_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   20,
+			NumEnums:      2,
+			NumMessages:   68,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_grpc_account_proto_goTypes,
 		DependencyIndexes: file_grpc_account_proto_depIdxs,
+		EnumInfos:         file_grpc_account_proto_enumTypes,
 		MessageInfos:      file_grpc_account_proto_msgTypes,
 	}.Build()
 	File_grpc_account_proto = out.File
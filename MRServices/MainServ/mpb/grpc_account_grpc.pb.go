@@ -19,6 +19,7 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
+	AccountService_RegisterAccount_FullMethodName               = "/mpb.AccountService/RegisterAccount"
 	AccountService_LoginByPassword_FullMethodName               = "/mpb.AccountService/LoginByPassword"
 	AccountService_GetAccountInfo_FullMethodName                = "/mpb.AccountService/GetAccountInfo"
 	AccountService_GetAccountInfoByAccount_FullMethodName       = "/mpb.AccountService/GetAccountInfoByAccount"
@@ -33,17 +34,55 @@ const (
 	AccountService_ResetPasswordByEmail_FullMethodName          = "/mpb.AccountService/ResetPasswordByEmail"
 	AccountService_ResetPasswordByEmailAndVCode_FullMethodName  = "/mpb.AccountService/ResetPasswordByEmailAndVCode"
 	AccountService_BatchGetAccountsByWalletAddrs_FullMethodName = "/mpb.AccountService/BatchGetAccountsByWalletAddrs"
+	AccountService_RefreshToken_FullMethodName                  = "/mpb.AccountService/RefreshToken"
+	AccountService_Logout_FullMethodName                        = "/mpb.AccountService/Logout"
+	AccountService_LogoutAllDevices_FullMethodName              = "/mpb.AccountService/LogoutAllDevices"
+	AccountService_GetSessions_FullMethodName                   = "/mpb.AccountService/GetSessions"
+	AccountService_RevokeSession_FullMethodName                 = "/mpb.AccountService/RevokeSession"
+	AccountService_EnrollTOTP_FullMethodName                    = "/mpb.AccountService/EnrollTOTP"
+	AccountService_ConfirmTOTP_FullMethodName                   = "/mpb.AccountService/ConfirmTOTP"
+	AccountService_DisableTOTP_FullMethodName                   = "/mpb.AccountService/DisableTOTP"
+	AccountService_LoginByTOTP_FullMethodName                   = "/mpb.AccountService/LoginByTOTP"
+	AccountService_LinkWallet_FullMethodName                    = "/mpb.AccountService/LinkWallet"
+	AccountService_UnlinkWallet_FullMethodName                  = "/mpb.AccountService/UnlinkWallet"
+	AccountService_SetPrimaryWallet_FullMethodName              = "/mpb.AccountService/SetPrimaryWallet"
+	AccountService_ListWallets_FullMethodName                   = "/mpb.AccountService/ListWallets"
+	AccountService_SendEmailChangeCode_FullMethodName           = "/mpb.AccountService/SendEmailChangeCode"
+	AccountService_ChangeEmail_FullMethodName                   = "/mpb.AccountService/ChangeEmail"
+	AccountService_AdminRepairAccounts_FullMethodName           = "/mpb.AccountService/AdminRepairAccounts"
+	AccountService_AdminGetLoginLocks_FullMethodName            = "/mpb.AccountService/AdminGetLoginLocks"
+	AccountService_AdminClearLoginLock_FullMethodName           = "/mpb.AccountService/AdminClearLoginLock"
+	AccountService_RequestAccountDeletion_FullMethodName        = "/mpb.AccountService/RequestAccountDeletion"
+	AccountService_CancelAccountDeletion_FullMethodName         = "/mpb.AccountService/CancelAccountDeletion"
+	AccountService_ExportAccountData_FullMethodName             = "/mpb.AccountService/ExportAccountData"
+	AccountService_UpdateProfile_FullMethodName                 = "/mpb.AccountService/UpdateProfile"
+	AccountService_LoginAsGuest_FullMethodName                  = "/mpb.AccountService/LoginAsGuest"
+	AccountService_UpgradeGuestByEmail_FullMethodName           = "/mpb.AccountService/UpgradeGuestByEmail"
+	AccountService_UpgradeGuestByWallet_FullMethodName          = "/mpb.AccountService/UpgradeGuestByWallet"
+	AccountService_GetLoginHistory_FullMethodName               = "/mpb.AccountService/GetLoginHistory"
+	AccountService_AdminBanAccount_FullMethodName               = "/mpb.AccountService/AdminBanAccount"
+	AccountService_AdminUnbanAccount_FullMethodName             = "/mpb.AccountService/AdminUnbanAccount"
+	AccountService_AdminListBans_FullMethodName                 = "/mpb.AccountService/AdminListBans"
+	AccountService_GetReferralStats_FullMethodName              = "/mpb.AccountService/GetReferralStats"
+	AccountService_AdminGetTopReferrers_FullMethodName          = "/mpb.AccountService/AdminGetTopReferrers"
+	AccountService_LoginByPlatform_FullMethodName               = "/mpb.AccountService/LoginByPlatform"
+	AccountService_LinkPlatform_FullMethodName                  = "/mpb.AccountService/LinkPlatform"
+	AccountService_MergeAccount_FullMethodName                  = "/mpb.AccountService/MergeAccount"
+	AccountService_AdminMergeAccounts_FullMethodName            = "/mpb.AccountService/AdminMergeAccounts"
+	AccountService_SendSMSCode_FullMethodName                   = "/mpb.AccountService/SendSMSCode"
+	AccountService_BindPhone_FullMethodName                     = "/mpb.AccountService/BindPhone"
+	AccountService_ResetPasswordByPhone_FullMethodName          = "/mpb.AccountService/ResetPasswordByPhone"
 )
 
 // AccountServiceClient is the client API for AccountService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type AccountServiceClient interface {
-	// rpc RegisterAccount(ReqRegisterAccount) returns (AccountInfo);
+	RegisterAccount(ctx context.Context, in *ReqRegisterAccount, opts ...grpc.CallOption) (*ResRegisterAccount, error)
 	LoginByPassword(ctx context.Context, in *ReqLoginByPassword, opts ...grpc.CallOption) (*ResLoginByPassword, error)
 	GetAccountInfo(ctx context.Context, in *ReqUserId, opts ...grpc.CallOption) (*AccountInfo, error)
 	GetAccountInfoByAccount(ctx context.Context, in *ReqGetAccountInfoByAccount, opts ...grpc.CallOption) (*AccountInfo, error)
-	GenerateNonce(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ResGenerateNonce, error)
+	GenerateNonce(ctx context.Context, in *ReqGenerateNonce, opts ...grpc.CallOption) (*ResGenerateNonce, error)
 	WebLoginByWallet(ctx context.Context, in *ReqWebLoginByWallet, opts ...grpc.CallOption) (*ResWebLoginByWallet, error)
 	GenerateAndSendEmailBindCode(ctx context.Context, in *ReqGenerateAndSendEmailBindCode, opts ...grpc.CallOption) (*Empty, error)
 	WebBindEmail(ctx context.Context, in *ReqWebBindEmail, opts ...grpc.CallOption) (*ResWebBindEmail, error)
@@ -54,6 +93,44 @@ type AccountServiceClient interface {
 	ResetPasswordByEmail(ctx context.Context, in *ReqResetPasswordByEmail, opts ...grpc.CallOption) (*Empty, error)
 	ResetPasswordByEmailAndVCode(ctx context.Context, in *ReqResetPasswordByEmailAndVCode, opts ...grpc.CallOption) (*Empty, error)
 	BatchGetAccountsByWalletAddrs(ctx context.Context, in *ReqBatchGetAccountsByWalletAddrs, opts ...grpc.CallOption) (*ResBatchGetAccountsByWalletAddrs, error)
+	RefreshToken(ctx context.Context, in *ReqRefreshToken, opts ...grpc.CallOption) (*ResRefreshToken, error)
+	Logout(ctx context.Context, in *ReqLogout, opts ...grpc.CallOption) (*Empty, error)
+	LogoutAllDevices(ctx context.Context, in *ReqUserId, opts ...grpc.CallOption) (*Empty, error)
+	GetSessions(ctx context.Context, in *ReqGetSessions, opts ...grpc.CallOption) (*ResGetSessions, error)
+	RevokeSession(ctx context.Context, in *ReqRevokeSession, opts ...grpc.CallOption) (*Empty, error)
+	EnrollTOTP(ctx context.Context, in *ReqUserId, opts ...grpc.CallOption) (*ResEnrollTOTP, error)
+	ConfirmTOTP(ctx context.Context, in *ReqTOTPCode, opts ...grpc.CallOption) (*ResConfirmTOTP, error)
+	DisableTOTP(ctx context.Context, in *ReqTOTPCode, opts ...grpc.CallOption) (*Empty, error)
+	LoginByTOTP(ctx context.Context, in *ReqLoginByTOTP, opts ...grpc.CallOption) (*ResLoginByPassword, error)
+	LinkWallet(ctx context.Context, in *ReqLinkWallet, opts ...grpc.CallOption) (*ResListWallets, error)
+	UnlinkWallet(ctx context.Context, in *ReqWalletAddr, opts ...grpc.CallOption) (*ResListWallets, error)
+	SetPrimaryWallet(ctx context.Context, in *ReqWalletAddr, opts ...grpc.CallOption) (*ResListWallets, error)
+	ListWallets(ctx context.Context, in *ReqUserId, opts ...grpc.CallOption) (*ResListWallets, error)
+	SendEmailChangeCode(ctx context.Context, in *ReqSendEmailChangeCode, opts ...grpc.CallOption) (*Empty, error)
+	ChangeEmail(ctx context.Context, in *ReqChangeEmail, opts ...grpc.CallOption) (*AccountInfo, error)
+	AdminRepairAccounts(ctx context.Context, in *ReqAdminRepairAccounts, opts ...grpc.CallOption) (*ResAdminRepairAccounts, error)
+	AdminGetLoginLocks(ctx context.Context, in *ReqAdminGetLoginLocks, opts ...grpc.CallOption) (*ResAdminGetLoginLocks, error)
+	AdminClearLoginLock(ctx context.Context, in *ReqAdminClearLoginLock, opts ...grpc.CallOption) (*Empty, error)
+	RequestAccountDeletion(ctx context.Context, in *ReqRequestAccountDeletion, opts ...grpc.CallOption) (*ResRequestAccountDeletion, error)
+	CancelAccountDeletion(ctx context.Context, in *ReqUserId, opts ...grpc.CallOption) (*Empty, error)
+	ExportAccountData(ctx context.Context, in *ReqUserId, opts ...grpc.CallOption) (*ResExportAccountData, error)
+	UpdateProfile(ctx context.Context, in *ReqUpdateProfile, opts ...grpc.CallOption) (*AccountInfo, error)
+	LoginAsGuest(ctx context.Context, in *ReqLoginAsGuest, opts ...grpc.CallOption) (*ResLoginByPassword, error)
+	UpgradeGuestByEmail(ctx context.Context, in *ReqUpgradeGuestByEmail, opts ...grpc.CallOption) (*AccountInfo, error)
+	UpgradeGuestByWallet(ctx context.Context, in *ReqLinkWallet, opts ...grpc.CallOption) (*AccountInfo, error)
+	GetLoginHistory(ctx context.Context, in *ReqUserId, opts ...grpc.CallOption) (*ResGetLoginHistory, error)
+	AdminBanAccount(ctx context.Context, in *ReqAdminBanAccount, opts ...grpc.CallOption) (*BanRecord, error)
+	AdminUnbanAccount(ctx context.Context, in *ReqAdminUnbanAccount, opts ...grpc.CallOption) (*Empty, error)
+	AdminListBans(ctx context.Context, in *ReqAdminListBans, opts ...grpc.CallOption) (*ResAdminListBans, error)
+	GetReferralStats(ctx context.Context, in *ReqUserId, opts ...grpc.CallOption) (*ResGetReferralStats, error)
+	AdminGetTopReferrers(ctx context.Context, in *ReqAdminGetTopReferrers, opts ...grpc.CallOption) (*ResAdminGetTopReferrers, error)
+	LoginByPlatform(ctx context.Context, in *ReqLoginByPlatform, opts ...grpc.CallOption) (*ResLoginByPassword, error)
+	LinkPlatform(ctx context.Context, in *ReqLinkPlatform, opts ...grpc.CallOption) (*AccountInfo, error)
+	MergeAccount(ctx context.Context, in *ReqMergeAccount, opts ...grpc.CallOption) (*AccountInfo, error)
+	AdminMergeAccounts(ctx context.Context, in *ReqAdminMergeAccounts, opts ...grpc.CallOption) (*AccountMergeLog, error)
+	SendSMSCode(ctx context.Context, in *ReqSendSMSCode, opts ...grpc.CallOption) (*Empty, error)
+	BindPhone(ctx context.Context, in *ReqBindPhone, opts ...grpc.CallOption) (*AccountInfo, error)
+	ResetPasswordByPhone(ctx context.Context, in *ReqResetPasswordByPhone, opts ...grpc.CallOption) (*Empty, error)
 }
 
 type accountServiceClient struct {
@@ -64,6 +141,15 @@ func NewAccountServiceClient(cc grpc.ClientConnInterface) AccountServiceClient {
 	return &accountServiceClient{cc}
 }
 
+func (c *accountServiceClient) RegisterAccount(ctx context.Context, in *ReqRegisterAccount, opts ...grpc.CallOption) (*ResRegisterAccount, error) {
+	out := new(ResRegisterAccount)
+	err := c.cc.Invoke(ctx, AccountService_RegisterAccount_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *accountServiceClient) LoginByPassword(ctx context.Context, in *ReqLoginByPassword, opts ...grpc.CallOption) (*ResLoginByPassword, error) {
 	out := new(ResLoginByPassword)
 	err := c.cc.Invoke(ctx, AccountService_LoginByPassword_FullMethodName, in, out, opts...)
@@ -91,7 +177,7 @@ func (c *accountServiceClient) GetAccountInfoByAccount(ctx context.Context, in *
 	return out, nil
 }
 
-func (c *accountServiceClient) GenerateNonce(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ResGenerateNonce, error) {
+func (c *accountServiceClient) GenerateNonce(ctx context.Context, in *ReqGenerateNonce, opts ...grpc.CallOption) (*ResGenerateNonce, error) {
 	out := new(ResGenerateNonce)
 	err := c.cc.Invoke(ctx, AccountService_GenerateNonce_FullMethodName, in, out, opts...)
 	if err != nil {
@@ -190,15 +276,357 @@ func (c *accountServiceClient) BatchGetAccountsByWalletAddrs(ctx context.Context
 	return out, nil
 }
 
+func (c *accountServiceClient) RefreshToken(ctx context.Context, in *ReqRefreshToken, opts ...grpc.CallOption) (*ResRefreshToken, error) {
+	out := new(ResRefreshToken)
+	err := c.cc.Invoke(ctx, AccountService_RefreshToken_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) Logout(ctx context.Context, in *ReqLogout, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, AccountService_Logout_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) LogoutAllDevices(ctx context.Context, in *ReqUserId, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, AccountService_LogoutAllDevices_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) GetSessions(ctx context.Context, in *ReqGetSessions, opts ...grpc.CallOption) (*ResGetSessions, error) {
+	out := new(ResGetSessions)
+	err := c.cc.Invoke(ctx, AccountService_GetSessions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) RevokeSession(ctx context.Context, in *ReqRevokeSession, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, AccountService_RevokeSession_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) EnrollTOTP(ctx context.Context, in *ReqUserId, opts ...grpc.CallOption) (*ResEnrollTOTP, error) {
+	out := new(ResEnrollTOTP)
+	err := c.cc.Invoke(ctx, AccountService_EnrollTOTP_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) ConfirmTOTP(ctx context.Context, in *ReqTOTPCode, opts ...grpc.CallOption) (*ResConfirmTOTP, error) {
+	out := new(ResConfirmTOTP)
+	err := c.cc.Invoke(ctx, AccountService_ConfirmTOTP_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) DisableTOTP(ctx context.Context, in *ReqTOTPCode, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, AccountService_DisableTOTP_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) LoginByTOTP(ctx context.Context, in *ReqLoginByTOTP, opts ...grpc.CallOption) (*ResLoginByPassword, error) {
+	out := new(ResLoginByPassword)
+	err := c.cc.Invoke(ctx, AccountService_LoginByTOTP_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) LinkWallet(ctx context.Context, in *ReqLinkWallet, opts ...grpc.CallOption) (*ResListWallets, error) {
+	out := new(ResListWallets)
+	err := c.cc.Invoke(ctx, AccountService_LinkWallet_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) UnlinkWallet(ctx context.Context, in *ReqWalletAddr, opts ...grpc.CallOption) (*ResListWallets, error) {
+	out := new(ResListWallets)
+	err := c.cc.Invoke(ctx, AccountService_UnlinkWallet_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) SetPrimaryWallet(ctx context.Context, in *ReqWalletAddr, opts ...grpc.CallOption) (*ResListWallets, error) {
+	out := new(ResListWallets)
+	err := c.cc.Invoke(ctx, AccountService_SetPrimaryWallet_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) ListWallets(ctx context.Context, in *ReqUserId, opts ...grpc.CallOption) (*ResListWallets, error) {
+	out := new(ResListWallets)
+	err := c.cc.Invoke(ctx, AccountService_ListWallets_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) SendEmailChangeCode(ctx context.Context, in *ReqSendEmailChangeCode, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, AccountService_SendEmailChangeCode_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) ChangeEmail(ctx context.Context, in *ReqChangeEmail, opts ...grpc.CallOption) (*AccountInfo, error) {
+	out := new(AccountInfo)
+	err := c.cc.Invoke(ctx, AccountService_ChangeEmail_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) AdminRepairAccounts(ctx context.Context, in *ReqAdminRepairAccounts, opts ...grpc.CallOption) (*ResAdminRepairAccounts, error) {
+	out := new(ResAdminRepairAccounts)
+	err := c.cc.Invoke(ctx, AccountService_AdminRepairAccounts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) AdminGetLoginLocks(ctx context.Context, in *ReqAdminGetLoginLocks, opts ...grpc.CallOption) (*ResAdminGetLoginLocks, error) {
+	out := new(ResAdminGetLoginLocks)
+	err := c.cc.Invoke(ctx, AccountService_AdminGetLoginLocks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) AdminClearLoginLock(ctx context.Context, in *ReqAdminClearLoginLock, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, AccountService_AdminClearLoginLock_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) RequestAccountDeletion(ctx context.Context, in *ReqRequestAccountDeletion, opts ...grpc.CallOption) (*ResRequestAccountDeletion, error) {
+	out := new(ResRequestAccountDeletion)
+	err := c.cc.Invoke(ctx, AccountService_RequestAccountDeletion_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) CancelAccountDeletion(ctx context.Context, in *ReqUserId, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, AccountService_CancelAccountDeletion_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) ExportAccountData(ctx context.Context, in *ReqUserId, opts ...grpc.CallOption) (*ResExportAccountData, error) {
+	out := new(ResExportAccountData)
+	err := c.cc.Invoke(ctx, AccountService_ExportAccountData_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) UpdateProfile(ctx context.Context, in *ReqUpdateProfile, opts ...grpc.CallOption) (*AccountInfo, error) {
+	out := new(AccountInfo)
+	err := c.cc.Invoke(ctx, AccountService_UpdateProfile_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) LoginAsGuest(ctx context.Context, in *ReqLoginAsGuest, opts ...grpc.CallOption) (*ResLoginByPassword, error) {
+	out := new(ResLoginByPassword)
+	err := c.cc.Invoke(ctx, AccountService_LoginAsGuest_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) UpgradeGuestByEmail(ctx context.Context, in *ReqUpgradeGuestByEmail, opts ...grpc.CallOption) (*AccountInfo, error) {
+	out := new(AccountInfo)
+	err := c.cc.Invoke(ctx, AccountService_UpgradeGuestByEmail_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) UpgradeGuestByWallet(ctx context.Context, in *ReqLinkWallet, opts ...grpc.CallOption) (*AccountInfo, error) {
+	out := new(AccountInfo)
+	err := c.cc.Invoke(ctx, AccountService_UpgradeGuestByWallet_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) GetLoginHistory(ctx context.Context, in *ReqUserId, opts ...grpc.CallOption) (*ResGetLoginHistory, error) {
+	out := new(ResGetLoginHistory)
+	err := c.cc.Invoke(ctx, AccountService_GetLoginHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) AdminBanAccount(ctx context.Context, in *ReqAdminBanAccount, opts ...grpc.CallOption) (*BanRecord, error) {
+	out := new(BanRecord)
+	err := c.cc.Invoke(ctx, AccountService_AdminBanAccount_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) AdminUnbanAccount(ctx context.Context, in *ReqAdminUnbanAccount, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, AccountService_AdminUnbanAccount_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) AdminListBans(ctx context.Context, in *ReqAdminListBans, opts ...grpc.CallOption) (*ResAdminListBans, error) {
+	out := new(ResAdminListBans)
+	err := c.cc.Invoke(ctx, AccountService_AdminListBans_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) GetReferralStats(ctx context.Context, in *ReqUserId, opts ...grpc.CallOption) (*ResGetReferralStats, error) {
+	out := new(ResGetReferralStats)
+	err := c.cc.Invoke(ctx, AccountService_GetReferralStats_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) AdminGetTopReferrers(ctx context.Context, in *ReqAdminGetTopReferrers, opts ...grpc.CallOption) (*ResAdminGetTopReferrers, error) {
+	out := new(ResAdminGetTopReferrers)
+	err := c.cc.Invoke(ctx, AccountService_AdminGetTopReferrers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) LoginByPlatform(ctx context.Context, in *ReqLoginByPlatform, opts ...grpc.CallOption) (*ResLoginByPassword, error) {
+	out := new(ResLoginByPassword)
+	err := c.cc.Invoke(ctx, AccountService_LoginByPlatform_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) LinkPlatform(ctx context.Context, in *ReqLinkPlatform, opts ...grpc.CallOption) (*AccountInfo, error) {
+	out := new(AccountInfo)
+	err := c.cc.Invoke(ctx, AccountService_LinkPlatform_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) MergeAccount(ctx context.Context, in *ReqMergeAccount, opts ...grpc.CallOption) (*AccountInfo, error) {
+	out := new(AccountInfo)
+	err := c.cc.Invoke(ctx, AccountService_MergeAccount_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) AdminMergeAccounts(ctx context.Context, in *ReqAdminMergeAccounts, opts ...grpc.CallOption) (*AccountMergeLog, error) {
+	out := new(AccountMergeLog)
+	err := c.cc.Invoke(ctx, AccountService_AdminMergeAccounts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) SendSMSCode(ctx context.Context, in *ReqSendSMSCode, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, AccountService_SendSMSCode_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) BindPhone(ctx context.Context, in *ReqBindPhone, opts ...grpc.CallOption) (*AccountInfo, error) {
+	out := new(AccountInfo)
+	err := c.cc.Invoke(ctx, AccountService_BindPhone_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) ResetPasswordByPhone(ctx context.Context, in *ReqResetPasswordByPhone, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, AccountService_ResetPasswordByPhone_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AccountServiceServer is the server API for AccountService service.
 // All implementations must embed UnimplementedAccountServiceServer
 // for forward compatibility
 type AccountServiceServer interface {
-	// rpc RegisterAccount(ReqRegisterAccount) returns (AccountInfo);
+	RegisterAccount(context.Context, *ReqRegisterAccount) (*ResRegisterAccount, error)
 	LoginByPassword(context.Context, *ReqLoginByPassword) (*ResLoginByPassword, error)
 	GetAccountInfo(context.Context, *ReqUserId) (*AccountInfo, error)
 	GetAccountInfoByAccount(context.Context, *ReqGetAccountInfoByAccount) (*AccountInfo, error)
-	GenerateNonce(context.Context, *Empty) (*ResGenerateNonce, error)
+	GenerateNonce(context.Context, *ReqGenerateNonce) (*ResGenerateNonce, error)
 	WebLoginByWallet(context.Context, *ReqWebLoginByWallet) (*ResWebLoginByWallet, error)
 	GenerateAndSendEmailBindCode(context.Context, *ReqGenerateAndSendEmailBindCode) (*Empty, error)
 	WebBindEmail(context.Context, *ReqWebBindEmail) (*ResWebBindEmail, error)
@@ -209,6 +637,44 @@ type AccountServiceServer interface {
 	ResetPasswordByEmail(context.Context, *ReqResetPasswordByEmail) (*Empty, error)
 	ResetPasswordByEmailAndVCode(context.Context, *ReqResetPasswordByEmailAndVCode) (*Empty, error)
 	BatchGetAccountsByWalletAddrs(context.Context, *ReqBatchGetAccountsByWalletAddrs) (*ResBatchGetAccountsByWalletAddrs, error)
+	RefreshToken(context.Context, *ReqRefreshToken) (*ResRefreshToken, error)
+	Logout(context.Context, *ReqLogout) (*Empty, error)
+	LogoutAllDevices(context.Context, *ReqUserId) (*Empty, error)
+	GetSessions(context.Context, *ReqGetSessions) (*ResGetSessions, error)
+	RevokeSession(context.Context, *ReqRevokeSession) (*Empty, error)
+	EnrollTOTP(context.Context, *ReqUserId) (*ResEnrollTOTP, error)
+	ConfirmTOTP(context.Context, *ReqTOTPCode) (*ResConfirmTOTP, error)
+	DisableTOTP(context.Context, *ReqTOTPCode) (*Empty, error)
+	LoginByTOTP(context.Context, *ReqLoginByTOTP) (*ResLoginByPassword, error)
+	LinkWallet(context.Context, *ReqLinkWallet) (*ResListWallets, error)
+	UnlinkWallet(context.Context, *ReqWalletAddr) (*ResListWallets, error)
+	SetPrimaryWallet(context.Context, *ReqWalletAddr) (*ResListWallets, error)
+	ListWallets(context.Context, *ReqUserId) (*ResListWallets, error)
+	SendEmailChangeCode(context.Context, *ReqSendEmailChangeCode) (*Empty, error)
+	ChangeEmail(context.Context, *ReqChangeEmail) (*AccountInfo, error)
+	AdminRepairAccounts(context.Context, *ReqAdminRepairAccounts) (*ResAdminRepairAccounts, error)
+	AdminGetLoginLocks(context.Context, *ReqAdminGetLoginLocks) (*ResAdminGetLoginLocks, error)
+	AdminClearLoginLock(context.Context, *ReqAdminClearLoginLock) (*Empty, error)
+	RequestAccountDeletion(context.Context, *ReqRequestAccountDeletion) (*ResRequestAccountDeletion, error)
+	CancelAccountDeletion(context.Context, *ReqUserId) (*Empty, error)
+	ExportAccountData(context.Context, *ReqUserId) (*ResExportAccountData, error)
+	UpdateProfile(context.Context, *ReqUpdateProfile) (*AccountInfo, error)
+	LoginAsGuest(context.Context, *ReqLoginAsGuest) (*ResLoginByPassword, error)
+	UpgradeGuestByEmail(context.Context, *ReqUpgradeGuestByEmail) (*AccountInfo, error)
+	UpgradeGuestByWallet(context.Context, *ReqLinkWallet) (*AccountInfo, error)
+	GetLoginHistory(context.Context, *ReqUserId) (*ResGetLoginHistory, error)
+	AdminBanAccount(context.Context, *ReqAdminBanAccount) (*BanRecord, error)
+	AdminUnbanAccount(context.Context, *ReqAdminUnbanAccount) (*Empty, error)
+	AdminListBans(context.Context, *ReqAdminListBans) (*ResAdminListBans, error)
+	GetReferralStats(context.Context, *ReqUserId) (*ResGetReferralStats, error)
+	AdminGetTopReferrers(context.Context, *ReqAdminGetTopReferrers) (*ResAdminGetTopReferrers, error)
+	LoginByPlatform(context.Context, *ReqLoginByPlatform) (*ResLoginByPassword, error)
+	LinkPlatform(context.Context, *ReqLinkPlatform) (*AccountInfo, error)
+	MergeAccount(context.Context, *ReqMergeAccount) (*AccountInfo, error)
+	AdminMergeAccounts(context.Context, *ReqAdminMergeAccounts) (*AccountMergeLog, error)
+	SendSMSCode(context.Context, *ReqSendSMSCode) (*Empty, error)
+	BindPhone(context.Context, *ReqBindPhone) (*AccountInfo, error)
+	ResetPasswordByPhone(context.Context, *ReqResetPasswordByPhone) (*Empty, error)
 	mustEmbedUnimplementedAccountServiceServer()
 }
 
@@ -216,6 +682,9 @@ type AccountServiceServer interface {
 type UnimplementedAccountServiceServer struct {
 }
 
+func (UnimplementedAccountServiceServer) RegisterAccount(context.Context, *ReqRegisterAccount) (*ResRegisterAccount, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterAccount not implemented")
+}
 func (UnimplementedAccountServiceServer) LoginByPassword(context.Context, *ReqLoginByPassword) (*ResLoginByPassword, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LoginByPassword not implemented")
 }
@@ -225,7 +694,7 @@ func (UnimplementedAccountServiceServer) GetAccountInfo(context.Context, *ReqUse
 func (UnimplementedAccountServiceServer) GetAccountInfoByAccount(context.Context, *ReqGetAccountInfoByAccount) (*AccountInfo, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAccountInfoByAccount not implemented")
 }
-func (UnimplementedAccountServiceServer) GenerateNonce(context.Context, *Empty) (*ResGenerateNonce, error) {
+func (UnimplementedAccountServiceServer) GenerateNonce(context.Context, *ReqGenerateNonce) (*ResGenerateNonce, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GenerateNonce not implemented")
 }
 func (UnimplementedAccountServiceServer) WebLoginByWallet(context.Context, *ReqWebLoginByWallet) (*ResWebLoginByWallet, error) {
@@ -243,282 +712,1098 @@ func (UnimplementedAccountServiceServer) GetAptosAccount(context.Context, *ReqUs
 func (UnimplementedAccountServiceServer) ChangePassword(context.Context, *ReqChangePassword) (*Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ChangePassword not implemented")
 }
-func (UnimplementedAccountServiceServer) SendEmailResetPasswordCode(context.Context, *ReqSendEmailResetPasswordCode) (*Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SendEmailResetPasswordCode not implemented")
+func (UnimplementedAccountServiceServer) SendEmailResetPasswordCode(context.Context, *ReqSendEmailResetPasswordCode) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendEmailResetPasswordCode not implemented")
+}
+func (UnimplementedAccountServiceServer) CheckEmailResetPasswordCode(context.Context, *ReqCheckEmailResetPasswordCode) (*ResCheckEmailResetPasswordCode, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckEmailResetPasswordCode not implemented")
+}
+func (UnimplementedAccountServiceServer) ResetPasswordByEmail(context.Context, *ReqResetPasswordByEmail) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetPasswordByEmail not implemented")
+}
+func (UnimplementedAccountServiceServer) ResetPasswordByEmailAndVCode(context.Context, *ReqResetPasswordByEmailAndVCode) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetPasswordByEmailAndVCode not implemented")
+}
+func (UnimplementedAccountServiceServer) BatchGetAccountsByWalletAddrs(context.Context, *ReqBatchGetAccountsByWalletAddrs) (*ResBatchGetAccountsByWalletAddrs, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchGetAccountsByWalletAddrs not implemented")
+}
+func (UnimplementedAccountServiceServer) RefreshToken(context.Context, *ReqRefreshToken) (*ResRefreshToken, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshToken not implemented")
+}
+func (UnimplementedAccountServiceServer) Logout(context.Context, *ReqLogout) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Logout not implemented")
+}
+func (UnimplementedAccountServiceServer) LogoutAllDevices(context.Context, *ReqUserId) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LogoutAllDevices not implemented")
+}
+func (UnimplementedAccountServiceServer) GetSessions(context.Context, *ReqGetSessions) (*ResGetSessions, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSessions not implemented")
+}
+func (UnimplementedAccountServiceServer) RevokeSession(context.Context, *ReqRevokeSession) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeSession not implemented")
+}
+func (UnimplementedAccountServiceServer) EnrollTOTP(context.Context, *ReqUserId) (*ResEnrollTOTP, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnrollTOTP not implemented")
+}
+func (UnimplementedAccountServiceServer) ConfirmTOTP(context.Context, *ReqTOTPCode) (*ResConfirmTOTP, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmTOTP not implemented")
+}
+func (UnimplementedAccountServiceServer) DisableTOTP(context.Context, *ReqTOTPCode) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableTOTP not implemented")
+}
+func (UnimplementedAccountServiceServer) LoginByTOTP(context.Context, *ReqLoginByTOTP) (*ResLoginByPassword, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LoginByTOTP not implemented")
+}
+func (UnimplementedAccountServiceServer) LinkWallet(context.Context, *ReqLinkWallet) (*ResListWallets, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LinkWallet not implemented")
+}
+func (UnimplementedAccountServiceServer) UnlinkWallet(context.Context, *ReqWalletAddr) (*ResListWallets, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlinkWallet not implemented")
+}
+func (UnimplementedAccountServiceServer) SetPrimaryWallet(context.Context, *ReqWalletAddr) (*ResListWallets, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPrimaryWallet not implemented")
+}
+func (UnimplementedAccountServiceServer) ListWallets(context.Context, *ReqUserId) (*ResListWallets, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWallets not implemented")
+}
+func (UnimplementedAccountServiceServer) SendEmailChangeCode(context.Context, *ReqSendEmailChangeCode) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendEmailChangeCode not implemented")
+}
+func (UnimplementedAccountServiceServer) ChangeEmail(context.Context, *ReqChangeEmail) (*AccountInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeEmail not implemented")
+}
+func (UnimplementedAccountServiceServer) AdminRepairAccounts(context.Context, *ReqAdminRepairAccounts) (*ResAdminRepairAccounts, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminRepairAccounts not implemented")
+}
+func (UnimplementedAccountServiceServer) AdminGetLoginLocks(context.Context, *ReqAdminGetLoginLocks) (*ResAdminGetLoginLocks, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminGetLoginLocks not implemented")
+}
+func (UnimplementedAccountServiceServer) AdminClearLoginLock(context.Context, *ReqAdminClearLoginLock) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminClearLoginLock not implemented")
+}
+func (UnimplementedAccountServiceServer) RequestAccountDeletion(context.Context, *ReqRequestAccountDeletion) (*ResRequestAccountDeletion, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestAccountDeletion not implemented")
+}
+func (UnimplementedAccountServiceServer) CancelAccountDeletion(context.Context, *ReqUserId) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelAccountDeletion not implemented")
+}
+func (UnimplementedAccountServiceServer) ExportAccountData(context.Context, *ReqUserId) (*ResExportAccountData, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportAccountData not implemented")
+}
+func (UnimplementedAccountServiceServer) UpdateProfile(context.Context, *ReqUpdateProfile) (*AccountInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProfile not implemented")
+}
+func (UnimplementedAccountServiceServer) LoginAsGuest(context.Context, *ReqLoginAsGuest) (*ResLoginByPassword, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LoginAsGuest not implemented")
+}
+func (UnimplementedAccountServiceServer) UpgradeGuestByEmail(context.Context, *ReqUpgradeGuestByEmail) (*AccountInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpgradeGuestByEmail not implemented")
+}
+func (UnimplementedAccountServiceServer) UpgradeGuestByWallet(context.Context, *ReqLinkWallet) (*AccountInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpgradeGuestByWallet not implemented")
+}
+func (UnimplementedAccountServiceServer) GetLoginHistory(context.Context, *ReqUserId) (*ResGetLoginHistory, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLoginHistory not implemented")
+}
+func (UnimplementedAccountServiceServer) AdminBanAccount(context.Context, *ReqAdminBanAccount) (*BanRecord, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminBanAccount not implemented")
+}
+func (UnimplementedAccountServiceServer) AdminUnbanAccount(context.Context, *ReqAdminUnbanAccount) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminUnbanAccount not implemented")
+}
+func (UnimplementedAccountServiceServer) AdminListBans(context.Context, *ReqAdminListBans) (*ResAdminListBans, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminListBans not implemented")
+}
+func (UnimplementedAccountServiceServer) GetReferralStats(context.Context, *ReqUserId) (*ResGetReferralStats, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReferralStats not implemented")
+}
+func (UnimplementedAccountServiceServer) AdminGetTopReferrers(context.Context, *ReqAdminGetTopReferrers) (*ResAdminGetTopReferrers, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminGetTopReferrers not implemented")
+}
+func (UnimplementedAccountServiceServer) LoginByPlatform(context.Context, *ReqLoginByPlatform) (*ResLoginByPassword, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LoginByPlatform not implemented")
+}
+func (UnimplementedAccountServiceServer) LinkPlatform(context.Context, *ReqLinkPlatform) (*AccountInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LinkPlatform not implemented")
+}
+func (UnimplementedAccountServiceServer) MergeAccount(context.Context, *ReqMergeAccount) (*AccountInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeAccount not implemented")
+}
+func (UnimplementedAccountServiceServer) AdminMergeAccounts(context.Context, *ReqAdminMergeAccounts) (*AccountMergeLog, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminMergeAccounts not implemented")
+}
+func (UnimplementedAccountServiceServer) SendSMSCode(context.Context, *ReqSendSMSCode) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendSMSCode not implemented")
+}
+func (UnimplementedAccountServiceServer) BindPhone(context.Context, *ReqBindPhone) (*AccountInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BindPhone not implemented")
+}
+func (UnimplementedAccountServiceServer) ResetPasswordByPhone(context.Context, *ReqResetPasswordByPhone) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetPasswordByPhone not implemented")
+}
+func (UnimplementedAccountServiceServer) mustEmbedUnimplementedAccountServiceServer() {}
+
+// UnsafeAccountServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AccountServiceServer will
+// result in compilation errors.
+type UnsafeAccountServiceServer interface {
+	mustEmbedUnimplementedAccountServiceServer()
+}
+
+func RegisterAccountServiceServer(s grpc.ServiceRegistrar, srv AccountServiceServer) {
+	s.RegisterService(&AccountService_ServiceDesc, srv)
+}
+
+func _AccountService_RegisterAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqRegisterAccount)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).RegisterAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_RegisterAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).RegisterAccount(ctx, req.(*ReqRegisterAccount))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_LoginByPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqLoginByPassword)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).LoginByPassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_LoginByPassword_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).LoginByPassword(ctx, req.(*ReqLoginByPassword))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_GetAccountInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqUserId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).GetAccountInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_GetAccountInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).GetAccountInfo(ctx, req.(*ReqUserId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_GetAccountInfoByAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqGetAccountInfoByAccount)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).GetAccountInfoByAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_GetAccountInfoByAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).GetAccountInfoByAccount(ctx, req.(*ReqGetAccountInfoByAccount))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_GenerateNonce_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqGenerateNonce)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).GenerateNonce(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_GenerateNonce_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).GenerateNonce(ctx, req.(*ReqGenerateNonce))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_WebLoginByWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqWebLoginByWallet)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).WebLoginByWallet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_WebLoginByWallet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).WebLoginByWallet(ctx, req.(*ReqWebLoginByWallet))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_GenerateAndSendEmailBindCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqGenerateAndSendEmailBindCode)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).GenerateAndSendEmailBindCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_GenerateAndSendEmailBindCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).GenerateAndSendEmailBindCode(ctx, req.(*ReqGenerateAndSendEmailBindCode))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_WebBindEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqWebBindEmail)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).WebBindEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_WebBindEmail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).WebBindEmail(ctx, req.(*ReqWebBindEmail))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_GetAptosAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqUserId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).GetAptosAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_GetAptosAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).GetAptosAccount(ctx, req.(*ReqUserId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_ChangePassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqChangePassword)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).ChangePassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_ChangePassword_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).ChangePassword(ctx, req.(*ReqChangePassword))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_SendEmailResetPasswordCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqSendEmailResetPasswordCode)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).SendEmailResetPasswordCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_SendEmailResetPasswordCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).SendEmailResetPasswordCode(ctx, req.(*ReqSendEmailResetPasswordCode))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_CheckEmailResetPasswordCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqCheckEmailResetPasswordCode)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).CheckEmailResetPasswordCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_CheckEmailResetPasswordCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).CheckEmailResetPasswordCode(ctx, req.(*ReqCheckEmailResetPasswordCode))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_ResetPasswordByEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqResetPasswordByEmail)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).ResetPasswordByEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_ResetPasswordByEmail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).ResetPasswordByEmail(ctx, req.(*ReqResetPasswordByEmail))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_ResetPasswordByEmailAndVCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqResetPasswordByEmailAndVCode)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).ResetPasswordByEmailAndVCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_ResetPasswordByEmailAndVCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).ResetPasswordByEmailAndVCode(ctx, req.(*ReqResetPasswordByEmailAndVCode))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_BatchGetAccountsByWalletAddrs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqBatchGetAccountsByWalletAddrs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).BatchGetAccountsByWalletAddrs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_BatchGetAccountsByWalletAddrs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).BatchGetAccountsByWalletAddrs(ctx, req.(*ReqBatchGetAccountsByWalletAddrs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_RefreshToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqRefreshToken)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).RefreshToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_RefreshToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).RefreshToken(ctx, req.(*ReqRefreshToken))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_Logout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqLogout)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).Logout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_Logout_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).Logout(ctx, req.(*ReqLogout))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_LogoutAllDevices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqUserId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).LogoutAllDevices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_LogoutAllDevices_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).LogoutAllDevices(ctx, req.(*ReqUserId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_GetSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqGetSessions)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).GetSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_GetSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).GetSessions(ctx, req.(*ReqGetSessions))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_RevokeSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqRevokeSession)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).RevokeSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_RevokeSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).RevokeSession(ctx, req.(*ReqRevokeSession))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_EnrollTOTP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqUserId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).EnrollTOTP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_EnrollTOTP_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).EnrollTOTP(ctx, req.(*ReqUserId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_ConfirmTOTP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqTOTPCode)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).ConfirmTOTP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_ConfirmTOTP_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).ConfirmTOTP(ctx, req.(*ReqTOTPCode))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_DisableTOTP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqTOTPCode)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).DisableTOTP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_DisableTOTP_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).DisableTOTP(ctx, req.(*ReqTOTPCode))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_LoginByTOTP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqLoginByTOTP)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).LoginByTOTP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_LoginByTOTP_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).LoginByTOTP(ctx, req.(*ReqLoginByTOTP))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_LinkWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqLinkWallet)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).LinkWallet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_LinkWallet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).LinkWallet(ctx, req.(*ReqLinkWallet))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_UnlinkWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqWalletAddr)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).UnlinkWallet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_UnlinkWallet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).UnlinkWallet(ctx, req.(*ReqWalletAddr))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_SetPrimaryWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqWalletAddr)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).SetPrimaryWallet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_SetPrimaryWallet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).SetPrimaryWallet(ctx, req.(*ReqWalletAddr))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_ListWallets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqUserId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).ListWallets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_ListWallets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).ListWallets(ctx, req.(*ReqUserId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_SendEmailChangeCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqSendEmailChangeCode)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).SendEmailChangeCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_SendEmailChangeCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).SendEmailChangeCode(ctx, req.(*ReqSendEmailChangeCode))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_ChangeEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqChangeEmail)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).ChangeEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_ChangeEmail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).ChangeEmail(ctx, req.(*ReqChangeEmail))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_AdminRepairAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqAdminRepairAccounts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).AdminRepairAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_AdminRepairAccounts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).AdminRepairAccounts(ctx, req.(*ReqAdminRepairAccounts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_AdminGetLoginLocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqAdminGetLoginLocks)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).AdminGetLoginLocks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_AdminGetLoginLocks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).AdminGetLoginLocks(ctx, req.(*ReqAdminGetLoginLocks))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_AdminClearLoginLock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqAdminClearLoginLock)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).AdminClearLoginLock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_AdminClearLoginLock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).AdminClearLoginLock(ctx, req.(*ReqAdminClearLoginLock))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedAccountServiceServer) CheckEmailResetPasswordCode(context.Context, *ReqCheckEmailResetPasswordCode) (*ResCheckEmailResetPasswordCode, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CheckEmailResetPasswordCode not implemented")
+
+func _AccountService_RequestAccountDeletion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqRequestAccountDeletion)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).RequestAccountDeletion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_RequestAccountDeletion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).RequestAccountDeletion(ctx, req.(*ReqRequestAccountDeletion))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedAccountServiceServer) ResetPasswordByEmail(context.Context, *ReqResetPasswordByEmail) (*Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ResetPasswordByEmail not implemented")
+
+func _AccountService_CancelAccountDeletion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqUserId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).CancelAccountDeletion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_CancelAccountDeletion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).CancelAccountDeletion(ctx, req.(*ReqUserId))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedAccountServiceServer) ResetPasswordByEmailAndVCode(context.Context, *ReqResetPasswordByEmailAndVCode) (*Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ResetPasswordByEmailAndVCode not implemented")
+
+func _AccountService_ExportAccountData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqUserId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).ExportAccountData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_ExportAccountData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).ExportAccountData(ctx, req.(*ReqUserId))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedAccountServiceServer) BatchGetAccountsByWalletAddrs(context.Context, *ReqBatchGetAccountsByWalletAddrs) (*ResBatchGetAccountsByWalletAddrs, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BatchGetAccountsByWalletAddrs not implemented")
+
+func _AccountService_UpdateProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqUpdateProfile)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).UpdateProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_UpdateProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).UpdateProfile(ctx, req.(*ReqUpdateProfile))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedAccountServiceServer) mustEmbedUnimplementedAccountServiceServer() {}
 
-// UnsafeAccountServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to AccountServiceServer will
-// result in compilation errors.
-type UnsafeAccountServiceServer interface {
-	mustEmbedUnimplementedAccountServiceServer()
+func _AccountService_LoginAsGuest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqLoginAsGuest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).LoginAsGuest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_LoginAsGuest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).LoginAsGuest(ctx, req.(*ReqLoginAsGuest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterAccountServiceServer(s grpc.ServiceRegistrar, srv AccountServiceServer) {
-	s.RegisterService(&AccountService_ServiceDesc, srv)
+func _AccountService_UpgradeGuestByEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqUpgradeGuestByEmail)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).UpgradeGuestByEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_UpgradeGuestByEmail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).UpgradeGuestByEmail(ctx, req.(*ReqUpgradeGuestByEmail))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _AccountService_LoginByPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ReqLoginByPassword)
+func _AccountService_UpgradeGuestByWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqLinkWallet)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AccountServiceServer).LoginByPassword(ctx, in)
+		return srv.(AccountServiceServer).UpgradeGuestByWallet(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AccountService_LoginByPassword_FullMethodName,
+		FullMethod: AccountService_UpgradeGuestByWallet_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AccountServiceServer).LoginByPassword(ctx, req.(*ReqLoginByPassword))
+		return srv.(AccountServiceServer).UpgradeGuestByWallet(ctx, req.(*ReqLinkWallet))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AccountService_GetAccountInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _AccountService_GetLoginHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ReqUserId)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AccountServiceServer).GetAccountInfo(ctx, in)
+		return srv.(AccountServiceServer).GetLoginHistory(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AccountService_GetAccountInfo_FullMethodName,
+		FullMethod: AccountService_GetLoginHistory_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AccountServiceServer).GetAccountInfo(ctx, req.(*ReqUserId))
+		return srv.(AccountServiceServer).GetLoginHistory(ctx, req.(*ReqUserId))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AccountService_GetAccountInfoByAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ReqGetAccountInfoByAccount)
+func _AccountService_AdminBanAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqAdminBanAccount)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AccountServiceServer).GetAccountInfoByAccount(ctx, in)
+		return srv.(AccountServiceServer).AdminBanAccount(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AccountService_GetAccountInfoByAccount_FullMethodName,
+		FullMethod: AccountService_AdminBanAccount_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AccountServiceServer).GetAccountInfoByAccount(ctx, req.(*ReqGetAccountInfoByAccount))
+		return srv.(AccountServiceServer).AdminBanAccount(ctx, req.(*ReqAdminBanAccount))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AccountService_GenerateNonce_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Empty)
+func _AccountService_AdminUnbanAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqAdminUnbanAccount)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AccountServiceServer).GenerateNonce(ctx, in)
+		return srv.(AccountServiceServer).AdminUnbanAccount(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AccountService_GenerateNonce_FullMethodName,
+		FullMethod: AccountService_AdminUnbanAccount_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AccountServiceServer).GenerateNonce(ctx, req.(*Empty))
+		return srv.(AccountServiceServer).AdminUnbanAccount(ctx, req.(*ReqAdminUnbanAccount))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AccountService_WebLoginByWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ReqWebLoginByWallet)
+func _AccountService_AdminListBans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqAdminListBans)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AccountServiceServer).WebLoginByWallet(ctx, in)
+		return srv.(AccountServiceServer).AdminListBans(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AccountService_WebLoginByWallet_FullMethodName,
+		FullMethod: AccountService_AdminListBans_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AccountServiceServer).WebLoginByWallet(ctx, req.(*ReqWebLoginByWallet))
+		return srv.(AccountServiceServer).AdminListBans(ctx, req.(*ReqAdminListBans))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AccountService_GenerateAndSendEmailBindCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ReqGenerateAndSendEmailBindCode)
+func _AccountService_GetReferralStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqUserId)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AccountServiceServer).GenerateAndSendEmailBindCode(ctx, in)
+		return srv.(AccountServiceServer).GetReferralStats(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AccountService_GenerateAndSendEmailBindCode_FullMethodName,
+		FullMethod: AccountService_GetReferralStats_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AccountServiceServer).GenerateAndSendEmailBindCode(ctx, req.(*ReqGenerateAndSendEmailBindCode))
+		return srv.(AccountServiceServer).GetReferralStats(ctx, req.(*ReqUserId))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AccountService_WebBindEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ReqWebBindEmail)
+func _AccountService_AdminGetTopReferrers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqAdminGetTopReferrers)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AccountServiceServer).WebBindEmail(ctx, in)
+		return srv.(AccountServiceServer).AdminGetTopReferrers(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AccountService_WebBindEmail_FullMethodName,
+		FullMethod: AccountService_AdminGetTopReferrers_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AccountServiceServer).WebBindEmail(ctx, req.(*ReqWebBindEmail))
+		return srv.(AccountServiceServer).AdminGetTopReferrers(ctx, req.(*ReqAdminGetTopReferrers))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AccountService_GetAptosAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ReqUserId)
+func _AccountService_LoginByPlatform_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqLoginByPlatform)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AccountServiceServer).GetAptosAccount(ctx, in)
+		return srv.(AccountServiceServer).LoginByPlatform(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AccountService_GetAptosAccount_FullMethodName,
+		FullMethod: AccountService_LoginByPlatform_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AccountServiceServer).GetAptosAccount(ctx, req.(*ReqUserId))
+		return srv.(AccountServiceServer).LoginByPlatform(ctx, req.(*ReqLoginByPlatform))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AccountService_ChangePassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ReqChangePassword)
+func _AccountService_LinkPlatform_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqLinkPlatform)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AccountServiceServer).ChangePassword(ctx, in)
+		return srv.(AccountServiceServer).LinkPlatform(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AccountService_ChangePassword_FullMethodName,
+		FullMethod: AccountService_LinkPlatform_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AccountServiceServer).ChangePassword(ctx, req.(*ReqChangePassword))
+		return srv.(AccountServiceServer).LinkPlatform(ctx, req.(*ReqLinkPlatform))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AccountService_SendEmailResetPasswordCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ReqSendEmailResetPasswordCode)
+func _AccountService_MergeAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqMergeAccount)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AccountServiceServer).SendEmailResetPasswordCode(ctx, in)
+		return srv.(AccountServiceServer).MergeAccount(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AccountService_SendEmailResetPasswordCode_FullMethodName,
+		FullMethod: AccountService_MergeAccount_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AccountServiceServer).SendEmailResetPasswordCode(ctx, req.(*ReqSendEmailResetPasswordCode))
+		return srv.(AccountServiceServer).MergeAccount(ctx, req.(*ReqMergeAccount))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AccountService_CheckEmailResetPasswordCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ReqCheckEmailResetPasswordCode)
+func _AccountService_AdminMergeAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqAdminMergeAccounts)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AccountServiceServer).CheckEmailResetPasswordCode(ctx, in)
+		return srv.(AccountServiceServer).AdminMergeAccounts(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AccountService_CheckEmailResetPasswordCode_FullMethodName,
+		FullMethod: AccountService_AdminMergeAccounts_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AccountServiceServer).CheckEmailResetPasswordCode(ctx, req.(*ReqCheckEmailResetPasswordCode))
+		return srv.(AccountServiceServer).AdminMergeAccounts(ctx, req.(*ReqAdminMergeAccounts))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AccountService_ResetPasswordByEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ReqResetPasswordByEmail)
+func _AccountService_SendSMSCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqSendSMSCode)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AccountServiceServer).ResetPasswordByEmail(ctx, in)
+		return srv.(AccountServiceServer).SendSMSCode(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AccountService_ResetPasswordByEmail_FullMethodName,
+		FullMethod: AccountService_SendSMSCode_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AccountServiceServer).ResetPasswordByEmail(ctx, req.(*ReqResetPasswordByEmail))
+		return srv.(AccountServiceServer).SendSMSCode(ctx, req.(*ReqSendSMSCode))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AccountService_ResetPasswordByEmailAndVCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ReqResetPasswordByEmailAndVCode)
+func _AccountService_BindPhone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqBindPhone)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AccountServiceServer).ResetPasswordByEmailAndVCode(ctx, in)
+		return srv.(AccountServiceServer).BindPhone(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AccountService_ResetPasswordByEmailAndVCode_FullMethodName,
+		FullMethod: AccountService_BindPhone_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AccountServiceServer).ResetPasswordByEmailAndVCode(ctx, req.(*ReqResetPasswordByEmailAndVCode))
+		return srv.(AccountServiceServer).BindPhone(ctx, req.(*ReqBindPhone))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AccountService_BatchGetAccountsByWalletAddrs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ReqBatchGetAccountsByWalletAddrs)
+func _AccountService_ResetPasswordByPhone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqResetPasswordByPhone)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AccountServiceServer).BatchGetAccountsByWalletAddrs(ctx, in)
+		return srv.(AccountServiceServer).ResetPasswordByPhone(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AccountService_BatchGetAccountsByWalletAddrs_FullMethodName,
+		FullMethod: AccountService_ResetPasswordByPhone_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AccountServiceServer).BatchGetAccountsByWalletAddrs(ctx, req.(*ReqBatchGetAccountsByWalletAddrs))
+		return srv.(AccountServiceServer).ResetPasswordByPhone(ctx, req.(*ReqResetPasswordByPhone))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -530,6 +1815,10 @@ var AccountService_ServiceDesc = grpc.ServiceDesc{
 	ServiceName: "mpb.AccountService",
 	HandlerType: (*AccountServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RegisterAccount",
+			Handler:    _AccountService_RegisterAccount_Handler,
+		},
 		{
 			MethodName: "LoginByPassword",
 			Handler:    _AccountService_LoginByPassword_Handler,
@@ -586,6 +1875,158 @@ var AccountService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "BatchGetAccountsByWalletAddrs",
 			Handler:    _AccountService_BatchGetAccountsByWalletAddrs_Handler,
 		},
+		{
+			MethodName: "RefreshToken",
+			Handler:    _AccountService_RefreshToken_Handler,
+		},
+		{
+			MethodName: "Logout",
+			Handler:    _AccountService_Logout_Handler,
+		},
+		{
+			MethodName: "LogoutAllDevices",
+			Handler:    _AccountService_LogoutAllDevices_Handler,
+		},
+		{
+			MethodName: "GetSessions",
+			Handler:    _AccountService_GetSessions_Handler,
+		},
+		{
+			MethodName: "RevokeSession",
+			Handler:    _AccountService_RevokeSession_Handler,
+		},
+		{
+			MethodName: "EnrollTOTP",
+			Handler:    _AccountService_EnrollTOTP_Handler,
+		},
+		{
+			MethodName: "ConfirmTOTP",
+			Handler:    _AccountService_ConfirmTOTP_Handler,
+		},
+		{
+			MethodName: "DisableTOTP",
+			Handler:    _AccountService_DisableTOTP_Handler,
+		},
+		{
+			MethodName: "LoginByTOTP",
+			Handler:    _AccountService_LoginByTOTP_Handler,
+		},
+		{
+			MethodName: "LinkWallet",
+			Handler:    _AccountService_LinkWallet_Handler,
+		},
+		{
+			MethodName: "UnlinkWallet",
+			Handler:    _AccountService_UnlinkWallet_Handler,
+		},
+		{
+			MethodName: "SetPrimaryWallet",
+			Handler:    _AccountService_SetPrimaryWallet_Handler,
+		},
+		{
+			MethodName: "ListWallets",
+			Handler:    _AccountService_ListWallets_Handler,
+		},
+		{
+			MethodName: "SendEmailChangeCode",
+			Handler:    _AccountService_SendEmailChangeCode_Handler,
+		},
+		{
+			MethodName: "ChangeEmail",
+			Handler:    _AccountService_ChangeEmail_Handler,
+		},
+		{
+			MethodName: "AdminRepairAccounts",
+			Handler:    _AccountService_AdminRepairAccounts_Handler,
+		},
+		{
+			MethodName: "AdminGetLoginLocks",
+			Handler:    _AccountService_AdminGetLoginLocks_Handler,
+		},
+		{
+			MethodName: "AdminClearLoginLock",
+			Handler:    _AccountService_AdminClearLoginLock_Handler,
+		},
+		{
+			MethodName: "RequestAccountDeletion",
+			Handler:    _AccountService_RequestAccountDeletion_Handler,
+		},
+		{
+			MethodName: "CancelAccountDeletion",
+			Handler:    _AccountService_CancelAccountDeletion_Handler,
+		},
+		{
+			MethodName: "ExportAccountData",
+			Handler:    _AccountService_ExportAccountData_Handler,
+		},
+		{
+			MethodName: "UpdateProfile",
+			Handler:    _AccountService_UpdateProfile_Handler,
+		},
+		{
+			MethodName: "LoginAsGuest",
+			Handler:    _AccountService_LoginAsGuest_Handler,
+		},
+		{
+			MethodName: "UpgradeGuestByEmail",
+			Handler:    _AccountService_UpgradeGuestByEmail_Handler,
+		},
+		{
+			MethodName: "UpgradeGuestByWallet",
+			Handler:    _AccountService_UpgradeGuestByWallet_Handler,
+		},
+		{
+			MethodName: "GetLoginHistory",
+			Handler:    _AccountService_GetLoginHistory_Handler,
+		},
+		{
+			MethodName: "AdminBanAccount",
+			Handler:    _AccountService_AdminBanAccount_Handler,
+		},
+		{
+			MethodName: "AdminUnbanAccount",
+			Handler:    _AccountService_AdminUnbanAccount_Handler,
+		},
+		{
+			MethodName: "AdminListBans",
+			Handler:    _AccountService_AdminListBans_Handler,
+		},
+		{
+			MethodName: "GetReferralStats",
+			Handler:    _AccountService_GetReferralStats_Handler,
+		},
+		{
+			MethodName: "AdminGetTopReferrers",
+			Handler:    _AccountService_AdminGetTopReferrers_Handler,
+		},
+		{
+			MethodName: "LoginByPlatform",
+			Handler:    _AccountService_LoginByPlatform_Handler,
+		},
+		{
+			MethodName: "LinkPlatform",
+			Handler:    _AccountService_LinkPlatform_Handler,
+		},
+		{
+			MethodName: "MergeAccount",
+			Handler:    _AccountService_MergeAccount_Handler,
+		},
+		{
+			MethodName: "AdminMergeAccounts",
+			Handler:    _AccountService_AdminMergeAccounts_Handler,
+		},
+		{
+			MethodName: "SendSMSCode",
+			Handler:    _AccountService_SendSMSCode_Handler,
+		},
+		{
+			MethodName: "BindPhone",
+			Handler:    _AccountService_BindPhone_Handler,
+		},
+		{
+			MethodName: "ResetPasswordByPhone",
+			Handler:    _AccountService_ResetPasswordByPhone_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "grpc_account.proto",
@@ -20,6 +20,61 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type EEmailStatus_Status int32
+
+const (
+	EEmailStatus_Status_None     EEmailStatus_Status = 0
+	EEmailStatus_Status_Queued   EEmailStatus_Status = 1
+	EEmailStatus_Status_Retrying EEmailStatus_Status = 2 // the last attempt failed, it will be sent again
+	EEmailStatus_Status_Sent     EEmailStatus_Status = 3
+	EEmailStatus_Status_Dead     EEmailStatus_Status = 4 // all attempts failed, it is moved to the dead letters
+)
+
+// Enum value maps for EEmailStatus_Status.
+var (
+	EEmailStatus_Status_name = map[int32]string{
+		0: "Status_None",
+		1: "Status_Queued",
+		2: "Status_Retrying",
+		3: "Status_Sent",
+		4: "Status_Dead",
+	}
+	EEmailStatus_Status_value = map[string]int32{
+		"Status_None":     0,
+		"Status_Queued":   1,
+		"Status_Retrying": 2,
+		"Status_Sent":     3,
+		"Status_Dead":     4,
+	}
+)
+
+func (x EEmailStatus_Status) Enum() *EEmailStatus_Status {
+	p := new(EEmailStatus_Status)
+	*p = x
+	return p
+}
+
+func (x EEmailStatus_Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EEmailStatus_Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_grpc_apiproxy_proto_enumTypes[0].Descriptor()
+}
+
+func (EEmailStatus_Status) Type() protoreflect.EnumType {
+	return &file_grpc_apiproxy_proto_enumTypes[0]
+}
+
+func (x EEmailStatus_Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EEmailStatus_Status.Descriptor instead.
+func (EEmailStatus_Status) EnumDescriptor() ([]byte, []int) {
+	return file_grpc_apiproxy_proto_rawDescGZIP(), []int{11, 0}
+}
+
 type ReqGetAptosResources struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -107,39 +162,566 @@ func (*ResGetAptosResources) Descriptor() ([]byte, []int) {
 	return file_grpc_apiproxy_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *ResGetAptosResources) GetResources() string {
+func (x *ResGetAptosResources) GetResources() string {
+	if x != nil {
+		return x.Resources
+	}
+	return ""
+}
+
+type ReqGetAptosAuthKey struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AptosAccAddr string `protobuf:"bytes,1,opt,name=aptos_acc_addr,json=aptosAccAddr,proto3" json:"aptos_acc_addr,omitempty"`
+}
+
+func (x *ReqGetAptosAuthKey) Reset() {
+	*x = ReqGetAptosAuthKey{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_apiproxy_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReqGetAptosAuthKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReqGetAptosAuthKey) ProtoMessage() {}
+
+func (x *ReqGetAptosAuthKey) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_apiproxy_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReqGetAptosAuthKey.ProtoReflect.Descriptor instead.
+func (*ReqGetAptosAuthKey) Descriptor() ([]byte, []int) {
+	return file_grpc_apiproxy_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ReqGetAptosAuthKey) GetAptosAccAddr() string {
+	if x != nil {
+		return x.AptosAccAddr
+	}
+	return ""
+}
+
+type ResGetAptosAuthKey struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AuthenticationKey string `protobuf:"bytes,1,opt,name=authentication_key,json=authenticationKey,proto3" json:"authentication_key,omitempty"` // empty if the account has not been created on chain
+}
+
+func (x *ResGetAptosAuthKey) Reset() {
+	*x = ResGetAptosAuthKey{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_apiproxy_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResGetAptosAuthKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResGetAptosAuthKey) ProtoMessage() {}
+
+func (x *ResGetAptosAuthKey) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_apiproxy_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResGetAptosAuthKey.ProtoReflect.Descriptor instead.
+func (*ResGetAptosAuthKey) Descriptor() ([]byte, []int) {
+	return file_grpc_apiproxy_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ResGetAptosAuthKey) GetAuthenticationKey() string {
+	if x != nil {
+		return x.AuthenticationKey
+	}
+	return ""
+}
+
+type ReqSendEmailBindCode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Email    string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Code     string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Language string `protobuf:"bytes,3,opt,name=language,proto3" json:"language,omitempty"` // one of common.AllLanguageSlice, falls back to en
+}
+
+func (x *ReqSendEmailBindCode) Reset() {
+	*x = ReqSendEmailBindCode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_apiproxy_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReqSendEmailBindCode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReqSendEmailBindCode) ProtoMessage() {}
+
+func (x *ReqSendEmailBindCode) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_apiproxy_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReqSendEmailBindCode.ProtoReflect.Descriptor instead.
+func (*ReqSendEmailBindCode) Descriptor() ([]byte, []int) {
+	return file_grpc_apiproxy_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ReqSendEmailBindCode) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *ReqSendEmailBindCode) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *ReqSendEmailBindCode) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+type ReqSendEmailResetPasswordValidationCode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Email    string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Code     string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Language string `protobuf:"bytes,3,opt,name=language,proto3" json:"language,omitempty"` // one of common.AllLanguageSlice, falls back to en
+}
+
+func (x *ReqSendEmailResetPasswordValidationCode) Reset() {
+	*x = ReqSendEmailResetPasswordValidationCode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_apiproxy_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReqSendEmailResetPasswordValidationCode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReqSendEmailResetPasswordValidationCode) ProtoMessage() {}
+
+func (x *ReqSendEmailResetPasswordValidationCode) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_apiproxy_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReqSendEmailResetPasswordValidationCode.ProtoReflect.Descriptor instead.
+func (*ReqSendEmailResetPasswordValidationCode) Descriptor() ([]byte, []int) {
+	return file_grpc_apiproxy_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ReqSendEmailResetPasswordValidationCode) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *ReqSendEmailResetPasswordValidationCode) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *ReqSendEmailResetPasswordValidationCode) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+// ReqSendEmailChangeEmailCode the code confirming the change of the account email, new_email is set when the code is
+// sent to the old address, empty when it is sent to the new one
+type ReqSendEmailChangeEmailCode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Email    string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Code     string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Language string `protobuf:"bytes,3,opt,name=language,proto3" json:"language,omitempty"` // one of common.AllLanguageSlice, falls back to en
+	NewEmail string `protobuf:"bytes,4,opt,name=new_email,json=newEmail,proto3" json:"new_email,omitempty"`
+}
+
+func (x *ReqSendEmailChangeEmailCode) Reset() {
+	*x = ReqSendEmailChangeEmailCode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_apiproxy_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReqSendEmailChangeEmailCode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReqSendEmailChangeEmailCode) ProtoMessage() {}
+
+func (x *ReqSendEmailChangeEmailCode) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_apiproxy_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReqSendEmailChangeEmailCode.ProtoReflect.Descriptor instead.
+func (*ReqSendEmailChangeEmailCode) Descriptor() ([]byte, []int) {
+	return file_grpc_apiproxy_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ReqSendEmailChangeEmailCode) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *ReqSendEmailChangeEmailCode) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *ReqSendEmailChangeEmailCode) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *ReqSendEmailChangeEmailCode) GetNewEmail() string {
+	if x != nil {
+		return x.NewEmail
+	}
+	return ""
+}
+
+type ReqSendEmailAccountDeletion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Email        string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	DeletionTime int64  `protobuf:"varint,2,opt,name=deletion_time,json=deletionTime,proto3" json:"deletion_time,omitempty"`
+}
+
+func (x *ReqSendEmailAccountDeletion) Reset() {
+	*x = ReqSendEmailAccountDeletion{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_apiproxy_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReqSendEmailAccountDeletion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReqSendEmailAccountDeletion) ProtoMessage() {}
+
+func (x *ReqSendEmailAccountDeletion) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_apiproxy_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReqSendEmailAccountDeletion.ProtoReflect.Descriptor instead.
+func (*ReqSendEmailAccountDeletion) Descriptor() ([]byte, []int) {
+	return file_grpc_apiproxy_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ReqSendEmailAccountDeletion) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *ReqSendEmailAccountDeletion) GetDeletionTime() int64 {
+	if x != nil {
+		return x.DeletionTime
+	}
+	return 0
+}
+
+type ReqSendEmailLoginAlert struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Email     string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	LoginTime int64  `protobuf:"varint,2,opt,name=login_time,json=loginTime,proto3" json:"login_time,omitempty"`
+	RemoteIp  string `protobuf:"bytes,3,opt,name=remote_ip,json=remoteIp,proto3" json:"remote_ip,omitempty"`
+	Region    string `protobuf:"bytes,4,opt,name=region,proto3" json:"region,omitempty"`
+	Device    string `protobuf:"bytes,5,opt,name=device,proto3" json:"device,omitempty"`
+}
+
+func (x *ReqSendEmailLoginAlert) Reset() {
+	*x = ReqSendEmailLoginAlert{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_apiproxy_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReqSendEmailLoginAlert) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReqSendEmailLoginAlert) ProtoMessage() {}
+
+func (x *ReqSendEmailLoginAlert) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_apiproxy_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReqSendEmailLoginAlert.ProtoReflect.Descriptor instead.
+func (*ReqSendEmailLoginAlert) Descriptor() ([]byte, []int) {
+	return file_grpc_apiproxy_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ReqSendEmailLoginAlert) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *ReqSendEmailLoginAlert) GetLoginTime() int64 {
+	if x != nil {
+		return x.LoginTime
+	}
+	return 0
+}
+
+func (x *ReqSendEmailLoginAlert) GetRemoteIp() string {
+	if x != nil {
+		return x.RemoteIp
+	}
+	return ""
+}
+
+func (x *ReqSendEmailLoginAlert) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *ReqSendEmailLoginAlert) GetDevice() string {
+	if x != nil {
+		return x.Device
+	}
+	return ""
+}
+
+// ResSendEmail the email is queued in the outbox, its delivery status can be queried by the message id
+type ResSendEmail struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MessageId string              `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	Status    EEmailStatus_Status `protobuf:"varint,2,opt,name=status,proto3,enum=mpb.EEmailStatus_Status" json:"status,omitempty"`
+}
+
+func (x *ResSendEmail) Reset() {
+	*x = ResSendEmail{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_apiproxy_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResSendEmail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResSendEmail) ProtoMessage() {}
+
+func (x *ResSendEmail) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_apiproxy_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResSendEmail.ProtoReflect.Descriptor instead.
+func (*ResSendEmail) Descriptor() ([]byte, []int) {
+	return file_grpc_apiproxy_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ResSendEmail) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *ResSendEmail) GetStatus() EEmailStatus_Status {
+	if x != nil {
+		return x.Status
+	}
+	return EEmailStatus_Status_None
+}
+
+type ReqGetEmailStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MessageId string `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+}
+
+func (x *ReqGetEmailStatus) Reset() {
+	*x = ReqGetEmailStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_apiproxy_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReqGetEmailStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReqGetEmailStatus) ProtoMessage() {}
+
+func (x *ReqGetEmailStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_apiproxy_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReqGetEmailStatus.ProtoReflect.Descriptor instead.
+func (*ReqGetEmailStatus) Descriptor() ([]byte, []int) {
+	return file_grpc_apiproxy_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ReqGetEmailStatus) GetMessageId() string {
 	if x != nil {
-		return x.Resources
+		return x.MessageId
 	}
 	return ""
 }
 
-type ReqSendEmailBindCode struct {
+type EEmailStatus struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Email string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
-	Code  string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
 }
 
-func (x *ReqSendEmailBindCode) Reset() {
-	*x = ReqSendEmailBindCode{}
+func (x *EEmailStatus) Reset() {
+	*x = EEmailStatus{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_grpc_apiproxy_proto_msgTypes[2]
+		mi := &file_grpc_apiproxy_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ReqSendEmailBindCode) String() string {
+func (x *EEmailStatus) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReqSendEmailBindCode) ProtoMessage() {}
+func (*EEmailStatus) ProtoMessage() {}
 
-func (x *ReqSendEmailBindCode) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_apiproxy_proto_msgTypes[2]
+func (x *EEmailStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_apiproxy_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -150,51 +732,43 @@ func (x *ReqSendEmailBindCode) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReqSendEmailBindCode.ProtoReflect.Descriptor instead.
-func (*ReqSendEmailBindCode) Descriptor() ([]byte, []int) {
-	return file_grpc_apiproxy_proto_rawDescGZIP(), []int{2}
-}
-
-func (x *ReqSendEmailBindCode) GetEmail() string {
-	if x != nil {
-		return x.Email
-	}
-	return ""
-}
-
-func (x *ReqSendEmailBindCode) GetCode() string {
-	if x != nil {
-		return x.Code
-	}
-	return ""
+// Deprecated: Use EEmailStatus.ProtoReflect.Descriptor instead.
+func (*EEmailStatus) Descriptor() ([]byte, []int) {
+	return file_grpc_apiproxy_proto_rawDescGZIP(), []int{11}
 }
 
-type ReqSendEmailResetPasswordValidationCode struct {
+type EmailStatus struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Email string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
-	Code  string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	MessageId       string              `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	Status          EEmailStatus_Status `protobuf:"varint,2,opt,name=status,proto3,enum=mpb.EEmailStatus_Status" json:"status,omitempty"`
+	Attempts        uint32              `protobuf:"varint,3,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	Sender          string              `protobuf:"bytes,4,opt,name=sender,proto3" json:"sender,omitempty"` // the sender account of the last attempt
+	LastError       string              `protobuf:"bytes,5,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	CreateTime      int64               `protobuf:"varint,6,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	UpdateTime      int64               `protobuf:"varint,7,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	NextAttemptTime int64               `protobuf:"varint,8,opt,name=next_attempt_time,json=nextAttemptTime,proto3" json:"next_attempt_time,omitempty"` // 0 if no more attempts
 }
 
-func (x *ReqSendEmailResetPasswordValidationCode) Reset() {
-	*x = ReqSendEmailResetPasswordValidationCode{}
+func (x *EmailStatus) Reset() {
+	*x = EmailStatus{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_grpc_apiproxy_proto_msgTypes[3]
+		mi := &file_grpc_apiproxy_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ReqSendEmailResetPasswordValidationCode) String() string {
+func (x *EmailStatus) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReqSendEmailResetPasswordValidationCode) ProtoMessage() {}
+func (*EmailStatus) ProtoMessage() {}
 
-func (x *ReqSendEmailResetPasswordValidationCode) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_apiproxy_proto_msgTypes[3]
+func (x *EmailStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_apiproxy_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -205,25 +779,67 @@ func (x *ReqSendEmailResetPasswordValidationCode) ProtoReflect() protoreflect.Me
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReqSendEmailResetPasswordValidationCode.ProtoReflect.Descriptor instead.
-func (*ReqSendEmailResetPasswordValidationCode) Descriptor() ([]byte, []int) {
-	return file_grpc_apiproxy_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use EmailStatus.ProtoReflect.Descriptor instead.
+func (*EmailStatus) Descriptor() ([]byte, []int) {
+	return file_grpc_apiproxy_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *ReqSendEmailResetPasswordValidationCode) GetEmail() string {
+func (x *EmailStatus) GetMessageId() string {
 	if x != nil {
-		return x.Email
+		return x.MessageId
 	}
 	return ""
 }
 
-func (x *ReqSendEmailResetPasswordValidationCode) GetCode() string {
+func (x *EmailStatus) GetStatus() EEmailStatus_Status {
 	if x != nil {
-		return x.Code
+		return x.Status
+	}
+	return EEmailStatus_Status_None
+}
+
+func (x *EmailStatus) GetAttempts() uint32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *EmailStatus) GetSender() string {
+	if x != nil {
+		return x.Sender
+	}
+	return ""
+}
+
+func (x *EmailStatus) GetLastError() string {
+	if x != nil {
+		return x.LastError
 	}
 	return ""
 }
 
+func (x *EmailStatus) GetCreateTime() int64 {
+	if x != nil {
+		return x.CreateTime
+	}
+	return 0
+}
+
+func (x *EmailStatus) GetUpdateTime() int64 {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return 0
+}
+
+func (x *EmailStatus) GetNextAttemptTime() int64 {
+	if x != nil {
+		return x.NextAttemptTime
+	}
+	return 0
+}
+
 type ReqMoralisGetNFTByWallets struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -236,7 +852,7 @@ type ReqMoralisGetNFTByWallets struct {
 func (x *ReqMoralisGetNFTByWallets) Reset() {
 	*x = ReqMoralisGetNFTByWallets{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_grpc_apiproxy_proto_msgTypes[4]
+		mi := &file_grpc_apiproxy_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -249,7 +865,7 @@ func (x *ReqMoralisGetNFTByWallets) String() string {
 func (*ReqMoralisGetNFTByWallets) ProtoMessage() {}
 
 func (x *ReqMoralisGetNFTByWallets) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_apiproxy_proto_msgTypes[4]
+	mi := &file_grpc_apiproxy_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -262,7 +878,7 @@ func (x *ReqMoralisGetNFTByWallets) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReqMoralisGetNFTByWallets.ProtoReflect.Descriptor instead.
 func (*ReqMoralisGetNFTByWallets) Descriptor() ([]byte, []int) {
-	return file_grpc_apiproxy_proto_rawDescGZIP(), []int{4}
+	return file_grpc_apiproxy_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *ReqMoralisGetNFTByWallets) GetWalletAddresses() []string {
@@ -290,7 +906,7 @@ type ResMoralisGetNFTByWallets struct {
 func (x *ResMoralisGetNFTByWallets) Reset() {
 	*x = ResMoralisGetNFTByWallets{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_grpc_apiproxy_proto_msgTypes[5]
+		mi := &file_grpc_apiproxy_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -303,7 +919,7 @@ func (x *ResMoralisGetNFTByWallets) String() string {
 func (*ResMoralisGetNFTByWallets) ProtoMessage() {}
 
 func (x *ResMoralisGetNFTByWallets) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_apiproxy_proto_msgTypes[5]
+	mi := &file_grpc_apiproxy_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -316,7 +932,7 @@ func (x *ResMoralisGetNFTByWallets) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResMoralisGetNFTByWallets.ProtoReflect.Descriptor instead.
 func (*ResMoralisGetNFTByWallets) Descriptor() ([]byte, []int) {
-	return file_grpc_apiproxy_proto_rawDescGZIP(), []int{5}
+	return file_grpc_apiproxy_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *ResMoralisGetNFTByWallets) GetNfts() map[string]*ResMoralisGetNFTByWallets_NFTList {
@@ -339,7 +955,7 @@ type ReqGraphiQLGetAccountTransactions struct {
 func (x *ReqGraphiQLGetAccountTransactions) Reset() {
 	*x = ReqGraphiQLGetAccountTransactions{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_grpc_apiproxy_proto_msgTypes[6]
+		mi := &file_grpc_apiproxy_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -352,7 +968,7 @@ func (x *ReqGraphiQLGetAccountTransactions) String() string {
 func (*ReqGraphiQLGetAccountTransactions) ProtoMessage() {}
 
 func (x *ReqGraphiQLGetAccountTransactions) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_apiproxy_proto_msgTypes[6]
+	mi := &file_grpc_apiproxy_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -365,7 +981,7 @@ func (x *ReqGraphiQLGetAccountTransactions) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use ReqGraphiQLGetAccountTransactions.ProtoReflect.Descriptor instead.
 func (*ReqGraphiQLGetAccountTransactions) Descriptor() ([]byte, []int) {
-	return file_grpc_apiproxy_proto_rawDescGZIP(), []int{6}
+	return file_grpc_apiproxy_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *ReqGraphiQLGetAccountTransactions) GetAddr() string {
@@ -400,7 +1016,7 @@ type ResGraphiQLGetAccountTransactions struct {
 func (x *ResGraphiQLGetAccountTransactions) Reset() {
 	*x = ResGraphiQLGetAccountTransactions{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_grpc_apiproxy_proto_msgTypes[7]
+		mi := &file_grpc_apiproxy_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -413,7 +1029,7 @@ func (x *ResGraphiQLGetAccountTransactions) String() string {
 func (*ResGraphiQLGetAccountTransactions) ProtoMessage() {}
 
 func (x *ResGraphiQLGetAccountTransactions) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_apiproxy_proto_msgTypes[7]
+	mi := &file_grpc_apiproxy_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -426,7 +1042,7 @@ func (x *ResGraphiQLGetAccountTransactions) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use ResGraphiQLGetAccountTransactions.ProtoReflect.Descriptor instead.
 func (*ResGraphiQLGetAccountTransactions) Descriptor() ([]byte, []int) {
-	return file_grpc_apiproxy_proto_rawDescGZIP(), []int{7}
+	return file_grpc_apiproxy_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *ResGraphiQLGetAccountTransactions) GetTransactions() *AptosAccountTransactions {
@@ -449,7 +1065,7 @@ type ReqGraphiQLGetCollectionTransactions struct {
 func (x *ReqGraphiQLGetCollectionTransactions) Reset() {
 	*x = ReqGraphiQLGetCollectionTransactions{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_grpc_apiproxy_proto_msgTypes[8]
+		mi := &file_grpc_apiproxy_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -462,7 +1078,7 @@ func (x *ReqGraphiQLGetCollectionTransactions) String() string {
 func (*ReqGraphiQLGetCollectionTransactions) ProtoMessage() {}
 
 func (x *ReqGraphiQLGetCollectionTransactions) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_apiproxy_proto_msgTypes[8]
+	mi := &file_grpc_apiproxy_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -475,7 +1091,7 @@ func (x *ReqGraphiQLGetCollectionTransactions) ProtoReflect() protoreflect.Messa
 
 // Deprecated: Use ReqGraphiQLGetCollectionTransactions.ProtoReflect.Descriptor instead.
 func (*ReqGraphiQLGetCollectionTransactions) Descriptor() ([]byte, []int) {
-	return file_grpc_apiproxy_proto_rawDescGZIP(), []int{8}
+	return file_grpc_apiproxy_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *ReqGraphiQLGetCollectionTransactions) GetCollectionId() string {
@@ -510,7 +1126,7 @@ type ResGraphiQLGetCollectionTransactions struct {
 func (x *ResGraphiQLGetCollectionTransactions) Reset() {
 	*x = ResGraphiQLGetCollectionTransactions{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_grpc_apiproxy_proto_msgTypes[9]
+		mi := &file_grpc_apiproxy_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -523,7 +1139,7 @@ func (x *ResGraphiQLGetCollectionTransactions) String() string {
 func (*ResGraphiQLGetCollectionTransactions) ProtoMessage() {}
 
 func (x *ResGraphiQLGetCollectionTransactions) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_apiproxy_proto_msgTypes[9]
+	mi := &file_grpc_apiproxy_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -536,7 +1152,7 @@ func (x *ResGraphiQLGetCollectionTransactions) ProtoReflect() protoreflect.Messa
 
 // Deprecated: Use ResGraphiQLGetCollectionTransactions.ProtoReflect.Descriptor instead.
 func (*ResGraphiQLGetCollectionTransactions) Descriptor() ([]byte, []int) {
-	return file_grpc_apiproxy_proto_rawDescGZIP(), []int{9}
+	return file_grpc_apiproxy_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *ResGraphiQLGetCollectionTransactions) GetTransactions() *AptosTransactions {
@@ -546,6 +1162,69 @@ func (x *ResGraphiQLGetCollectionTransactions) GetTransactions() *AptosTransacti
 	return nil
 }
 
+type ReqSendSMSVerificationCode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tel     string           `protobuf:"bytes,1,opt,name=tel,proto3" json:"tel,omitempty"`
+	Code    string           `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Purpose ESMSCode_Purpose `protobuf:"varint,3,opt,name=purpose,proto3,enum=mpb.ESMSCode_Purpose" json:"purpose,omitempty"`
+}
+
+func (x *ReqSendSMSVerificationCode) Reset() {
+	*x = ReqSendSMSVerificationCode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_apiproxy_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReqSendSMSVerificationCode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReqSendSMSVerificationCode) ProtoMessage() {}
+
+func (x *ReqSendSMSVerificationCode) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_apiproxy_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReqSendSMSVerificationCode.ProtoReflect.Descriptor instead.
+func (*ReqSendSMSVerificationCode) Descriptor() ([]byte, []int) {
+	return file_grpc_apiproxy_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ReqSendSMSVerificationCode) GetTel() string {
+	if x != nil {
+		return x.Tel
+	}
+	return ""
+}
+
+func (x *ReqSendSMSVerificationCode) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *ReqSendSMSVerificationCode) GetPurpose() ESMSCode_Purpose {
+	if x != nil {
+		return x.Purpose
+	}
+	return ESMSCode_Purpose_None
+}
+
 type ResMoralisGetNFTByWallets_NFTList struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -557,7 +1236,7 @@ type ResMoralisGetNFTByWallets_NFTList struct {
 func (x *ResMoralisGetNFTByWallets_NFTList) Reset() {
 	*x = ResMoralisGetNFTByWallets_NFTList{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_grpc_apiproxy_proto_msgTypes[10]
+		mi := &file_grpc_apiproxy_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -570,7 +1249,7 @@ func (x *ResMoralisGetNFTByWallets_NFTList) String() string {
 func (*ResMoralisGetNFTByWallets_NFTList) ProtoMessage() {}
 
 func (x *ResMoralisGetNFTByWallets_NFTList) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_apiproxy_proto_msgTypes[10]
+	mi := &file_grpc_apiproxy_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -583,7 +1262,7 @@ func (x *ResMoralisGetNFTByWallets_NFTList) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use ResMoralisGetNFTByWallets_NFTList.ProtoReflect.Descriptor instead.
 func (*ResMoralisGetNFTByWallets_NFTList) Descriptor() ([]byte, []int) {
-	return file_grpc_apiproxy_proto_rawDescGZIP(), []int{5, 0}
+	return file_grpc_apiproxy_proto_rawDescGZIP(), []int{14, 0}
 }
 
 func (x *ResMoralisGetNFTByWallets_NFTList) GetList() []*MoralisNFTData {
@@ -606,104 +1285,209 @@ var file_grpc_apiproxy_proto_rawDesc = []byte{
 	0x64, 0x64, 0x72, 0x22, 0x34, 0x0a, 0x14, 0x52, 0x65, 0x73, 0x47, 0x65, 0x74, 0x41, 0x70, 0x74,
 	0x6f, 0x73, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x72,
 	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x22, 0x40, 0x0a, 0x14, 0x52, 0x65, 0x71,
-	0x53, 0x65, 0x6e, 0x64, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x42, 0x69, 0x6e, 0x64, 0x43, 0x6f, 0x64,
-	0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x53, 0x0a, 0x27, 0x52,
-	0x65, 0x71, 0x53, 0x65, 0x6e, 0x64, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x73, 0x65, 0x74,
-	0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x12, 0x0a, 0x04,
-	0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65,
-	0x22, 0x68, 0x0a, 0x19, 0x52, 0x65, 0x71, 0x4d, 0x6f, 0x72, 0x61, 0x6c, 0x69, 0x73, 0x47, 0x65,
-	0x74, 0x4e, 0x46, 0x54, 0x42, 0x79, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x73, 0x12, 0x29, 0x0a,
-	0x10, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x41,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6c, 0x6c,
-	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x63,
-	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xee, 0x01, 0x0a, 0x19, 0x52,
-	0x65, 0x73, 0x4d, 0x6f, 0x72, 0x61, 0x6c, 0x69, 0x73, 0x47, 0x65, 0x74, 0x4e, 0x46, 0x54, 0x42,
-	0x79, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x73, 0x12, 0x3c, 0x0a, 0x04, 0x6e, 0x66, 0x74, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73,
-	0x4d, 0x6f, 0x72, 0x61, 0x6c, 0x69, 0x73, 0x47, 0x65, 0x74, 0x4e, 0x46, 0x54, 0x42, 0x79, 0x57,
-	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x73, 0x2e, 0x4e, 0x66, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x52, 0x04, 0x6e, 0x66, 0x74, 0x73, 0x1a, 0x32, 0x0a, 0x07, 0x4e, 0x46, 0x54, 0x4c, 0x69, 0x73,
-	0x74, 0x12, 0x27, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x13, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x4d, 0x6f, 0x72, 0x61, 0x6c, 0x69, 0x73, 0x4e, 0x46, 0x54,
-	0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x1a, 0x5f, 0x0a, 0x09, 0x4e, 0x66,
-	0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x3c, 0x0a, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x52,
-	0x65, 0x73, 0x4d, 0x6f, 0x72, 0x61, 0x6c, 0x69, 0x73, 0x47, 0x65, 0x74, 0x4e, 0x46, 0x54, 0x42,
-	0x79, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x73, 0x2e, 0x4e, 0x46, 0x54, 0x4c, 0x69, 0x73, 0x74,
-	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x73, 0x0a, 0x21, 0x52,
-	0x65, 0x71, 0x47, 0x72, 0x61, 0x70, 0x68, 0x69, 0x51, 0x4c, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x12, 0x12, 0x0a, 0x04, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x61, 0x64, 0x64, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x69, 0x6e,
-	0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74,
-	0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x6e, 0x75,
-	0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x70, 0x61, 0x67, 0x65, 0x4e, 0x75, 0x6d,
-	0x22, 0x66, 0x0a, 0x21, 0x52, 0x65, 0x73, 0x47, 0x72, 0x61, 0x70, 0x68, 0x69, 0x51, 0x4c, 0x47,
-	0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x41, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6d, 0x70,
-	0x62, 0x2e, 0x41, 0x70, 0x74, 0x6f, 0x73, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x72,
-	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x6e,
-	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x87, 0x01, 0x0a, 0x24, 0x52, 0x65, 0x71,
-	0x47, 0x72, 0x61, 0x70, 0x68, 0x69, 0x51, 0x4c, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f,
-	0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x74, 0x61,
-	0x72, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x5f,
-	0x6e, 0x75, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x70, 0x61, 0x67, 0x65, 0x4e,
-	0x75, 0x6d, 0x22, 0x62, 0x0a, 0x24, 0x52, 0x65, 0x73, 0x47, 0x72, 0x61, 0x70, 0x68, 0x69, 0x51,
-	0x4c, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x72,
-	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x3a, 0x0a, 0x0c, 0x74, 0x72,
-	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x16, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x41, 0x70, 0x74, 0x6f, 0x73, 0x54, 0x72, 0x61, 0x6e,
-	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x32, 0xbe, 0x04, 0x0a, 0x0c, 0x41, 0x50, 0x49, 0x50, 0x72,
-	0x6f, 0x78, 0x79, 0x47, 0x52, 0x50, 0x43, 0x12, 0x49, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x41, 0x70,
-	0x74, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x19, 0x2e, 0x6d,
-	0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x47, 0x65, 0x74, 0x41, 0x70, 0x74, 0x6f, 0x73, 0x52, 0x65,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x1a, 0x19, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65,
-	0x73, 0x47, 0x65, 0x74, 0x41, 0x70, 0x74, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x73, 0x12, 0x3a, 0x0a, 0x11, 0x53, 0x65, 0x6e, 0x64, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x42,
-	0x69, 0x6e, 0x64, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x19, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65,
+	0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x22, 0x3a, 0x0a, 0x12, 0x52, 0x65, 0x71,
+	0x47, 0x65, 0x74, 0x41, 0x70, 0x74, 0x6f, 0x73, 0x41, 0x75, 0x74, 0x68, 0x4b, 0x65, 0x79, 0x12,
+	0x24, 0x0a, 0x0e, 0x61, 0x70, 0x74, 0x6f, 0x73, 0x5f, 0x61, 0x63, 0x63, 0x5f, 0x61, 0x64, 0x64,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x70, 0x74, 0x6f, 0x73, 0x41, 0x63,
+	0x63, 0x41, 0x64, 0x64, 0x72, 0x22, 0x43, 0x0a, 0x12, 0x52, 0x65, 0x73, 0x47, 0x65, 0x74, 0x41,
+	0x70, 0x74, 0x6f, 0x73, 0x41, 0x75, 0x74, 0x68, 0x4b, 0x65, 0x79, 0x12, 0x2d, 0x0a, 0x12, 0x61,
+	0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4b, 0x65, 0x79, 0x22, 0x5c, 0x0a, 0x14, 0x52, 0x65,
 	0x71, 0x53, 0x65, 0x6e, 0x64, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x42, 0x69, 0x6e, 0x64, 0x43, 0x6f,
-	0x64, 0x65, 0x1a, 0x0a, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x60,
+	0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x22, 0x6f, 0x0a, 0x27, 0x52, 0x65, 0x71, 0x53,
+	0x65, 0x6e, 0x64, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x73, 0x65, 0x74, 0x50, 0x61, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43,
+	0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x22, 0x80, 0x01, 0x0a, 0x1b, 0x52, 0x65,
+	0x71, 0x53, 0x65, 0x6e, 0x64, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x45, 0x6d, 0x61, 0x69, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61,
+	0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12,
+	0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63,
+	0x6f, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12,
+	0x1b, 0x0a, 0x09, 0x6e, 0x65, 0x77, 0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x6e, 0x65, 0x77, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0x58, 0x0a, 0x1b,
+	0x52, 0x65, 0x71, 0x53, 0x65, 0x6e, 0x64, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x65,
+	0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69,
+	0x6c, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x69,
+	0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x9a, 0x01, 0x0a, 0x16, 0x52, 0x65, 0x71, 0x53, 0x65,
+	0x6e, 0x64, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x41, 0x6c, 0x65, 0x72,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x6f, 0x67, 0x69, 0x6e,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6c, 0x6f, 0x67,
+	0x69, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65,
+	0x5f, 0x69, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x6d, 0x6f, 0x74,
+	0x65, 0x49, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x64,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x22, 0x5f, 0x0a, 0x0c, 0x52, 0x65, 0x73, 0x53, 0x65, 0x6e, 0x64, 0x45, 0x6d,
+	0x61, 0x69, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x49, 0x64, 0x12, 0x30, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x45, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x22, 0x32, 0x0a, 0x11, 0x52, 0x65, 0x71, 0x47, 0x65, 0x74, 0x45, 0x6d,
+	0x61, 0x69, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x64, 0x22, 0x73, 0x0a, 0x0c, 0x45, 0x45, 0x6d, 0x61,
+	0x69, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x63, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x0f, 0x0a, 0x0b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x4e, 0x6f, 0x6e,
+	0x65, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x51, 0x75,
+	0x65, 0x75, 0x65, 0x64, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x5f, 0x52, 0x65, 0x74, 0x72, 0x79, 0x69, 0x6e, 0x67, 0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x53, 0x65, 0x6e, 0x74, 0x10, 0x03, 0x12, 0x0f, 0x0a, 0x0b,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x44, 0x65, 0x61, 0x64, 0x10, 0x04, 0x22, 0x9f, 0x02,
+	0x0a, 0x0b, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1d, 0x0a,
+	0x0a, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6d,
+	0x70, 0x62, 0x2e, 0x45, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1a,
+	0x0a, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65,
+	0x6e, 0x64, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64,
+	0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69,
+	0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x61, 0x74, 0x74, 0x65,
+	0x6d, 0x70, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f,
+	0x6e, 0x65, 0x78, 0x74, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x22,
+	0x68, 0x0a, 0x19, 0x52, 0x65, 0x71, 0x4d, 0x6f, 0x72, 0x61, 0x6c, 0x69, 0x73, 0x47, 0x65, 0x74,
+	0x4e, 0x46, 0x54, 0x42, 0x79, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x73, 0x12, 0x29, 0x0a, 0x10,
+	0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xee, 0x01, 0x0a, 0x19, 0x52, 0x65,
+	0x73, 0x4d, 0x6f, 0x72, 0x61, 0x6c, 0x69, 0x73, 0x47, 0x65, 0x74, 0x4e, 0x46, 0x54, 0x42, 0x79,
+	0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x73, 0x12, 0x3c, 0x0a, 0x04, 0x6e, 0x66, 0x74, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x4d,
+	0x6f, 0x72, 0x61, 0x6c, 0x69, 0x73, 0x47, 0x65, 0x74, 0x4e, 0x46, 0x54, 0x42, 0x79, 0x57, 0x61,
+	0x6c, 0x6c, 0x65, 0x74, 0x73, 0x2e, 0x4e, 0x66, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x04, 0x6e, 0x66, 0x74, 0x73, 0x1a, 0x32, 0x0a, 0x07, 0x4e, 0x46, 0x54, 0x4c, 0x69, 0x73, 0x74,
+	0x12, 0x27, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13,
+	0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x4d, 0x6f, 0x72, 0x61, 0x6c, 0x69, 0x73, 0x4e, 0x46, 0x54, 0x44,
+	0x61, 0x74, 0x61, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x1a, 0x5f, 0x0a, 0x09, 0x4e, 0x66, 0x74,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x3c, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65,
+	0x73, 0x4d, 0x6f, 0x72, 0x61, 0x6c, 0x69, 0x73, 0x47, 0x65, 0x74, 0x4e, 0x46, 0x54, 0x42, 0x79,
+	0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x73, 0x2e, 0x4e, 0x46, 0x54, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x73, 0x0a, 0x21, 0x52, 0x65,
+	0x71, 0x47, 0x72, 0x61, 0x70, 0x68, 0x69, 0x51, 0x4c, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x12, 0x0a, 0x04, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61,
+	0x64, 0x64, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x49,
+	0x6e, 0x64, 0x65, 0x78, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x6e, 0x75, 0x6d,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x70, 0x61, 0x67, 0x65, 0x4e, 0x75, 0x6d, 0x22,
+	0x66, 0x0a, 0x21, 0x52, 0x65, 0x73, 0x47, 0x72, 0x61, 0x70, 0x68, 0x69, 0x51, 0x4c, 0x47, 0x65,
+	0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x41, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6d, 0x70, 0x62,
+	0x2e, 0x41, 0x70, 0x74, 0x6f, 0x73, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x87, 0x01, 0x0a, 0x24, 0x52, 0x65, 0x71, 0x47,
+	0x72, 0x61, 0x70, 0x68, 0x69, 0x51, 0x4c, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x6e,
+	0x75, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x70, 0x61, 0x67, 0x65, 0x4e, 0x75,
+	0x6d, 0x22, 0x62, 0x0a, 0x24, 0x52, 0x65, 0x73, 0x47, 0x72, 0x61, 0x70, 0x68, 0x69, 0x51, 0x4c,
+	0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x3a, 0x0a, 0x0c, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x41, 0x70, 0x74, 0x6f, 0x73, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x73, 0x0a, 0x1a, 0x52, 0x65, 0x71, 0x53, 0x65, 0x6e, 0x64,
+	0x53, 0x4d, 0x53, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43,
+	0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x74, 0x65, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x2f, 0x0a, 0x07, 0x70, 0x75, 0x72,
+	0x70, 0x6f, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x6d, 0x70, 0x62,
+	0x2e, 0x45, 0x53, 0x4d, 0x53, 0x43, 0x6f, 0x64, 0x65, 0x2e, 0x50, 0x75, 0x72, 0x70, 0x6f, 0x73,
+	0x65, 0x52, 0x07, 0x70, 0x75, 0x72, 0x70, 0x6f, 0x73, 0x65, 0x32, 0xf9, 0x07, 0x0a, 0x0c, 0x41,
+	0x50, 0x49, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x47, 0x52, 0x50, 0x43, 0x12, 0x49, 0x0a, 0x11, 0x47,
+	0x65, 0x74, 0x41, 0x70, 0x74, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73,
+	0x12, 0x19, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x47, 0x65, 0x74, 0x41, 0x70, 0x74,
+	0x6f, 0x73, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x1a, 0x19, 0x2e, 0x6d, 0x70,
+	0x62, 0x2e, 0x52, 0x65, 0x73, 0x47, 0x65, 0x74, 0x41, 0x70, 0x74, 0x6f, 0x73, 0x52, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x43, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x41, 0x70, 0x74,
+	0x6f, 0x73, 0x41, 0x75, 0x74, 0x68, 0x4b, 0x65, 0x79, 0x12, 0x17, 0x2e, 0x6d, 0x70, 0x62, 0x2e,
+	0x52, 0x65, 0x71, 0x47, 0x65, 0x74, 0x41, 0x70, 0x74, 0x6f, 0x73, 0x41, 0x75, 0x74, 0x68, 0x4b,
+	0x65, 0x79, 0x1a, 0x17, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x47, 0x65, 0x74, 0x41,
+	0x70, 0x74, 0x6f, 0x73, 0x41, 0x75, 0x74, 0x68, 0x4b, 0x65, 0x79, 0x12, 0x41, 0x0a, 0x11, 0x53,
+	0x65, 0x6e, 0x64, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x42, 0x69, 0x6e, 0x64, 0x43, 0x6f, 0x64, 0x65,
+	0x12, 0x19, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x53, 0x65, 0x6e, 0x64, 0x45, 0x6d,
+	0x61, 0x69, 0x6c, 0x42, 0x69, 0x6e, 0x64, 0x43, 0x6f, 0x64, 0x65, 0x1a, 0x11, 0x2e, 0x6d, 0x70,
+	0x62, 0x2e, 0x52, 0x65, 0x73, 0x53, 0x65, 0x6e, 0x64, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x67,
 	0x0a, 0x24, 0x53, 0x65, 0x6e, 0x64, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x73, 0x65, 0x74,
 	0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69,
 	0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x2c, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71,
 	0x53, 0x65, 0x6e, 0x64, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x73, 0x65, 0x74, 0x50, 0x61,
 	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x43, 0x6f, 0x64, 0x65, 0x1a, 0x0a, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
-	0x12, 0x58, 0x0a, 0x16, 0x4d, 0x6f, 0x72, 0x61, 0x6c, 0x69, 0x73, 0x47, 0x65, 0x74, 0x4e, 0x46,
-	0x54, 0x42, 0x79, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x73, 0x12, 0x1e, 0x2e, 0x6d, 0x70, 0x62,
-	0x2e, 0x52, 0x65, 0x71, 0x4d, 0x6f, 0x72, 0x61, 0x6c, 0x69, 0x73, 0x47, 0x65, 0x74, 0x4e, 0x46,
-	0x54, 0x42, 0x79, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x73, 0x1a, 0x1e, 0x2e, 0x6d, 0x70, 0x62,
-	0x2e, 0x52, 0x65, 0x73, 0x4d, 0x6f, 0x72, 0x61, 0x6c, 0x69, 0x73, 0x47, 0x65, 0x74, 0x4e, 0x46,
-	0x54, 0x42, 0x79, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x73, 0x12, 0x70, 0x0a, 0x1e, 0x47, 0x72,
-	0x61, 0x70, 0x68, 0x69, 0x51, 0x4c, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x26, 0x2e, 0x6d,
-	0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x47, 0x72, 0x61, 0x70, 0x68, 0x69, 0x51, 0x4c, 0x47, 0x65,
-	0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x26, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x47, 0x72,
-	0x61, 0x70, 0x68, 0x69, 0x51, 0x4c, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x79, 0x0a, 0x21,
-	0x47, 0x72, 0x61, 0x70, 0x68, 0x69, 0x51, 0x4c, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x12, 0x29, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x47, 0x72, 0x61, 0x70, 0x68,
-	0x69, 0x51, 0x4c, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x29, 0x2e, 0x6d,
-	0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x47, 0x72, 0x61, 0x70, 0x68, 0x69, 0x51, 0x4c, 0x47, 0x65,
-	0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x72, 0x61, 0x6e, 0x73,
-	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x07, 0x5a, 0x05, 0x2e, 0x2f, 0x6d, 0x70, 0x62,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x43, 0x6f, 0x64, 0x65, 0x1a, 0x11, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x53, 0x65,
+	0x6e, 0x64, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x4a, 0x0a, 0x13, 0x53, 0x65, 0x6e, 0x64, 0x45,
+	0x6d, 0x61, 0x69, 0x6c, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x20,
+	0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x53, 0x65, 0x6e, 0x64, 0x45, 0x6d, 0x61, 0x69,
+	0x6c, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x43, 0x6f, 0x64, 0x65,
+	0x1a, 0x11, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x53, 0x65, 0x6e, 0x64, 0x45, 0x6d,
+	0x61, 0x69, 0x6c, 0x12, 0x4f, 0x0a, 0x18, 0x53, 0x65, 0x6e, 0x64, 0x45, 0x6d, 0x61, 0x69, 0x6c,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x20, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x53, 0x65, 0x6e, 0x64, 0x45, 0x6d, 0x61,
+	0x69, 0x6c, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f,
+	0x6e, 0x1a, 0x11, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x53, 0x65, 0x6e, 0x64, 0x45,
+	0x6d, 0x61, 0x69, 0x6c, 0x12, 0x45, 0x0a, 0x13, 0x53, 0x65, 0x6e, 0x64, 0x45, 0x6d, 0x61, 0x69,
+	0x6c, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x12, 0x1b, 0x2e, 0x6d, 0x70,
+	0x62, 0x2e, 0x52, 0x65, 0x71, 0x53, 0x65, 0x6e, 0x64, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x4c, 0x6f,
+	0x67, 0x69, 0x6e, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x1a, 0x11, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x52,
+	0x65, 0x73, 0x53, 0x65, 0x6e, 0x64, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x3a, 0x0a, 0x0e, 0x47,
+	0x65, 0x74, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x2e,
+	0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x47, 0x65, 0x74, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x1a, 0x10, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x61, 0x69,
+	0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x46, 0x0a, 0x17, 0x53, 0x65, 0x6e, 0x64, 0x53,
+	0x4d, 0x53, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f,
+	0x64, 0x65, 0x12, 0x1f, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x53, 0x65, 0x6e, 0x64,
+	0x53, 0x4d, 0x53, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43,
+	0x6f, 0x64, 0x65, 0x1a, 0x0a, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12,
+	0x58, 0x0a, 0x16, 0x4d, 0x6f, 0x72, 0x61, 0x6c, 0x69, 0x73, 0x47, 0x65, 0x74, 0x4e, 0x46, 0x54,
+	0x42, 0x79, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x73, 0x12, 0x1e, 0x2e, 0x6d, 0x70, 0x62, 0x2e,
+	0x52, 0x65, 0x71, 0x4d, 0x6f, 0x72, 0x61, 0x6c, 0x69, 0x73, 0x47, 0x65, 0x74, 0x4e, 0x46, 0x54,
+	0x42, 0x79, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x73, 0x1a, 0x1e, 0x2e, 0x6d, 0x70, 0x62, 0x2e,
+	0x52, 0x65, 0x73, 0x4d, 0x6f, 0x72, 0x61, 0x6c, 0x69, 0x73, 0x47, 0x65, 0x74, 0x4e, 0x46, 0x54,
+	0x42, 0x79, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x73, 0x12, 0x70, 0x0a, 0x1e, 0x47, 0x72, 0x61,
+	0x70, 0x68, 0x69, 0x51, 0x4c, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x26, 0x2e, 0x6d, 0x70,
+	0x62, 0x2e, 0x52, 0x65, 0x71, 0x47, 0x72, 0x61, 0x70, 0x68, 0x69, 0x51, 0x4c, 0x47, 0x65, 0x74,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x1a, 0x26, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x47, 0x72, 0x61,
+	0x70, 0x68, 0x69, 0x51, 0x4c, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x79, 0x0a, 0x21, 0x47,
+	0x72, 0x61, 0x70, 0x68, 0x69, 0x51, 0x4c, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x29, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x47, 0x72, 0x61, 0x70, 0x68, 0x69,
+	0x51, 0x4c, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x29, 0x2e, 0x6d, 0x70,
+	0x62, 0x2e, 0x52, 0x65, 0x73, 0x47, 0x72, 0x61, 0x70, 0x68, 0x69, 0x51, 0x4c, 0x47, 0x65, 0x74,
+	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x07, 0x5a, 0x05, 0x2e, 0x2f, 0x6d, 0x70, 0x62, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -718,48 +1502,76 @@ func file_grpc_apiproxy_proto_rawDescGZIP() []byte {
 	return file_grpc_apiproxy_proto_rawDescData
 }
 
-var file_grpc_apiproxy_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_grpc_apiproxy_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_grpc_apiproxy_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
 var file_grpc_apiproxy_proto_goTypes = []interface{}{
-	(*ReqGetAptosResources)(nil),                    // 0: mpb.ReqGetAptosResources
-	(*ResGetAptosResources)(nil),                    // 1: mpb.ResGetAptosResources
-	(*ReqSendEmailBindCode)(nil),                    // 2: mpb.ReqSendEmailBindCode
-	(*ReqSendEmailResetPasswordValidationCode)(nil), // 3: mpb.ReqSendEmailResetPasswordValidationCode
-	(*ReqMoralisGetNFTByWallets)(nil),               // 4: mpb.ReqMoralisGetNFTByWallets
-	(*ResMoralisGetNFTByWallets)(nil),               // 5: mpb.ResMoralisGetNFTByWallets
-	(*ReqGraphiQLGetAccountTransactions)(nil),       // 6: mpb.ReqGraphiQLGetAccountTransactions
-	(*ResGraphiQLGetAccountTransactions)(nil),       // 7: mpb.ResGraphiQLGetAccountTransactions
-	(*ReqGraphiQLGetCollectionTransactions)(nil),    // 8: mpb.ReqGraphiQLGetCollectionTransactions
-	(*ResGraphiQLGetCollectionTransactions)(nil),    // 9: mpb.ResGraphiQLGetCollectionTransactions
-	(*ResMoralisGetNFTByWallets_NFTList)(nil),       // 10: mpb.ResMoralisGetNFTByWallets.NFTList
-	nil,                              // 11: mpb.ResMoralisGetNFTByWallets.NftsEntry
-	(*AptosAccountTransactions)(nil), // 12: mpb.AptosAccountTransactions
-	(*AptosTransactions)(nil),        // 13: mpb.AptosTransactions
-	(*MoralisNFTData)(nil),           // 14: mpb.MoralisNFTData
-	(*Empty)(nil),                    // 15: mpb.Empty
+	(EEmailStatus_Status)(0),                        // 0: mpb.EEmailStatus.Status
+	(*ReqGetAptosResources)(nil),                    // 1: mpb.ReqGetAptosResources
+	(*ResGetAptosResources)(nil),                    // 2: mpb.ResGetAptosResources
+	(*ReqGetAptosAuthKey)(nil),                      // 3: mpb.ReqGetAptosAuthKey
+	(*ResGetAptosAuthKey)(nil),                      // 4: mpb.ResGetAptosAuthKey
+	(*ReqSendEmailBindCode)(nil),                    // 5: mpb.ReqSendEmailBindCode
+	(*ReqSendEmailResetPasswordValidationCode)(nil), // 6: mpb.ReqSendEmailResetPasswordValidationCode
+	(*ReqSendEmailChangeEmailCode)(nil),             // 7: mpb.ReqSendEmailChangeEmailCode
+	(*ReqSendEmailAccountDeletion)(nil),             // 8: mpb.ReqSendEmailAccountDeletion
+	(*ReqSendEmailLoginAlert)(nil),                  // 9: mpb.ReqSendEmailLoginAlert
+	(*ResSendEmail)(nil),                            // 10: mpb.ResSendEmail
+	(*ReqGetEmailStatus)(nil),                       // 11: mpb.ReqGetEmailStatus
+	(*EEmailStatus)(nil),                            // 12: mpb.EEmailStatus
+	(*EmailStatus)(nil),                             // 13: mpb.EmailStatus
+	(*ReqMoralisGetNFTByWallets)(nil),               // 14: mpb.ReqMoralisGetNFTByWallets
+	(*ResMoralisGetNFTByWallets)(nil),               // 15: mpb.ResMoralisGetNFTByWallets
+	(*ReqGraphiQLGetAccountTransactions)(nil),       // 16: mpb.ReqGraphiQLGetAccountTransactions
+	(*ResGraphiQLGetAccountTransactions)(nil),       // 17: mpb.ResGraphiQLGetAccountTransactions
+	(*ReqGraphiQLGetCollectionTransactions)(nil),    // 18: mpb.ReqGraphiQLGetCollectionTransactions
+	(*ResGraphiQLGetCollectionTransactions)(nil),    // 19: mpb.ResGraphiQLGetCollectionTransactions
+	(*ReqSendSMSVerificationCode)(nil),              // 20: mpb.ReqSendSMSVerificationCode
+	(*ResMoralisGetNFTByWallets_NFTList)(nil),       // 21: mpb.ResMoralisGetNFTByWallets.NFTList
+	nil,                              // 22: mpb.ResMoralisGetNFTByWallets.NftsEntry
+	(*AptosAccountTransactions)(nil), // 23: mpb.AptosAccountTransactions
+	(*AptosTransactions)(nil),        // 24: mpb.AptosTransactions
+	(ESMSCode_Purpose)(0),            // 25: mpb.ESMSCode.Purpose
+	(*MoralisNFTData)(nil),           // 26: mpb.MoralisNFTData
+	(*Empty)(nil),                    // 27: mpb.Empty
 }
 var file_grpc_apiproxy_proto_depIdxs = []int32{
-	11, // 0: mpb.ResMoralisGetNFTByWallets.nfts:type_name -> mpb.ResMoralisGetNFTByWallets.NftsEntry
-	12, // 1: mpb.ResGraphiQLGetAccountTransactions.transactions:type_name -> mpb.AptosAccountTransactions
-	13, // 2: mpb.ResGraphiQLGetCollectionTransactions.transactions:type_name -> mpb.AptosTransactions
-	14, // 3: mpb.ResMoralisGetNFTByWallets.NFTList.list:type_name -> mpb.MoralisNFTData
-	10, // 4: mpb.ResMoralisGetNFTByWallets.NftsEntry.value:type_name -> mpb.ResMoralisGetNFTByWallets.NFTList
-	0,  // 5: mpb.APIProxyGRPC.GetAptosResources:input_type -> mpb.ReqGetAptosResources
-	2,  // 6: mpb.APIProxyGRPC.SendEmailBindCode:input_type -> mpb.ReqSendEmailBindCode
-	3,  // 7: mpb.APIProxyGRPC.SendEmailResetPasswordValidationCode:input_type -> mpb.ReqSendEmailResetPasswordValidationCode
-	4,  // 8: mpb.APIProxyGRPC.MoralisGetNFTByWallets:input_type -> mpb.ReqMoralisGetNFTByWallets
-	6,  // 9: mpb.APIProxyGRPC.GraphiQLGetAccountTransactions:input_type -> mpb.ReqGraphiQLGetAccountTransactions
-	8,  // 10: mpb.APIProxyGRPC.GraphiQLGetCollectionTransactions:input_type -> mpb.ReqGraphiQLGetCollectionTransactions
-	1,  // 11: mpb.APIProxyGRPC.GetAptosResources:output_type -> mpb.ResGetAptosResources
-	15, // 12: mpb.APIProxyGRPC.SendEmailBindCode:output_type -> mpb.Empty
-	15, // 13: mpb.APIProxyGRPC.SendEmailResetPasswordValidationCode:output_type -> mpb.Empty
-	5,  // 14: mpb.APIProxyGRPC.MoralisGetNFTByWallets:output_type -> mpb.ResMoralisGetNFTByWallets
-	7,  // 15: mpb.APIProxyGRPC.GraphiQLGetAccountTransactions:output_type -> mpb.ResGraphiQLGetAccountTransactions
-	9,  // 16: mpb.APIProxyGRPC.GraphiQLGetCollectionTransactions:output_type -> mpb.ResGraphiQLGetCollectionTransactions
-	11, // [11:17] is the sub-list for method output_type
-	5,  // [5:11] is the sub-list for method input_type
-	5,  // [5:5] is the sub-list for extension type_name
-	5,  // [5:5] is the sub-list for extension extendee
-	0,  // [0:5] is the sub-list for field type_name
+	0,  // 0: mpb.ResSendEmail.status:type_name -> mpb.EEmailStatus.Status
+	0,  // 1: mpb.EmailStatus.status:type_name -> mpb.EEmailStatus.Status
+	22, // 2: mpb.ResMoralisGetNFTByWallets.nfts:type_name -> mpb.ResMoralisGetNFTByWallets.NftsEntry
+	23, // 3: mpb.ResGraphiQLGetAccountTransactions.transactions:type_name -> mpb.AptosAccountTransactions
+	24, // 4: mpb.ResGraphiQLGetCollectionTransactions.transactions:type_name -> mpb.AptosTransactions
+	25, // 5: mpb.ReqSendSMSVerificationCode.purpose:type_name -> mpb.ESMSCode.Purpose
+	26, // 6: mpb.ResMoralisGetNFTByWallets.NFTList.list:type_name -> mpb.MoralisNFTData
+	21, // 7: mpb.ResMoralisGetNFTByWallets.NftsEntry.value:type_name -> mpb.ResMoralisGetNFTByWallets.NFTList
+	1,  // 8: mpb.APIProxyGRPC.GetAptosResources:input_type -> mpb.ReqGetAptosResources
+	3,  // 9: mpb.APIProxyGRPC.GetAptosAuthKey:input_type -> mpb.ReqGetAptosAuthKey
+	5,  // 10: mpb.APIProxyGRPC.SendEmailBindCode:input_type -> mpb.ReqSendEmailBindCode
+	6,  // 11: mpb.APIProxyGRPC.SendEmailResetPasswordValidationCode:input_type -> mpb.ReqSendEmailResetPasswordValidationCode
+	7,  // 12: mpb.APIProxyGRPC.SendEmailChangeCode:input_type -> mpb.ReqSendEmailChangeEmailCode
+	8,  // 13: mpb.APIProxyGRPC.SendEmailAccountDeletion:input_type -> mpb.ReqSendEmailAccountDeletion
+	9,  // 14: mpb.APIProxyGRPC.SendEmailLoginAlert:input_type -> mpb.ReqSendEmailLoginAlert
+	11, // 15: mpb.APIProxyGRPC.GetEmailStatus:input_type -> mpb.ReqGetEmailStatus
+	20, // 16: mpb.APIProxyGRPC.SendSMSVerificationCode:input_type -> mpb.ReqSendSMSVerificationCode
+	14, // 17: mpb.APIProxyGRPC.MoralisGetNFTByWallets:input_type -> mpb.ReqMoralisGetNFTByWallets
+	16, // 18: mpb.APIProxyGRPC.GraphiQLGetAccountTransactions:input_type -> mpb.ReqGraphiQLGetAccountTransactions
+	18, // 19: mpb.APIProxyGRPC.GraphiQLGetCollectionTransactions:input_type -> mpb.ReqGraphiQLGetCollectionTransactions
+	2,  // 20: mpb.APIProxyGRPC.GetAptosResources:output_type -> mpb.ResGetAptosResources
+	4,  // 21: mpb.APIProxyGRPC.GetAptosAuthKey:output_type -> mpb.ResGetAptosAuthKey
+	10, // 22: mpb.APIProxyGRPC.SendEmailBindCode:output_type -> mpb.ResSendEmail
+	10, // 23: mpb.APIProxyGRPC.SendEmailResetPasswordValidationCode:output_type -> mpb.ResSendEmail
+	10, // 24: mpb.APIProxyGRPC.SendEmailChangeCode:output_type -> mpb.ResSendEmail
+	10, // 25: mpb.APIProxyGRPC.SendEmailAccountDeletion:output_type -> mpb.ResSendEmail
+	10, // 26: mpb.APIProxyGRPC.SendEmailLoginAlert:output_type -> mpb.ResSendEmail
+	13, // 27: mpb.APIProxyGRPC.GetEmailStatus:output_type -> mpb.EmailStatus
+	27, // 28: mpb.APIProxyGRPC.SendSMSVerificationCode:output_type -> mpb.Empty
+	15, // 29: mpb.APIProxyGRPC.MoralisGetNFTByWallets:output_type -> mpb.ResMoralisGetNFTByWallets
+	17, // 30: mpb.APIProxyGRPC.GraphiQLGetAccountTransactions:output_type -> mpb.ResGraphiQLGetAccountTransactions
+	19, // 31: mpb.APIProxyGRPC.GraphiQLGetCollectionTransactions:output_type -> mpb.ResGraphiQLGetCollectionTransactions
+	20, // [20:32] is the sub-list for method output_type
+	8,  // [8:20] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_grpc_apiproxy_proto_init() }
@@ -795,7 +1607,7 @@ func file_grpc_apiproxy_proto_init() {
 			}
 		}
 		file_grpc_apiproxy_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReqSendEmailBindCode); i {
+			switch v := v.(*ReqGetAptosAuthKey); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -807,7 +1619,7 @@ func file_grpc_apiproxy_proto_init() {
 			}
 		}
 		file_grpc_apiproxy_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReqSendEmailResetPasswordValidationCode); i {
+			switch v := v.(*ResGetAptosAuthKey); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -819,7 +1631,7 @@ func file_grpc_apiproxy_proto_init() {
 			}
 		}
 		file_grpc_apiproxy_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReqMoralisGetNFTByWallets); i {
+			switch v := v.(*ReqSendEmailBindCode); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -831,7 +1643,7 @@ func file_grpc_apiproxy_proto_init() {
 			}
 		}
 		file_grpc_apiproxy_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ResMoralisGetNFTByWallets); i {
+			switch v := v.(*ReqSendEmailResetPasswordValidationCode); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -843,7 +1655,7 @@ func file_grpc_apiproxy_proto_init() {
 			}
 		}
 		file_grpc_apiproxy_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReqGraphiQLGetAccountTransactions); i {
+			switch v := v.(*ReqSendEmailChangeEmailCode); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -855,7 +1667,7 @@ func file_grpc_apiproxy_proto_init() {
 			}
 		}
 		file_grpc_apiproxy_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ResGraphiQLGetAccountTransactions); i {
+			switch v := v.(*ReqSendEmailAccountDeletion); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -867,7 +1679,7 @@ func file_grpc_apiproxy_proto_init() {
 			}
 		}
 		file_grpc_apiproxy_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReqGraphiQLGetCollectionTransactions); i {
+			switch v := v.(*ReqSendEmailLoginAlert); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -879,7 +1691,7 @@ func file_grpc_apiproxy_proto_init() {
 			}
 		}
 		file_grpc_apiproxy_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ResGraphiQLGetCollectionTransactions); i {
+			switch v := v.(*ResSendEmail); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -891,6 +1703,126 @@ func file_grpc_apiproxy_proto_init() {
 			}
 		}
 		file_grpc_apiproxy_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReqGetEmailStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_apiproxy_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EEmailStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_apiproxy_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EmailStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_apiproxy_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReqMoralisGetNFTByWallets); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_apiproxy_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResMoralisGetNFTByWallets); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_apiproxy_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReqGraphiQLGetAccountTransactions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_apiproxy_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResGraphiQLGetAccountTransactions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_apiproxy_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReqGraphiQLGetCollectionTransactions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_apiproxy_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResGraphiQLGetCollectionTransactions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_apiproxy_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReqSendSMSVerificationCode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_apiproxy_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ResMoralisGetNFTByWallets_NFTList); i {
 			case 0:
 				return &v.state
@@ -908,13 +1840,14 @@ func file_grpc_apiproxy_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_grpc_apiproxy_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   12,
+			NumEnums:      1,
+			NumMessages:   22,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_grpc_apiproxy_proto_goTypes,
 		DependencyIndexes: file_grpc_apiproxy_proto_depIdxs,
+		EnumInfos:         file_grpc_apiproxy_proto_enumTypes,
 		MessageInfos:      file_grpc_apiproxy_proto_msgTypes,
 	}.Build()
 	File_grpc_apiproxy_proto = out.File
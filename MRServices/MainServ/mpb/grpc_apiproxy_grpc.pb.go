@@ -20,8 +20,14 @@ const _ = grpc.SupportPackageIsVersion7
 
 const (
 	APIProxyGRPC_GetAptosResources_FullMethodName                    = "/mpb.APIProxyGRPC/GetAptosResources"
+	APIProxyGRPC_GetAptosAuthKey_FullMethodName                      = "/mpb.APIProxyGRPC/GetAptosAuthKey"
 	APIProxyGRPC_SendEmailBindCode_FullMethodName                    = "/mpb.APIProxyGRPC/SendEmailBindCode"
 	APIProxyGRPC_SendEmailResetPasswordValidationCode_FullMethodName = "/mpb.APIProxyGRPC/SendEmailResetPasswordValidationCode"
+	APIProxyGRPC_SendEmailChangeCode_FullMethodName                  = "/mpb.APIProxyGRPC/SendEmailChangeCode"
+	APIProxyGRPC_SendEmailAccountDeletion_FullMethodName             = "/mpb.APIProxyGRPC/SendEmailAccountDeletion"
+	APIProxyGRPC_SendEmailLoginAlert_FullMethodName                  = "/mpb.APIProxyGRPC/SendEmailLoginAlert"
+	APIProxyGRPC_GetEmailStatus_FullMethodName                       = "/mpb.APIProxyGRPC/GetEmailStatus"
+	APIProxyGRPC_SendSMSVerificationCode_FullMethodName              = "/mpb.APIProxyGRPC/SendSMSVerificationCode"
 	APIProxyGRPC_MoralisGetNFTByWallets_FullMethodName               = "/mpb.APIProxyGRPC/MoralisGetNFTByWallets"
 	APIProxyGRPC_GraphiQLGetAccountTransactions_FullMethodName       = "/mpb.APIProxyGRPC/GraphiQLGetAccountTransactions"
 	APIProxyGRPC_GraphiQLGetCollectionTransactions_FullMethodName    = "/mpb.APIProxyGRPC/GraphiQLGetCollectionTransactions"
@@ -32,8 +38,14 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type APIProxyGRPCClient interface {
 	GetAptosResources(ctx context.Context, in *ReqGetAptosResources, opts ...grpc.CallOption) (*ResGetAptosResources, error)
-	SendEmailBindCode(ctx context.Context, in *ReqSendEmailBindCode, opts ...grpc.CallOption) (*Empty, error)
-	SendEmailResetPasswordValidationCode(ctx context.Context, in *ReqSendEmailResetPasswordValidationCode, opts ...grpc.CallOption) (*Empty, error)
+	GetAptosAuthKey(ctx context.Context, in *ReqGetAptosAuthKey, opts ...grpc.CallOption) (*ResGetAptosAuthKey, error)
+	SendEmailBindCode(ctx context.Context, in *ReqSendEmailBindCode, opts ...grpc.CallOption) (*ResSendEmail, error)
+	SendEmailResetPasswordValidationCode(ctx context.Context, in *ReqSendEmailResetPasswordValidationCode, opts ...grpc.CallOption) (*ResSendEmail, error)
+	SendEmailChangeCode(ctx context.Context, in *ReqSendEmailChangeEmailCode, opts ...grpc.CallOption) (*ResSendEmail, error)
+	SendEmailAccountDeletion(ctx context.Context, in *ReqSendEmailAccountDeletion, opts ...grpc.CallOption) (*ResSendEmail, error)
+	SendEmailLoginAlert(ctx context.Context, in *ReqSendEmailLoginAlert, opts ...grpc.CallOption) (*ResSendEmail, error)
+	GetEmailStatus(ctx context.Context, in *ReqGetEmailStatus, opts ...grpc.CallOption) (*EmailStatus, error)
+	SendSMSVerificationCode(ctx context.Context, in *ReqSendSMSVerificationCode, opts ...grpc.CallOption) (*Empty, error)
 	MoralisGetNFTByWallets(ctx context.Context, in *ReqMoralisGetNFTByWallets, opts ...grpc.CallOption) (*ResMoralisGetNFTByWallets, error)
 	GraphiQLGetAccountTransactions(ctx context.Context, in *ReqGraphiQLGetAccountTransactions, opts ...grpc.CallOption) (*ResGraphiQLGetAccountTransactions, error)
 	GraphiQLGetCollectionTransactions(ctx context.Context, in *ReqGraphiQLGetCollectionTransactions, opts ...grpc.CallOption) (*ResGraphiQLGetCollectionTransactions, error)
@@ -56,8 +68,17 @@ func (c *aPIProxyGRPCClient) GetAptosResources(ctx context.Context, in *ReqGetAp
 	return out, nil
 }
 
-func (c *aPIProxyGRPCClient) SendEmailBindCode(ctx context.Context, in *ReqSendEmailBindCode, opts ...grpc.CallOption) (*Empty, error) {
-	out := new(Empty)
+func (c *aPIProxyGRPCClient) GetAptosAuthKey(ctx context.Context, in *ReqGetAptosAuthKey, opts ...grpc.CallOption) (*ResGetAptosAuthKey, error) {
+	out := new(ResGetAptosAuthKey)
+	err := c.cc.Invoke(ctx, APIProxyGRPC_GetAptosAuthKey_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIProxyGRPCClient) SendEmailBindCode(ctx context.Context, in *ReqSendEmailBindCode, opts ...grpc.CallOption) (*ResSendEmail, error) {
+	out := new(ResSendEmail)
 	err := c.cc.Invoke(ctx, APIProxyGRPC_SendEmailBindCode_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
@@ -65,8 +86,8 @@ func (c *aPIProxyGRPCClient) SendEmailBindCode(ctx context.Context, in *ReqSendE
 	return out, nil
 }
 
-func (c *aPIProxyGRPCClient) SendEmailResetPasswordValidationCode(ctx context.Context, in *ReqSendEmailResetPasswordValidationCode, opts ...grpc.CallOption) (*Empty, error) {
-	out := new(Empty)
+func (c *aPIProxyGRPCClient) SendEmailResetPasswordValidationCode(ctx context.Context, in *ReqSendEmailResetPasswordValidationCode, opts ...grpc.CallOption) (*ResSendEmail, error) {
+	out := new(ResSendEmail)
 	err := c.cc.Invoke(ctx, APIProxyGRPC_SendEmailResetPasswordValidationCode_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
@@ -74,6 +95,51 @@ func (c *aPIProxyGRPCClient) SendEmailResetPasswordValidationCode(ctx context.Co
 	return out, nil
 }
 
+func (c *aPIProxyGRPCClient) SendEmailChangeCode(ctx context.Context, in *ReqSendEmailChangeEmailCode, opts ...grpc.CallOption) (*ResSendEmail, error) {
+	out := new(ResSendEmail)
+	err := c.cc.Invoke(ctx, APIProxyGRPC_SendEmailChangeCode_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIProxyGRPCClient) SendEmailAccountDeletion(ctx context.Context, in *ReqSendEmailAccountDeletion, opts ...grpc.CallOption) (*ResSendEmail, error) {
+	out := new(ResSendEmail)
+	err := c.cc.Invoke(ctx, APIProxyGRPC_SendEmailAccountDeletion_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIProxyGRPCClient) SendEmailLoginAlert(ctx context.Context, in *ReqSendEmailLoginAlert, opts ...grpc.CallOption) (*ResSendEmail, error) {
+	out := new(ResSendEmail)
+	err := c.cc.Invoke(ctx, APIProxyGRPC_SendEmailLoginAlert_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIProxyGRPCClient) GetEmailStatus(ctx context.Context, in *ReqGetEmailStatus, opts ...grpc.CallOption) (*EmailStatus, error) {
+	out := new(EmailStatus)
+	err := c.cc.Invoke(ctx, APIProxyGRPC_GetEmailStatus_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIProxyGRPCClient) SendSMSVerificationCode(ctx context.Context, in *ReqSendSMSVerificationCode, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, APIProxyGRPC_SendSMSVerificationCode_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *aPIProxyGRPCClient) MoralisGetNFTByWallets(ctx context.Context, in *ReqMoralisGetNFTByWallets, opts ...grpc.CallOption) (*ResMoralisGetNFTByWallets, error) {
 	out := new(ResMoralisGetNFTByWallets)
 	err := c.cc.Invoke(ctx, APIProxyGRPC_MoralisGetNFTByWallets_FullMethodName, in, out, opts...)
@@ -106,8 +172,14 @@ func (c *aPIProxyGRPCClient) GraphiQLGetCollectionTransactions(ctx context.Conte
 // for forward compatibility
 type APIProxyGRPCServer interface {
 	GetAptosResources(context.Context, *ReqGetAptosResources) (*ResGetAptosResources, error)
-	SendEmailBindCode(context.Context, *ReqSendEmailBindCode) (*Empty, error)
-	SendEmailResetPasswordValidationCode(context.Context, *ReqSendEmailResetPasswordValidationCode) (*Empty, error)
+	GetAptosAuthKey(context.Context, *ReqGetAptosAuthKey) (*ResGetAptosAuthKey, error)
+	SendEmailBindCode(context.Context, *ReqSendEmailBindCode) (*ResSendEmail, error)
+	SendEmailResetPasswordValidationCode(context.Context, *ReqSendEmailResetPasswordValidationCode) (*ResSendEmail, error)
+	SendEmailChangeCode(context.Context, *ReqSendEmailChangeEmailCode) (*ResSendEmail, error)
+	SendEmailAccountDeletion(context.Context, *ReqSendEmailAccountDeletion) (*ResSendEmail, error)
+	SendEmailLoginAlert(context.Context, *ReqSendEmailLoginAlert) (*ResSendEmail, error)
+	GetEmailStatus(context.Context, *ReqGetEmailStatus) (*EmailStatus, error)
+	SendSMSVerificationCode(context.Context, *ReqSendSMSVerificationCode) (*Empty, error)
 	MoralisGetNFTByWallets(context.Context, *ReqMoralisGetNFTByWallets) (*ResMoralisGetNFTByWallets, error)
 	GraphiQLGetAccountTransactions(context.Context, *ReqGraphiQLGetAccountTransactions) (*ResGraphiQLGetAccountTransactions, error)
 	GraphiQLGetCollectionTransactions(context.Context, *ReqGraphiQLGetCollectionTransactions) (*ResGraphiQLGetCollectionTransactions, error)
@@ -121,12 +193,30 @@ type UnimplementedAPIProxyGRPCServer struct {
 func (UnimplementedAPIProxyGRPCServer) GetAptosResources(context.Context, *ReqGetAptosResources) (*ResGetAptosResources, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAptosResources not implemented")
 }
-func (UnimplementedAPIProxyGRPCServer) SendEmailBindCode(context.Context, *ReqSendEmailBindCode) (*Empty, error) {
+func (UnimplementedAPIProxyGRPCServer) GetAptosAuthKey(context.Context, *ReqGetAptosAuthKey) (*ResGetAptosAuthKey, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAptosAuthKey not implemented")
+}
+func (UnimplementedAPIProxyGRPCServer) SendEmailBindCode(context.Context, *ReqSendEmailBindCode) (*ResSendEmail, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendEmailBindCode not implemented")
 }
-func (UnimplementedAPIProxyGRPCServer) SendEmailResetPasswordValidationCode(context.Context, *ReqSendEmailResetPasswordValidationCode) (*Empty, error) {
+func (UnimplementedAPIProxyGRPCServer) SendEmailResetPasswordValidationCode(context.Context, *ReqSendEmailResetPasswordValidationCode) (*ResSendEmail, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendEmailResetPasswordValidationCode not implemented")
 }
+func (UnimplementedAPIProxyGRPCServer) SendEmailChangeCode(context.Context, *ReqSendEmailChangeEmailCode) (*ResSendEmail, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendEmailChangeCode not implemented")
+}
+func (UnimplementedAPIProxyGRPCServer) SendEmailAccountDeletion(context.Context, *ReqSendEmailAccountDeletion) (*ResSendEmail, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendEmailAccountDeletion not implemented")
+}
+func (UnimplementedAPIProxyGRPCServer) SendEmailLoginAlert(context.Context, *ReqSendEmailLoginAlert) (*ResSendEmail, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendEmailLoginAlert not implemented")
+}
+func (UnimplementedAPIProxyGRPCServer) GetEmailStatus(context.Context, *ReqGetEmailStatus) (*EmailStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEmailStatus not implemented")
+}
+func (UnimplementedAPIProxyGRPCServer) SendSMSVerificationCode(context.Context, *ReqSendSMSVerificationCode) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendSMSVerificationCode not implemented")
+}
 func (UnimplementedAPIProxyGRPCServer) MoralisGetNFTByWallets(context.Context, *ReqMoralisGetNFTByWallets) (*ResMoralisGetNFTByWallets, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MoralisGetNFTByWallets not implemented")
 }
@@ -167,6 +257,24 @@ func _APIProxyGRPC_GetAptosResources_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _APIProxyGRPC_GetAptosAuthKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqGetAptosAuthKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIProxyGRPCServer).GetAptosAuthKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: APIProxyGRPC_GetAptosAuthKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIProxyGRPCServer).GetAptosAuthKey(ctx, req.(*ReqGetAptosAuthKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _APIProxyGRPC_SendEmailBindCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ReqSendEmailBindCode)
 	if err := dec(in); err != nil {
@@ -203,6 +311,96 @@ func _APIProxyGRPC_SendEmailResetPasswordValidationCode_Handler(srv interface{},
 	return interceptor(ctx, in, info, handler)
 }
 
+func _APIProxyGRPC_SendEmailChangeCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqSendEmailChangeEmailCode)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIProxyGRPCServer).SendEmailChangeCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: APIProxyGRPC_SendEmailChangeCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIProxyGRPCServer).SendEmailChangeCode(ctx, req.(*ReqSendEmailChangeEmailCode))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIProxyGRPC_SendEmailAccountDeletion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqSendEmailAccountDeletion)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIProxyGRPCServer).SendEmailAccountDeletion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: APIProxyGRPC_SendEmailAccountDeletion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIProxyGRPCServer).SendEmailAccountDeletion(ctx, req.(*ReqSendEmailAccountDeletion))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIProxyGRPC_SendEmailLoginAlert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqSendEmailLoginAlert)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIProxyGRPCServer).SendEmailLoginAlert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: APIProxyGRPC_SendEmailLoginAlert_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIProxyGRPCServer).SendEmailLoginAlert(ctx, req.(*ReqSendEmailLoginAlert))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIProxyGRPC_GetEmailStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqGetEmailStatus)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIProxyGRPCServer).GetEmailStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: APIProxyGRPC_GetEmailStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIProxyGRPCServer).GetEmailStatus(ctx, req.(*ReqGetEmailStatus))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIProxyGRPC_SendSMSVerificationCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqSendSMSVerificationCode)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIProxyGRPCServer).SendSMSVerificationCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: APIProxyGRPC_SendSMSVerificationCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIProxyGRPCServer).SendSMSVerificationCode(ctx, req.(*ReqSendSMSVerificationCode))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _APIProxyGRPC_MoralisGetNFTByWallets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ReqMoralisGetNFTByWallets)
 	if err := dec(in); err != nil {
@@ -268,6 +466,10 @@ var APIProxyGRPC_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetAptosResources",
 			Handler:    _APIProxyGRPC_GetAptosResources_Handler,
 		},
+		{
+			MethodName: "GetAptosAuthKey",
+			Handler:    _APIProxyGRPC_GetAptosAuthKey_Handler,
+		},
 		{
 			MethodName: "SendEmailBindCode",
 			Handler:    _APIProxyGRPC_SendEmailBindCode_Handler,
@@ -276,6 +478,26 @@ var APIProxyGRPC_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SendEmailResetPasswordValidationCode",
 			Handler:    _APIProxyGRPC_SendEmailResetPasswordValidationCode_Handler,
 		},
+		{
+			MethodName: "SendEmailChangeCode",
+			Handler:    _APIProxyGRPC_SendEmailChangeCode_Handler,
+		},
+		{
+			MethodName: "SendEmailAccountDeletion",
+			Handler:    _APIProxyGRPC_SendEmailAccountDeletion_Handler,
+		},
+		{
+			MethodName: "SendEmailLoginAlert",
+			Handler:    _APIProxyGRPC_SendEmailLoginAlert_Handler,
+		},
+		{
+			MethodName: "GetEmailStatus",
+			Handler:    _APIProxyGRPC_GetEmailStatus_Handler,
+		},
+		{
+			MethodName: "SendSMSVerificationCode",
+			Handler:    _APIProxyGRPC_SendSMSVerificationCode_Handler,
+		},
 		{
 			MethodName: "MoralisGetNFTByWallets",
 			Handler:    _APIProxyGRPC_MoralisGetNFTByWallets_Handler,
@@ -126,22 +126,40 @@ var File_grpc_gm_proto protoreflect.FileDescriptor
 
 var file_grpc_gm_proto_rawDesc = []byte{
 	0x0a, 0x0d, 0x67, 0x72, 0x70, 0x63, 0x5f, 0x67, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
-	0x03, 0x6d, 0x70, 0x62, 0x22, 0x4f, 0x0a, 0x17, 0x52, 0x65, 0x71, 0x41, 0x64, 0x6d, 0x69, 0x6e,
-	0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x42, 0x79, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12,
-	0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73,
-	0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73,
-	0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x2f, 0x0a, 0x17, 0x52, 0x65, 0x73, 0x41, 0x64, 0x6d, 0x69,
-	0x6e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x42, 0x79, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
-	0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x32, 0x5f, 0x0a, 0x09, 0x47, 0x4d, 0x53, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x12, 0x52, 0x0a, 0x14, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x67, 0x69,
-	0x6e, 0x42, 0x79, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1c, 0x2e, 0x6d, 0x70,
-	0x62, 0x2e, 0x52, 0x65, 0x71, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x42,
-	0x79, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x1a, 0x1c, 0x2e, 0x6d, 0x70, 0x62, 0x2e,
-	0x52, 0x65, 0x73, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x42, 0x79, 0x50,
-	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x42, 0x07, 0x5a, 0x05, 0x2e, 0x2f, 0x6d, 0x70, 0x62,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x03, 0x6d, 0x70, 0x62, 0x1a, 0x0c, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x12, 0x67, 0x72, 0x70, 0x63, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x4f, 0x0a, 0x17, 0x52, 0x65, 0x71, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x42, 0x79, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70,
+	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70,
+	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x2f, 0x0a, 0x17, 0x52, 0x65, 0x73, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x42, 0x79, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x32, 0xde, 0x02, 0x0a, 0x09, 0x47, 0x4d, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x52, 0x0a, 0x14, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c,
+	0x6f, 0x67, 0x69, 0x6e, 0x42, 0x79, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1c,
+	0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x67,
+	0x69, 0x6e, 0x42, 0x79, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x1a, 0x1c, 0x2e, 0x6d,
+	0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x67, 0x69, 0x6e,
+	0x42, 0x79, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x3a, 0x0a, 0x0f, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x42, 0x61, 0x6e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x17, 0x2e,
+	0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x42, 0x61, 0x6e, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x0e, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x42, 0x61, 0x6e,
+	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x3a, 0x0a, 0x11, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55,
+	0x6e, 0x62, 0x61, 0x6e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x19, 0x2e, 0x6d, 0x70,
+	0x62, 0x2e, 0x52, 0x65, 0x71, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x6e, 0x62, 0x61, 0x6e, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x0a, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x12, 0x3d, 0x0a, 0x0d, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x42,
+	0x61, 0x6e, 0x73, 0x12, 0x15, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x6e, 0x73, 0x1a, 0x15, 0x2e, 0x6d, 0x70, 0x62,
+	0x2e, 0x52, 0x65, 0x73, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x6e,
+	0x73, 0x12, 0x46, 0x0a, 0x12, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x1a, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65,
+	0x71, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x73, 0x1a, 0x14, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x4c, 0x6f, 0x67, 0x42, 0x07, 0x5a, 0x05, 0x2e, 0x2f, 0x6d,
+	0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -160,12 +178,28 @@ var file_grpc_gm_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
 var file_grpc_gm_proto_goTypes = []interface{}{
 	(*ReqAdminLoginByPassword)(nil), // 0: mpb.ReqAdminLoginByPassword
 	(*ResAdminLoginByPassword)(nil), // 1: mpb.ResAdminLoginByPassword
+	(*ReqAdminBanAccount)(nil),      // 2: mpb.ReqAdminBanAccount
+	(*ReqAdminUnbanAccount)(nil),    // 3: mpb.ReqAdminUnbanAccount
+	(*ReqAdminListBans)(nil),        // 4: mpb.ReqAdminListBans
+	(*ReqAdminMergeAccounts)(nil),   // 5: mpb.ReqAdminMergeAccounts
+	(*BanRecord)(nil),               // 6: mpb.BanRecord
+	(*Empty)(nil),                   // 7: mpb.Empty
+	(*ResAdminListBans)(nil),        // 8: mpb.ResAdminListBans
+	(*AccountMergeLog)(nil),         // 9: mpb.AccountMergeLog
 }
 var file_grpc_gm_proto_depIdxs = []int32{
 	0, // 0: mpb.GMService.AdminLoginByPassword:input_type -> mpb.ReqAdminLoginByPassword
-	1, // 1: mpb.GMService.AdminLoginByPassword:output_type -> mpb.ResAdminLoginByPassword
-	1, // [1:2] is the sub-list for method output_type
-	0, // [0:1] is the sub-list for method input_type
+	2, // 1: mpb.GMService.AdminBanAccount:input_type -> mpb.ReqAdminBanAccount
+	3, // 2: mpb.GMService.AdminUnbanAccount:input_type -> mpb.ReqAdminUnbanAccount
+	4, // 3: mpb.GMService.AdminListBans:input_type -> mpb.ReqAdminListBans
+	5, // 4: mpb.GMService.AdminMergeAccounts:input_type -> mpb.ReqAdminMergeAccounts
+	1, // 5: mpb.GMService.AdminLoginByPassword:output_type -> mpb.ResAdminLoginByPassword
+	6, // 6: mpb.GMService.AdminBanAccount:output_type -> mpb.BanRecord
+	7, // 7: mpb.GMService.AdminUnbanAccount:output_type -> mpb.Empty
+	8, // 8: mpb.GMService.AdminListBans:output_type -> mpb.ResAdminListBans
+	9, // 9: mpb.GMService.AdminMergeAccounts:output_type -> mpb.AccountMergeLog
+	5, // [5:10] is the sub-list for method output_type
+	0, // [0:5] is the sub-list for method input_type
 	0, // [0:0] is the sub-list for extension type_name
 	0, // [0:0] is the sub-list for extension extendee
 	0, // [0:0] is the sub-list for field type_name
@@ -176,6 +210,8 @@ func file_grpc_gm_proto_init() {
 	if File_grpc_gm_proto != nil {
 		return
 	}
+	file_common_proto_init()
+	file_grpc_account_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_grpc_gm_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ReqAdminLoginByPassword); i {
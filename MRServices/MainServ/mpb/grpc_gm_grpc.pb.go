@@ -20,6 +20,10 @@ const _ = grpc.SupportPackageIsVersion7
 
 const (
 	GMService_AdminLoginByPassword_FullMethodName = "/mpb.GMService/AdminLoginByPassword"
+	GMService_AdminBanAccount_FullMethodName      = "/mpb.GMService/AdminBanAccount"
+	GMService_AdminUnbanAccount_FullMethodName    = "/mpb.GMService/AdminUnbanAccount"
+	GMService_AdminListBans_FullMethodName        = "/mpb.GMService/AdminListBans"
+	GMService_AdminMergeAccounts_FullMethodName   = "/mpb.GMService/AdminMergeAccounts"
 )
 
 // GMServiceClient is the client API for GMService service.
@@ -27,6 +31,10 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type GMServiceClient interface {
 	AdminLoginByPassword(ctx context.Context, in *ReqAdminLoginByPassword, opts ...grpc.CallOption) (*ResAdminLoginByPassword, error)
+	AdminBanAccount(ctx context.Context, in *ReqAdminBanAccount, opts ...grpc.CallOption) (*BanRecord, error)
+	AdminUnbanAccount(ctx context.Context, in *ReqAdminUnbanAccount, opts ...grpc.CallOption) (*Empty, error)
+	AdminListBans(ctx context.Context, in *ReqAdminListBans, opts ...grpc.CallOption) (*ResAdminListBans, error)
+	AdminMergeAccounts(ctx context.Context, in *ReqAdminMergeAccounts, opts ...grpc.CallOption) (*AccountMergeLog, error)
 }
 
 type gMServiceClient struct {
@@ -46,11 +54,51 @@ func (c *gMServiceClient) AdminLoginByPassword(ctx context.Context, in *ReqAdmin
 	return out, nil
 }
 
+func (c *gMServiceClient) AdminBanAccount(ctx context.Context, in *ReqAdminBanAccount, opts ...grpc.CallOption) (*BanRecord, error) {
+	out := new(BanRecord)
+	err := c.cc.Invoke(ctx, GMService_AdminBanAccount_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gMServiceClient) AdminUnbanAccount(ctx context.Context, in *ReqAdminUnbanAccount, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, GMService_AdminUnbanAccount_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gMServiceClient) AdminListBans(ctx context.Context, in *ReqAdminListBans, opts ...grpc.CallOption) (*ResAdminListBans, error) {
+	out := new(ResAdminListBans)
+	err := c.cc.Invoke(ctx, GMService_AdminListBans_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gMServiceClient) AdminMergeAccounts(ctx context.Context, in *ReqAdminMergeAccounts, opts ...grpc.CallOption) (*AccountMergeLog, error) {
+	out := new(AccountMergeLog)
+	err := c.cc.Invoke(ctx, GMService_AdminMergeAccounts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // GMServiceServer is the server API for GMService service.
 // All implementations must embed UnimplementedGMServiceServer
 // for forward compatibility
 type GMServiceServer interface {
 	AdminLoginByPassword(context.Context, *ReqAdminLoginByPassword) (*ResAdminLoginByPassword, error)
+	AdminBanAccount(context.Context, *ReqAdminBanAccount) (*BanRecord, error)
+	AdminUnbanAccount(context.Context, *ReqAdminUnbanAccount) (*Empty, error)
+	AdminListBans(context.Context, *ReqAdminListBans) (*ResAdminListBans, error)
+	AdminMergeAccounts(context.Context, *ReqAdminMergeAccounts) (*AccountMergeLog, error)
 	mustEmbedUnimplementedGMServiceServer()
 }
 
@@ -61,6 +109,18 @@ type UnimplementedGMServiceServer struct {
 func (UnimplementedGMServiceServer) AdminLoginByPassword(context.Context, *ReqAdminLoginByPassword) (*ResAdminLoginByPassword, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AdminLoginByPassword not implemented")
 }
+func (UnimplementedGMServiceServer) AdminBanAccount(context.Context, *ReqAdminBanAccount) (*BanRecord, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminBanAccount not implemented")
+}
+func (UnimplementedGMServiceServer) AdminUnbanAccount(context.Context, *ReqAdminUnbanAccount) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminUnbanAccount not implemented")
+}
+func (UnimplementedGMServiceServer) AdminListBans(context.Context, *ReqAdminListBans) (*ResAdminListBans, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminListBans not implemented")
+}
+func (UnimplementedGMServiceServer) AdminMergeAccounts(context.Context, *ReqAdminMergeAccounts) (*AccountMergeLog, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminMergeAccounts not implemented")
+}
 func (UnimplementedGMServiceServer) mustEmbedUnimplementedGMServiceServer() {}
 
 // UnsafeGMServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -92,6 +152,78 @@ func _GMService_AdminLoginByPassword_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GMService_AdminBanAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqAdminBanAccount)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GMServiceServer).AdminBanAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GMService_AdminBanAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GMServiceServer).AdminBanAccount(ctx, req.(*ReqAdminBanAccount))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GMService_AdminUnbanAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqAdminUnbanAccount)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GMServiceServer).AdminUnbanAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GMService_AdminUnbanAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GMServiceServer).AdminUnbanAccount(ctx, req.(*ReqAdminUnbanAccount))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GMService_AdminListBans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqAdminListBans)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GMServiceServer).AdminListBans(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GMService_AdminListBans_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GMServiceServer).AdminListBans(ctx, req.(*ReqAdminListBans))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GMService_AdminMergeAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqAdminMergeAccounts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GMServiceServer).AdminMergeAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GMService_AdminMergeAccounts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GMServiceServer).AdminMergeAccounts(ctx, req.(*ReqAdminMergeAccounts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // GMService_ServiceDesc is the grpc.ServiceDesc for GMService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -103,6 +235,22 @@ var GMService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AdminLoginByPassword",
 			Handler:    _GMService_AdminLoginByPassword_Handler,
 		},
+		{
+			MethodName: "AdminBanAccount",
+			Handler:    _GMService_AdminBanAccount_Handler,
+		},
+		{
+			MethodName: "AdminUnbanAccount",
+			Handler:    _GMService_AdminUnbanAccount_Handler,
+		},
+		{
+			MethodName: "AdminListBans",
+			Handler:    _GMService_AdminListBans_Handler,
+		},
+		{
+			MethodName: "AdminMergeAccounts",
+			Handler:    _GMService_AdminMergeAccounts_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "grpc_gm.proto",
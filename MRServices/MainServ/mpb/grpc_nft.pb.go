@@ -318,6 +318,179 @@ func (x *ResGetAptosNFTsV2) GetNfts() []*AptosNFTNodeV2 {
 	return nil
 }
 
+type ReqDeleteUserNFTs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId      uint64   `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	WalletAddrs []string `protobuf:"bytes,2,rep,name=wallet_addrs,json=walletAddrs,proto3" json:"wallet_addrs,omitempty"`
+}
+
+func (x *ReqDeleteUserNFTs) Reset() {
+	*x = ReqDeleteUserNFTs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_nft_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReqDeleteUserNFTs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReqDeleteUserNFTs) ProtoMessage() {}
+
+func (x *ReqDeleteUserNFTs) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_nft_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReqDeleteUserNFTs.ProtoReflect.Descriptor instead.
+func (*ReqDeleteUserNFTs) Descriptor() ([]byte, []int) {
+	return file_grpc_nft_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ReqDeleteUserNFTs) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ReqDeleteUserNFTs) GetWalletAddrs() []string {
+	if x != nil {
+		return x.WalletAddrs
+	}
+	return nil
+}
+
+type ReqMergeUserNFTs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId       uint64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	MergedUserId uint64 `protobuf:"varint,2,opt,name=merged_user_id,json=mergedUserId,proto3" json:"merged_user_id,omitempty"` // all nfts of the user are moved to user_id
+}
+
+func (x *ReqMergeUserNFTs) Reset() {
+	*x = ReqMergeUserNFTs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_nft_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReqMergeUserNFTs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReqMergeUserNFTs) ProtoMessage() {}
+
+func (x *ReqMergeUserNFTs) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_nft_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReqMergeUserNFTs.ProtoReflect.Descriptor instead.
+func (*ReqMergeUserNFTs) Descriptor() ([]byte, []int) {
+	return file_grpc_nft_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ReqMergeUserNFTs) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ReqMergeUserNFTs) GetMergedUserId() uint64 {
+	if x != nil {
+		return x.MergedUserId
+	}
+	return 0
+}
+
+type ReqRemoveWalletNFTs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId     uint64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	WalletAddr string `protobuf:"bytes,2,opt,name=wallet_addr,json=walletAddr,proto3" json:"wallet_addr,omitempty"`
+	Legacy     bool   `protobuf:"varint,3,opt,name=legacy,proto3" json:"legacy,omitempty"` // also remove the records without owner, set when the wallet was the only one before multi-wallet
+}
+
+func (x *ReqRemoveWalletNFTs) Reset() {
+	*x = ReqRemoveWalletNFTs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_nft_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReqRemoveWalletNFTs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReqRemoveWalletNFTs) ProtoMessage() {}
+
+func (x *ReqRemoveWalletNFTs) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_nft_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReqRemoveWalletNFTs.ProtoReflect.Descriptor instead.
+func (*ReqRemoveWalletNFTs) Descriptor() ([]byte, []int) {
+	return file_grpc_nft_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ReqRemoveWalletNFTs) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ReqRemoveWalletNFTs) GetWalletAddr() string {
+	if x != nil {
+		return x.WalletAddr
+	}
+	return ""
+}
+
+func (x *ReqRemoveWalletNFTs) GetLegacy() bool {
+	if x != nil {
+		return x.Legacy
+	}
+	return false
+}
+
 type ReqGetAptosNFTOwner struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -329,7 +502,7 @@ type ReqGetAptosNFTOwner struct {
 func (x *ReqGetAptosNFTOwner) Reset() {
 	*x = ReqGetAptosNFTOwner{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_grpc_nft_proto_msgTypes[6]
+		mi := &file_grpc_nft_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -342,7 +515,7 @@ func (x *ReqGetAptosNFTOwner) String() string {
 func (*ReqGetAptosNFTOwner) ProtoMessage() {}
 
 func (x *ReqGetAptosNFTOwner) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_nft_proto_msgTypes[6]
+	mi := &file_grpc_nft_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -355,7 +528,7 @@ func (x *ReqGetAptosNFTOwner) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReqGetAptosNFTOwner.ProtoReflect.Descriptor instead.
 func (*ReqGetAptosNFTOwner) Descriptor() ([]byte, []int) {
-	return file_grpc_nft_proto_rawDescGZIP(), []int{6}
+	return file_grpc_nft_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *ReqGetAptosNFTOwner) GetTokenId() string {
@@ -376,7 +549,7 @@ type ResGetAptosNFTOwner struct {
 func (x *ResGetAptosNFTOwner) Reset() {
 	*x = ResGetAptosNFTOwner{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_grpc_nft_proto_msgTypes[7]
+		mi := &file_grpc_nft_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -389,7 +562,7 @@ func (x *ResGetAptosNFTOwner) String() string {
 func (*ResGetAptosNFTOwner) ProtoMessage() {}
 
 func (x *ResGetAptosNFTOwner) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_nft_proto_msgTypes[7]
+	mi := &file_grpc_nft_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -402,7 +575,7 @@ func (x *ResGetAptosNFTOwner) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResGetAptosNFTOwner.ProtoReflect.Descriptor instead.
 func (*ResGetAptosNFTOwner) Descriptor() ([]byte, []int) {
-	return file_grpc_nft_proto_rawDescGZIP(), []int{7}
+	return file_grpc_nft_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *ResGetAptosNFTOwner) GetOwner() *AccountInfo {
@@ -423,7 +596,7 @@ type ReqAdminGetAptosNFTsInCollection struct {
 func (x *ReqAdminGetAptosNFTsInCollection) Reset() {
 	*x = ReqAdminGetAptosNFTsInCollection{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_grpc_nft_proto_msgTypes[8]
+		mi := &file_grpc_nft_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -436,7 +609,7 @@ func (x *ReqAdminGetAptosNFTsInCollection) String() string {
 func (*ReqAdminGetAptosNFTsInCollection) ProtoMessage() {}
 
 func (x *ReqAdminGetAptosNFTsInCollection) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_nft_proto_msgTypes[8]
+	mi := &file_grpc_nft_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -449,7 +622,7 @@ func (x *ReqAdminGetAptosNFTsInCollection) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReqAdminGetAptosNFTsInCollection.ProtoReflect.Descriptor instead.
 func (*ReqAdminGetAptosNFTsInCollection) Descriptor() ([]byte, []int) {
-	return file_grpc_nft_proto_rawDescGZIP(), []int{8}
+	return file_grpc_nft_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *ReqAdminGetAptosNFTsInCollection) GetCollectionId() string {
@@ -477,7 +650,7 @@ type AdminGetAptosNFTsInCollectionNode struct {
 func (x *AdminGetAptosNFTsInCollectionNode) Reset() {
 	*x = AdminGetAptosNFTsInCollectionNode{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_grpc_nft_proto_msgTypes[9]
+		mi := &file_grpc_nft_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -490,7 +663,7 @@ func (x *AdminGetAptosNFTsInCollectionNode) String() string {
 func (*AdminGetAptosNFTsInCollectionNode) ProtoMessage() {}
 
 func (x *AdminGetAptosNFTsInCollectionNode) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_nft_proto_msgTypes[9]
+	mi := &file_grpc_nft_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -503,7 +676,7 @@ func (x *AdminGetAptosNFTsInCollectionNode) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use AdminGetAptosNFTsInCollectionNode.ProtoReflect.Descriptor instead.
 func (*AdminGetAptosNFTsInCollectionNode) Descriptor() ([]byte, []int) {
-	return file_grpc_nft_proto_rawDescGZIP(), []int{9}
+	return file_grpc_nft_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *AdminGetAptosNFTsInCollectionNode) GetTokenId() uint32 {
@@ -573,7 +746,7 @@ type ResAdminGetAptosNFTsInCollection struct {
 func (x *ResAdminGetAptosNFTsInCollection) Reset() {
 	*x = ResAdminGetAptosNFTsInCollection{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_grpc_nft_proto_msgTypes[10]
+		mi := &file_grpc_nft_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -586,7 +759,7 @@ func (x *ResAdminGetAptosNFTsInCollection) String() string {
 func (*ResAdminGetAptosNFTsInCollection) ProtoMessage() {}
 
 func (x *ResAdminGetAptosNFTsInCollection) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_nft_proto_msgTypes[10]
+	mi := &file_grpc_nft_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -599,7 +772,7 @@ func (x *ResAdminGetAptosNFTsInCollection) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResAdminGetAptosNFTsInCollection.ProtoReflect.Descriptor instead.
 func (*ResAdminGetAptosNFTsInCollection) Descriptor() ([]byte, []int) {
-	return file_grpc_nft_proto_rawDescGZIP(), []int{10}
+	return file_grpc_nft_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *ResAdminGetAptosNFTsInCollection) GetNftList() []*AdminGetAptosNFTsInCollectionNode {
@@ -620,7 +793,7 @@ type ReqAdminGetCollectionNFTBuyers struct {
 func (x *ReqAdminGetCollectionNFTBuyers) Reset() {
 	*x = ReqAdminGetCollectionNFTBuyers{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_grpc_nft_proto_msgTypes[11]
+		mi := &file_grpc_nft_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -633,7 +806,7 @@ func (x *ReqAdminGetCollectionNFTBuyers) String() string {
 func (*ReqAdminGetCollectionNFTBuyers) ProtoMessage() {}
 
 func (x *ReqAdminGetCollectionNFTBuyers) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_nft_proto_msgTypes[11]
+	mi := &file_grpc_nft_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -646,7 +819,7 @@ func (x *ReqAdminGetCollectionNFTBuyers) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReqAdminGetCollectionNFTBuyers.ProtoReflect.Descriptor instead.
 func (*ReqAdminGetCollectionNFTBuyers) Descriptor() ([]byte, []int) {
-	return file_grpc_nft_proto_rawDescGZIP(), []int{11}
+	return file_grpc_nft_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *ReqAdminGetCollectionNFTBuyers) GetCollectionId() string {
@@ -674,7 +847,7 @@ type AdminGetCollectionNFTBuyersNode struct {
 func (x *AdminGetCollectionNFTBuyersNode) Reset() {
 	*x = AdminGetCollectionNFTBuyersNode{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_grpc_nft_proto_msgTypes[12]
+		mi := &file_grpc_nft_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -687,7 +860,7 @@ func (x *AdminGetCollectionNFTBuyersNode) String() string {
 func (*AdminGetCollectionNFTBuyersNode) ProtoMessage() {}
 
 func (x *AdminGetCollectionNFTBuyersNode) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_nft_proto_msgTypes[12]
+	mi := &file_grpc_nft_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -700,7 +873,7 @@ func (x *AdminGetCollectionNFTBuyersNode) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminGetCollectionNFTBuyersNode.ProtoReflect.Descriptor instead.
 func (*AdminGetCollectionNFTBuyersNode) Descriptor() ([]byte, []int) {
-	return file_grpc_nft_proto_rawDescGZIP(), []int{12}
+	return file_grpc_nft_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *AdminGetCollectionNFTBuyersNode) GetTokenId() uint32 {
@@ -770,7 +943,7 @@ type ResAdminGetCollectionNFTBuyers struct {
 func (x *ResAdminGetCollectionNFTBuyers) Reset() {
 	*x = ResAdminGetCollectionNFTBuyers{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_grpc_nft_proto_msgTypes[13]
+		mi := &file_grpc_nft_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -783,7 +956,7 @@ func (x *ResAdminGetCollectionNFTBuyers) String() string {
 func (*ResAdminGetCollectionNFTBuyers) ProtoMessage() {}
 
 func (x *ResAdminGetCollectionNFTBuyers) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_nft_proto_msgTypes[13]
+	mi := &file_grpc_nft_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -796,7 +969,7 @@ func (x *ResAdminGetCollectionNFTBuyers) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResAdminGetCollectionNFTBuyers.ProtoReflect.Descriptor instead.
 func (*ResAdminGetCollectionNFTBuyers) Descriptor() ([]byte, []int) {
-	return file_grpc_nft_proto_rawDescGZIP(), []int{13}
+	return file_grpc_nft_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *ResAdminGetCollectionNFTBuyers) GetNftList() []*AdminGetCollectionNFTBuyersNode {
@@ -820,7 +993,7 @@ type ReqAdminGetCollectionNFTOffers struct {
 func (x *ReqAdminGetCollectionNFTOffers) Reset() {
 	*x = ReqAdminGetCollectionNFTOffers{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_grpc_nft_proto_msgTypes[14]
+		mi := &file_grpc_nft_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -833,7 +1006,7 @@ func (x *ReqAdminGetCollectionNFTOffers) String() string {
 func (*ReqAdminGetCollectionNFTOffers) ProtoMessage() {}
 
 func (x *ReqAdminGetCollectionNFTOffers) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_nft_proto_msgTypes[14]
+	mi := &file_grpc_nft_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -846,7 +1019,7 @@ func (x *ReqAdminGetCollectionNFTOffers) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReqAdminGetCollectionNFTOffers.ProtoReflect.Descriptor instead.
 func (*ReqAdminGetCollectionNFTOffers) Descriptor() ([]byte, []int) {
-	return file_grpc_nft_proto_rawDescGZIP(), []int{14}
+	return file_grpc_nft_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *ReqAdminGetCollectionNFTOffers) GetCollectionId() string {
@@ -895,7 +1068,7 @@ type AdminGetCollectionNFTOffersNode struct {
 func (x *AdminGetCollectionNFTOffersNode) Reset() {
 	*x = AdminGetCollectionNFTOffersNode{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_grpc_nft_proto_msgTypes[15]
+		mi := &file_grpc_nft_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -908,7 +1081,7 @@ func (x *AdminGetCollectionNFTOffersNode) String() string {
 func (*AdminGetCollectionNFTOffersNode) ProtoMessage() {}
 
 func (x *AdminGetCollectionNFTOffersNode) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_nft_proto_msgTypes[15]
+	mi := &file_grpc_nft_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -921,7 +1094,7 @@ func (x *AdminGetCollectionNFTOffersNode) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminGetCollectionNFTOffersNode.ProtoReflect.Descriptor instead.
 func (*AdminGetCollectionNFTOffersNode) Descriptor() ([]byte, []int) {
-	return file_grpc_nft_proto_rawDescGZIP(), []int{15}
+	return file_grpc_nft_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *AdminGetCollectionNFTOffersNode) GetTokenId() uint32 {
@@ -991,7 +1164,7 @@ type ResAdminGetCollectionNFTOffers struct {
 func (x *ResAdminGetCollectionNFTOffers) Reset() {
 	*x = ResAdminGetCollectionNFTOffers{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_grpc_nft_proto_msgTypes[16]
+		mi := &file_grpc_nft_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1004,7 +1177,7 @@ func (x *ResAdminGetCollectionNFTOffers) String() string {
 func (*ResAdminGetCollectionNFTOffers) ProtoMessage() {}
 
 func (x *ResAdminGetCollectionNFTOffers) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_nft_proto_msgTypes[16]
+	mi := &file_grpc_nft_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1017,7 +1190,7 @@ func (x *ResAdminGetCollectionNFTOffers) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResAdminGetCollectionNFTOffers.ProtoReflect.Descriptor instead.
 func (*ResAdminGetCollectionNFTOffers) Descriptor() ([]byte, []int) {
-	return file_grpc_nft_proto_rawDescGZIP(), []int{16}
+	return file_grpc_nft_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *ResAdminGetCollectionNFTOffers) GetNftList() []*AdminGetCollectionNFTOffersNode {
@@ -1059,153 +1232,184 @@ var file_grpc_nft_proto_rawDesc = []byte{
 	0x74, 0x6f, 0x73, 0x4e, 0x46, 0x54, 0x73, 0x56, 0x32, 0x12, 0x27, 0x0a, 0x04, 0x6e, 0x66, 0x74,
 	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x41, 0x70,
 	0x74, 0x6f, 0x73, 0x4e, 0x46, 0x54, 0x4e, 0x6f, 0x64, 0x65, 0x56, 0x32, 0x52, 0x04, 0x6e, 0x66,
-	0x74, 0x73, 0x22, 0x30, 0x0a, 0x13, 0x52, 0x65, 0x71, 0x47, 0x65, 0x74, 0x41, 0x70, 0x74, 0x6f,
-	0x73, 0x4e, 0x46, 0x54, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x6b,
-	0x65, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x6f, 0x6b,
-	0x65, 0x6e, 0x49, 0x64, 0x22, 0x3d, 0x0a, 0x13, 0x52, 0x65, 0x73, 0x47, 0x65, 0x74, 0x41, 0x70,
-	0x74, 0x6f, 0x73, 0x4e, 0x46, 0x54, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x26, 0x0a, 0x05, 0x6f,
-	0x77, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d, 0x70, 0x62,
-	0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x6f, 0x77,
-	0x6e, 0x65, 0x72, 0x22, 0x47, 0x0a, 0x20, 0x52, 0x65, 0x71, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x47,
-	0x65, 0x74, 0x41, 0x70, 0x74, 0x6f, 0x73, 0x4e, 0x46, 0x54, 0x73, 0x49, 0x6e, 0x43, 0x6f, 0x6c,
-	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6c, 0x6c, 0x65,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
-	0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0xcb, 0x02, 0x0a,
-	0x21, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x47, 0x65, 0x74, 0x41, 0x70, 0x74, 0x6f, 0x73, 0x4e, 0x46,
-	0x54, 0x73, 0x49, 0x6e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f,
-	0x64, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x12, 0x22, 0x0a,
-	0x0d, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x69, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x49,
-	0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x4e, 0x61, 0x6d, 0x65,
-	0x12, 0x33, 0x0a, 0x15, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
-	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x14, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x75,
-	0x72, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x55,
-	0x72, 0x6c, 0x12, 0x2f, 0x0a, 0x13, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x12, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x6f,
-	0x77, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x22, 0x65, 0x0a, 0x20, 0x52, 0x65,
-	0x73, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x47, 0x65, 0x74, 0x41, 0x70, 0x74, 0x6f, 0x73, 0x4e, 0x46,
-	0x54, 0x73, 0x49, 0x6e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x41,
-	0x0a, 0x08, 0x6e, 0x66, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x26, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x47, 0x65, 0x74, 0x41,
-	0x70, 0x74, 0x6f, 0x73, 0x4e, 0x46, 0x54, 0x73, 0x49, 0x6e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x07, 0x6e, 0x66, 0x74, 0x4c, 0x69, 0x73,
-	0x74, 0x22, 0x45, 0x0a, 0x1e, 0x52, 0x65, 0x71, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x47, 0x65, 0x74,
-	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x46, 0x54, 0x42, 0x75, 0x79,
-	0x65, 0x72, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6c, 0x6c,
-	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0xc9, 0x02, 0x0a, 0x1f, 0x41, 0x64, 0x6d,
-	0x69, 0x6e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e,
-	0x46, 0x54, 0x42, 0x75, 0x79, 0x65, 0x72, 0x73, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x19, 0x0a, 0x08,
-	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07,
-	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0d, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
-	0x5f, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
-	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x74,
-	0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x33, 0x0a, 0x15, 0x74, 0x72,
-	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x74, 0x72, 0x61, 0x6e, 0x73,
-	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12,
-	0x1b, 0x0a, 0x09, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x55, 0x72, 0x6c, 0x12, 0x2f, 0x0a, 0x13,
-	0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x76, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x74, 0x72, 0x61, 0x6e, 0x73,
-	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a,
-	0x05, 0x62, 0x75, 0x79, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d,
-	0x70, 0x62, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05,
-	0x62, 0x75, 0x79, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x75, 0x79, 0x65, 0x72, 0x5f, 0x61,
-	0x64, 0x64, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x75, 0x79, 0x65, 0x72,
-	0x41, 0x64, 0x64, 0x72, 0x22, 0x61, 0x0a, 0x1e, 0x52, 0x65, 0x73, 0x41, 0x64, 0x6d, 0x69, 0x6e,
-	0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x46, 0x54,
-	0x42, 0x75, 0x79, 0x65, 0x72, 0x73, 0x12, 0x3f, 0x0a, 0x08, 0x6e, 0x66, 0x74, 0x5f, 0x6c, 0x69,
-	0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x41,
-	0x64, 0x6d, 0x69, 0x6e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x4e, 0x46, 0x54, 0x42, 0x75, 0x79, 0x65, 0x72, 0x73, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x07,
-	0x6e, 0x66, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x9a, 0x01, 0x0a, 0x1e, 0x52, 0x65, 0x71, 0x41,
-	0x64, 0x6d, 0x69, 0x6e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x4e, 0x46, 0x54, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f,
-	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12,
-	0x19, 0x0a, 0x08, 0x64, 0x73, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x64, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74,
-	0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
-	0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64,
-	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x65, 0x6e, 0x64,
-	0x54, 0x69, 0x6d, 0x65, 0x22, 0xc9, 0x02, 0x0a, 0x1f, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x47, 0x65,
-	0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x46, 0x54, 0x4f, 0x66,
-	0x66, 0x65, 0x72, 0x73, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x6b, 0x65,
-	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x74, 0x6f, 0x6b, 0x65,
-	0x6e, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0d, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x64, 0x61, 0x74,
-	0x61, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x6f, 0x6b, 0x65,
-	0x6e, 0x44, 0x61, 0x74, 0x61, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
-	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x6f, 0x6b,
-	0x65, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x33, 0x0a, 0x15, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1b, 0x0a, 0x09, 0x74,
-	0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x55, 0x72, 0x6c, 0x12, 0x2f, 0x0a, 0x13, 0x74, 0x72, 0x61, 0x6e,
-	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x05, 0x6f, 0x66, 0x66,
-	0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x41,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x6f, 0x66, 0x66, 0x65,
-	0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18,
-	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72,
-	0x22, 0x61, 0x0a, 0x1e, 0x52, 0x65, 0x73, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x47, 0x65, 0x74, 0x43,
-	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x46, 0x54, 0x4f, 0x66, 0x66, 0x65,
-	0x72, 0x73, 0x12, 0x3f, 0x0a, 0x08, 0x6e, 0x66, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e,
-	0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x46, 0x54,
-	0x4f, 0x66, 0x66, 0x65, 0x72, 0x73, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x07, 0x6e, 0x66, 0x74, 0x4c,
-	0x69, 0x73, 0x74, 0x32, 0xe7, 0x04, 0x0a, 0x0a, 0x4e, 0x46, 0x54, 0x53, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x12, 0x3a, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x41, 0x70, 0x74, 0x6f, 0x73, 0x4e, 0x46,
-	0x54, 0x73, 0x12, 0x14, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x47, 0x65, 0x74, 0x41,
-	0x70, 0x74, 0x6f, 0x73, 0x4e, 0x46, 0x54, 0x73, 0x1a, 0x14, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x52,
-	0x65, 0x73, 0x47, 0x65, 0x74, 0x41, 0x70, 0x74, 0x6f, 0x73, 0x4e, 0x46, 0x54, 0x73, 0x12, 0x52,
-	0x0a, 0x14, 0x47, 0x65, 0x74, 0x41, 0x70, 0x74, 0x6f, 0x73, 0x4e, 0x46, 0x54, 0x4d, 0x65, 0x74,
-	0x61, 0x64, 0x61, 0x74, 0x61, 0x73, 0x12, 0x1c, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71,
-	0x47, 0x65, 0x74, 0x41, 0x70, 0x74, 0x6f, 0x73, 0x4e, 0x46, 0x54, 0x4d, 0x65, 0x74, 0x61, 0x64,
-	0x61, 0x74, 0x61, 0x73, 0x1a, 0x1c, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x47, 0x65,
-	0x74, 0x41, 0x70, 0x74, 0x6f, 0x73, 0x4e, 0x46, 0x54, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0x73, 0x12, 0x40, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x41, 0x70, 0x74, 0x6f, 0x73, 0x4e, 0x46,
-	0x54, 0x73, 0x56, 0x32, 0x12, 0x16, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x47, 0x65,
-	0x74, 0x41, 0x70, 0x74, 0x6f, 0x73, 0x4e, 0x46, 0x54, 0x73, 0x56, 0x32, 0x1a, 0x16, 0x2e, 0x6d,
-	0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x47, 0x65, 0x74, 0x41, 0x70, 0x74, 0x6f, 0x73, 0x4e, 0x46,
-	0x54, 0x73, 0x56, 0x32, 0x12, 0x46, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x41, 0x70, 0x74, 0x6f, 0x73,
-	0x4e, 0x46, 0x54, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x18, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x52,
-	0x65, 0x71, 0x47, 0x65, 0x74, 0x41, 0x70, 0x74, 0x6f, 0x73, 0x4e, 0x46, 0x54, 0x4f, 0x77, 0x6e,
-	0x65, 0x72, 0x1a, 0x18, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x47, 0x65, 0x74, 0x41,
-	0x70, 0x74, 0x6f, 0x73, 0x4e, 0x46, 0x54, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x6d, 0x0a, 0x1d,
-	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x47, 0x65, 0x74, 0x41, 0x70, 0x74, 0x6f, 0x73, 0x4e, 0x46, 0x54,
-	0x73, 0x49, 0x6e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x2e,
-	0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x47, 0x65, 0x74, 0x41,
+	0x74, 0x73, 0x22, 0x4f, 0x0a, 0x11, 0x52, 0x65, 0x71, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55,
+	0x73, 0x65, 0x72, 0x4e, 0x46, 0x54, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x21, 0x0a, 0x0c, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x41, 0x64,
+	0x64, 0x72, 0x73, 0x22, 0x51, 0x0a, 0x10, 0x52, 0x65, 0x71, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x55,
+	0x73, 0x65, 0x72, 0x4e, 0x46, 0x54, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x24, 0x0a, 0x0e, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x64, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x64,
+	0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x67, 0x0a, 0x13, 0x52, 0x65, 0x71, 0x52, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x4e, 0x46, 0x54, 0x73, 0x12, 0x17, 0x0a,
+	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74,
+	0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x77, 0x61, 0x6c,
+	0x6c, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x65, 0x67, 0x61, 0x63,
+	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x22,
+	0x30, 0x0a, 0x13, 0x52, 0x65, 0x71, 0x47, 0x65, 0x74, 0x41, 0x70, 0x74, 0x6f, 0x73, 0x4e, 0x46,
+	0x54, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49,
+	0x64, 0x22, 0x3d, 0x0a, 0x13, 0x52, 0x65, 0x73, 0x47, 0x65, 0x74, 0x41, 0x70, 0x74, 0x6f, 0x73,
+	0x4e, 0x46, 0x54, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x26, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72,
+	0x22, 0x47, 0x0a, 0x20, 0x52, 0x65, 0x71, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x47, 0x65, 0x74, 0x41,
 	0x70, 0x74, 0x6f, 0x73, 0x4e, 0x46, 0x54, 0x73, 0x49, 0x6e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x25, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x41, 0x64,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0xcb, 0x02, 0x0a, 0x21, 0x41, 0x64,
 	0x6d, 0x69, 0x6e, 0x47, 0x65, 0x74, 0x41, 0x70, 0x74, 0x6f, 0x73, 0x4e, 0x46, 0x54, 0x73, 0x49,
-	0x6e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x67, 0x0a, 0x1b, 0x41,
-	0x64, 0x6d, 0x69, 0x6e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x4e, 0x46, 0x54, 0x42, 0x75, 0x79, 0x65, 0x72, 0x73, 0x12, 0x23, 0x2e, 0x6d, 0x70, 0x62,
-	0x2e, 0x52, 0x65, 0x71, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c,
-	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x46, 0x54, 0x42, 0x75, 0x79, 0x65, 0x72, 0x73, 0x1a,
-	0x23, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x47, 0x65,
-	0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x46, 0x54, 0x42, 0x75,
-	0x79, 0x65, 0x72, 0x73, 0x12, 0x67, 0x0a, 0x1b, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x47, 0x65, 0x74,
+	0x6e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x64, 0x65, 0x12,
+	0x19, 0x0a, 0x08, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x07, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0d, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x49, 0x64, 0x12, 0x1d,
+	0x0a, 0x0a, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x33, 0x0a,
+	0x15, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x75, 0x72, 0x6c, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x55, 0x72, 0x6c, 0x12,
+	0x2f, 0x0a, 0x13, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x26, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x10, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x6f, 0x77, 0x6e, 0x65,
+	0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x77,
+	0x6e, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x22, 0x65, 0x0a, 0x20, 0x52, 0x65, 0x73, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x47, 0x65, 0x74, 0x41, 0x70, 0x74, 0x6f, 0x73, 0x4e, 0x46, 0x54, 0x73, 0x49,
+	0x6e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x41, 0x0a, 0x08, 0x6e,
+	0x66, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e,
+	0x6d, 0x70, 0x62, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x47, 0x65, 0x74, 0x41, 0x70, 0x74, 0x6f,
+	0x73, 0x4e, 0x46, 0x54, 0x73, 0x49, 0x6e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x07, 0x6e, 0x66, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x45,
+	0x0a, 0x1e, 0x52, 0x65, 0x71, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x46, 0x54, 0x42, 0x75, 0x79, 0x65, 0x72, 0x73,
+	0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0xc9, 0x02, 0x0a, 0x1f, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x47,
+	0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x46, 0x54, 0x42,
+	0x75, 0x79, 0x65, 0x72, 0x73, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0d, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x64, 0x61,
+	0x74, 0x61, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x33, 0x0a, 0x15, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1b, 0x0a, 0x09,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x55, 0x72, 0x6c, 0x12, 0x2f, 0x0a, 0x13, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x05, 0x62, 0x75,
+	0x79, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d, 0x70, 0x62, 0x2e,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x62, 0x75, 0x79,
+	0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x75, 0x79, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x75, 0x79, 0x65, 0x72, 0x41, 0x64, 0x64,
+	0x72, 0x22, 0x61, 0x0a, 0x1e, 0x52, 0x65, 0x73, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x47, 0x65, 0x74,
+	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x46, 0x54, 0x42, 0x75, 0x79,
+	0x65, 0x72, 0x73, 0x12, 0x3f, 0x0a, 0x08, 0x6e, 0x66, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x46,
+	0x54, 0x42, 0x75, 0x79, 0x65, 0x72, 0x73, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x07, 0x6e, 0x66, 0x74,
+	0x4c, 0x69, 0x73, 0x74, 0x22, 0x9a, 0x01, 0x0a, 0x1e, 0x52, 0x65, 0x71, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x46,
+	0x54, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08,
+	0x64, 0x73, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x64, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d,
+	0x65, 0x22, 0xc9, 0x02, 0x0a, 0x1f, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x47, 0x65, 0x74, 0x43, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x46, 0x54, 0x4f, 0x66, 0x66, 0x65, 0x72,
+	0x73, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64,
+	0x12, 0x22, 0x0a, 0x0d, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x44, 0x61,
+	0x74, 0x61, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x33, 0x0a, 0x15, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x14, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x55, 0x72, 0x6c, 0x12, 0x2f, 0x0a, 0x13, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x12, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x05, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x12, 0x1d,
+	0x0a, 0x0a, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x22, 0x61, 0x0a,
+	0x1e, 0x52, 0x65, 0x73, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x46, 0x54, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x73, 0x12,
+	0x3f, 0x0a, 0x08, 0x6e, 0x66, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x24, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x47, 0x65, 0x74,
 	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x46, 0x54, 0x4f, 0x66, 0x66,
-	0x65, 0x72, 0x73, 0x12, 0x23, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x41, 0x64, 0x6d,
-	0x69, 0x6e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e,
-	0x46, 0x54, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x73, 0x1a, 0x23, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x52,
-	0x65, 0x73, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x46, 0x54, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x73, 0x42, 0x07, 0x5a,
-	0x05, 0x2e, 0x2f, 0x6d, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x72, 0x73, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x07, 0x6e, 0x66, 0x74, 0x4c, 0x69, 0x73, 0x74,
+	0x32, 0xc4, 0x06, 0x0a, 0x0a, 0x4e, 0x46, 0x54, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
+	0x3a, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x41, 0x70, 0x74, 0x6f, 0x73, 0x4e, 0x46, 0x54, 0x73, 0x12,
+	0x14, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x47, 0x65, 0x74, 0x41, 0x70, 0x74, 0x6f,
+	0x73, 0x4e, 0x46, 0x54, 0x73, 0x1a, 0x14, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x47,
+	0x65, 0x74, 0x41, 0x70, 0x74, 0x6f, 0x73, 0x4e, 0x46, 0x54, 0x73, 0x12, 0x52, 0x0a, 0x14, 0x47,
+	0x65, 0x74, 0x41, 0x70, 0x74, 0x6f, 0x73, 0x4e, 0x46, 0x54, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x73, 0x12, 0x1c, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x47, 0x65, 0x74,
+	0x41, 0x70, 0x74, 0x6f, 0x73, 0x4e, 0x46, 0x54, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x73, 0x1a, 0x1c, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x47, 0x65, 0x74, 0x41, 0x70,
+	0x74, 0x6f, 0x73, 0x4e, 0x46, 0x54, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x73, 0x12,
+	0x40, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x41, 0x70, 0x74, 0x6f, 0x73, 0x4e, 0x46, 0x54, 0x73, 0x56,
+	0x32, 0x12, 0x16, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x47, 0x65, 0x74, 0x41, 0x70,
+	0x74, 0x6f, 0x73, 0x4e, 0x46, 0x54, 0x73, 0x56, 0x32, 0x1a, 0x16, 0x2e, 0x6d, 0x70, 0x62, 0x2e,
+	0x52, 0x65, 0x73, 0x47, 0x65, 0x74, 0x41, 0x70, 0x74, 0x6f, 0x73, 0x4e, 0x46, 0x54, 0x73, 0x56,
+	0x32, 0x12, 0x37, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x4e, 0x46,
+	0x54, 0x73, 0x12, 0x0e, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x55, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x1a, 0x16, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x47, 0x65, 0x74, 0x41,
+	0x70, 0x74, 0x6f, 0x73, 0x4e, 0x46, 0x54, 0x73, 0x56, 0x32, 0x12, 0x46, 0x0a, 0x10, 0x47, 0x65,
+	0x74, 0x41, 0x70, 0x74, 0x6f, 0x73, 0x4e, 0x46, 0x54, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x18,
+	0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x47, 0x65, 0x74, 0x41, 0x70, 0x74, 0x6f, 0x73,
+	0x4e, 0x46, 0x54, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x1a, 0x18, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x52,
+	0x65, 0x73, 0x47, 0x65, 0x74, 0x41, 0x70, 0x74, 0x6f, 0x73, 0x4e, 0x46, 0x54, 0x4f, 0x77, 0x6e,
+	0x65, 0x72, 0x12, 0x38, 0x0a, 0x10, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x57, 0x61, 0x6c, 0x6c,
+	0x65, 0x74, 0x4e, 0x46, 0x54, 0x73, 0x12, 0x18, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x4e, 0x46, 0x54, 0x73,
+	0x1a, 0x0a, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x34, 0x0a, 0x0e,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x4e, 0x46, 0x54, 0x73, 0x12, 0x16,
+	0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73,
+	0x65, 0x72, 0x4e, 0x46, 0x54, 0x73, 0x1a, 0x0a, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x12, 0x32, 0x0a, 0x0d, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x55, 0x73, 0x65, 0x72, 0x4e,
+	0x46, 0x54, 0x73, 0x12, 0x15, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x4d, 0x65, 0x72,
+	0x67, 0x65, 0x55, 0x73, 0x65, 0x72, 0x4e, 0x46, 0x54, 0x73, 0x1a, 0x0a, 0x2e, 0x6d, 0x70, 0x62,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x6d, 0x0a, 0x1d, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x47,
+	0x65, 0x74, 0x41, 0x70, 0x74, 0x6f, 0x73, 0x4e, 0x46, 0x54, 0x73, 0x49, 0x6e, 0x43, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65,
+	0x71, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x47, 0x65, 0x74, 0x41, 0x70, 0x74, 0x6f, 0x73, 0x4e, 0x46,
+	0x54, 0x73, 0x49, 0x6e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x25,
+	0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x47, 0x65, 0x74,
+	0x41, 0x70, 0x74, 0x6f, 0x73, 0x4e, 0x46, 0x54, 0x73, 0x49, 0x6e, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x67, 0x0a, 0x1b, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x47, 0x65,
+	0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x46, 0x54, 0x42, 0x75,
+	0x79, 0x65, 0x72, 0x73, 0x12, 0x23, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x4e, 0x46, 0x54, 0x42, 0x75, 0x79, 0x65, 0x72, 0x73, 0x1a, 0x23, 0x2e, 0x6d, 0x70, 0x62, 0x2e,
+	0x52, 0x65, 0x73, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x46, 0x54, 0x42, 0x75, 0x79, 0x65, 0x72, 0x73, 0x12, 0x67,
+	0x0a, 0x1b, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x46, 0x54, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x73, 0x12, 0x23, 0x2e,
+	0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x47, 0x65, 0x74, 0x43,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x46, 0x54, 0x4f, 0x66, 0x66, 0x65,
+	0x72, 0x73, 0x1a, 0x23, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x46,
+	0x54, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x73, 0x42, 0x07, 0x5a, 0x05, 0x2e, 0x2f, 0x6d, 0x70, 0x62,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1220,7 +1424,7 @@ func file_grpc_nft_proto_rawDescGZIP() []byte {
 	return file_grpc_nft_proto_rawDescData
 }
 
-var file_grpc_nft_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_grpc_nft_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
 var file_grpc_nft_proto_goTypes = []interface{}{
 	(*ReqGetAptosNFTs)(nil),                   // 0: mpb.ReqGetAptosNFTs
 	(*ResGetAptosNFTs)(nil),                   // 1: mpb.ResGetAptosNFTs
@@ -1228,51 +1432,64 @@ var file_grpc_nft_proto_goTypes = []interface{}{
 	(*ResGetAptosNFTMetadatas)(nil),           // 3: mpb.ResGetAptosNFTMetadatas
 	(*ReqGetAptosNFTsV2)(nil),                 // 4: mpb.ReqGetAptosNFTsV2
 	(*ResGetAptosNFTsV2)(nil),                 // 5: mpb.ResGetAptosNFTsV2
-	(*ReqGetAptosNFTOwner)(nil),               // 6: mpb.ReqGetAptosNFTOwner
-	(*ResGetAptosNFTOwner)(nil),               // 7: mpb.ResGetAptosNFTOwner
-	(*ReqAdminGetAptosNFTsInCollection)(nil),  // 8: mpb.ReqAdminGetAptosNFTsInCollection
-	(*AdminGetAptosNFTsInCollectionNode)(nil), // 9: mpb.AdminGetAptosNFTsInCollectionNode
-	(*ResAdminGetAptosNFTsInCollection)(nil),  // 10: mpb.ResAdminGetAptosNFTsInCollection
-	(*ReqAdminGetCollectionNFTBuyers)(nil),    // 11: mpb.ReqAdminGetCollectionNFTBuyers
-	(*AdminGetCollectionNFTBuyersNode)(nil),   // 12: mpb.AdminGetCollectionNFTBuyersNode
-	(*ResAdminGetCollectionNFTBuyers)(nil),    // 13: mpb.ResAdminGetCollectionNFTBuyers
-	(*ReqAdminGetCollectionNFTOffers)(nil),    // 14: mpb.ReqAdminGetCollectionNFTOffers
-	(*AdminGetCollectionNFTOffersNode)(nil),   // 15: mpb.AdminGetCollectionNFTOffersNode
-	(*ResAdminGetCollectionNFTOffers)(nil),    // 16: mpb.ResAdminGetCollectionNFTOffers
-	(ENFT_NFTType)(0),                         // 17: mpb.ENFT.NFTType
-	(*AptosNFTNode)(nil),                      // 18: mpb.AptosNFTNode
-	(*AptosNFTMetadata)(nil),                  // 19: mpb.AptosNFTMetadata
-	(*AptosNFTNodeV2)(nil),                    // 20: mpb.AptosNFTNodeV2
-	(*AccountInfo)(nil),                       // 21: mpb.AccountInfo
+	(*ReqDeleteUserNFTs)(nil),                 // 6: mpb.ReqDeleteUserNFTs
+	(*ReqMergeUserNFTs)(nil),                  // 7: mpb.ReqMergeUserNFTs
+	(*ReqRemoveWalletNFTs)(nil),               // 8: mpb.ReqRemoveWalletNFTs
+	(*ReqGetAptosNFTOwner)(nil),               // 9: mpb.ReqGetAptosNFTOwner
+	(*ResGetAptosNFTOwner)(nil),               // 10: mpb.ResGetAptosNFTOwner
+	(*ReqAdminGetAptosNFTsInCollection)(nil),  // 11: mpb.ReqAdminGetAptosNFTsInCollection
+	(*AdminGetAptosNFTsInCollectionNode)(nil), // 12: mpb.AdminGetAptosNFTsInCollectionNode
+	(*ResAdminGetAptosNFTsInCollection)(nil),  // 13: mpb.ResAdminGetAptosNFTsInCollection
+	(*ReqAdminGetCollectionNFTBuyers)(nil),    // 14: mpb.ReqAdminGetCollectionNFTBuyers
+	(*AdminGetCollectionNFTBuyersNode)(nil),   // 15: mpb.AdminGetCollectionNFTBuyersNode
+	(*ResAdminGetCollectionNFTBuyers)(nil),    // 16: mpb.ResAdminGetCollectionNFTBuyers
+	(*ReqAdminGetCollectionNFTOffers)(nil),    // 17: mpb.ReqAdminGetCollectionNFTOffers
+	(*AdminGetCollectionNFTOffersNode)(nil),   // 18: mpb.AdminGetCollectionNFTOffersNode
+	(*ResAdminGetCollectionNFTOffers)(nil),    // 19: mpb.ResAdminGetCollectionNFTOffers
+	(ENFT_NFTType)(0),                         // 20: mpb.ENFT.NFTType
+	(*AptosNFTNode)(nil),                      // 21: mpb.AptosNFTNode
+	(*AptosNFTMetadata)(nil),                  // 22: mpb.AptosNFTMetadata
+	(*AptosNFTNodeV2)(nil),                    // 23: mpb.AptosNFTNodeV2
+	(*AccountInfo)(nil),                       // 24: mpb.AccountInfo
+	(*ReqUserId)(nil),                         // 25: mpb.ReqUserId
+	(*Empty)(nil),                             // 26: mpb.Empty
 }
 var file_grpc_nft_proto_depIdxs = []int32{
-	17, // 0: mpb.ReqGetAptosNFTs.nft_types:type_name -> mpb.ENFT.NFTType
-	18, // 1: mpb.ResGetAptosNFTs.nfts:type_name -> mpb.AptosNFTNode
-	19, // 2: mpb.ResGetAptosNFTMetadatas.metadatas:type_name -> mpb.AptosNFTMetadata
-	20, // 3: mpb.ResGetAptosNFTsV2.nfts:type_name -> mpb.AptosNFTNodeV2
-	21, // 4: mpb.ResGetAptosNFTOwner.owner:type_name -> mpb.AccountInfo
-	21, // 5: mpb.AdminGetAptosNFTsInCollectionNode.owner:type_name -> mpb.AccountInfo
-	9,  // 6: mpb.ResAdminGetAptosNFTsInCollection.nft_list:type_name -> mpb.AdminGetAptosNFTsInCollectionNode
-	21, // 7: mpb.AdminGetCollectionNFTBuyersNode.buyer:type_name -> mpb.AccountInfo
-	12, // 8: mpb.ResAdminGetCollectionNFTBuyers.nft_list:type_name -> mpb.AdminGetCollectionNFTBuyersNode
-	21, // 9: mpb.AdminGetCollectionNFTOffersNode.offer:type_name -> mpb.AccountInfo
-	15, // 10: mpb.ResAdminGetCollectionNFTOffers.nft_list:type_name -> mpb.AdminGetCollectionNFTOffersNode
+	20, // 0: mpb.ReqGetAptosNFTs.nft_types:type_name -> mpb.ENFT.NFTType
+	21, // 1: mpb.ResGetAptosNFTs.nfts:type_name -> mpb.AptosNFTNode
+	22, // 2: mpb.ResGetAptosNFTMetadatas.metadatas:type_name -> mpb.AptosNFTMetadata
+	23, // 3: mpb.ResGetAptosNFTsV2.nfts:type_name -> mpb.AptosNFTNodeV2
+	24, // 4: mpb.ResGetAptosNFTOwner.owner:type_name -> mpb.AccountInfo
+	24, // 5: mpb.AdminGetAptosNFTsInCollectionNode.owner:type_name -> mpb.AccountInfo
+	12, // 6: mpb.ResAdminGetAptosNFTsInCollection.nft_list:type_name -> mpb.AdminGetAptosNFTsInCollectionNode
+	24, // 7: mpb.AdminGetCollectionNFTBuyersNode.buyer:type_name -> mpb.AccountInfo
+	15, // 8: mpb.ResAdminGetCollectionNFTBuyers.nft_list:type_name -> mpb.AdminGetCollectionNFTBuyersNode
+	24, // 9: mpb.AdminGetCollectionNFTOffersNode.offer:type_name -> mpb.AccountInfo
+	18, // 10: mpb.ResAdminGetCollectionNFTOffers.nft_list:type_name -> mpb.AdminGetCollectionNFTOffersNode
 	0,  // 11: mpb.NFTService.GetAptosNFTs:input_type -> mpb.ReqGetAptosNFTs
 	2,  // 12: mpb.NFTService.GetAptosNFTMetadatas:input_type -> mpb.ReqGetAptosNFTMetadatas
 	4,  // 13: mpb.NFTService.GetAptosNFTsV2:input_type -> mpb.ReqGetAptosNFTsV2
-	6,  // 14: mpb.NFTService.GetAptosNFTOwner:input_type -> mpb.ReqGetAptosNFTOwner
-	8,  // 15: mpb.NFTService.AdminGetAptosNFTsInCollection:input_type -> mpb.ReqAdminGetAptosNFTsInCollection
-	11, // 16: mpb.NFTService.AdminGetCollectionNFTBuyers:input_type -> mpb.ReqAdminGetCollectionNFTBuyers
-	14, // 17: mpb.NFTService.AdminGetCollectionNFTOffers:input_type -> mpb.ReqAdminGetCollectionNFTOffers
-	1,  // 18: mpb.NFTService.GetAptosNFTs:output_type -> mpb.ResGetAptosNFTs
-	3,  // 19: mpb.NFTService.GetAptosNFTMetadatas:output_type -> mpb.ResGetAptosNFTMetadatas
-	5,  // 20: mpb.NFTService.GetAptosNFTsV2:output_type -> mpb.ResGetAptosNFTsV2
-	7,  // 21: mpb.NFTService.GetAptosNFTOwner:output_type -> mpb.ResGetAptosNFTOwner
-	10, // 22: mpb.NFTService.AdminGetAptosNFTsInCollection:output_type -> mpb.ResAdminGetAptosNFTsInCollection
-	13, // 23: mpb.NFTService.AdminGetCollectionNFTBuyers:output_type -> mpb.ResAdminGetCollectionNFTBuyers
-	16, // 24: mpb.NFTService.AdminGetCollectionNFTOffers:output_type -> mpb.ResAdminGetCollectionNFTOffers
-	18, // [18:25] is the sub-list for method output_type
-	11, // [11:18] is the sub-list for method input_type
+	25, // 14: mpb.NFTService.GetStoredNFTs:input_type -> mpb.ReqUserId
+	9,  // 15: mpb.NFTService.GetAptosNFTOwner:input_type -> mpb.ReqGetAptosNFTOwner
+	8,  // 16: mpb.NFTService.RemoveWalletNFTs:input_type -> mpb.ReqRemoveWalletNFTs
+	6,  // 17: mpb.NFTService.DeleteUserNFTs:input_type -> mpb.ReqDeleteUserNFTs
+	7,  // 18: mpb.NFTService.MergeUserNFTs:input_type -> mpb.ReqMergeUserNFTs
+	11, // 19: mpb.NFTService.AdminGetAptosNFTsInCollection:input_type -> mpb.ReqAdminGetAptosNFTsInCollection
+	14, // 20: mpb.NFTService.AdminGetCollectionNFTBuyers:input_type -> mpb.ReqAdminGetCollectionNFTBuyers
+	17, // 21: mpb.NFTService.AdminGetCollectionNFTOffers:input_type -> mpb.ReqAdminGetCollectionNFTOffers
+	1,  // 22: mpb.NFTService.GetAptosNFTs:output_type -> mpb.ResGetAptosNFTs
+	3,  // 23: mpb.NFTService.GetAptosNFTMetadatas:output_type -> mpb.ResGetAptosNFTMetadatas
+	5,  // 24: mpb.NFTService.GetAptosNFTsV2:output_type -> mpb.ResGetAptosNFTsV2
+	5,  // 25: mpb.NFTService.GetStoredNFTs:output_type -> mpb.ResGetAptosNFTsV2
+	10, // 26: mpb.NFTService.GetAptosNFTOwner:output_type -> mpb.ResGetAptosNFTOwner
+	26, // 27: mpb.NFTService.RemoveWalletNFTs:output_type -> mpb.Empty
+	26, // 28: mpb.NFTService.DeleteUserNFTs:output_type -> mpb.Empty
+	26, // 29: mpb.NFTService.MergeUserNFTs:output_type -> mpb.Empty
+	13, // 30: mpb.NFTService.AdminGetAptosNFTsInCollection:output_type -> mpb.ResAdminGetAptosNFTsInCollection
+	16, // 31: mpb.NFTService.AdminGetCollectionNFTBuyers:output_type -> mpb.ResAdminGetCollectionNFTBuyers
+	19, // 32: mpb.NFTService.AdminGetCollectionNFTOffers:output_type -> mpb.ResAdminGetCollectionNFTOffers
+	22, // [22:33] is the sub-list for method output_type
+	11, // [11:22] is the sub-list for method input_type
 	11, // [11:11] is the sub-list for extension type_name
 	11, // [11:11] is the sub-list for extension extendee
 	0,  // [0:11] is the sub-list for field type_name
@@ -1358,7 +1575,7 @@ func file_grpc_nft_proto_init() {
 			}
 		}
 		file_grpc_nft_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReqGetAptosNFTOwner); i {
+			switch v := v.(*ReqDeleteUserNFTs); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1370,7 +1587,7 @@ func file_grpc_nft_proto_init() {
 			}
 		}
 		file_grpc_nft_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ResGetAptosNFTOwner); i {
+			switch v := v.(*ReqMergeUserNFTs); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1382,7 +1599,7 @@ func file_grpc_nft_proto_init() {
 			}
 		}
 		file_grpc_nft_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReqAdminGetAptosNFTsInCollection); i {
+			switch v := v.(*ReqRemoveWalletNFTs); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1394,7 +1611,7 @@ func file_grpc_nft_proto_init() {
 			}
 		}
 		file_grpc_nft_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminGetAptosNFTsInCollectionNode); i {
+			switch v := v.(*ReqGetAptosNFTOwner); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1406,7 +1623,7 @@ func file_grpc_nft_proto_init() {
 			}
 		}
 		file_grpc_nft_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ResAdminGetAptosNFTsInCollection); i {
+			switch v := v.(*ResGetAptosNFTOwner); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1418,7 +1635,7 @@ func file_grpc_nft_proto_init() {
 			}
 		}
 		file_grpc_nft_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReqAdminGetCollectionNFTBuyers); i {
+			switch v := v.(*ReqAdminGetAptosNFTsInCollection); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1430,7 +1647,7 @@ func file_grpc_nft_proto_init() {
 			}
 		}
 		file_grpc_nft_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminGetCollectionNFTBuyersNode); i {
+			switch v := v.(*AdminGetAptosNFTsInCollectionNode); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1442,7 +1659,7 @@ func file_grpc_nft_proto_init() {
 			}
 		}
 		file_grpc_nft_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ResAdminGetCollectionNFTBuyers); i {
+			switch v := v.(*ResAdminGetAptosNFTsInCollection); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1454,7 +1671,7 @@ func file_grpc_nft_proto_init() {
 			}
 		}
 		file_grpc_nft_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReqAdminGetCollectionNFTOffers); i {
+			switch v := v.(*ReqAdminGetCollectionNFTBuyers); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1466,7 +1683,7 @@ func file_grpc_nft_proto_init() {
 			}
 		}
 		file_grpc_nft_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminGetCollectionNFTOffersNode); i {
+			switch v := v.(*AdminGetCollectionNFTBuyersNode); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1478,6 +1695,42 @@ func file_grpc_nft_proto_init() {
 			}
 		}
 		file_grpc_nft_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResAdminGetCollectionNFTBuyers); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_nft_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReqAdminGetCollectionNFTOffers); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_nft_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminGetCollectionNFTOffersNode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_nft_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ResAdminGetCollectionNFTOffers); i {
 			case 0:
 				return &v.state
@@ -1496,7 +1749,7 @@ func file_grpc_nft_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_grpc_nft_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   17,
+			NumMessages:   20,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
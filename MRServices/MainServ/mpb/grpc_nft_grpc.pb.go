@@ -22,7 +22,11 @@ const (
 	NFTService_GetAptosNFTs_FullMethodName                  = "/mpb.NFTService/GetAptosNFTs"
 	NFTService_GetAptosNFTMetadatas_FullMethodName          = "/mpb.NFTService/GetAptosNFTMetadatas"
 	NFTService_GetAptosNFTsV2_FullMethodName                = "/mpb.NFTService/GetAptosNFTsV2"
+	NFTService_GetStoredNFTs_FullMethodName                 = "/mpb.NFTService/GetStoredNFTs"
 	NFTService_GetAptosNFTOwner_FullMethodName              = "/mpb.NFTService/GetAptosNFTOwner"
+	NFTService_RemoveWalletNFTs_FullMethodName              = "/mpb.NFTService/RemoveWalletNFTs"
+	NFTService_DeleteUserNFTs_FullMethodName                = "/mpb.NFTService/DeleteUserNFTs"
+	NFTService_MergeUserNFTs_FullMethodName                 = "/mpb.NFTService/MergeUserNFTs"
 	NFTService_AdminGetAptosNFTsInCollection_FullMethodName = "/mpb.NFTService/AdminGetAptosNFTsInCollection"
 	NFTService_AdminGetCollectionNFTBuyers_FullMethodName   = "/mpb.NFTService/AdminGetCollectionNFTBuyers"
 	NFTService_AdminGetCollectionNFTOffers_FullMethodName   = "/mpb.NFTService/AdminGetCollectionNFTOffers"
@@ -35,7 +39,11 @@ type NFTServiceClient interface {
 	GetAptosNFTs(ctx context.Context, in *ReqGetAptosNFTs, opts ...grpc.CallOption) (*ResGetAptosNFTs, error)
 	GetAptosNFTMetadatas(ctx context.Context, in *ReqGetAptosNFTMetadatas, opts ...grpc.CallOption) (*ResGetAptosNFTMetadatas, error)
 	GetAptosNFTsV2(ctx context.Context, in *ReqGetAptosNFTsV2, opts ...grpc.CallOption) (*ResGetAptosNFTsV2, error)
+	GetStoredNFTs(ctx context.Context, in *ReqUserId, opts ...grpc.CallOption) (*ResGetAptosNFTsV2, error)
 	GetAptosNFTOwner(ctx context.Context, in *ReqGetAptosNFTOwner, opts ...grpc.CallOption) (*ResGetAptosNFTOwner, error)
+	RemoveWalletNFTs(ctx context.Context, in *ReqRemoveWalletNFTs, opts ...grpc.CallOption) (*Empty, error)
+	DeleteUserNFTs(ctx context.Context, in *ReqDeleteUserNFTs, opts ...grpc.CallOption) (*Empty, error)
+	MergeUserNFTs(ctx context.Context, in *ReqMergeUserNFTs, opts ...grpc.CallOption) (*Empty, error)
 	AdminGetAptosNFTsInCollection(ctx context.Context, in *ReqAdminGetAptosNFTsInCollection, opts ...grpc.CallOption) (*ResAdminGetAptosNFTsInCollection, error)
 	AdminGetCollectionNFTBuyers(ctx context.Context, in *ReqAdminGetCollectionNFTBuyers, opts ...grpc.CallOption) (*ResAdminGetCollectionNFTBuyers, error)
 	AdminGetCollectionNFTOffers(ctx context.Context, in *ReqAdminGetCollectionNFTOffers, opts ...grpc.CallOption) (*ResAdminGetCollectionNFTOffers, error)
@@ -76,6 +84,15 @@ func (c *nFTServiceClient) GetAptosNFTsV2(ctx context.Context, in *ReqGetAptosNF
 	return out, nil
 }
 
+func (c *nFTServiceClient) GetStoredNFTs(ctx context.Context, in *ReqUserId, opts ...grpc.CallOption) (*ResGetAptosNFTsV2, error) {
+	out := new(ResGetAptosNFTsV2)
+	err := c.cc.Invoke(ctx, NFTService_GetStoredNFTs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *nFTServiceClient) GetAptosNFTOwner(ctx context.Context, in *ReqGetAptosNFTOwner, opts ...grpc.CallOption) (*ResGetAptosNFTOwner, error) {
 	out := new(ResGetAptosNFTOwner)
 	err := c.cc.Invoke(ctx, NFTService_GetAptosNFTOwner_FullMethodName, in, out, opts...)
@@ -85,6 +102,33 @@ func (c *nFTServiceClient) GetAptosNFTOwner(ctx context.Context, in *ReqGetAptos
 	return out, nil
 }
 
+func (c *nFTServiceClient) RemoveWalletNFTs(ctx context.Context, in *ReqRemoveWalletNFTs, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, NFTService_RemoveWalletNFTs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nFTServiceClient) DeleteUserNFTs(ctx context.Context, in *ReqDeleteUserNFTs, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, NFTService_DeleteUserNFTs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nFTServiceClient) MergeUserNFTs(ctx context.Context, in *ReqMergeUserNFTs, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, NFTService_MergeUserNFTs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *nFTServiceClient) AdminGetAptosNFTsInCollection(ctx context.Context, in *ReqAdminGetAptosNFTsInCollection, opts ...grpc.CallOption) (*ResAdminGetAptosNFTsInCollection, error) {
 	out := new(ResAdminGetAptosNFTsInCollection)
 	err := c.cc.Invoke(ctx, NFTService_AdminGetAptosNFTsInCollection_FullMethodName, in, out, opts...)
@@ -119,7 +163,11 @@ type NFTServiceServer interface {
 	GetAptosNFTs(context.Context, *ReqGetAptosNFTs) (*ResGetAptosNFTs, error)
 	GetAptosNFTMetadatas(context.Context, *ReqGetAptosNFTMetadatas) (*ResGetAptosNFTMetadatas, error)
 	GetAptosNFTsV2(context.Context, *ReqGetAptosNFTsV2) (*ResGetAptosNFTsV2, error)
+	GetStoredNFTs(context.Context, *ReqUserId) (*ResGetAptosNFTsV2, error)
 	GetAptosNFTOwner(context.Context, *ReqGetAptosNFTOwner) (*ResGetAptosNFTOwner, error)
+	RemoveWalletNFTs(context.Context, *ReqRemoveWalletNFTs) (*Empty, error)
+	DeleteUserNFTs(context.Context, *ReqDeleteUserNFTs) (*Empty, error)
+	MergeUserNFTs(context.Context, *ReqMergeUserNFTs) (*Empty, error)
 	AdminGetAptosNFTsInCollection(context.Context, *ReqAdminGetAptosNFTsInCollection) (*ResAdminGetAptosNFTsInCollection, error)
 	AdminGetCollectionNFTBuyers(context.Context, *ReqAdminGetCollectionNFTBuyers) (*ResAdminGetCollectionNFTBuyers, error)
 	AdminGetCollectionNFTOffers(context.Context, *ReqAdminGetCollectionNFTOffers) (*ResAdminGetCollectionNFTOffers, error)
@@ -139,9 +187,21 @@ func (UnimplementedNFTServiceServer) GetAptosNFTMetadatas(context.Context, *ReqG
 func (UnimplementedNFTServiceServer) GetAptosNFTsV2(context.Context, *ReqGetAptosNFTsV2) (*ResGetAptosNFTsV2, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAptosNFTsV2 not implemented")
 }
+func (UnimplementedNFTServiceServer) GetStoredNFTs(context.Context, *ReqUserId) (*ResGetAptosNFTsV2, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStoredNFTs not implemented")
+}
 func (UnimplementedNFTServiceServer) GetAptosNFTOwner(context.Context, *ReqGetAptosNFTOwner) (*ResGetAptosNFTOwner, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAptosNFTOwner not implemented")
 }
+func (UnimplementedNFTServiceServer) RemoveWalletNFTs(context.Context, *ReqRemoveWalletNFTs) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveWalletNFTs not implemented")
+}
+func (UnimplementedNFTServiceServer) DeleteUserNFTs(context.Context, *ReqDeleteUserNFTs) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteUserNFTs not implemented")
+}
+func (UnimplementedNFTServiceServer) MergeUserNFTs(context.Context, *ReqMergeUserNFTs) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeUserNFTs not implemented")
+}
 func (UnimplementedNFTServiceServer) AdminGetAptosNFTsInCollection(context.Context, *ReqAdminGetAptosNFTsInCollection) (*ResAdminGetAptosNFTsInCollection, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AdminGetAptosNFTsInCollection not implemented")
 }
@@ -218,6 +278,24 @@ func _NFTService_GetAptosNFTsV2_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _NFTService_GetStoredNFTs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqUserId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NFTServiceServer).GetStoredNFTs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NFTService_GetStoredNFTs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NFTServiceServer).GetStoredNFTs(ctx, req.(*ReqUserId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _NFTService_GetAptosNFTOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ReqGetAptosNFTOwner)
 	if err := dec(in); err != nil {
@@ -236,6 +314,60 @@ func _NFTService_GetAptosNFTOwner_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _NFTService_RemoveWalletNFTs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqRemoveWalletNFTs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NFTServiceServer).RemoveWalletNFTs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NFTService_RemoveWalletNFTs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NFTServiceServer).RemoveWalletNFTs(ctx, req.(*ReqRemoveWalletNFTs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NFTService_DeleteUserNFTs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqDeleteUserNFTs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NFTServiceServer).DeleteUserNFTs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NFTService_DeleteUserNFTs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NFTServiceServer).DeleteUserNFTs(ctx, req.(*ReqDeleteUserNFTs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NFTService_MergeUserNFTs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqMergeUserNFTs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NFTServiceServer).MergeUserNFTs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NFTService_MergeUserNFTs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NFTServiceServer).MergeUserNFTs(ctx, req.(*ReqMergeUserNFTs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _NFTService_AdminGetAptosNFTsInCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ReqAdminGetAptosNFTsInCollection)
 	if err := dec(in); err != nil {
@@ -309,10 +441,26 @@ var NFTService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetAptosNFTsV2",
 			Handler:    _NFTService_GetAptosNFTsV2_Handler,
 		},
+		{
+			MethodName: "GetStoredNFTs",
+			Handler:    _NFTService_GetStoredNFTs_Handler,
+		},
 		{
 			MethodName: "GetAptosNFTOwner",
 			Handler:    _NFTService_GetAptosNFTOwner_Handler,
 		},
+		{
+			MethodName: "RemoveWalletNFTs",
+			Handler:    _NFTService_RemoveWalletNFTs_Handler,
+		},
+		{
+			MethodName: "DeleteUserNFTs",
+			Handler:    _NFTService_DeleteUserNFTs_Handler,
+		},
+		{
+			MethodName: "MergeUserNFTs",
+			Handler:    _NFTService_MergeUserNFTs_Handler,
+		},
 		{
 			MethodName: "AdminGetAptosNFTsInCollection",
 			Handler:    _NFTService_AdminGetAptosNFTsInCollection_Handler,
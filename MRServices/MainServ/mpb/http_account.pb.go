@@ -28,6 +28,8 @@ type CReqLoginByPassword struct {
 
 	Account  string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
 	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	Device   string `protobuf:"bytes,3,opt,name=device,proto3" json:"device,omitempty"`
+	DeviceId string `protobuf:"bytes,4,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
 }
 
 func (x *CReqLoginByPassword) Reset() {
@@ -76,14 +78,31 @@ func (x *CReqLoginByPassword) GetPassword() string {
 	return ""
 }
 
+func (x *CReqLoginByPassword) GetDevice() string {
+	if x != nil {
+		return x.Device
+	}
+	return ""
+}
+
+func (x *CReqLoginByPassword) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
 type CResLoginByPassword struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Account   *AccountInfo `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
-	Resources string       `protobuf:"bytes,2,opt,name=resources,proto3" json:"resources,omitempty"`
-	Token     string       `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+	Account      *AccountInfo `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Resources    string       `protobuf:"bytes,2,opt,name=resources,proto3" json:"resources,omitempty"`
+	Token        string       `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+	RefreshToken string       `protobuf:"bytes,4,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	TotpRequired bool         `protobuf:"varint,5,opt,name=totp_required,json=totpRequired,proto3" json:"totp_required,omitempty"`
+	LoginTicket  string       `protobuf:"bytes,6,opt,name=login_ticket,json=loginTicket,proto3" json:"login_ticket,omitempty"`
 }
 
 func (x *CResLoginByPassword) Reset() {
@@ -139,6 +158,74 @@ func (x *CResLoginByPassword) GetToken() string {
 	return ""
 }
 
+func (x *CResLoginByPassword) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+func (x *CResLoginByPassword) GetTotpRequired() bool {
+	if x != nil {
+		return x.TotpRequired
+	}
+	return false
+}
+
+func (x *CResLoginByPassword) GetLoginTicket() string {
+	if x != nil {
+		return x.LoginTicket
+	}
+	return ""
+}
+
+type CReqGenerateNonce struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WalletAddr string `protobuf:"bytes,1,opt,name=wallet_addr,json=walletAddr,proto3" json:"wallet_addr,omitempty"`
+}
+
+func (x *CReqGenerateNonce) Reset() {
+	*x = CReqGenerateNonce{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_http_account_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CReqGenerateNonce) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CReqGenerateNonce) ProtoMessage() {}
+
+func (x *CReqGenerateNonce) ProtoReflect() protoreflect.Message {
+	mi := &file_http_account_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CReqGenerateNonce.ProtoReflect.Descriptor instead.
+func (*CReqGenerateNonce) Descriptor() ([]byte, []int) {
+	return file_http_account_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CReqGenerateNonce) GetWalletAddr() string {
+	if x != nil {
+		return x.WalletAddr
+	}
+	return ""
+}
+
 type CResGenerateNonce struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -150,7 +237,7 @@ type CResGenerateNonce struct {
 func (x *CResGenerateNonce) Reset() {
 	*x = CResGenerateNonce{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_http_account_proto_msgTypes[2]
+		mi := &file_http_account_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -163,7 +250,7 @@ func (x *CResGenerateNonce) String() string {
 func (*CResGenerateNonce) ProtoMessage() {}
 
 func (x *CResGenerateNonce) ProtoReflect() protoreflect.Message {
-	mi := &file_http_account_proto_msgTypes[2]
+	mi := &file_http_account_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -176,7 +263,7 @@ func (x *CResGenerateNonce) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CResGenerateNonce.ProtoReflect.Descriptor instead.
 func (*CResGenerateNonce) Descriptor() ([]byte, []int) {
-	return file_http_account_proto_rawDescGZIP(), []int{2}
+	return file_http_account_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *CResGenerateNonce) GetNonce() string {
@@ -195,12 +282,15 @@ type CReqWebLoginByWallet struct {
 	PubKey         string `protobuf:"bytes,2,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
 	AptosFullMsg   string `protobuf:"bytes,3,opt,name=aptos_full_msg,json=aptosFullMsg,proto3" json:"aptos_full_msg,omitempty"`
 	AptosSignature string `protobuf:"bytes,4,opt,name=aptos_signature,json=aptosSignature,proto3" json:"aptos_signature,omitempty"`
+	Device         string `protobuf:"bytes,5,opt,name=device,proto3" json:"device,omitempty"`
+	DeviceId       string `protobuf:"bytes,6,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	ReferrerCode   string `protobuf:"bytes,7,opt,name=referrer_code,json=referrerCode,proto3" json:"referrer_code,omitempty"`
 }
 
 func (x *CReqWebLoginByWallet) Reset() {
 	*x = CReqWebLoginByWallet{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_http_account_proto_msgTypes[3]
+		mi := &file_http_account_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -213,7 +303,7 @@ func (x *CReqWebLoginByWallet) String() string {
 func (*CReqWebLoginByWallet) ProtoMessage() {}
 
 func (x *CReqWebLoginByWallet) ProtoReflect() protoreflect.Message {
-	mi := &file_http_account_proto_msgTypes[3]
+	mi := &file_http_account_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -226,7 +316,7 @@ func (x *CReqWebLoginByWallet) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CReqWebLoginByWallet.ProtoReflect.Descriptor instead.
 func (*CReqWebLoginByWallet) Descriptor() ([]byte, []int) {
-	return file_http_account_proto_rawDescGZIP(), []int{3}
+	return file_http_account_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *CReqWebLoginByWallet) GetWalletAddr() string {
@@ -257,18 +347,40 @@ func (x *CReqWebLoginByWallet) GetAptosSignature() string {
 	return ""
 }
 
+func (x *CReqWebLoginByWallet) GetDevice() string {
+	if x != nil {
+		return x.Device
+	}
+	return ""
+}
+
+func (x *CReqWebLoginByWallet) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+func (x *CReqWebLoginByWallet) GetReferrerCode() string {
+	if x != nil {
+		return x.ReferrerCode
+	}
+	return ""
+}
+
 type CReqSendEmailBindCode struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Email string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Email    string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Language string `protobuf:"bytes,2,opt,name=language,proto3" json:"language,omitempty"` // language of the email
 }
 
 func (x *CReqSendEmailBindCode) Reset() {
 	*x = CReqSendEmailBindCode{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_http_account_proto_msgTypes[4]
+		mi := &file_http_account_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -281,7 +393,7 @@ func (x *CReqSendEmailBindCode) String() string {
 func (*CReqSendEmailBindCode) ProtoMessage() {}
 
 func (x *CReqSendEmailBindCode) ProtoReflect() protoreflect.Message {
-	mi := &file_http_account_proto_msgTypes[4]
+	mi := &file_http_account_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -294,7 +406,7 @@ func (x *CReqSendEmailBindCode) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CReqSendEmailBindCode.ProtoReflect.Descriptor instead.
 func (*CReqSendEmailBindCode) Descriptor() ([]byte, []int) {
-	return file_http_account_proto_rawDescGZIP(), []int{4}
+	return file_http_account_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *CReqSendEmailBindCode) GetEmail() string {
@@ -304,20 +416,28 @@ func (x *CReqSendEmailBindCode) GetEmail() string {
 	return ""
 }
 
+func (x *CReqSendEmailBindCode) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
 type CResWebLoginByWallet struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Account   *AccountInfo `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
-	Resources string       `protobuf:"bytes,2,opt,name=resources,proto3" json:"resources,omitempty"`
-	Token     string       `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+	Account      *AccountInfo `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Resources    string       `protobuf:"bytes,2,opt,name=resources,proto3" json:"resources,omitempty"`
+	Token        string       `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+	RefreshToken string       `protobuf:"bytes,4,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
 }
 
 func (x *CResWebLoginByWallet) Reset() {
 	*x = CResWebLoginByWallet{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_http_account_proto_msgTypes[5]
+		mi := &file_http_account_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -330,7 +450,7 @@ func (x *CResWebLoginByWallet) String() string {
 func (*CResWebLoginByWallet) ProtoMessage() {}
 
 func (x *CResWebLoginByWallet) ProtoReflect() protoreflect.Message {
-	mi := &file_http_account_proto_msgTypes[5]
+	mi := &file_http_account_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -343,7 +463,7 @@ func (x *CResWebLoginByWallet) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CResWebLoginByWallet.ProtoReflect.Descriptor instead.
 func (*CResWebLoginByWallet) Descriptor() ([]byte, []int) {
-	return file_http_account_proto_rawDescGZIP(), []int{5}
+	return file_http_account_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *CResWebLoginByWallet) GetAccount() *AccountInfo {
@@ -367,19 +487,28 @@ func (x *CResWebLoginByWallet) GetToken() string {
 	return ""
 }
 
+func (x *CResWebLoginByWallet) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
 type CReqWebBindEmail struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Email string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
-	Code  string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Email    string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Code     string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Device   string `protobuf:"bytes,3,opt,name=device,proto3" json:"device,omitempty"`
+	DeviceId string `protobuf:"bytes,4,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
 }
 
 func (x *CReqWebBindEmail) Reset() {
 	*x = CReqWebBindEmail{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_http_account_proto_msgTypes[6]
+		mi := &file_http_account_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -392,7 +521,7 @@ func (x *CReqWebBindEmail) String() string {
 func (*CReqWebBindEmail) ProtoMessage() {}
 
 func (x *CReqWebBindEmail) ProtoReflect() protoreflect.Message {
-	mi := &file_http_account_proto_msgTypes[6]
+	mi := &file_http_account_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -405,7 +534,7 @@ func (x *CReqWebBindEmail) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CReqWebBindEmail.ProtoReflect.Descriptor instead.
 func (*CReqWebBindEmail) Descriptor() ([]byte, []int) {
-	return file_http_account_proto_rawDescGZIP(), []int{6}
+	return file_http_account_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *CReqWebBindEmail) GetEmail() string {
@@ -422,20 +551,35 @@ func (x *CReqWebBindEmail) GetCode() string {
 	return ""
 }
 
+func (x *CReqWebBindEmail) GetDevice() string {
+	if x != nil {
+		return x.Device
+	}
+	return ""
+}
+
+func (x *CReqWebBindEmail) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
 type CResWebBindEmail struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Account   *AccountInfo `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
-	Resources string       `protobuf:"bytes,2,opt,name=resources,proto3" json:"resources,omitempty"`
-	Token     string       `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+	Account      *AccountInfo `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Resources    string       `protobuf:"bytes,2,opt,name=resources,proto3" json:"resources,omitempty"`
+	Token        string       `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+	RefreshToken string       `protobuf:"bytes,4,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
 }
 
 func (x *CResWebBindEmail) Reset() {
 	*x = CResWebBindEmail{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_http_account_proto_msgTypes[7]
+		mi := &file_http_account_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -448,7 +592,7 @@ func (x *CResWebBindEmail) String() string {
 func (*CResWebBindEmail) ProtoMessage() {}
 
 func (x *CResWebBindEmail) ProtoReflect() protoreflect.Message {
-	mi := &file_http_account_proto_msgTypes[7]
+	mi := &file_http_account_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -461,7 +605,7 @@ func (x *CResWebBindEmail) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CResWebBindEmail.ProtoReflect.Descriptor instead.
 func (*CResWebBindEmail) Descriptor() ([]byte, []int) {
-	return file_http_account_proto_rawDescGZIP(), []int{7}
+	return file_http_account_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *CResWebBindEmail) GetAccount() *AccountInfo {
@@ -485,6 +629,13 @@ func (x *CResWebBindEmail) GetToken() string {
 	return ""
 }
 
+func (x *CResWebBindEmail) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
 type CResGetAccountInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -496,7 +647,7 @@ type CResGetAccountInfo struct {
 func (x *CResGetAccountInfo) Reset() {
 	*x = CResGetAccountInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_http_account_proto_msgTypes[8]
+		mi := &file_http_account_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -509,7 +660,7 @@ func (x *CResGetAccountInfo) String() string {
 func (*CResGetAccountInfo) ProtoMessage() {}
 
 func (x *CResGetAccountInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_http_account_proto_msgTypes[8]
+	mi := &file_http_account_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -522,7 +673,7 @@ func (x *CResGetAccountInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CResGetAccountInfo.ProtoReflect.Descriptor instead.
 func (*CResGetAccountInfo) Descriptor() ([]byte, []int) {
-	return file_http_account_proto_rawDescGZIP(), []int{8}
+	return file_http_account_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *CResGetAccountInfo) GetAccount() *AccountInfo {
@@ -543,7 +694,7 @@ type CResGetAptosResources struct {
 func (x *CResGetAptosResources) Reset() {
 	*x = CResGetAptosResources{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_http_account_proto_msgTypes[9]
+		mi := &file_http_account_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -556,7 +707,7 @@ func (x *CResGetAptosResources) String() string {
 func (*CResGetAptosResources) ProtoMessage() {}
 
 func (x *CResGetAptosResources) ProtoReflect() protoreflect.Message {
-	mi := &file_http_account_proto_msgTypes[9]
+	mi := &file_http_account_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -569,7 +720,7 @@ func (x *CResGetAptosResources) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CResGetAptosResources.ProtoReflect.Descriptor instead.
 func (*CResGetAptosResources) Descriptor() ([]byte, []int) {
-	return file_http_account_proto_rawDescGZIP(), []int{9}
+	return file_http_account_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *CResGetAptosResources) GetResources() string {
@@ -586,12 +737,13 @@ type CReqChangePassword struct {
 
 	OldPassword string `protobuf:"bytes,1,opt,name=old_password,json=oldPassword,proto3" json:"old_password,omitempty"`
 	NewPassword string `protobuf:"bytes,2,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`
+	TotpCode    string `protobuf:"bytes,3,opt,name=totp_code,json=totpCode,proto3" json:"totp_code,omitempty"`
 }
 
 func (x *CReqChangePassword) Reset() {
 	*x = CReqChangePassword{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_http_account_proto_msgTypes[10]
+		mi := &file_http_account_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -604,7 +756,7 @@ func (x *CReqChangePassword) String() string {
 func (*CReqChangePassword) ProtoMessage() {}
 
 func (x *CReqChangePassword) ProtoReflect() protoreflect.Message {
-	mi := &file_http_account_proto_msgTypes[10]
+	mi := &file_http_account_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -617,7 +769,7 @@ func (x *CReqChangePassword) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CReqChangePassword.ProtoReflect.Descriptor instead.
 func (*CReqChangePassword) Descriptor() ([]byte, []int) {
-	return file_http_account_proto_rawDescGZIP(), []int{10}
+	return file_http_account_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *CReqChangePassword) GetOldPassword() string {
@@ -634,18 +786,26 @@ func (x *CReqChangePassword) GetNewPassword() string {
 	return ""
 }
 
+func (x *CReqChangePassword) GetTotpCode() string {
+	if x != nil {
+		return x.TotpCode
+	}
+	return ""
+}
+
 type CReqSendEmailResetPasswordCode struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Email string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Email    string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Language string `protobuf:"bytes,2,opt,name=language,proto3" json:"language,omitempty"` // language of the email
 }
 
 func (x *CReqSendEmailResetPasswordCode) Reset() {
 	*x = CReqSendEmailResetPasswordCode{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_http_account_proto_msgTypes[11]
+		mi := &file_http_account_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -658,7 +818,7 @@ func (x *CReqSendEmailResetPasswordCode) String() string {
 func (*CReqSendEmailResetPasswordCode) ProtoMessage() {}
 
 func (x *CReqSendEmailResetPasswordCode) ProtoReflect() protoreflect.Message {
-	mi := &file_http_account_proto_msgTypes[11]
+	mi := &file_http_account_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -671,7 +831,7 @@ func (x *CReqSendEmailResetPasswordCode) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CReqSendEmailResetPasswordCode.ProtoReflect.Descriptor instead.
 func (*CReqSendEmailResetPasswordCode) Descriptor() ([]byte, []int) {
-	return file_http_account_proto_rawDescGZIP(), []int{11}
+	return file_http_account_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *CReqSendEmailResetPasswordCode) GetEmail() string {
@@ -681,6 +841,13 @@ func (x *CReqSendEmailResetPasswordCode) GetEmail() string {
 	return ""
 }
 
+func (x *CReqSendEmailResetPasswordCode) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
 type CReqCheckEmailResetPasswordCode struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -693,7 +860,7 @@ type CReqCheckEmailResetPasswordCode struct {
 func (x *CReqCheckEmailResetPasswordCode) Reset() {
 	*x = CReqCheckEmailResetPasswordCode{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_http_account_proto_msgTypes[12]
+		mi := &file_http_account_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -706,7 +873,7 @@ func (x *CReqCheckEmailResetPasswordCode) String() string {
 func (*CReqCheckEmailResetPasswordCode) ProtoMessage() {}
 
 func (x *CReqCheckEmailResetPasswordCode) ProtoReflect() protoreflect.Message {
-	mi := &file_http_account_proto_msgTypes[12]
+	mi := &file_http_account_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -719,7 +886,7 @@ func (x *CReqCheckEmailResetPasswordCode) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CReqCheckEmailResetPasswordCode.ProtoReflect.Descriptor instead.
 func (*CReqCheckEmailResetPasswordCode) Descriptor() ([]byte, []int) {
-	return file_http_account_proto_rawDescGZIP(), []int{12}
+	return file_http_account_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *CReqCheckEmailResetPasswordCode) GetEmail() string {
@@ -747,7 +914,7 @@ type CResCheckEmailResetPasswordCode struct {
 func (x *CResCheckEmailResetPasswordCode) Reset() {
 	*x = CResCheckEmailResetPasswordCode{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_http_account_proto_msgTypes[13]
+		mi := &file_http_account_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -760,7 +927,7 @@ func (x *CResCheckEmailResetPasswordCode) String() string {
 func (*CResCheckEmailResetPasswordCode) ProtoMessage() {}
 
 func (x *CResCheckEmailResetPasswordCode) ProtoReflect() protoreflect.Message {
-	mi := &file_http_account_proto_msgTypes[13]
+	mi := &file_http_account_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -773,7 +940,7 @@ func (x *CResCheckEmailResetPasswordCode) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CResCheckEmailResetPasswordCode.ProtoReflect.Descriptor instead.
 func (*CResCheckEmailResetPasswordCode) Descriptor() ([]byte, []int) {
-	return file_http_account_proto_rawDescGZIP(), []int{13}
+	return file_http_account_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *CResCheckEmailResetPasswordCode) GetNonce() string {
@@ -791,12 +958,13 @@ type CReqResetPasswordByEmail struct {
 	Email    string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
 	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
 	Nonce    string `protobuf:"bytes,3,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	TotpCode string `protobuf:"bytes,4,opt,name=totp_code,json=totpCode,proto3" json:"totp_code,omitempty"`
 }
 
 func (x *CReqResetPasswordByEmail) Reset() {
 	*x = CReqResetPasswordByEmail{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_http_account_proto_msgTypes[14]
+		mi := &file_http_account_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -809,7 +977,7 @@ func (x *CReqResetPasswordByEmail) String() string {
 func (*CReqResetPasswordByEmail) ProtoMessage() {}
 
 func (x *CReqResetPasswordByEmail) ProtoReflect() protoreflect.Message {
-	mi := &file_http_account_proto_msgTypes[14]
+	mi := &file_http_account_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -822,7 +990,7 @@ func (x *CReqResetPasswordByEmail) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CReqResetPasswordByEmail.ProtoReflect.Descriptor instead.
 func (*CReqResetPasswordByEmail) Descriptor() ([]byte, []int) {
-	return file_http_account_proto_rawDescGZIP(), []int{14}
+	return file_http_account_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *CReqResetPasswordByEmail) GetEmail() string {
@@ -846,6 +1014,13 @@ func (x *CReqResetPasswordByEmail) GetNonce() string {
 	return ""
 }
 
+func (x *CReqResetPasswordByEmail) GetTotpCode() string {
+	if x != nil {
+		return x.TotpCode
+	}
+	return ""
+}
+
 type CReqResetPasswordByEmailAndVCode struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -854,12 +1029,13 @@ type CReqResetPasswordByEmailAndVCode struct {
 	Email    string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
 	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
 	Code     string `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
+	TotpCode string `protobuf:"bytes,4,opt,name=totp_code,json=totpCode,proto3" json:"totp_code,omitempty"`
 }
 
 func (x *CReqResetPasswordByEmailAndVCode) Reset() {
 	*x = CReqResetPasswordByEmailAndVCode{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_http_account_proto_msgTypes[15]
+		mi := &file_http_account_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -872,7 +1048,7 @@ func (x *CReqResetPasswordByEmailAndVCode) String() string {
 func (*CReqResetPasswordByEmailAndVCode) ProtoMessage() {}
 
 func (x *CReqResetPasswordByEmailAndVCode) ProtoReflect() protoreflect.Message {
-	mi := &file_http_account_proto_msgTypes[15]
+	mi := &file_http_account_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -885,7 +1061,7 @@ func (x *CReqResetPasswordByEmailAndVCode) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CReqResetPasswordByEmailAndVCode.ProtoReflect.Descriptor instead.
 func (*CReqResetPasswordByEmailAndVCode) Descriptor() ([]byte, []int) {
-	return file_http_account_proto_rawDescGZIP(), []int{15}
+	return file_http_account_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *CReqResetPasswordByEmailAndVCode) GetEmail() string {
@@ -909,152 +1085,2638 @@ func (x *CReqResetPasswordByEmailAndVCode) GetCode() string {
 	return ""
 }
 
-var File_http_account_proto protoreflect.FileDescriptor
+func (x *CReqResetPasswordByEmailAndVCode) GetTotpCode() string {
+	if x != nil {
+		return x.TotpCode
+	}
+	return ""
+}
 
-var file_http_account_proto_rawDesc = []byte{
-	0x0a, 0x12, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x03, 0x6d, 0x70, 0x62, 0x1a, 0x0c, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
-	0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x4b, 0x0a, 0x13, 0x43, 0x52, 0x65, 0x71, 0x4c,
-	0x6f, 0x67, 0x69, 0x6e, 0x42, 0x79, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x18,
-	0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73,
-	0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73,
-	0x77, 0x6f, 0x72, 0x64, 0x22, 0x75, 0x0a, 0x13, 0x43, 0x52, 0x65, 0x73, 0x4c, 0x6f, 0x67, 0x69,
-	0x6e, 0x42, 0x79, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x2a, 0x0a, 0x07, 0x61,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d,
-	0x70, 0x62, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07,
-	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x29, 0x0a, 0x11, 0x43,
-	0x52, 0x65, 0x73, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x6e, 0x63, 0x65,
-	0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x22, 0x9f, 0x01, 0x0a, 0x14, 0x43, 0x52, 0x65, 0x71, 0x57,
-	0x65, 0x62, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x42, 0x79, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x12,
-	0x1f, 0x0a, 0x0b, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72,
-	0x12, 0x17, 0x0a, 0x07, 0x70, 0x75, 0x62, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x70, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x12, 0x24, 0x0a, 0x0e, 0x61, 0x70, 0x74,
-	0x6f, 0x73, 0x5f, 0x66, 0x75, 0x6c, 0x6c, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0c, 0x61, 0x70, 0x74, 0x6f, 0x73, 0x46, 0x75, 0x6c, 0x6c, 0x4d, 0x73, 0x67, 0x12,
-	0x27, 0x0a, 0x0f, 0x61, 0x70, 0x74, 0x6f, 0x73, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
-	0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x70, 0x74, 0x6f, 0x73, 0x53,
-	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x2d, 0x0a, 0x15, 0x43, 0x52, 0x65, 0x71,
-	0x53, 0x65, 0x6e, 0x64, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x42, 0x69, 0x6e, 0x64, 0x43, 0x6f, 0x64,
-	0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0x76, 0x0a, 0x14, 0x43, 0x52, 0x65, 0x73, 0x57,
-	0x65, 0x62, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x42, 0x79, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x12,
-	0x2a, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x10, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e,
-	0x66, 0x6f, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72,
-	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b,
-	0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22,
-	0x3c, 0x0a, 0x10, 0x43, 0x52, 0x65, 0x71, 0x57, 0x65, 0x62, 0x42, 0x69, 0x6e, 0x64, 0x45, 0x6d,
-	0x61, 0x69, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x72, 0x0a,
-	0x10, 0x43, 0x52, 0x65, 0x73, 0x57, 0x65, 0x62, 0x42, 0x69, 0x6e, 0x64, 0x45, 0x6d, 0x61, 0x69,
-	0x6c, 0x12, 0x2a, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x0a,
-	0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74,
-	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65,
-	0x6e, 0x22, 0x40, 0x0a, 0x12, 0x43, 0x52, 0x65, 0x73, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x2a, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x41,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x22, 0x35, 0x0a, 0x15, 0x43, 0x52, 0x65, 0x73, 0x47, 0x65, 0x74, 0x41, 0x70,
-	0x74, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09,
-	0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x22, 0x5a, 0x0a, 0x12, 0x43, 0x52,
-	0x65, 0x71, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
-	0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x6c, 0x64, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x6c, 0x64, 0x50, 0x61, 0x73, 0x73, 0x77,
-	0x6f, 0x72, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x65, 0x77, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77,
-	0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x65, 0x77, 0x50, 0x61,
-	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x36, 0x0a, 0x1e, 0x43, 0x52, 0x65, 0x71, 0x53, 0x65,
-	0x6e, 0x64, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x73, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73,
-	0x77, 0x6f, 0x72, 0x64, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69,
-	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0x4b,
-	0x0a, 0x1f, 0x43, 0x52, 0x65, 0x71, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x45, 0x6d, 0x61, 0x69, 0x6c,
-	0x52, 0x65, 0x73, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x43, 0x6f, 0x64,
-	0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x37, 0x0a, 0x1f, 0x43,
-	0x52, 0x65, 0x73, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x73,
-	0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x14,
-	0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e,
-	0x6f, 0x6e, 0x63, 0x65, 0x22, 0x62, 0x0a, 0x18, 0x43, 0x52, 0x65, 0x71, 0x52, 0x65, 0x73, 0x65,
-	0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x42, 0x79, 0x45, 0x6d, 0x61, 0x69, 0x6c,
-	0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f,
-	0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f,
-	0x72, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x22, 0x68, 0x0a, 0x20, 0x43, 0x52, 0x65, 0x71,
-	0x52, 0x65, 0x73, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x42, 0x79, 0x45,
-	0x6d, 0x61, 0x69, 0x6c, 0x41, 0x6e, 0x64, 0x56, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05,
-	0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61,
-	0x69, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x12,
-	0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f,
-	0x64, 0x65, 0x42, 0x07, 0x5a, 0x05, 0x2e, 0x2f, 0x6d, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+type CReqRefreshToken struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RefreshToken string `protobuf:"bytes,1,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
 }
 
-var (
-	file_http_account_proto_rawDescOnce sync.Once
-	file_http_account_proto_rawDescData = file_http_account_proto_rawDesc
-)
+func (x *CReqRefreshToken) Reset() {
+	*x = CReqRefreshToken{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_http_account_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_http_account_proto_rawDescGZIP() []byte {
-	file_http_account_proto_rawDescOnce.Do(func() {
-		file_http_account_proto_rawDescData = protoimpl.X.CompressGZIP(file_http_account_proto_rawDescData)
-	})
-	return file_http_account_proto_rawDescData
+func (x *CReqRefreshToken) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_http_account_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
-var file_http_account_proto_goTypes = []interface{}{
-	(*CReqLoginByPassword)(nil),              // 0: mpb.CReqLoginByPassword
-	(*CResLoginByPassword)(nil),              // 1: mpb.CResLoginByPassword
-	(*CResGenerateNonce)(nil),                // 2: mpb.CResGenerateNonce
-	(*CReqWebLoginByWallet)(nil),             // 3: mpb.CReqWebLoginByWallet
-	(*CReqSendEmailBindCode)(nil),            // 4: mpb.CReqSendEmailBindCode
-	(*CResWebLoginByWallet)(nil),             // 5: mpb.CResWebLoginByWallet
-	(*CReqWebBindEmail)(nil),                 // 6: mpb.CReqWebBindEmail
-	(*CResWebBindEmail)(nil),                 // 7: mpb.CResWebBindEmail
-	(*CResGetAccountInfo)(nil),               // 8: mpb.CResGetAccountInfo
-	(*CResGetAptosResources)(nil),            // 9: mpb.CResGetAptosResources
-	(*CReqChangePassword)(nil),               // 10: mpb.CReqChangePassword
-	(*CReqSendEmailResetPasswordCode)(nil),   // 11: mpb.CReqSendEmailResetPasswordCode
-	(*CReqCheckEmailResetPasswordCode)(nil),  // 12: mpb.CReqCheckEmailResetPasswordCode
-	(*CResCheckEmailResetPasswordCode)(nil),  // 13: mpb.CResCheckEmailResetPasswordCode
-	(*CReqResetPasswordByEmail)(nil),         // 14: mpb.CReqResetPasswordByEmail
-	(*CReqResetPasswordByEmailAndVCode)(nil), // 15: mpb.CReqResetPasswordByEmailAndVCode
-	(*AccountInfo)(nil),                      // 16: mpb.AccountInfo
+func (*CReqRefreshToken) ProtoMessage() {}
+
+func (x *CReqRefreshToken) ProtoReflect() protoreflect.Message {
+	mi := &file_http_account_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_http_account_proto_depIdxs = []int32{
-	16, // 0: mpb.CResLoginByPassword.account:type_name -> mpb.AccountInfo
-	16, // 1: mpb.CResWebLoginByWallet.account:type_name -> mpb.AccountInfo
-	16, // 2: mpb.CResWebBindEmail.account:type_name -> mpb.AccountInfo
-	16, // 3: mpb.CResGetAccountInfo.account:type_name -> mpb.AccountInfo
-	4,  // [4:4] is the sub-list for method output_type
-	4,  // [4:4] is the sub-list for method input_type
-	4,  // [4:4] is the sub-list for extension type_name
-	4,  // [4:4] is the sub-list for extension extendee
-	0,  // [0:4] is the sub-list for field type_name
+
+// Deprecated: Use CReqRefreshToken.ProtoReflect.Descriptor instead.
+func (*CReqRefreshToken) Descriptor() ([]byte, []int) {
+	return file_http_account_proto_rawDescGZIP(), []int{17}
 }
 
-func init() { file_http_account_proto_init() }
-func file_http_account_proto_init() {
-	if File_http_account_proto != nil {
-		return
+func (x *CReqRefreshToken) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
 	}
-	file_common_proto_init()
-	if !protoimpl.UnsafeEnabled {
-		file_http_account_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+	return ""
+}
+
+type CResRefreshToken struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token        string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	RefreshToken string `protobuf:"bytes,2,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+}
+
+func (x *CResRefreshToken) Reset() {
+	*x = CResRefreshToken{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_http_account_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CResRefreshToken) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CResRefreshToken) ProtoMessage() {}
+
+func (x *CResRefreshToken) ProtoReflect() protoreflect.Message {
+	mi := &file_http_account_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CResRefreshToken.ProtoReflect.Descriptor instead.
+func (*CResRefreshToken) Descriptor() ([]byte, []int) {
+	return file_http_account_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CResRefreshToken) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *CResRefreshToken) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+type CResGetSessions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sessions []*SessionInfo `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"`
+}
+
+func (x *CResGetSessions) Reset() {
+	*x = CResGetSessions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_http_account_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CResGetSessions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CResGetSessions) ProtoMessage() {}
+
+func (x *CResGetSessions) ProtoReflect() protoreflect.Message {
+	mi := &file_http_account_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CResGetSessions.ProtoReflect.Descriptor instead.
+func (*CResGetSessions) Descriptor() ([]byte, []int) {
+	return file_http_account_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CResGetSessions) GetSessions() []*SessionInfo {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
+type CReqRevokeSession struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionId string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+}
+
+func (x *CReqRevokeSession) Reset() {
+	*x = CReqRevokeSession{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_http_account_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CReqRevokeSession) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CReqRevokeSession) ProtoMessage() {}
+
+func (x *CReqRevokeSession) ProtoReflect() protoreflect.Message {
+	mi := &file_http_account_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CReqRevokeSession.ProtoReflect.Descriptor instead.
+func (*CReqRevokeSession) Descriptor() ([]byte, []int) {
+	return file_http_account_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *CReqRevokeSession) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+type CReqLoginByTOTP struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LoginTicket string `protobuf:"bytes,1,opt,name=login_ticket,json=loginTicket,proto3" json:"login_ticket,omitempty"`
+	Code        string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+}
+
+func (x *CReqLoginByTOTP) Reset() {
+	*x = CReqLoginByTOTP{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_http_account_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CReqLoginByTOTP) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CReqLoginByTOTP) ProtoMessage() {}
+
+func (x *CReqLoginByTOTP) ProtoReflect() protoreflect.Message {
+	mi := &file_http_account_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CReqLoginByTOTP.ProtoReflect.Descriptor instead.
+func (*CReqLoginByTOTP) Descriptor() ([]byte, []int) {
+	return file_http_account_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *CReqLoginByTOTP) GetLoginTicket() string {
+	if x != nil {
+		return x.LoginTicket
+	}
+	return ""
+}
+
+func (x *CReqLoginByTOTP) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type CResEnrollTOTP struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Secret     string `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	OtpauthUri string `protobuf:"bytes,2,opt,name=otpauth_uri,json=otpauthUri,proto3" json:"otpauth_uri,omitempty"`
+}
+
+func (x *CResEnrollTOTP) Reset() {
+	*x = CResEnrollTOTP{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_http_account_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CResEnrollTOTP) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CResEnrollTOTP) ProtoMessage() {}
+
+func (x *CResEnrollTOTP) ProtoReflect() protoreflect.Message {
+	mi := &file_http_account_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CResEnrollTOTP.ProtoReflect.Descriptor instead.
+func (*CResEnrollTOTP) Descriptor() ([]byte, []int) {
+	return file_http_account_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *CResEnrollTOTP) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *CResEnrollTOTP) GetOtpauthUri() string {
+	if x != nil {
+		return x.OtpauthUri
+	}
+	return ""
+}
+
+type CReqTOTPCode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+}
+
+func (x *CReqTOTPCode) Reset() {
+	*x = CReqTOTPCode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_http_account_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CReqTOTPCode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CReqTOTPCode) ProtoMessage() {}
+
+func (x *CReqTOTPCode) ProtoReflect() protoreflect.Message {
+	mi := &file_http_account_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CReqTOTPCode.ProtoReflect.Descriptor instead.
+func (*CReqTOTPCode) Descriptor() ([]byte, []int) {
+	return file_http_account_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *CReqTOTPCode) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type CResConfirmTOTP struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RecoveryCodes []string `protobuf:"bytes,1,rep,name=recovery_codes,json=recoveryCodes,proto3" json:"recovery_codes,omitempty"`
+}
+
+func (x *CResConfirmTOTP) Reset() {
+	*x = CResConfirmTOTP{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_http_account_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CResConfirmTOTP) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CResConfirmTOTP) ProtoMessage() {}
+
+func (x *CResConfirmTOTP) ProtoReflect() protoreflect.Message {
+	mi := &file_http_account_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CResConfirmTOTP.ProtoReflect.Descriptor instead.
+func (*CResConfirmTOTP) Descriptor() ([]byte, []int) {
+	return file_http_account_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *CResConfirmTOTP) GetRecoveryCodes() []string {
+	if x != nil {
+		return x.RecoveryCodes
+	}
+	return nil
+}
+
+type CReqRegisterAccount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Email        string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Password     string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	Code         string `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
+	Device       string `protobuf:"bytes,4,opt,name=device,proto3" json:"device,omitempty"`
+	DeviceId     string `protobuf:"bytes,5,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	Os           string `protobuf:"bytes,6,opt,name=os,proto3" json:"os,omitempty"`
+	Platform     string `protobuf:"bytes,7,opt,name=platform,proto3" json:"platform,omitempty"`
+	ReferrerCode string `protobuf:"bytes,8,opt,name=referrer_code,json=referrerCode,proto3" json:"referrer_code,omitempty"`
+}
+
+func (x *CReqRegisterAccount) Reset() {
+	*x = CReqRegisterAccount{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_http_account_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CReqRegisterAccount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CReqRegisterAccount) ProtoMessage() {}
+
+func (x *CReqRegisterAccount) ProtoReflect() protoreflect.Message {
+	mi := &file_http_account_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CReqRegisterAccount.ProtoReflect.Descriptor instead.
+func (*CReqRegisterAccount) Descriptor() ([]byte, []int) {
+	return file_http_account_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *CReqRegisterAccount) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *CReqRegisterAccount) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *CReqRegisterAccount) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *CReqRegisterAccount) GetDevice() string {
+	if x != nil {
+		return x.Device
+	}
+	return ""
+}
+
+func (x *CReqRegisterAccount) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+func (x *CReqRegisterAccount) GetOs() string {
+	if x != nil {
+		return x.Os
+	}
+	return ""
+}
+
+func (x *CReqRegisterAccount) GetPlatform() string {
+	if x != nil {
+		return x.Platform
+	}
+	return ""
+}
+
+func (x *CReqRegisterAccount) GetReferrerCode() string {
+	if x != nil {
+		return x.ReferrerCode
+	}
+	return ""
+}
+
+type CResRegisterAccount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Account      *AccountInfo `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Token        string       `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	RefreshToken string       `protobuf:"bytes,3,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+}
+
+func (x *CResRegisterAccount) Reset() {
+	*x = CResRegisterAccount{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_http_account_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CResRegisterAccount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CResRegisterAccount) ProtoMessage() {}
+
+func (x *CResRegisterAccount) ProtoReflect() protoreflect.Message {
+	mi := &file_http_account_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CResRegisterAccount.ProtoReflect.Descriptor instead.
+func (*CResRegisterAccount) Descriptor() ([]byte, []int) {
+	return file_http_account_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *CResRegisterAccount) GetAccount() *AccountInfo {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *CResRegisterAccount) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *CResRegisterAccount) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+type CReqLinkWallet struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WalletAddr     string `protobuf:"bytes,1,opt,name=wallet_addr,json=walletAddr,proto3" json:"wallet_addr,omitempty"`
+	PubKey         string `protobuf:"bytes,2,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+	AptosFullMsg   string `protobuf:"bytes,3,opt,name=aptos_full_msg,json=aptosFullMsg,proto3" json:"aptos_full_msg,omitempty"`
+	AptosSignature string `protobuf:"bytes,4,opt,name=aptos_signature,json=aptosSignature,proto3" json:"aptos_signature,omitempty"`
+}
+
+func (x *CReqLinkWallet) Reset() {
+	*x = CReqLinkWallet{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_http_account_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CReqLinkWallet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CReqLinkWallet) ProtoMessage() {}
+
+func (x *CReqLinkWallet) ProtoReflect() protoreflect.Message {
+	mi := &file_http_account_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CReqLinkWallet.ProtoReflect.Descriptor instead.
+func (*CReqLinkWallet) Descriptor() ([]byte, []int) {
+	return file_http_account_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *CReqLinkWallet) GetWalletAddr() string {
+	if x != nil {
+		return x.WalletAddr
+	}
+	return ""
+}
+
+func (x *CReqLinkWallet) GetPubKey() string {
+	if x != nil {
+		return x.PubKey
+	}
+	return ""
+}
+
+func (x *CReqLinkWallet) GetAptosFullMsg() string {
+	if x != nil {
+		return x.AptosFullMsg
+	}
+	return ""
+}
+
+func (x *CReqLinkWallet) GetAptosSignature() string {
+	if x != nil {
+		return x.AptosSignature
+	}
+	return ""
+}
+
+type CReqWalletAddr struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WalletAddr string `protobuf:"bytes,1,opt,name=wallet_addr,json=walletAddr,proto3" json:"wallet_addr,omitempty"`
+}
+
+func (x *CReqWalletAddr) Reset() {
+	*x = CReqWalletAddr{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_http_account_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CReqWalletAddr) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CReqWalletAddr) ProtoMessage() {}
+
+func (x *CReqWalletAddr) ProtoReflect() protoreflect.Message {
+	mi := &file_http_account_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CReqWalletAddr.ProtoReflect.Descriptor instead.
+func (*CReqWalletAddr) Descriptor() ([]byte, []int) {
+	return file_http_account_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *CReqWalletAddr) GetWalletAddr() string {
+	if x != nil {
+		return x.WalletAddr
+	}
+	return ""
+}
+
+type CResListWallets struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Wallets []*WalletInfo `protobuf:"bytes,1,rep,name=wallets,proto3" json:"wallets,omitempty"`
+}
+
+func (x *CResListWallets) Reset() {
+	*x = CResListWallets{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_http_account_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CResListWallets) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CResListWallets) ProtoMessage() {}
+
+func (x *CResListWallets) ProtoReflect() protoreflect.Message {
+	mi := &file_http_account_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CResListWallets.ProtoReflect.Descriptor instead.
+func (*CResListWallets) Descriptor() ([]byte, []int) {
+	return file_http_account_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *CResListWallets) GetWallets() []*WalletInfo {
+	if x != nil {
+		return x.Wallets
+	}
+	return nil
+}
+
+type CReqSendEmailChangeCode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NewEmail     string `protobuf:"bytes,1,opt,name=new_email,json=newEmail,proto3" json:"new_email,omitempty"`
+	SkipOldEmail bool   `protobuf:"varint,2,opt,name=skip_old_email,json=skipOldEmail,proto3" json:"skip_old_email,omitempty"`
+}
+
+func (x *CReqSendEmailChangeCode) Reset() {
+	*x = CReqSendEmailChangeCode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_http_account_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CReqSendEmailChangeCode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CReqSendEmailChangeCode) ProtoMessage() {}
+
+func (x *CReqSendEmailChangeCode) ProtoReflect() protoreflect.Message {
+	mi := &file_http_account_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CReqSendEmailChangeCode.ProtoReflect.Descriptor instead.
+func (*CReqSendEmailChangeCode) Descriptor() ([]byte, []int) {
+	return file_http_account_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *CReqSendEmailChangeCode) GetNewEmail() string {
+	if x != nil {
+		return x.NewEmail
+	}
+	return ""
+}
+
+func (x *CReqSendEmailChangeCode) GetSkipOldEmail() bool {
+	if x != nil {
+		return x.SkipOldEmail
+	}
+	return false
+}
+
+type CReqChangeEmail struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NewEmail       string `protobuf:"bytes,1,opt,name=new_email,json=newEmail,proto3" json:"new_email,omitempty"`
+	NewCode        string `protobuf:"bytes,2,opt,name=new_code,json=newCode,proto3" json:"new_code,omitempty"`
+	OldCode        string `protobuf:"bytes,3,opt,name=old_code,json=oldCode,proto3" json:"old_code,omitempty"`
+	WalletAddr     string `protobuf:"bytes,4,opt,name=wallet_addr,json=walletAddr,proto3" json:"wallet_addr,omitempty"`
+	PubKey         string `protobuf:"bytes,5,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+	AptosFullMsg   string `protobuf:"bytes,6,opt,name=aptos_full_msg,json=aptosFullMsg,proto3" json:"aptos_full_msg,omitempty"`
+	AptosSignature string `protobuf:"bytes,7,opt,name=aptos_signature,json=aptosSignature,proto3" json:"aptos_signature,omitempty"`
+	TotpCode       string `protobuf:"bytes,8,opt,name=totp_code,json=totpCode,proto3" json:"totp_code,omitempty"`
+}
+
+func (x *CReqChangeEmail) Reset() {
+	*x = CReqChangeEmail{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_http_account_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CReqChangeEmail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CReqChangeEmail) ProtoMessage() {}
+
+func (x *CReqChangeEmail) ProtoReflect() protoreflect.Message {
+	mi := &file_http_account_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CReqChangeEmail.ProtoReflect.Descriptor instead.
+func (*CReqChangeEmail) Descriptor() ([]byte, []int) {
+	return file_http_account_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *CReqChangeEmail) GetNewEmail() string {
+	if x != nil {
+		return x.NewEmail
+	}
+	return ""
+}
+
+func (x *CReqChangeEmail) GetNewCode() string {
+	if x != nil {
+		return x.NewCode
+	}
+	return ""
+}
+
+func (x *CReqChangeEmail) GetOldCode() string {
+	if x != nil {
+		return x.OldCode
+	}
+	return ""
+}
+
+func (x *CReqChangeEmail) GetWalletAddr() string {
+	if x != nil {
+		return x.WalletAddr
+	}
+	return ""
+}
+
+func (x *CReqChangeEmail) GetPubKey() string {
+	if x != nil {
+		return x.PubKey
+	}
+	return ""
+}
+
+func (x *CReqChangeEmail) GetAptosFullMsg() string {
+	if x != nil {
+		return x.AptosFullMsg
+	}
+	return ""
+}
+
+func (x *CReqChangeEmail) GetAptosSignature() string {
+	if x != nil {
+		return x.AptosSignature
+	}
+	return ""
+}
+
+func (x *CReqChangeEmail) GetTotpCode() string {
+	if x != nil {
+		return x.TotpCode
+	}
+	return ""
+}
+
+type CResChangeEmail struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Account *AccountInfo `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+}
+
+func (x *CResChangeEmail) Reset() {
+	*x = CResChangeEmail{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_http_account_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CResChangeEmail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CResChangeEmail) ProtoMessage() {}
+
+func (x *CResChangeEmail) ProtoReflect() protoreflect.Message {
+	mi := &file_http_account_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CResChangeEmail.ProtoReflect.Descriptor instead.
+func (*CResChangeEmail) Descriptor() ([]byte, []int) {
+	return file_http_account_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *CResChangeEmail) GetAccount() *AccountInfo {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+type CReqRequestAccountDeletion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TotpCode       string `protobuf:"bytes,1,opt,name=totp_code,json=totpCode,proto3" json:"totp_code,omitempty"`
+	Password       string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	WalletAddr     string `protobuf:"bytes,3,opt,name=wallet_addr,json=walletAddr,proto3" json:"wallet_addr,omitempty"`
+	PubKey         string `protobuf:"bytes,4,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+	AptosFullMsg   string `protobuf:"bytes,5,opt,name=aptos_full_msg,json=aptosFullMsg,proto3" json:"aptos_full_msg,omitempty"`
+	AptosSignature string `protobuf:"bytes,6,opt,name=aptos_signature,json=aptosSignature,proto3" json:"aptos_signature,omitempty"`
+	Provider       string `protobuf:"bytes,7,opt,name=provider,proto3" json:"provider,omitempty"`
+	IdToken        string `protobuf:"bytes,8,opt,name=id_token,json=idToken,proto3" json:"id_token,omitempty"`
+	Nonce          string `protobuf:"bytes,9,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	DeviceId       string `protobuf:"bytes,10,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	Device         string `protobuf:"bytes,11,opt,name=device,proto3" json:"device,omitempty"`
+}
+
+func (x *CReqRequestAccountDeletion) Reset() {
+	*x = CReqRequestAccountDeletion{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_http_account_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CReqRequestAccountDeletion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CReqRequestAccountDeletion) ProtoMessage() {}
+
+func (x *CReqRequestAccountDeletion) ProtoReflect() protoreflect.Message {
+	mi := &file_http_account_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CReqRequestAccountDeletion.ProtoReflect.Descriptor instead.
+func (*CReqRequestAccountDeletion) Descriptor() ([]byte, []int) {
+	return file_http_account_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *CReqRequestAccountDeletion) GetTotpCode() string {
+	if x != nil {
+		return x.TotpCode
+	}
+	return ""
+}
+
+func (x *CReqRequestAccountDeletion) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *CReqRequestAccountDeletion) GetWalletAddr() string {
+	if x != nil {
+		return x.WalletAddr
+	}
+	return ""
+}
+
+func (x *CReqRequestAccountDeletion) GetPubKey() string {
+	if x != nil {
+		return x.PubKey
+	}
+	return ""
+}
+
+func (x *CReqRequestAccountDeletion) GetAptosFullMsg() string {
+	if x != nil {
+		return x.AptosFullMsg
+	}
+	return ""
+}
+
+func (x *CReqRequestAccountDeletion) GetAptosSignature() string {
+	if x != nil {
+		return x.AptosSignature
+	}
+	return ""
+}
+
+func (x *CReqRequestAccountDeletion) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *CReqRequestAccountDeletion) GetIdToken() string {
+	if x != nil {
+		return x.IdToken
+	}
+	return ""
+}
+
+func (x *CReqRequestAccountDeletion) GetNonce() string {
+	if x != nil {
+		return x.Nonce
+	}
+	return ""
+}
+
+func (x *CReqRequestAccountDeletion) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+func (x *CReqRequestAccountDeletion) GetDevice() string {
+	if x != nil {
+		return x.Device
+	}
+	return ""
+}
+
+type CResRequestAccountDeletion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DeletionTime int64 `protobuf:"varint,1,opt,name=deletion_time,json=deletionTime,proto3" json:"deletion_time,omitempty"`
+}
+
+func (x *CResRequestAccountDeletion) Reset() {
+	*x = CResRequestAccountDeletion{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_http_account_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CResRequestAccountDeletion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CResRequestAccountDeletion) ProtoMessage() {}
+
+func (x *CResRequestAccountDeletion) ProtoReflect() protoreflect.Message {
+	mi := &file_http_account_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CResRequestAccountDeletion.ProtoReflect.Descriptor instead.
+func (*CResRequestAccountDeletion) Descriptor() ([]byte, []int) {
+	return file_http_account_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *CResRequestAccountDeletion) GetDeletionTime() int64 {
+	if x != nil {
+		return x.DeletionTime
+	}
+	return 0
+}
+
+type CResExportAccountData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Archive string `protobuf:"bytes,1,opt,name=archive,proto3" json:"archive,omitempty"`
+}
+
+func (x *CResExportAccountData) Reset() {
+	*x = CResExportAccountData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_http_account_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CResExportAccountData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CResExportAccountData) ProtoMessage() {}
+
+func (x *CResExportAccountData) ProtoReflect() protoreflect.Message {
+	mi := &file_http_account_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CResExportAccountData.ProtoReflect.Descriptor instead.
+func (*CResExportAccountData) Descriptor() ([]byte, []int) {
+	return file_http_account_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *CResExportAccountData) GetArchive() string {
+	if x != nil {
+		return x.Archive
+	}
+	return ""
+}
+
+type CReqUpdateProfile struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Nickname string `protobuf:"bytes,1,opt,name=nickname,proto3" json:"nickname,omitempty"`
+	Icon     string `protobuf:"bytes,2,opt,name=icon,proto3" json:"icon,omitempty"`
+}
+
+func (x *CReqUpdateProfile) Reset() {
+	*x = CReqUpdateProfile{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_http_account_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CReqUpdateProfile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CReqUpdateProfile) ProtoMessage() {}
+
+func (x *CReqUpdateProfile) ProtoReflect() protoreflect.Message {
+	mi := &file_http_account_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CReqUpdateProfile.ProtoReflect.Descriptor instead.
+func (*CReqUpdateProfile) Descriptor() ([]byte, []int) {
+	return file_http_account_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *CReqUpdateProfile) GetNickname() string {
+	if x != nil {
+		return x.Nickname
+	}
+	return ""
+}
+
+func (x *CReqUpdateProfile) GetIcon() string {
+	if x != nil {
+		return x.Icon
+	}
+	return ""
+}
+
+type CResUpdateProfile struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Account *AccountInfo `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+}
+
+func (x *CResUpdateProfile) Reset() {
+	*x = CResUpdateProfile{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_http_account_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CResUpdateProfile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CResUpdateProfile) ProtoMessage() {}
+
+func (x *CResUpdateProfile) ProtoReflect() protoreflect.Message {
+	mi := &file_http_account_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CResUpdateProfile.ProtoReflect.Descriptor instead.
+func (*CResUpdateProfile) Descriptor() ([]byte, []int) {
+	return file_http_account_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *CResUpdateProfile) GetAccount() *AccountInfo {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+type CReqLoginAsGuest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DeviceId   string `protobuf:"bytes,1,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	Device     string `protobuf:"bytes,2,opt,name=device,proto3" json:"device,omitempty"`
+	Os         string `protobuf:"bytes,3,opt,name=os,proto3" json:"os,omitempty"`
+	Platform   string `protobuf:"bytes,4,opt,name=platform,proto3" json:"platform,omitempty"`
+	NewAccount bool   `protobuf:"varint,5,opt,name=new_account,json=newAccount,proto3" json:"new_account,omitempty"`
+}
+
+func (x *CReqLoginAsGuest) Reset() {
+	*x = CReqLoginAsGuest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_http_account_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CReqLoginAsGuest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CReqLoginAsGuest) ProtoMessage() {}
+
+func (x *CReqLoginAsGuest) ProtoReflect() protoreflect.Message {
+	mi := &file_http_account_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CReqLoginAsGuest.ProtoReflect.Descriptor instead.
+func (*CReqLoginAsGuest) Descriptor() ([]byte, []int) {
+	return file_http_account_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *CReqLoginAsGuest) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+func (x *CReqLoginAsGuest) GetDevice() string {
+	if x != nil {
+		return x.Device
+	}
+	return ""
+}
+
+func (x *CReqLoginAsGuest) GetOs() string {
+	if x != nil {
+		return x.Os
+	}
+	return ""
+}
+
+func (x *CReqLoginAsGuest) GetPlatform() string {
+	if x != nil {
+		return x.Platform
+	}
+	return ""
+}
+
+func (x *CReqLoginAsGuest) GetNewAccount() bool {
+	if x != nil {
+		return x.NewAccount
+	}
+	return false
+}
+
+type CReqUpgradeGuestByEmail struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Email    string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	Code     string `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
+}
+
+func (x *CReqUpgradeGuestByEmail) Reset() {
+	*x = CReqUpgradeGuestByEmail{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_http_account_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CReqUpgradeGuestByEmail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CReqUpgradeGuestByEmail) ProtoMessage() {}
+
+func (x *CReqUpgradeGuestByEmail) ProtoReflect() protoreflect.Message {
+	mi := &file_http_account_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CReqUpgradeGuestByEmail.ProtoReflect.Descriptor instead.
+func (*CReqUpgradeGuestByEmail) Descriptor() ([]byte, []int) {
+	return file_http_account_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *CReqUpgradeGuestByEmail) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *CReqUpgradeGuestByEmail) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *CReqUpgradeGuestByEmail) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type CResUpgradeGuest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Account *AccountInfo `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+}
+
+func (x *CResUpgradeGuest) Reset() {
+	*x = CResUpgradeGuest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_http_account_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CResUpgradeGuest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CResUpgradeGuest) ProtoMessage() {}
+
+func (x *CResUpgradeGuest) ProtoReflect() protoreflect.Message {
+	mi := &file_http_account_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CResUpgradeGuest.ProtoReflect.Descriptor instead.
+func (*CResUpgradeGuest) Descriptor() ([]byte, []int) {
+	return file_http_account_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *CResUpgradeGuest) GetAccount() *AccountInfo {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+type CResGetLoginHistory struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Records []*LoginRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
+}
+
+func (x *CResGetLoginHistory) Reset() {
+	*x = CResGetLoginHistory{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_http_account_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CResGetLoginHistory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CResGetLoginHistory) ProtoMessage() {}
+
+func (x *CResGetLoginHistory) ProtoReflect() protoreflect.Message {
+	mi := &file_http_account_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CResGetLoginHistory.ProtoReflect.Descriptor instead.
+func (*CResGetLoginHistory) Descriptor() ([]byte, []int) {
+	return file_http_account_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *CResGetLoginHistory) GetRecords() []*LoginRecord {
+	if x != nil {
+		return x.Records
+	}
+	return nil
+}
+
+type CResGetReferralStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InviteCode    string      `protobuf:"bytes,1,opt,name=invite_code,json=inviteCode,proto3" json:"invite_code,omitempty"`
+	ReferrerId    uint64      `protobuf:"varint,2,opt,name=referrer_id,json=referrerId,proto3" json:"referrer_id,omitempty"`
+	InvitedCount  uint32      `protobuf:"varint,3,opt,name=invited_count,json=invitedCount,proto3" json:"invited_count,omitempty"`
+	RejectedCount uint32      `protobuf:"varint,4,opt,name=rejected_count,json=rejectedCount,proto3" json:"rejected_count,omitempty"`
+	Referrals     []*Referral `protobuf:"bytes,5,rep,name=referrals,proto3" json:"referrals,omitempty"`
+}
+
+func (x *CResGetReferralStats) Reset() {
+	*x = CResGetReferralStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_http_account_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CResGetReferralStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CResGetReferralStats) ProtoMessage() {}
+
+func (x *CResGetReferralStats) ProtoReflect() protoreflect.Message {
+	mi := &file_http_account_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CResGetReferralStats.ProtoReflect.Descriptor instead.
+func (*CResGetReferralStats) Descriptor() ([]byte, []int) {
+	return file_http_account_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *CResGetReferralStats) GetInviteCode() string {
+	if x != nil {
+		return x.InviteCode
+	}
+	return ""
+}
+
+func (x *CResGetReferralStats) GetReferrerId() uint64 {
+	if x != nil {
+		return x.ReferrerId
+	}
+	return 0
+}
+
+func (x *CResGetReferralStats) GetInvitedCount() uint32 {
+	if x != nil {
+		return x.InvitedCount
+	}
+	return 0
+}
+
+func (x *CResGetReferralStats) GetRejectedCount() uint32 {
+	if x != nil {
+		return x.RejectedCount
+	}
+	return 0
+}
+
+func (x *CResGetReferralStats) GetReferrals() []*Referral {
+	if x != nil {
+		return x.Referrals
+	}
+	return nil
+}
+
+type CReqLoginByPlatform struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Provider     string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	IdToken      string `protobuf:"bytes,2,opt,name=id_token,json=idToken,proto3" json:"id_token,omitempty"`
+	Device       string `protobuf:"bytes,3,opt,name=device,proto3" json:"device,omitempty"`
+	DeviceId     string `protobuf:"bytes,4,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	Os           string `protobuf:"bytes,5,opt,name=os,proto3" json:"os,omitempty"`
+	Platform     string `protobuf:"bytes,6,opt,name=platform,proto3" json:"platform,omitempty"`
+	ReferrerCode string `protobuf:"bytes,7,opt,name=referrer_code,json=referrerCode,proto3" json:"referrer_code,omitempty"`
+	Nonce        string `protobuf:"bytes,8,opt,name=nonce,proto3" json:"nonce,omitempty"`
+}
+
+func (x *CReqLoginByPlatform) Reset() {
+	*x = CReqLoginByPlatform{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_http_account_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CReqLoginByPlatform) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CReqLoginByPlatform) ProtoMessage() {}
+
+func (x *CReqLoginByPlatform) ProtoReflect() protoreflect.Message {
+	mi := &file_http_account_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CReqLoginByPlatform.ProtoReflect.Descriptor instead.
+func (*CReqLoginByPlatform) Descriptor() ([]byte, []int) {
+	return file_http_account_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *CReqLoginByPlatform) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *CReqLoginByPlatform) GetIdToken() string {
+	if x != nil {
+		return x.IdToken
+	}
+	return ""
+}
+
+func (x *CReqLoginByPlatform) GetDevice() string {
+	if x != nil {
+		return x.Device
+	}
+	return ""
+}
+
+func (x *CReqLoginByPlatform) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+func (x *CReqLoginByPlatform) GetOs() string {
+	if x != nil {
+		return x.Os
+	}
+	return ""
+}
+
+func (x *CReqLoginByPlatform) GetPlatform() string {
+	if x != nil {
+		return x.Platform
+	}
+	return ""
+}
+
+func (x *CReqLoginByPlatform) GetReferrerCode() string {
+	if x != nil {
+		return x.ReferrerCode
+	}
+	return ""
+}
+
+func (x *CReqLoginByPlatform) GetNonce() string {
+	if x != nil {
+		return x.Nonce
+	}
+	return ""
+}
+
+type CReqLinkPlatform struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Provider string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	IdToken  string `protobuf:"bytes,2,opt,name=id_token,json=idToken,proto3" json:"id_token,omitempty"`
+	Nonce    string `protobuf:"bytes,3,opt,name=nonce,proto3" json:"nonce,omitempty"`
+}
+
+func (x *CReqLinkPlatform) Reset() {
+	*x = CReqLinkPlatform{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_http_account_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CReqLinkPlatform) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CReqLinkPlatform) ProtoMessage() {}
+
+func (x *CReqLinkPlatform) ProtoReflect() protoreflect.Message {
+	mi := &file_http_account_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CReqLinkPlatform.ProtoReflect.Descriptor instead.
+func (*CReqLinkPlatform) Descriptor() ([]byte, []int) {
+	return file_http_account_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *CReqLinkPlatform) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *CReqLinkPlatform) GetIdToken() string {
+	if x != nil {
+		return x.IdToken
+	}
+	return ""
+}
+
+func (x *CReqLinkPlatform) GetNonce() string {
+	if x != nil {
+		return x.Nonce
+	}
+	return ""
+}
+
+type CResLinkPlatform struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Account *AccountInfo `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+}
+
+func (x *CResLinkPlatform) Reset() {
+	*x = CResLinkPlatform{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_http_account_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CResLinkPlatform) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CResLinkPlatform) ProtoMessage() {}
+
+func (x *CResLinkPlatform) ProtoReflect() protoreflect.Message {
+	mi := &file_http_account_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CResLinkPlatform.ProtoReflect.Descriptor instead.
+func (*CResLinkPlatform) Descriptor() ([]byte, []int) {
+	return file_http_account_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *CResLinkPlatform) GetAccount() *AccountInfo {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+type CReqMergeAccount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TotpCode       string `protobuf:"bytes,1,opt,name=totp_code,json=totpCode,proto3" json:"totp_code,omitempty"`
+	Email          string `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Password       string `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	WalletAddr     string `protobuf:"bytes,4,opt,name=wallet_addr,json=walletAddr,proto3" json:"wallet_addr,omitempty"`
+	PubKey         string `protobuf:"bytes,5,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+	AptosFullMsg   string `protobuf:"bytes,6,opt,name=aptos_full_msg,json=aptosFullMsg,proto3" json:"aptos_full_msg,omitempty"`
+	AptosSignature string `protobuf:"bytes,7,opt,name=aptos_signature,json=aptosSignature,proto3" json:"aptos_signature,omitempty"`
+	OtherTotpCode  string `protobuf:"bytes,8,opt,name=other_totp_code,json=otherTotpCode,proto3" json:"other_totp_code,omitempty"`
+}
+
+func (x *CReqMergeAccount) Reset() {
+	*x = CReqMergeAccount{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_http_account_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CReqMergeAccount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CReqMergeAccount) ProtoMessage() {}
+
+func (x *CReqMergeAccount) ProtoReflect() protoreflect.Message {
+	mi := &file_http_account_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CReqMergeAccount.ProtoReflect.Descriptor instead.
+func (*CReqMergeAccount) Descriptor() ([]byte, []int) {
+	return file_http_account_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *CReqMergeAccount) GetTotpCode() string {
+	if x != nil {
+		return x.TotpCode
+	}
+	return ""
+}
+
+func (x *CReqMergeAccount) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *CReqMergeAccount) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *CReqMergeAccount) GetWalletAddr() string {
+	if x != nil {
+		return x.WalletAddr
+	}
+	return ""
+}
+
+func (x *CReqMergeAccount) GetPubKey() string {
+	if x != nil {
+		return x.PubKey
+	}
+	return ""
+}
+
+func (x *CReqMergeAccount) GetAptosFullMsg() string {
+	if x != nil {
+		return x.AptosFullMsg
+	}
+	return ""
+}
+
+func (x *CReqMergeAccount) GetAptosSignature() string {
+	if x != nil {
+		return x.AptosSignature
+	}
+	return ""
+}
+
+func (x *CReqMergeAccount) GetOtherTotpCode() string {
+	if x != nil {
+		return x.OtherTotpCode
+	}
+	return ""
+}
+
+type CResMergeAccount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Account *AccountInfo `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+}
+
+func (x *CResMergeAccount) Reset() {
+	*x = CResMergeAccount{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_http_account_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CResMergeAccount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CResMergeAccount) ProtoMessage() {}
+
+func (x *CResMergeAccount) ProtoReflect() protoreflect.Message {
+	mi := &file_http_account_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CResMergeAccount.ProtoReflect.Descriptor instead.
+func (*CResMergeAccount) Descriptor() ([]byte, []int) {
+	return file_http_account_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *CResMergeAccount) GetAccount() *AccountInfo {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+type CReqSendSMSCode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tel string `protobuf:"bytes,1,opt,name=tel,proto3" json:"tel,omitempty"` // E.164, e.g. +6591234567
+}
+
+func (x *CReqSendSMSCode) Reset() {
+	*x = CReqSendSMSCode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_http_account_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CReqSendSMSCode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CReqSendSMSCode) ProtoMessage() {}
+
+func (x *CReqSendSMSCode) ProtoReflect() protoreflect.Message {
+	mi := &file_http_account_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CReqSendSMSCode.ProtoReflect.Descriptor instead.
+func (*CReqSendSMSCode) Descriptor() ([]byte, []int) {
+	return file_http_account_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *CReqSendSMSCode) GetTel() string {
+	if x != nil {
+		return x.Tel
+	}
+	return ""
+}
+
+type CReqBindPhone struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tel  string `protobuf:"bytes,1,opt,name=tel,proto3" json:"tel,omitempty"`
+	Code string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+}
+
+func (x *CReqBindPhone) Reset() {
+	*x = CReqBindPhone{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_http_account_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CReqBindPhone) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CReqBindPhone) ProtoMessage() {}
+
+func (x *CReqBindPhone) ProtoReflect() protoreflect.Message {
+	mi := &file_http_account_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CReqBindPhone.ProtoReflect.Descriptor instead.
+func (*CReqBindPhone) Descriptor() ([]byte, []int) {
+	return file_http_account_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *CReqBindPhone) GetTel() string {
+	if x != nil {
+		return x.Tel
+	}
+	return ""
+}
+
+func (x *CReqBindPhone) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type CResBindPhone struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Account *AccountInfo `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+}
+
+func (x *CResBindPhone) Reset() {
+	*x = CResBindPhone{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_http_account_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CResBindPhone) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CResBindPhone) ProtoMessage() {}
+
+func (x *CResBindPhone) ProtoReflect() protoreflect.Message {
+	mi := &file_http_account_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CResBindPhone.ProtoReflect.Descriptor instead.
+func (*CResBindPhone) Descriptor() ([]byte, []int) {
+	return file_http_account_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *CResBindPhone) GetAccount() *AccountInfo {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+type CReqResetPasswordByPhone struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tel      string `protobuf:"bytes,1,opt,name=tel,proto3" json:"tel,omitempty"`
+	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	Code     string `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
+	TotpCode string `protobuf:"bytes,4,opt,name=totp_code,json=totpCode,proto3" json:"totp_code,omitempty"`
+}
+
+func (x *CReqResetPasswordByPhone) Reset() {
+	*x = CReqResetPasswordByPhone{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_http_account_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CReqResetPasswordByPhone) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CReqResetPasswordByPhone) ProtoMessage() {}
+
+func (x *CReqResetPasswordByPhone) ProtoReflect() protoreflect.Message {
+	mi := &file_http_account_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CReqResetPasswordByPhone.ProtoReflect.Descriptor instead.
+func (*CReqResetPasswordByPhone) Descriptor() ([]byte, []int) {
+	return file_http_account_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *CReqResetPasswordByPhone) GetTel() string {
+	if x != nil {
+		return x.Tel
+	}
+	return ""
+}
+
+func (x *CReqResetPasswordByPhone) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *CReqResetPasswordByPhone) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *CReqResetPasswordByPhone) GetTotpCode() string {
+	if x != nil {
+		return x.TotpCode
+	}
+	return ""
+}
+
+var File_http_account_proto protoreflect.FileDescriptor
+
+var file_http_account_proto_rawDesc = []byte{
+	0x0a, 0x12, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x03, 0x6d, 0x70, 0x62, 0x1a, 0x0c, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x80, 0x01, 0x0a, 0x13, 0x43, 0x52, 0x65, 0x71,
+	0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x42, 0x79, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12,
+	0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x1b, 0x0a,
+	0x09, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x22, 0xe2, 0x01, 0x0a, 0x13, 0x43,
+	0x52, 0x65, 0x73, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x42, 0x79, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x12, 0x2a, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c,
+	0x0a, 0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x5f, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x66, 0x72, 0x65,
+	0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x6f, 0x74, 0x70, 0x5f,
+	0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c,
+	0x74, 0x6f, 0x74, 0x70, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x12, 0x21, 0x0a, 0x0c,
+	0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x22,
+	0x34, 0x0a, 0x11, 0x43, 0x52, 0x65, 0x71, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x4e,
+	0x6f, 0x6e, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x61,
+	0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x77, 0x61, 0x6c, 0x6c, 0x65,
+	0x74, 0x41, 0x64, 0x64, 0x72, 0x22, 0x29, 0x0a, 0x11, 0x43, 0x52, 0x65, 0x73, 0x47, 0x65, 0x6e,
+	0x65, 0x72, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f,
+	0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65,
+	0x22, 0xf9, 0x01, 0x0a, 0x14, 0x43, 0x52, 0x65, 0x71, 0x57, 0x65, 0x62, 0x4c, 0x6f, 0x67, 0x69,
+	0x6e, 0x42, 0x79, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x77, 0x61, 0x6c,
+	0x6c, 0x65, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x75,
+	0x62, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x75, 0x62,
+	0x4b, 0x65, 0x79, 0x12, 0x24, 0x0a, 0x0e, 0x61, 0x70, 0x74, 0x6f, 0x73, 0x5f, 0x66, 0x75, 0x6c,
+	0x6c, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x70, 0x74,
+	0x6f, 0x73, 0x46, 0x75, 0x6c, 0x6c, 0x4d, 0x73, 0x67, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x70, 0x74,
+	0x6f, 0x73, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x61, 0x70, 0x74, 0x6f, 0x73, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x66, 0x65, 0x72,
+	0x72, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x49, 0x0a, 0x15,
+	0x43, 0x52, 0x65, 0x71, 0x53, 0x65, 0x6e, 0x64, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x42, 0x69, 0x6e,
+	0x64, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x6c,
+	0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c,
+	0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x22, 0x9b, 0x01, 0x0a, 0x14, 0x43, 0x52, 0x65, 0x73,
+	0x57, 0x65, 0x62, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x42, 0x79, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74,
+	0x12, 0x2a, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x10, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09,
+	0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x71, 0x0a, 0x10, 0x43, 0x52, 0x65, 0x71, 0x57, 0x65, 0x62,
+	0x42, 0x69, 0x6e, 0x64, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61,
+	0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12,
+	0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63,
+	0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x64,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x22, 0x97, 0x01, 0x0a, 0x10, 0x43, 0x52, 0x65,
+	0x73, 0x57, 0x65, 0x62, 0x42, 0x69, 0x6e, 0x64, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x2a, 0x0a,
+	0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10,
+	0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x23, 0x0a,
+	0x0d, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x22, 0x40, 0x0a, 0x12, 0x43, 0x52, 0x65, 0x73, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x2a, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d, 0x70, 0x62, 0x2e,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x22, 0x35, 0x0a, 0x15, 0x43, 0x52, 0x65, 0x73, 0x47, 0x65, 0x74, 0x41,
+	0x70, 0x74, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x1c, 0x0a,
+	0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x22, 0x77, 0x0a, 0x12, 0x43,
+	0x52, 0x65, 0x71, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x6c, 0x64, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x6c, 0x64, 0x50, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x65, 0x77, 0x5f, 0x70, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x65, 0x77, 0x50,
+	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x6f, 0x74, 0x70, 0x5f,
+	0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x6f, 0x74, 0x70,
+	0x43, 0x6f, 0x64, 0x65, 0x22, 0x52, 0x0a, 0x1e, 0x43, 0x52, 0x65, 0x71, 0x53, 0x65, 0x6e, 0x64,
+	0x45, 0x6d, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x73, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1a, 0x0a, 0x08,
+	0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x22, 0x4b, 0x0a, 0x1f, 0x43, 0x52, 0x65, 0x71,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x73, 0x65, 0x74, 0x50,
+	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65,
+	0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69,
+	0x6c, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x37, 0x0a, 0x1f, 0x43, 0x52, 0x65, 0x73, 0x43, 0x68, 0x65,
+	0x63, 0x6b, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x73, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x22, 0x7f,
+	0x0a, 0x18, 0x43, 0x52, 0x65, 0x71, 0x52, 0x65, 0x73, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x42, 0x79, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d,
+	0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c,
+	0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x14, 0x0a, 0x05,
+	0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x6e,
+	0x63, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x6f, 0x74, 0x70, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x6f, 0x74, 0x70, 0x43, 0x6f, 0x64, 0x65, 0x22,
+	0x85, 0x01, 0x0a, 0x20, 0x43, 0x52, 0x65, 0x71, 0x52, 0x65, 0x73, 0x65, 0x74, 0x50, 0x61, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0x42, 0x79, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x41, 0x6e, 0x64, 0x56,
+	0x43, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61,
+	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61,
+	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x6f,
+	0x74, 0x70, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74,
+	0x6f, 0x74, 0x70, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x37, 0x0a, 0x10, 0x43, 0x52, 0x65, 0x71, 0x52,
+	0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x72,
+	0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x22, 0x4d, 0x0a, 0x10, 0x43, 0x52, 0x65, 0x73, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65,
+	0x66, 0x72, 0x65, 0x73, 0x68, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22,
+	0x3f, 0x0a, 0x0f, 0x43, 0x52, 0x65, 0x73, 0x47, 0x65, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x2c, 0x0a, 0x08, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x22, 0x32, 0x0a, 0x11, 0x43, 0x52, 0x65, 0x71, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x53, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x22, 0x48, 0x0a, 0x0f, 0x43, 0x52, 0x65, 0x71, 0x4c, 0x6f, 0x67, 0x69,
+	0x6e, 0x42, 0x79, 0x54, 0x4f, 0x54, 0x50, 0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x6f, 0x67, 0x69, 0x6e,
+	0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6c,
+	0x6f, 0x67, 0x69, 0x6e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x49,
+	0x0a, 0x0e, 0x43, 0x52, 0x65, 0x73, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x54, 0x4f, 0x54, 0x50,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x74, 0x70, 0x61,
+	0x75, 0x74, 0x68, 0x5f, 0x75, 0x72, 0x69, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6f,
+	0x74, 0x70, 0x61, 0x75, 0x74, 0x68, 0x55, 0x72, 0x69, 0x22, 0x22, 0x0a, 0x0c, 0x43, 0x52, 0x65,
+	0x71, 0x54, 0x4f, 0x54, 0x50, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x38, 0x0a,
+	0x0f, 0x43, 0x52, 0x65, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x54, 0x4f, 0x54, 0x50,
+	0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x63, 0x6f, 0x64,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65,
+	0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x22, 0xe1, 0x01, 0x0a, 0x13, 0x43, 0x52, 0x65, 0x71,
+	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x1b, 0x0a,
+	0x09, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x6f, 0x73,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x6f, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6c,
+	0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6c,
+	0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72,
+	0x65, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72,
+	0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x7c, 0x0a, 0x13, 0x43,
+	0x52, 0x65, 0x73, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x5f,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x66,
+	0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x99, 0x01, 0x0a, 0x0e, 0x43, 0x52,
+	0x65, 0x71, 0x4c, 0x69, 0x6e, 0x6b, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x12, 0x1f, 0x0a, 0x0b,
+	0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x12, 0x17, 0x0a,
+	0x07, 0x70, 0x75, 0x62, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x70, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x12, 0x24, 0x0a, 0x0e, 0x61, 0x70, 0x74, 0x6f, 0x73, 0x5f,
+	0x66, 0x75, 0x6c, 0x6c, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x61, 0x70, 0x74, 0x6f, 0x73, 0x46, 0x75, 0x6c, 0x6c, 0x4d, 0x73, 0x67, 0x12, 0x27, 0x0a, 0x0f,
+	0x61, 0x70, 0x74, 0x6f, 0x73, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x70, 0x74, 0x6f, 0x73, 0x53, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x31, 0x0a, 0x0e, 0x43, 0x52, 0x65, 0x71, 0x57, 0x61, 0x6c,
+	0x6c, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x77, 0x61, 0x6c, 0x6c, 0x65,
+	0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x77, 0x61,
+	0x6c, 0x6c, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x22, 0x3c, 0x0a, 0x0f, 0x43, 0x52, 0x65, 0x73,
+	0x4c, 0x69, 0x73, 0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x73, 0x12, 0x29, 0x0a, 0x07, 0x77,
+	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6d,
+	0x70, 0x62, 0x2e, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x77,
+	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x73, 0x22, 0x5c, 0x0a, 0x17, 0x43, 0x52, 0x65, 0x71, 0x53, 0x65,
+	0x6e, 0x64, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x43, 0x6f, 0x64,
+	0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x65, 0x77, 0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x65, 0x77, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x24,
+	0x0a, 0x0e, 0x73, 0x6b, 0x69, 0x70, 0x5f, 0x6f, 0x6c, 0x64, 0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x73, 0x6b, 0x69, 0x70, 0x4f, 0x6c, 0x64, 0x45,
+	0x6d, 0x61, 0x69, 0x6c, 0x22, 0x8a, 0x02, 0x0a, 0x0f, 0x43, 0x52, 0x65, 0x71, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x65, 0x77, 0x5f,
+	0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x65, 0x77,
+	0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x65, 0x77, 0x5f, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x77, 0x43, 0x6f, 0x64, 0x65,
+	0x12, 0x19, 0x0a, 0x08, 0x6f, 0x6c, 0x64, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6f, 0x6c, 0x64, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x77,
+	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x12, 0x17, 0x0a, 0x07,
+	0x70, 0x75, 0x62, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70,
+	0x75, 0x62, 0x4b, 0x65, 0x79, 0x12, 0x24, 0x0a, 0x0e, 0x61, 0x70, 0x74, 0x6f, 0x73, 0x5f, 0x66,
+	0x75, 0x6c, 0x6c, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61,
+	0x70, 0x74, 0x6f, 0x73, 0x46, 0x75, 0x6c, 0x6c, 0x4d, 0x73, 0x67, 0x12, 0x27, 0x0a, 0x0f, 0x61,
+	0x70, 0x74, 0x6f, 0x73, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x70, 0x74, 0x6f, 0x73, 0x53, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x6f, 0x74, 0x70, 0x5f, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x6f, 0x74, 0x70, 0x43, 0x6f, 0x64,
+	0x65, 0x22, 0x3d, 0x0a, 0x0f, 0x43, 0x52, 0x65, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x45,
+	0x6d, 0x61, 0x69, 0x6c, 0x12, 0x2a, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x22, 0xe0, 0x02, 0x0a, 0x1a, 0x43, 0x52, 0x65, 0x71, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x1b, 0x0a, 0x09, 0x74, 0x6f, 0x74, 0x70, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x74, 0x6f, 0x74, 0x70, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x77, 0x61, 0x6c, 0x6c,
+	0x65, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x77,
+	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x75, 0x62,
+	0x5f, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x75, 0x62, 0x4b,
+	0x65, 0x79, 0x12, 0x24, 0x0a, 0x0e, 0x61, 0x70, 0x74, 0x6f, 0x73, 0x5f, 0x66, 0x75, 0x6c, 0x6c,
+	0x5f, 0x6d, 0x73, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x70, 0x74, 0x6f,
+	0x73, 0x46, 0x75, 0x6c, 0x6c, 0x4d, 0x73, 0x67, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x70, 0x74, 0x6f,
+	0x73, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x61, 0x70, 0x74, 0x6f, 0x73, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x19, 0x0a,
+	0x08, 0x69, 0x64, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x69, 0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63,
+	0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x1b,
+	0x0a, 0x09, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x64,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x22, 0x41, 0x0a, 0x1a, 0x43, 0x52, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x69,
+	0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x31, 0x0a, 0x15, 0x43, 0x52, 0x65, 0x73, 0x45, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12,
+	0x18, 0x0a, 0x07, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x22, 0x43, 0x0a, 0x11, 0x43, 0x52, 0x65,
+	0x71, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x63,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x63, 0x6f, 0x6e, 0x22, 0x3f,
+	0x0a, 0x11, 0x43, 0x52, 0x65, 0x73, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x12, 0x2a, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22,
+	0x94, 0x01, 0x0a, 0x10, 0x43, 0x52, 0x65, 0x71, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x41, 0x73, 0x47,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x6f, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x6f, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6c, 0x61,
+	0x74, 0x66, 0x6f, 0x72, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6c, 0x61,
+	0x74, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x77, 0x5f, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x6e, 0x65, 0x77, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x5f, 0x0a, 0x17, 0x43, 0x52, 0x65, 0x71, 0x55, 0x70,
+	0x67, 0x72, 0x61, 0x64, 0x65, 0x47, 0x75, 0x65, 0x73, 0x74, 0x42, 0x79, 0x45, 0x6d, 0x61, 0x69,
+	0x6c, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x3e, 0x0a, 0x10, 0x43, 0x52, 0x65, 0x73, 0x55,
+	0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x47, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x07, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d,
+	0x70, 0x62, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x41, 0x0a, 0x13, 0x43, 0x52, 0x65, 0x73, 0x47,
+	0x65, 0x74, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x2a,
+	0x0a, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x10, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x52, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x22, 0xd1, 0x01, 0x0a, 0x14, 0x43,
+	0x52, 0x65, 0x73, 0x47, 0x65, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x72, 0x61, 0x6c, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x5f, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65,
+	0x43, 0x6f, 0x64, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x72, 0x65, 0x66, 0x65, 0x72,
+	0x72, 0x65, 0x72, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x64,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x69, 0x6e,
+	0x76, 0x69, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65,
+	0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0d, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x2b, 0x0a, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x61, 0x6c, 0x73, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72,
+	0x72, 0x61, 0x6c, 0x52, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x61, 0x6c, 0x73, 0x22, 0xe8,
+	0x01, 0x0a, 0x13, 0x43, 0x52, 0x65, 0x71, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x42, 0x79, 0x50, 0x6c,
+	0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x64, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x69, 0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x16, 0x0a,
+	0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x49, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x6f, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x6f, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x23,
+	0x0a, 0x0d, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x72, 0x43,
+	0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x22, 0x5f, 0x0a, 0x10, 0x43, 0x52, 0x65,
+	0x71, 0x4c, 0x69, 0x6e, 0x6b, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x1a, 0x0a,
+	0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x64, 0x5f,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x69, 0x64, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x22, 0x3e, 0x0a, 0x10, 0x43, 0x52,
+	0x65, 0x73, 0x4c, 0x69, 0x6e, 0x6b, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x2a,
+	0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x10, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x92, 0x02, 0x0a, 0x10, 0x43,
+	0x52, 0x65, 0x71, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x1b, 0x0a, 0x09, 0x74, 0x6f, 0x74, 0x70, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x74, 0x6f, 0x74, 0x70, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61,
+	0x69, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1f,
+	0x0a, 0x0b, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x12,
+	0x17, 0x0a, 0x07, 0x70, 0x75, 0x62, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x70, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x12, 0x24, 0x0a, 0x0e, 0x61, 0x70, 0x74, 0x6f,
+	0x73, 0x5f, 0x66, 0x75, 0x6c, 0x6c, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x61, 0x70, 0x74, 0x6f, 0x73, 0x46, 0x75, 0x6c, 0x6c, 0x4d, 0x73, 0x67, 0x12, 0x27,
+	0x0a, 0x0f, 0x61, 0x70, 0x74, 0x6f, 0x73, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x70, 0x74, 0x6f, 0x73, 0x53, 0x69,
+	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x6f, 0x74, 0x68, 0x65, 0x72,
+	0x5f, 0x74, 0x6f, 0x74, 0x70, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x54, 0x6f, 0x74, 0x70, 0x43, 0x6f, 0x64, 0x65, 0x22,
+	0x3e, 0x0a, 0x10, 0x43, 0x52, 0x65, 0x73, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22,
+	0x23, 0x0a, 0x0f, 0x43, 0x52, 0x65, 0x71, 0x53, 0x65, 0x6e, 0x64, 0x53, 0x4d, 0x53, 0x43, 0x6f,
+	0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x74, 0x65, 0x6c, 0x22, 0x35, 0x0a, 0x0d, 0x43, 0x52, 0x65, 0x71, 0x42, 0x69, 0x6e, 0x64,
+	0x50, 0x68, 0x6f, 0x6e, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x74, 0x65, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x3b, 0x0a, 0x0d, 0x43,
+	0x52, 0x65, 0x73, 0x42, 0x69, 0x6e, 0x64, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x12, 0x2a, 0x0a, 0x07,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e,
+	0x6d, 0x70, 0x62, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x79, 0x0a, 0x18, 0x43, 0x52, 0x65, 0x71,
+	0x52, 0x65, 0x73, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x42, 0x79, 0x50,
+	0x68, 0x6f, 0x6e, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x74, 0x65, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x6f, 0x74, 0x70, 0x5f, 0x63,
+	0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x6f, 0x74, 0x70, 0x43,
+	0x6f, 0x64, 0x65, 0x42, 0x07, 0x5a, 0x05, 0x2e, 0x2f, 0x6d, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_http_account_proto_rawDescOnce sync.Once
+	file_http_account_proto_rawDescData = file_http_account_proto_rawDesc
+)
+
+func file_http_account_proto_rawDescGZIP() []byte {
+	file_http_account_proto_rawDescOnce.Do(func() {
+		file_http_account_proto_rawDescData = protoimpl.X.CompressGZIP(file_http_account_proto_rawDescData)
+	})
+	return file_http_account_proto_rawDescData
+}
+
+var file_http_account_proto_msgTypes = make([]protoimpl.MessageInfo, 52)
+var file_http_account_proto_goTypes = []interface{}{
+	(*CReqLoginByPassword)(nil),              // 0: mpb.CReqLoginByPassword
+	(*CResLoginByPassword)(nil),              // 1: mpb.CResLoginByPassword
+	(*CReqGenerateNonce)(nil),                // 2: mpb.CReqGenerateNonce
+	(*CResGenerateNonce)(nil),                // 3: mpb.CResGenerateNonce
+	(*CReqWebLoginByWallet)(nil),             // 4: mpb.CReqWebLoginByWallet
+	(*CReqSendEmailBindCode)(nil),            // 5: mpb.CReqSendEmailBindCode
+	(*CResWebLoginByWallet)(nil),             // 6: mpb.CResWebLoginByWallet
+	(*CReqWebBindEmail)(nil),                 // 7: mpb.CReqWebBindEmail
+	(*CResWebBindEmail)(nil),                 // 8: mpb.CResWebBindEmail
+	(*CResGetAccountInfo)(nil),               // 9: mpb.CResGetAccountInfo
+	(*CResGetAptosResources)(nil),            // 10: mpb.CResGetAptosResources
+	(*CReqChangePassword)(nil),               // 11: mpb.CReqChangePassword
+	(*CReqSendEmailResetPasswordCode)(nil),   // 12: mpb.CReqSendEmailResetPasswordCode
+	(*CReqCheckEmailResetPasswordCode)(nil),  // 13: mpb.CReqCheckEmailResetPasswordCode
+	(*CResCheckEmailResetPasswordCode)(nil),  // 14: mpb.CResCheckEmailResetPasswordCode
+	(*CReqResetPasswordByEmail)(nil),         // 15: mpb.CReqResetPasswordByEmail
+	(*CReqResetPasswordByEmailAndVCode)(nil), // 16: mpb.CReqResetPasswordByEmailAndVCode
+	(*CReqRefreshToken)(nil),                 // 17: mpb.CReqRefreshToken
+	(*CResRefreshToken)(nil),                 // 18: mpb.CResRefreshToken
+	(*CResGetSessions)(nil),                  // 19: mpb.CResGetSessions
+	(*CReqRevokeSession)(nil),                // 20: mpb.CReqRevokeSession
+	(*CReqLoginByTOTP)(nil),                  // 21: mpb.CReqLoginByTOTP
+	(*CResEnrollTOTP)(nil),                   // 22: mpb.CResEnrollTOTP
+	(*CReqTOTPCode)(nil),                     // 23: mpb.CReqTOTPCode
+	(*CResConfirmTOTP)(nil),                  // 24: mpb.CResConfirmTOTP
+	(*CReqRegisterAccount)(nil),              // 25: mpb.CReqRegisterAccount
+	(*CResRegisterAccount)(nil),              // 26: mpb.CResRegisterAccount
+	(*CReqLinkWallet)(nil),                   // 27: mpb.CReqLinkWallet
+	(*CReqWalletAddr)(nil),                   // 28: mpb.CReqWalletAddr
+	(*CResListWallets)(nil),                  // 29: mpb.CResListWallets
+	(*CReqSendEmailChangeCode)(nil),          // 30: mpb.CReqSendEmailChangeCode
+	(*CReqChangeEmail)(nil),                  // 31: mpb.CReqChangeEmail
+	(*CResChangeEmail)(nil),                  // 32: mpb.CResChangeEmail
+	(*CReqRequestAccountDeletion)(nil),       // 33: mpb.CReqRequestAccountDeletion
+	(*CResRequestAccountDeletion)(nil),       // 34: mpb.CResRequestAccountDeletion
+	(*CResExportAccountData)(nil),            // 35: mpb.CResExportAccountData
+	(*CReqUpdateProfile)(nil),                // 36: mpb.CReqUpdateProfile
+	(*CResUpdateProfile)(nil),                // 37: mpb.CResUpdateProfile
+	(*CReqLoginAsGuest)(nil),                 // 38: mpb.CReqLoginAsGuest
+	(*CReqUpgradeGuestByEmail)(nil),          // 39: mpb.CReqUpgradeGuestByEmail
+	(*CResUpgradeGuest)(nil),                 // 40: mpb.CResUpgradeGuest
+	(*CResGetLoginHistory)(nil),              // 41: mpb.CResGetLoginHistory
+	(*CResGetReferralStats)(nil),             // 42: mpb.CResGetReferralStats
+	(*CReqLoginByPlatform)(nil),              // 43: mpb.CReqLoginByPlatform
+	(*CReqLinkPlatform)(nil),                 // 44: mpb.CReqLinkPlatform
+	(*CResLinkPlatform)(nil),                 // 45: mpb.CResLinkPlatform
+	(*CReqMergeAccount)(nil),                 // 46: mpb.CReqMergeAccount
+	(*CResMergeAccount)(nil),                 // 47: mpb.CResMergeAccount
+	(*CReqSendSMSCode)(nil),                  // 48: mpb.CReqSendSMSCode
+	(*CReqBindPhone)(nil),                    // 49: mpb.CReqBindPhone
+	(*CResBindPhone)(nil),                    // 50: mpb.CResBindPhone
+	(*CReqResetPasswordByPhone)(nil),         // 51: mpb.CReqResetPasswordByPhone
+	(*AccountInfo)(nil),                      // 52: mpb.AccountInfo
+	(*SessionInfo)(nil),                      // 53: mpb.SessionInfo
+	(*WalletInfo)(nil),                       // 54: mpb.WalletInfo
+	(*LoginRecord)(nil),                      // 55: mpb.LoginRecord
+	(*Referral)(nil),                         // 56: mpb.Referral
+}
+var file_http_account_proto_depIdxs = []int32{
+	52, // 0: mpb.CResLoginByPassword.account:type_name -> mpb.AccountInfo
+	52, // 1: mpb.CResWebLoginByWallet.account:type_name -> mpb.AccountInfo
+	52, // 2: mpb.CResWebBindEmail.account:type_name -> mpb.AccountInfo
+	52, // 3: mpb.CResGetAccountInfo.account:type_name -> mpb.AccountInfo
+	53, // 4: mpb.CResGetSessions.sessions:type_name -> mpb.SessionInfo
+	52, // 5: mpb.CResRegisterAccount.account:type_name -> mpb.AccountInfo
+	54, // 6: mpb.CResListWallets.wallets:type_name -> mpb.WalletInfo
+	52, // 7: mpb.CResChangeEmail.account:type_name -> mpb.AccountInfo
+	52, // 8: mpb.CResUpdateProfile.account:type_name -> mpb.AccountInfo
+	52, // 9: mpb.CResUpgradeGuest.account:type_name -> mpb.AccountInfo
+	55, // 10: mpb.CResGetLoginHistory.records:type_name -> mpb.LoginRecord
+	56, // 11: mpb.CResGetReferralStats.referrals:type_name -> mpb.Referral
+	52, // 12: mpb.CResLinkPlatform.account:type_name -> mpb.AccountInfo
+	52, // 13: mpb.CResMergeAccount.account:type_name -> mpb.AccountInfo
+	52, // 14: mpb.CResBindPhone.account:type_name -> mpb.AccountInfo
+	15, // [15:15] is the sub-list for method output_type
+	15, // [15:15] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
+}
+
+func init() { file_http_account_proto_init() }
+func file_http_account_proto_init() {
+	if File_http_account_proto != nil {
+		return
+	}
+	file_common_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_http_account_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CReqLoginByPassword); i {
 			case 0:
 				return &v.state
@@ -1066,8 +3728,176 @@ func file_http_account_proto_init() {
 				return nil
 			}
 		}
-		file_http_account_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CResLoginByPassword); i {
+		file_http_account_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CResLoginByPassword); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_http_account_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CReqGenerateNonce); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_http_account_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CResGenerateNonce); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_http_account_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CReqWebLoginByWallet); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_http_account_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CReqSendEmailBindCode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_http_account_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CResWebLoginByWallet); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_http_account_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CReqWebBindEmail); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_http_account_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CResWebBindEmail); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_http_account_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CResGetAccountInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_http_account_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CResGetAptosResources); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_http_account_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CReqChangePassword); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_http_account_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CReqSendEmailResetPasswordCode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_http_account_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CReqCheckEmailResetPasswordCode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_http_account_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CResCheckEmailResetPasswordCode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_http_account_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CReqResetPasswordByEmail); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1078,8 +3908,8 @@ func file_http_account_proto_init() {
 				return nil
 			}
 		}
-		file_http_account_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CResGenerateNonce); i {
+		file_http_account_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CReqResetPasswordByEmailAndVCode); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1090,8 +3920,8 @@ func file_http_account_proto_init() {
 				return nil
 			}
 		}
-		file_http_account_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CReqWebLoginByWallet); i {
+		file_http_account_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CReqRefreshToken); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1102,8 +3932,8 @@ func file_http_account_proto_init() {
 				return nil
 			}
 		}
-		file_http_account_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CReqSendEmailBindCode); i {
+		file_http_account_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CResRefreshToken); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1114,8 +3944,8 @@ func file_http_account_proto_init() {
 				return nil
 			}
 		}
-		file_http_account_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CResWebLoginByWallet); i {
+		file_http_account_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CResGetSessions); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1126,8 +3956,8 @@ func file_http_account_proto_init() {
 				return nil
 			}
 		}
-		file_http_account_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CReqWebBindEmail); i {
+		file_http_account_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CReqRevokeSession); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1138,8 +3968,8 @@ func file_http_account_proto_init() {
 				return nil
 			}
 		}
-		file_http_account_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CResWebBindEmail); i {
+		file_http_account_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CReqLoginByTOTP); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1150,8 +3980,8 @@ func file_http_account_proto_init() {
 				return nil
 			}
 		}
-		file_http_account_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CResGetAccountInfo); i {
+		file_http_account_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CResEnrollTOTP); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1162,8 +3992,8 @@ func file_http_account_proto_init() {
 				return nil
 			}
 		}
-		file_http_account_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CResGetAptosResources); i {
+		file_http_account_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CReqTOTPCode); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1174,8 +4004,8 @@ func file_http_account_proto_init() {
 				return nil
 			}
 		}
-		file_http_account_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CReqChangePassword); i {
+		file_http_account_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CResConfirmTOTP); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1186,8 +4016,8 @@ func file_http_account_proto_init() {
 				return nil
 			}
 		}
-		file_http_account_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CReqSendEmailResetPasswordCode); i {
+		file_http_account_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CReqRegisterAccount); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1198,8 +4028,8 @@ func file_http_account_proto_init() {
 				return nil
 			}
 		}
-		file_http_account_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CReqCheckEmailResetPasswordCode); i {
+		file_http_account_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CResRegisterAccount); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1210,8 +4040,8 @@ func file_http_account_proto_init() {
 				return nil
 			}
 		}
-		file_http_account_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CResCheckEmailResetPasswordCode); i {
+		file_http_account_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CReqLinkWallet); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1222,8 +4052,8 @@ func file_http_account_proto_init() {
 				return nil
 			}
 		}
-		file_http_account_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CReqResetPasswordByEmail); i {
+		file_http_account_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CReqWalletAddr); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1234,8 +4064,272 @@ func file_http_account_proto_init() {
 				return nil
 			}
 		}
-		file_http_account_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CReqResetPasswordByEmailAndVCode); i {
+		file_http_account_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CResListWallets); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_http_account_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CReqSendEmailChangeCode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_http_account_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CReqChangeEmail); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_http_account_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CResChangeEmail); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_http_account_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CReqRequestAccountDeletion); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_http_account_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CResRequestAccountDeletion); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_http_account_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CResExportAccountData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_http_account_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CReqUpdateProfile); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_http_account_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CResUpdateProfile); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_http_account_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CReqLoginAsGuest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_http_account_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CReqUpgradeGuestByEmail); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_http_account_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CResUpgradeGuest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_http_account_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CResGetLoginHistory); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_http_account_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CResGetReferralStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_http_account_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CReqLoginByPlatform); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_http_account_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CReqLinkPlatform); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_http_account_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CResLinkPlatform); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_http_account_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CReqMergeAccount); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_http_account_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CResMergeAccount); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_http_account_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CReqSendSMSCode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_http_account_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CReqBindPhone); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_http_account_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CResBindPhone); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_http_account_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CReqResetPasswordByPhone); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1253,7 +4347,7 @@ func file_http_account_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_http_account_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   16,
+			NumMessages:   52,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
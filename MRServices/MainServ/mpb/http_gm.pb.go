@@ -522,68 +522,917 @@ func (x *CResAdminGetCollectionNFTOffers) GetNftList() []*AdminGetCollectionNFTO
 	return nil
 }
 
+type CReqAdminRepairAccounts struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StartUserId uint64 `protobuf:"varint,1,opt,name=start_user_id,json=startUserId,proto3" json:"start_user_id,omitempty"`
+	Limit       uint64 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	DryRun      bool   `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+}
+
+func (x *CReqAdminRepairAccounts) Reset() {
+	*x = CReqAdminRepairAccounts{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_http_gm_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CReqAdminRepairAccounts) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CReqAdminRepairAccounts) ProtoMessage() {}
+
+func (x *CReqAdminRepairAccounts) ProtoReflect() protoreflect.Message {
+	mi := &file_http_gm_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CReqAdminRepairAccounts.ProtoReflect.Descriptor instead.
+func (*CReqAdminRepairAccounts) Descriptor() ([]byte, []int) {
+	return file_http_gm_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CReqAdminRepairAccounts) GetStartUserId() uint64 {
+	if x != nil {
+		return x.StartUserId
+	}
+	return 0
+}
+
+func (x *CReqAdminRepairAccounts) GetLimit() uint64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *CReqAdminRepairAccounts) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type CResAdminRepairAccounts struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Repairs    []*AccountRepair `protobuf:"bytes,1,rep,name=repairs,proto3" json:"repairs,omitempty"`
+	NextUserId uint64           `protobuf:"varint,2,opt,name=next_user_id,json=nextUserId,proto3" json:"next_user_id,omitempty"`
+}
+
+func (x *CResAdminRepairAccounts) Reset() {
+	*x = CResAdminRepairAccounts{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_http_gm_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CResAdminRepairAccounts) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CResAdminRepairAccounts) ProtoMessage() {}
+
+func (x *CResAdminRepairAccounts) ProtoReflect() protoreflect.Message {
+	mi := &file_http_gm_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CResAdminRepairAccounts.ProtoReflect.Descriptor instead.
+func (*CResAdminRepairAccounts) Descriptor() ([]byte, []int) {
+	return file_http_gm_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CResAdminRepairAccounts) GetRepairs() []*AccountRepair {
+	if x != nil {
+		return x.Repairs
+	}
+	return nil
+}
+
+func (x *CResAdminRepairAccounts) GetNextUserId() uint64 {
+	if x != nil {
+		return x.NextUserId
+	}
+	return 0
+}
+
+type CReqAdminGetLoginLocks struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type   ELoginLock_Type `protobuf:"varint,1,opt,name=type,proto3,enum=mpb.ELoginLock_Type" json:"type,omitempty"`
+	Target string          `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+}
+
+func (x *CReqAdminGetLoginLocks) Reset() {
+	*x = CReqAdminGetLoginLocks{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_http_gm_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CReqAdminGetLoginLocks) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CReqAdminGetLoginLocks) ProtoMessage() {}
+
+func (x *CReqAdminGetLoginLocks) ProtoReflect() protoreflect.Message {
+	mi := &file_http_gm_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CReqAdminGetLoginLocks.ProtoReflect.Descriptor instead.
+func (*CReqAdminGetLoginLocks) Descriptor() ([]byte, []int) {
+	return file_http_gm_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CReqAdminGetLoginLocks) GetType() ELoginLock_Type {
+	if x != nil {
+		return x.Type
+	}
+	return ELoginLock_Type_None
+}
+
+func (x *CReqAdminGetLoginLocks) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+type CResAdminGetLoginLocks struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Locks []*LoginLock `protobuf:"bytes,1,rep,name=locks,proto3" json:"locks,omitempty"`
+}
+
+func (x *CResAdminGetLoginLocks) Reset() {
+	*x = CResAdminGetLoginLocks{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_http_gm_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CResAdminGetLoginLocks) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CResAdminGetLoginLocks) ProtoMessage() {}
+
+func (x *CResAdminGetLoginLocks) ProtoReflect() protoreflect.Message {
+	mi := &file_http_gm_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CResAdminGetLoginLocks.ProtoReflect.Descriptor instead.
+func (*CResAdminGetLoginLocks) Descriptor() ([]byte, []int) {
+	return file_http_gm_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *CResAdminGetLoginLocks) GetLocks() []*LoginLock {
+	if x != nil {
+		return x.Locks
+	}
+	return nil
+}
+
+type CReqAdminClearLoginLock struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type   ELoginLock_Type `protobuf:"varint,1,opt,name=type,proto3,enum=mpb.ELoginLock_Type" json:"type,omitempty"`
+	Target string          `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+}
+
+func (x *CReqAdminClearLoginLock) Reset() {
+	*x = CReqAdminClearLoginLock{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_http_gm_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CReqAdminClearLoginLock) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CReqAdminClearLoginLock) ProtoMessage() {}
+
+func (x *CReqAdminClearLoginLock) ProtoReflect() protoreflect.Message {
+	mi := &file_http_gm_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CReqAdminClearLoginLock.ProtoReflect.Descriptor instead.
+func (*CReqAdminClearLoginLock) Descriptor() ([]byte, []int) {
+	return file_http_gm_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CReqAdminClearLoginLock) GetType() ELoginLock_Type {
+	if x != nil {
+		return x.Type
+	}
+	return ELoginLock_Type_None
+}
+
+func (x *CReqAdminClearLoginLock) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+type CReqAdminBanAccount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId   uint64    `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Type     EBan_Type `protobuf:"varint,2,opt,name=type,proto3,enum=mpb.EBan_Type" json:"type,omitempty"`
+	Reason   string    `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	Duration int64     `protobuf:"varint,4,opt,name=duration,proto3" json:"duration,omitempty"` // seconds
+}
+
+func (x *CReqAdminBanAccount) Reset() {
+	*x = CReqAdminBanAccount{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_http_gm_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CReqAdminBanAccount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CReqAdminBanAccount) ProtoMessage() {}
+
+func (x *CReqAdminBanAccount) ProtoReflect() protoreflect.Message {
+	mi := &file_http_gm_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CReqAdminBanAccount.ProtoReflect.Descriptor instead.
+func (*CReqAdminBanAccount) Descriptor() ([]byte, []int) {
+	return file_http_gm_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CReqAdminBanAccount) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CReqAdminBanAccount) GetType() EBan_Type {
+	if x != nil {
+		return x.Type
+	}
+	return EBan_Type_None
+}
+
+func (x *CReqAdminBanAccount) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *CReqAdminBanAccount) GetDuration() int64 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+type CResAdminBanAccount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ban *BanRecord `protobuf:"bytes,1,opt,name=ban,proto3" json:"ban,omitempty"`
+}
+
+func (x *CResAdminBanAccount) Reset() {
+	*x = CResAdminBanAccount{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_http_gm_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CResAdminBanAccount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CResAdminBanAccount) ProtoMessage() {}
+
+func (x *CResAdminBanAccount) ProtoReflect() protoreflect.Message {
+	mi := &file_http_gm_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CResAdminBanAccount.ProtoReflect.Descriptor instead.
+func (*CResAdminBanAccount) Descriptor() ([]byte, []int) {
+	return file_http_gm_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *CResAdminBanAccount) GetBan() *BanRecord {
+	if x != nil {
+		return x.Ban
+	}
+	return nil
+}
+
+type CReqAdminUnbanAccount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId uint64    `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Type   EBan_Type `protobuf:"varint,2,opt,name=type,proto3,enum=mpb.EBan_Type" json:"type,omitempty"`
+	Reason string    `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *CReqAdminUnbanAccount) Reset() {
+	*x = CReqAdminUnbanAccount{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_http_gm_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CReqAdminUnbanAccount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CReqAdminUnbanAccount) ProtoMessage() {}
+
+func (x *CReqAdminUnbanAccount) ProtoReflect() protoreflect.Message {
+	mi := &file_http_gm_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CReqAdminUnbanAccount.ProtoReflect.Descriptor instead.
+func (*CReqAdminUnbanAccount) Descriptor() ([]byte, []int) {
+	return file_http_gm_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *CReqAdminUnbanAccount) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CReqAdminUnbanAccount) GetType() EBan_Type {
+	if x != nil {
+		return x.Type
+	}
+	return EBan_Type_None
+}
+
+func (x *CReqAdminUnbanAccount) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type CReqAdminListBans struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type   EBan_Type `protobuf:"varint,1,opt,name=type,proto3,enum=mpb.EBan_Type" json:"type,omitempty"`
+	UserId uint64    `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *CReqAdminListBans) Reset() {
+	*x = CReqAdminListBans{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_http_gm_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CReqAdminListBans) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CReqAdminListBans) ProtoMessage() {}
+
+func (x *CReqAdminListBans) ProtoReflect() protoreflect.Message {
+	mi := &file_http_gm_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CReqAdminListBans.ProtoReflect.Descriptor instead.
+func (*CReqAdminListBans) Descriptor() ([]byte, []int) {
+	return file_http_gm_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CReqAdminListBans) GetType() EBan_Type {
+	if x != nil {
+		return x.Type
+	}
+	return EBan_Type_None
+}
+
+func (x *CReqAdminListBans) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type CResAdminListBans struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Bans []*BanRecord   `protobuf:"bytes,1,rep,name=bans,proto3" json:"bans,omitempty"`
+	Logs []*BanAuditLog `protobuf:"bytes,2,rep,name=logs,proto3" json:"logs,omitempty"`
+}
+
+func (x *CResAdminListBans) Reset() {
+	*x = CResAdminListBans{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_http_gm_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CResAdminListBans) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CResAdminListBans) ProtoMessage() {}
+
+func (x *CResAdminListBans) ProtoReflect() protoreflect.Message {
+	mi := &file_http_gm_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CResAdminListBans.ProtoReflect.Descriptor instead.
+func (*CResAdminListBans) Descriptor() ([]byte, []int) {
+	return file_http_gm_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CResAdminListBans) GetBans() []*BanRecord {
+	if x != nil {
+		return x.Bans
+	}
+	return nil
+}
+
+func (x *CResAdminListBans) GetLogs() []*BanAuditLog {
+	if x != nil {
+		return x.Logs
+	}
+	return nil
+}
+
+type CReqAdminGetTopReferrers struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Limit uint32 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *CReqAdminGetTopReferrers) Reset() {
+	*x = CReqAdminGetTopReferrers{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_http_gm_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CReqAdminGetTopReferrers) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CReqAdminGetTopReferrers) ProtoMessage() {}
+
+func (x *CReqAdminGetTopReferrers) ProtoReflect() protoreflect.Message {
+	mi := &file_http_gm_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CReqAdminGetTopReferrers.ProtoReflect.Descriptor instead.
+func (*CReqAdminGetTopReferrers) Descriptor() ([]byte, []int) {
+	return file_http_gm_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *CReqAdminGetTopReferrers) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type CResAdminGetTopReferrers struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Referrers []*ReferrerRank `protobuf:"bytes,1,rep,name=referrers,proto3" json:"referrers,omitempty"`
+}
+
+func (x *CResAdminGetTopReferrers) Reset() {
+	*x = CResAdminGetTopReferrers{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_http_gm_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CResAdminGetTopReferrers) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CResAdminGetTopReferrers) ProtoMessage() {}
+
+func (x *CResAdminGetTopReferrers) ProtoReflect() protoreflect.Message {
+	mi := &file_http_gm_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CResAdminGetTopReferrers.ProtoReflect.Descriptor instead.
+func (*CResAdminGetTopReferrers) Descriptor() ([]byte, []int) {
+	return file_http_gm_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *CResAdminGetTopReferrers) GetReferrers() []*ReferrerRank {
+	if x != nil {
+		return x.Referrers
+	}
+	return nil
+}
+
+type CReqAdminMergeAccounts struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId       uint64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	MergedUserId uint64 `protobuf:"varint,2,opt,name=merged_user_id,json=mergedUserId,proto3" json:"merged_user_id,omitempty"`
+	Reason       string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *CReqAdminMergeAccounts) Reset() {
+	*x = CReqAdminMergeAccounts{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_http_gm_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CReqAdminMergeAccounts) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CReqAdminMergeAccounts) ProtoMessage() {}
+
+func (x *CReqAdminMergeAccounts) ProtoReflect() protoreflect.Message {
+	mi := &file_http_gm_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CReqAdminMergeAccounts.ProtoReflect.Descriptor instead.
+func (*CReqAdminMergeAccounts) Descriptor() ([]byte, []int) {
+	return file_http_gm_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *CReqAdminMergeAccounts) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CReqAdminMergeAccounts) GetMergedUserId() uint64 {
+	if x != nil {
+		return x.MergedUserId
+	}
+	return 0
+}
+
+func (x *CReqAdminMergeAccounts) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type CResAdminMergeAccounts struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Log *AccountMergeLog `protobuf:"bytes,1,opt,name=log,proto3" json:"log,omitempty"`
+}
+
+func (x *CResAdminMergeAccounts) Reset() {
+	*x = CResAdminMergeAccounts{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_http_gm_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CResAdminMergeAccounts) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CResAdminMergeAccounts) ProtoMessage() {}
+
+func (x *CResAdminMergeAccounts) ProtoReflect() protoreflect.Message {
+	mi := &file_http_gm_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CResAdminMergeAccounts.ProtoReflect.Descriptor instead.
+func (*CResAdminMergeAccounts) Descriptor() ([]byte, []int) {
+	return file_http_gm_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *CResAdminMergeAccounts) GetLog() *AccountMergeLog {
+	if x != nil {
+		return x.Log
+	}
+	return nil
+}
+
 var File_http_gm_proto protoreflect.FileDescriptor
 
 var file_http_gm_proto_rawDesc = []byte{
 	0x0a, 0x0d, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x67, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
 	0x03, 0x6d, 0x70, 0x62, 0x1a, 0x0c, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x1a, 0x0e, 0x67, 0x72, 0x70, 0x63, 0x5f, 0x6e, 0x66, 0x74, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x22, 0x50, 0x0a, 0x18, 0x43, 0x52, 0x65, 0x71, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c,
-	0x6f, 0x67, 0x69, 0x6e, 0x42, 0x79, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x18,
-	0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73,
-	0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73,
-	0x77, 0x6f, 0x72, 0x64, 0x22, 0x30, 0x0a, 0x18, 0x43, 0x52, 0x65, 0x73, 0x41, 0x64, 0x6d, 0x69,
-	0x6e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x42, 0x79, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
-	0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x36, 0x0a, 0x19, 0x43, 0x52, 0x65, 0x71, 0x41, 0x64,
-	0x6d, 0x69, 0x6e, 0x47, 0x65, 0x74, 0x41, 0x70, 0x74, 0x6f, 0x73, 0x4e, 0x46, 0x54, 0x4f, 0x77,
-	0x6e, 0x65, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x22, 0x43,
-	0x0a, 0x19, 0x43, 0x52, 0x65, 0x73, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x47, 0x65, 0x74, 0x41, 0x70,
-	0x74, 0x6f, 0x73, 0x4e, 0x46, 0x54, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x26, 0x0a, 0x05, 0x6f,
-	0x77, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d, 0x70, 0x62,
-	0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x6f, 0x77,
-	0x6e, 0x65, 0x72, 0x22, 0x48, 0x0a, 0x21, 0x43, 0x52, 0x65, 0x71, 0x41, 0x64, 0x6d, 0x69, 0x6e,
-	0x47, 0x65, 0x74, 0x41, 0x70, 0x74, 0x6f, 0x73, 0x4e, 0x46, 0x54, 0x73, 0x49, 0x6e, 0x43, 0x6f,
-	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6c, 0x6c,
-	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0c, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x66, 0x0a,
-	0x21, 0x43, 0x52, 0x65, 0x73, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x47, 0x65, 0x74, 0x41, 0x70, 0x74,
-	0x6f, 0x73, 0x4e, 0x46, 0x54, 0x73, 0x49, 0x6e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x41, 0x0a, 0x08, 0x6e, 0x66, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e,
-	0x47, 0x65, 0x74, 0x41, 0x70, 0x74, 0x6f, 0x73, 0x4e, 0x46, 0x54, 0x73, 0x49, 0x6e, 0x43, 0x6f,
-	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x07, 0x6e, 0x66,
-	0x74, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x46, 0x0a, 0x1f, 0x43, 0x52, 0x65, 0x71, 0x41, 0x64, 0x6d,
-	0x69, 0x6e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e,
-	0x46, 0x54, 0x42, 0x75, 0x79, 0x65, 0x72, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6c, 0x6c,
-	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0c, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x62, 0x0a,
-	0x1f, 0x43, 0x52, 0x65, 0x73, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c,
-	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x46, 0x54, 0x42, 0x75, 0x79, 0x65, 0x72, 0x73,
-	0x12, 0x3f, 0x0a, 0x08, 0x6e, 0x66, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x47, 0x65,
+	0x74, 0x6f, 0x1a, 0x12, 0x67, 0x72, 0x70, 0x63, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x50, 0x0a, 0x18, 0x43, 0x52, 0x65, 0x71, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x42, 0x79, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08,
+	0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x30, 0x0a, 0x18, 0x43, 0x52, 0x65, 0x73,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x42, 0x79, 0x50, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x36, 0x0a, 0x19, 0x43, 0x52,
+	0x65, 0x71, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x47, 0x65, 0x74, 0x41, 0x70, 0x74, 0x6f, 0x73, 0x4e,
+	0x46, 0x54, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x49, 0x64, 0x22, 0x43, 0x0a, 0x19, 0x43, 0x52, 0x65, 0x73, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x47,
+	0x65, 0x74, 0x41, 0x70, 0x74, 0x6f, 0x73, 0x4e, 0x46, 0x54, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12,
+	0x26, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10,
+	0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x22, 0x48, 0x0a, 0x21, 0x43, 0x52, 0x65, 0x71, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x47, 0x65, 0x74, 0x41, 0x70, 0x74, 0x6f, 0x73, 0x4e, 0x46, 0x54, 0x73,
+	0x49, 0x6e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d,
+	0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x64, 0x22, 0x66, 0x0a, 0x21, 0x43, 0x52, 0x65, 0x73, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x47, 0x65,
+	0x74, 0x41, 0x70, 0x74, 0x6f, 0x73, 0x4e, 0x46, 0x54, 0x73, 0x49, 0x6e, 0x43, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x41, 0x0a, 0x08, 0x6e, 0x66, 0x74, 0x5f, 0x6c, 0x69,
+	0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x47, 0x65, 0x74, 0x41, 0x70, 0x74, 0x6f, 0x73, 0x4e, 0x46, 0x54, 0x73,
+	0x49, 0x6e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x64, 0x65,
+	0x52, 0x07, 0x6e, 0x66, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x46, 0x0a, 0x1f, 0x43, 0x52, 0x65,
+	0x71, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x4e, 0x46, 0x54, 0x42, 0x75, 0x79, 0x65, 0x72, 0x73, 0x12, 0x23, 0x0a, 0x0d,
+	0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x64, 0x22, 0x62, 0x0a, 0x1f, 0x43, 0x52, 0x65, 0x73, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x47, 0x65,
 	0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x46, 0x54, 0x42, 0x75,
-	0x79, 0x65, 0x72, 0x73, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x07, 0x6e, 0x66, 0x74, 0x4c, 0x69, 0x73,
-	0x74, 0x22, 0x9b, 0x01, 0x0a, 0x1f, 0x43, 0x52, 0x65, 0x71, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x47,
-	0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x46, 0x54, 0x4f,
-	0x66, 0x66, 0x65, 0x72, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f,
-	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x64, 0x73,
-	0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x64, 0x73,
-	0x74, 0x41, 0x64, 0x64, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74,
-	0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74,
-	0x54, 0x69, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x22,
-	0x62, 0x0a, 0x1f, 0x43, 0x52, 0x65, 0x73, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x47, 0x65, 0x74, 0x43,
-	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x46, 0x54, 0x4f, 0x66, 0x66, 0x65,
-	0x72, 0x73, 0x12, 0x3f, 0x0a, 0x08, 0x6e, 0x66, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x79, 0x65, 0x72, 0x73, 0x12, 0x3f, 0x0a, 0x08, 0x6e, 0x66, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e,
+	0x46, 0x54, 0x42, 0x75, 0x79, 0x65, 0x72, 0x73, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x07, 0x6e, 0x66,
+	0x74, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x9b, 0x01, 0x0a, 0x1f, 0x43, 0x52, 0x65, 0x71, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x4e, 0x46, 0x54, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x19,
+	0x0a, 0x08, 0x64, 0x73, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x64, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54,
+	0x69, 0x6d, 0x65, 0x22, 0x62, 0x0a, 0x1f, 0x43, 0x52, 0x65, 0x73, 0x41, 0x64, 0x6d, 0x69, 0x6e,
 	0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x46, 0x54,
-	0x4f, 0x66, 0x66, 0x65, 0x72, 0x73, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x07, 0x6e, 0x66, 0x74, 0x4c,
-	0x69, 0x73, 0x74, 0x42, 0x07, 0x5a, 0x05, 0x2e, 0x2f, 0x6d, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	0x4f, 0x66, 0x66, 0x65, 0x72, 0x73, 0x12, 0x3f, 0x0a, 0x08, 0x6e, 0x66, 0x74, 0x5f, 0x6c, 0x69,
+	0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x4e, 0x46, 0x54, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x73, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x07,
+	0x6e, 0x66, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x6c, 0x0a, 0x17, 0x43, 0x52, 0x65, 0x71, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x70, 0x61, 0x69, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x73, 0x12, 0x22, 0x0a, 0x0d, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x17, 0x0a, 0x07,
+	0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64,
+	0x72, 0x79, 0x52, 0x75, 0x6e, 0x22, 0x69, 0x0a, 0x17, 0x43, 0x52, 0x65, 0x73, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x52, 0x65, 0x70, 0x61, 0x69, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73,
+	0x12, 0x2c, 0x0a, 0x07, 0x72, 0x65, 0x70, 0x61, 0x69, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52,
+	0x65, 0x70, 0x61, 0x69, 0x72, 0x52, 0x07, 0x72, 0x65, 0x70, 0x61, 0x69, 0x72, 0x73, 0x12, 0x20,
+	0x0a, 0x0c, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x22, 0x5a, 0x0a, 0x16, 0x43, 0x52, 0x65, 0x71, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x47, 0x65, 0x74,
+	0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x4c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x28, 0x0a, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x45,
+	0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x4c, 0x6f, 0x63, 0x6b, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x22, 0x3e, 0x0a, 0x16,
+	0x43, 0x52, 0x65, 0x73, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x69,
+	0x6e, 0x4c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x24, 0x0a, 0x05, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x4c, 0x6f, 0x67, 0x69,
+	0x6e, 0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x05, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x22, 0x5b, 0x0a, 0x17,
+	0x43, 0x52, 0x65, 0x71, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x4c, 0x6f,
+	0x67, 0x69, 0x6e, 0x4c, 0x6f, 0x63, 0x6b, 0x12, 0x28, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x45, 0x4c, 0x6f, 0x67,
+	0x69, 0x6e, 0x4c, 0x6f, 0x63, 0x6b, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x22, 0x86, 0x01, 0x0a, 0x13, 0x43, 0x52,
+	0x65, 0x71, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x42, 0x61, 0x6e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x45,
+	0x42, 0x61, 0x6e, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x22, 0x37, 0x0a, 0x13, 0x43, 0x52, 0x65, 0x73, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x42,
+	0x61, 0x6e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x03, 0x62, 0x61, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x42, 0x61, 0x6e,
+	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x03, 0x62, 0x61, 0x6e, 0x22, 0x6c, 0x0a, 0x15, 0x43,
+	0x52, 0x65, 0x71, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x6e, 0x62, 0x61, 0x6e, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x22, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x6d, 0x70,
+	0x62, 0x2e, 0x45, 0x42, 0x61, 0x6e, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x50, 0x0a, 0x11, 0x43, 0x52, 0x65,
+	0x71, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x6e, 0x73, 0x12, 0x22,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x6d,
+	0x70, 0x62, 0x2e, 0x45, 0x42, 0x61, 0x6e, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x5d, 0x0a, 0x11, 0x43,
+	0x52, 0x65, 0x73, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x6e, 0x73,
+	0x12, 0x22, 0x0a, 0x04, 0x62, 0x61, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e,
+	0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x42, 0x61, 0x6e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x04,
+	0x62, 0x61, 0x6e, 0x73, 0x12, 0x24, 0x0a, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x42, 0x61, 0x6e, 0x41, 0x75, 0x64, 0x69,
+	0x74, 0x4c, 0x6f, 0x67, 0x52, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x22, 0x30, 0x0a, 0x18, 0x43, 0x52,
+	0x65, 0x71, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x70, 0x52, 0x65, 0x66,
+	0x65, 0x72, 0x72, 0x65, 0x72, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x4b, 0x0a, 0x18,
+	0x43, 0x52, 0x65, 0x73, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x70, 0x52,
+	0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x72, 0x73, 0x12, 0x2f, 0x0a, 0x09, 0x72, 0x65, 0x66, 0x65,
+	0x72, 0x72, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6d, 0x70,
+	0x62, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x72, 0x52, 0x61, 0x6e, 0x6b, 0x52, 0x09,
+	0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x72, 0x73, 0x22, 0x6f, 0x0a, 0x16, 0x43, 0x52, 0x65,
+	0x71, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0e,
+	0x6d, 0x65, 0x72, 0x67, 0x65, 0x64, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x40, 0x0a, 0x16, 0x43, 0x52,
+	0x65, 0x73, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x73, 0x12, 0x26, 0x0a, 0x03, 0x6c, 0x6f, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x6d, 0x70, 0x62, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d,
+	0x65, 0x72, 0x67, 0x65, 0x4c, 0x6f, 0x67, 0x52, 0x03, 0x6c, 0x6f, 0x67, 0x42, 0x07, 0x5a, 0x05,
+	0x2e, 0x2f, 0x6d, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -598,7 +1447,7 @@ func file_http_gm_proto_rawDescGZIP() []byte {
 	return file_http_gm_proto_rawDescData
 }
 
-var file_http_gm_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_http_gm_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
 var file_http_gm_proto_goTypes = []interface{}{
 	(*CReqAdminLoginByPassword)(nil),          // 0: mpb.CReqAdminLoginByPassword
 	(*CResAdminLoginByPassword)(nil),          // 1: mpb.CResAdminLoginByPassword
@@ -610,21 +1459,55 @@ var file_http_gm_proto_goTypes = []interface{}{
 	(*CResAdminGetCollectionNFTBuyers)(nil),   // 7: mpb.CResAdminGetCollectionNFTBuyers
 	(*CReqAdminGetCollectionNFTOffers)(nil),   // 8: mpb.CReqAdminGetCollectionNFTOffers
 	(*CResAdminGetCollectionNFTOffers)(nil),   // 9: mpb.CResAdminGetCollectionNFTOffers
-	(*AccountInfo)(nil),                       // 10: mpb.AccountInfo
-	(*AdminGetAptosNFTsInCollectionNode)(nil), // 11: mpb.AdminGetAptosNFTsInCollectionNode
-	(*AdminGetCollectionNFTBuyersNode)(nil),   // 12: mpb.AdminGetCollectionNFTBuyersNode
-	(*AdminGetCollectionNFTOffersNode)(nil),   // 13: mpb.AdminGetCollectionNFTOffersNode
+	(*CReqAdminRepairAccounts)(nil),           // 10: mpb.CReqAdminRepairAccounts
+	(*CResAdminRepairAccounts)(nil),           // 11: mpb.CResAdminRepairAccounts
+	(*CReqAdminGetLoginLocks)(nil),            // 12: mpb.CReqAdminGetLoginLocks
+	(*CResAdminGetLoginLocks)(nil),            // 13: mpb.CResAdminGetLoginLocks
+	(*CReqAdminClearLoginLock)(nil),           // 14: mpb.CReqAdminClearLoginLock
+	(*CReqAdminBanAccount)(nil),               // 15: mpb.CReqAdminBanAccount
+	(*CResAdminBanAccount)(nil),               // 16: mpb.CResAdminBanAccount
+	(*CReqAdminUnbanAccount)(nil),             // 17: mpb.CReqAdminUnbanAccount
+	(*CReqAdminListBans)(nil),                 // 18: mpb.CReqAdminListBans
+	(*CResAdminListBans)(nil),                 // 19: mpb.CResAdminListBans
+	(*CReqAdminGetTopReferrers)(nil),          // 20: mpb.CReqAdminGetTopReferrers
+	(*CResAdminGetTopReferrers)(nil),          // 21: mpb.CResAdminGetTopReferrers
+	(*CReqAdminMergeAccounts)(nil),            // 22: mpb.CReqAdminMergeAccounts
+	(*CResAdminMergeAccounts)(nil),            // 23: mpb.CResAdminMergeAccounts
+	(*AccountInfo)(nil),                       // 24: mpb.AccountInfo
+	(*AdminGetAptosNFTsInCollectionNode)(nil), // 25: mpb.AdminGetAptosNFTsInCollectionNode
+	(*AdminGetCollectionNFTBuyersNode)(nil),   // 26: mpb.AdminGetCollectionNFTBuyersNode
+	(*AdminGetCollectionNFTOffersNode)(nil),   // 27: mpb.AdminGetCollectionNFTOffersNode
+	(*AccountRepair)(nil),                     // 28: mpb.AccountRepair
+	(ELoginLock_Type)(0),                      // 29: mpb.ELoginLock.Type
+	(*LoginLock)(nil),                         // 30: mpb.LoginLock
+	(EBan_Type)(0),                            // 31: mpb.EBan.Type
+	(*BanRecord)(nil),                         // 32: mpb.BanRecord
+	(*BanAuditLog)(nil),                       // 33: mpb.BanAuditLog
+	(*ReferrerRank)(nil),                      // 34: mpb.ReferrerRank
+	(*AccountMergeLog)(nil),                   // 35: mpb.AccountMergeLog
 }
 var file_http_gm_proto_depIdxs = []int32{
-	10, // 0: mpb.CResAdminGetAptosNFTOwner.owner:type_name -> mpb.AccountInfo
-	11, // 1: mpb.CResAdminGetAptosNFTsInCollection.nft_list:type_name -> mpb.AdminGetAptosNFTsInCollectionNode
-	12, // 2: mpb.CResAdminGetCollectionNFTBuyers.nft_list:type_name -> mpb.AdminGetCollectionNFTBuyersNode
-	13, // 3: mpb.CResAdminGetCollectionNFTOffers.nft_list:type_name -> mpb.AdminGetCollectionNFTOffersNode
-	4,  // [4:4] is the sub-list for method output_type
-	4,  // [4:4] is the sub-list for method input_type
-	4,  // [4:4] is the sub-list for extension type_name
-	4,  // [4:4] is the sub-list for extension extendee
-	0,  // [0:4] is the sub-list for field type_name
+	24, // 0: mpb.CResAdminGetAptosNFTOwner.owner:type_name -> mpb.AccountInfo
+	25, // 1: mpb.CResAdminGetAptosNFTsInCollection.nft_list:type_name -> mpb.AdminGetAptosNFTsInCollectionNode
+	26, // 2: mpb.CResAdminGetCollectionNFTBuyers.nft_list:type_name -> mpb.AdminGetCollectionNFTBuyersNode
+	27, // 3: mpb.CResAdminGetCollectionNFTOffers.nft_list:type_name -> mpb.AdminGetCollectionNFTOffersNode
+	28, // 4: mpb.CResAdminRepairAccounts.repairs:type_name -> mpb.AccountRepair
+	29, // 5: mpb.CReqAdminGetLoginLocks.type:type_name -> mpb.ELoginLock.Type
+	30, // 6: mpb.CResAdminGetLoginLocks.locks:type_name -> mpb.LoginLock
+	29, // 7: mpb.CReqAdminClearLoginLock.type:type_name -> mpb.ELoginLock.Type
+	31, // 8: mpb.CReqAdminBanAccount.type:type_name -> mpb.EBan.Type
+	32, // 9: mpb.CResAdminBanAccount.ban:type_name -> mpb.BanRecord
+	31, // 10: mpb.CReqAdminUnbanAccount.type:type_name -> mpb.EBan.Type
+	31, // 11: mpb.CReqAdminListBans.type:type_name -> mpb.EBan.Type
+	32, // 12: mpb.CResAdminListBans.bans:type_name -> mpb.BanRecord
+	33, // 13: mpb.CResAdminListBans.logs:type_name -> mpb.BanAuditLog
+	34, // 14: mpb.CResAdminGetTopReferrers.referrers:type_name -> mpb.ReferrerRank
+	35, // 15: mpb.CResAdminMergeAccounts.log:type_name -> mpb.AccountMergeLog
+	16, // [16:16] is the sub-list for method output_type
+	16, // [16:16] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
 }
 
 func init() { file_http_gm_proto_init() }
@@ -634,6 +1517,7 @@ func file_http_gm_proto_init() {
 	}
 	file_common_proto_init()
 	file_grpc_nft_proto_init()
+	file_grpc_account_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_http_gm_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CReqAdminLoginByPassword); i {
@@ -755,6 +1639,174 @@ func file_http_gm_proto_init() {
 				return nil
 			}
 		}
+		file_http_gm_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CReqAdminRepairAccounts); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_http_gm_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CResAdminRepairAccounts); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_http_gm_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CReqAdminGetLoginLocks); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_http_gm_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CResAdminGetLoginLocks); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_http_gm_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CReqAdminClearLoginLock); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_http_gm_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CReqAdminBanAccount); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_http_gm_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CResAdminBanAccount); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_http_gm_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CReqAdminUnbanAccount); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_http_gm_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CReqAdminListBans); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_http_gm_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CResAdminListBans); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_http_gm_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CReqAdminGetTopReferrers); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_http_gm_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CResAdminGetTopReferrers); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_http_gm_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CReqAdminMergeAccounts); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_http_gm_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CResAdminMergeAccounts); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -762,7 +1814,7 @@ func file_http_gm_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_http_gm_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   10,
+			NumMessages:   24,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -16,6 +16,7 @@ var (
 	ErrDB              = errors.New(mpb.ErrCode_ERR_DB.String())
 	ErrConfig          = errors.New(mpb.ErrCode_ERR_CONFIG.String())
 	ErrRepeatedRequest = errors.New(mpb.ErrCode_ERR_REPEATED_REQUEST.String())
+	ErrUnknown         = errors.New(mpb.ErrCode_ERR_UNKNOWN.String())
 
 	// tcpgateway error
 	ErrMsgDecode    = errors.New(mpb.ErrCode_ERR_MSG_DECODE.String())
@@ -36,6 +37,41 @@ var (
 	ErrAptosVerifySignature  = errors.New(mpb.ErrCode_ERR_APTOS_VERIFY_SIGNATURE.String())
 	ErrNewPWSameWithOldPW    = errors.New(mpb.ErrCode_ERR_NEW_PASSWD_SAME_WITH_OLD_PASSWD.String())
 	ErrEmailVerificationCode = errors.New(mpb.ErrCode_ERR_EMAIL_VERIFICATION_CODE.String())
+	ErrSessionNotExist       = errors.New(mpb.ErrCode_ERR_SESSION_NOT_EXIST.String())
+	ErrTOTPEnabled           = errors.New(mpb.ErrCode_ERR_TOTP_ENABLED.String())
+	ErrTOTPNotEnabled        = errors.New(mpb.ErrCode_ERR_TOTP_NOT_ENABLED.String())
+	ErrTOTPRequired          = errors.New(mpb.ErrCode_ERR_TOTP_REQUIRED.String())
+	ErrTOTPCode              = errors.New(mpb.ErrCode_ERR_TOTP_CODE.String())
+	ErrTOTPTooManyAttempts   = errors.New(mpb.ErrCode_ERR_TOTP_TOO_MANY_ATTEMPTS.String())
+	ErrWalletBound           = errors.New(mpb.ErrCode_ERR_WALLET_BOUND.String())
+	ErrAccBoundWallet        = errors.New(mpb.ErrCode_ERR_ACC_BOUND_WALLET.String())
+	ErrWalletLimit           = errors.New(mpb.ErrCode_ERR_WALLET_LIMIT.String())
+	ErrWalletNotLinked       = errors.New(mpb.ErrCode_ERR_WALLET_NOT_LINKED.String())
+	ErrWalletLastLogin       = errors.New(mpb.ErrCode_ERR_WALLET_LAST_LOGIN_METHOD.String())
+	ErrAptosAuthKey          = errors.New(mpb.ErrCode_ERR_APTOS_AUTH_KEY.String())
+	ErrAptosMessage          = errors.New(mpb.ErrCode_ERR_APTOS_MESSAGE.String())
+	ErrAptosMessageExpired   = errors.New(mpb.ErrCode_ERR_APTOS_MESSAGE_EXPIRED.String())
+	ErrNicknameInvalid       = errors.New(mpb.ErrCode_ERR_NICKNAME_INVALID.String())
+	ErrNicknameSensitive     = errors.New(mpb.ErrCode_ERR_NICKNAME_SENSITIVE.String())
+	ErrNicknameExist         = errors.New(mpb.ErrCode_ERR_NICKNAME_EXIST.String())
+	ErrProfileCooldown       = errors.New(mpb.ErrCode_ERR_PROFILE_COOLDOWN.String())
+	ErrIconInvalid           = errors.New(mpb.ErrCode_ERR_ICON_INVALID.String())
+	ErrGuestLimit            = errors.New(mpb.ErrCode_ERR_GUEST_LIMIT.String())
+	ErrNotGuest              = errors.New(mpb.ErrCode_ERR_NOT_GUEST.String())
+	ErrLoginLocked           = errors.New(mpb.ErrCode_ERR_LOGIN_LOCKED.String())
+	ErrAccountBanned         = errors.New(mpb.ErrCode_ERR_ACCOUNT_BANNED.String())
+	ErrAccountMuted          = errors.New(mpb.ErrCode_ERR_ACCOUNT_MUTED.String())
+	ErrReferrerCode          = errors.New(mpb.ErrCode_ERR_REFERRER_CODE.String())
+	ErrPlatformToken         = errors.New(mpb.ErrCode_ERR_PLATFORM_TOKEN.String())
+	ErrPlatformNotSupported  = errors.New(mpb.ErrCode_ERR_PLATFORM_NOT_SUPPORTED.String())
+	ErrPlatformLinked        = errors.New(mpb.ErrCode_ERR_PLATFORM_LINKED.String())
+	ErrAccountMergeConflict  = errors.New(mpb.ErrCode_ERR_ACCOUNT_MERGE_CONFLICT.String())
+	ErrPhoneNumber           = errors.New(mpb.ErrCode_ERR_PHONE_NUMBER.String())
+	ErrSMSSendMax            = errors.New(mpb.ErrCode_ERR_SMS_SEND_MAX.String())
+	ErrSMSVerificationCode   = errors.New(mpb.ErrCode_ERR_SMS_VERIFICATION_CODE.String())
+	ErrPhoneBound            = errors.New(mpb.ErrCode_ERR_PHONE_BOUND.String())
+	ErrAccBoundPhone         = errors.New(mpb.ErrCode_ERR_ACC_BOUND_PHONE.String())
+	ErrPhoneNotExist         = errors.New(mpb.ErrCode_ERR_PHONE_NOT_EXIST.String())
 
 	//nft
 	ErrParseNFTId = errors.New(mpb.ErrCode_ERR_PARSE_NFT_ID.String())
@@ -43,6 +79,15 @@ var (
 	ErrNFTNoOwner = errors.New(mpb.ErrCode_ERR_NFT_NO_OWNER.String())
 	ErrNFTHashId  = errors.New(mpb.ErrCode_ERR_NFT_HASH_ID.String())
 
+	//apiproxy
+	ErrEmailMessageNotExist = errors.New(mpb.ErrCode_ERR_EMAIL_MESSAGE_NOT_EXIST.String())
+	ErrSMSSend              = errors.New(mpb.ErrCode_ERR_SMS_SEND.String())
+	ErrUpstreamUnavailable  = errors.New(mpb.ErrCode_ERR_UPSTREAM_UNAVAILABLE.String())
+	ErrUpstreamTimeout      = errors.New(mpb.ErrCode_ERR_UPSTREAM_TIMEOUT.String())
+	ErrUpstreamRateLimited  = errors.New(mpb.ErrCode_ERR_UPSTREAM_RATE_LIMITED.String())
+	ErrUpstreamNotFound     = errors.New(mpb.ErrCode_ERR_UPSTREAM_NOT_FOUND.String())
+	ErrUpstreamBadResponse  = errors.New(mpb.ErrCode_ERR_UPSTREAM_BAD_RESPONSE.String())
+
 	//gm
 	ErrAdminAccountOrPasswd = errors.New(mpb.ErrCode_ERR_ADMIN_ACCOUNT_OR_PASSWD.String())
 )
@@ -70,11 +115,53 @@ var HTTPErrMap = map[string]int{
 	mpb.ErrCode_ERR_APTOS_VERIFY_SIGNATURE.String():          http.StatusBadRequest,
 	mpb.ErrCode_ERR_NEW_PASSWD_SAME_WITH_OLD_PASSWD.String(): http.StatusBadRequest,
 	mpb.ErrCode_ERR_EMAIL_VERIFICATION_CODE.String():         http.StatusBadRequest,
+	mpb.ErrCode_ERR_SESSION_NOT_EXIST.String():               http.StatusBadRequest,
+	mpb.ErrCode_ERR_TOTP_ENABLED.String():                    http.StatusBadRequest,
+	mpb.ErrCode_ERR_TOTP_NOT_ENABLED.String():                http.StatusBadRequest,
+	mpb.ErrCode_ERR_TOTP_REQUIRED.String():                   http.StatusBadRequest,
+	mpb.ErrCode_ERR_TOTP_CODE.String():                       http.StatusBadRequest,
+	mpb.ErrCode_ERR_TOTP_TOO_MANY_ATTEMPTS.String():          http.StatusBadRequest,
+	mpb.ErrCode_ERR_WALLET_BOUND.String():                    http.StatusBadRequest,
+	mpb.ErrCode_ERR_ACC_BOUND_WALLET.String():                http.StatusBadRequest,
+	mpb.ErrCode_ERR_WALLET_LIMIT.String():                    http.StatusBadRequest,
+	mpb.ErrCode_ERR_WALLET_NOT_LINKED.String():               http.StatusBadRequest,
+	mpb.ErrCode_ERR_WALLET_LAST_LOGIN_METHOD.String():        http.StatusBadRequest,
+	mpb.ErrCode_ERR_APTOS_AUTH_KEY.String():                  http.StatusBadRequest,
+	mpb.ErrCode_ERR_APTOS_MESSAGE.String():                   http.StatusBadRequest,
+	mpb.ErrCode_ERR_APTOS_MESSAGE_EXPIRED.String():           http.StatusBadRequest,
+	mpb.ErrCode_ERR_NICKNAME_INVALID.String():                http.StatusBadRequest,
+	mpb.ErrCode_ERR_NICKNAME_SENSITIVE.String():              http.StatusBadRequest,
+	mpb.ErrCode_ERR_NICKNAME_EXIST.String():                  http.StatusBadRequest,
+	mpb.ErrCode_ERR_PROFILE_COOLDOWN.String():                http.StatusBadRequest,
+	mpb.ErrCode_ERR_ICON_INVALID.String():                    http.StatusBadRequest,
+	mpb.ErrCode_ERR_GUEST_LIMIT.String():                     http.StatusBadRequest,
+	mpb.ErrCode_ERR_NOT_GUEST.String():                       http.StatusBadRequest,
+	mpb.ErrCode_ERR_LOGIN_LOCKED.String():                    http.StatusBadRequest,
+	mpb.ErrCode_ERR_ACCOUNT_BANNED.String():                  http.StatusBadRequest,
+	mpb.ErrCode_ERR_ACCOUNT_MUTED.String():                   http.StatusBadRequest,
+	mpb.ErrCode_ERR_REFERRER_CODE.String():                   http.StatusBadRequest,
+	mpb.ErrCode_ERR_PLATFORM_TOKEN.String():                  http.StatusBadRequest,
+	mpb.ErrCode_ERR_PLATFORM_NOT_SUPPORTED.String():          http.StatusBadRequest,
+	mpb.ErrCode_ERR_PLATFORM_LINKED.String():                 http.StatusBadRequest,
+	mpb.ErrCode_ERR_ACCOUNT_MERGE_CONFLICT.String():          http.StatusBadRequest,
+	mpb.ErrCode_ERR_PHONE_NUMBER.String():                    http.StatusBadRequest,
+	mpb.ErrCode_ERR_SMS_SEND_MAX.String():                    http.StatusBadRequest,
+	mpb.ErrCode_ERR_SMS_VERIFICATION_CODE.String():           http.StatusBadRequest,
+	mpb.ErrCode_ERR_PHONE_BOUND.String():                     http.StatusBadRequest,
+	mpb.ErrCode_ERR_ACC_BOUND_PHONE.String():                 http.StatusBadRequest,
+	mpb.ErrCode_ERR_PHONE_NOT_EXIST.String():                 http.StatusBadRequest,
 	mpb.ErrCode_ERR_PARSE_NFT_ID.String():                    http.StatusBadRequest,
 	mpb.ErrCode_ERR_ADMIN_ACCOUNT_OR_PASSWD.String():         http.StatusBadRequest,
 	mpb.ErrCode_ERR_NFT_TOKEN_ID.String():                    http.StatusBadRequest,
 	mpb.ErrCode_ERR_NFT_NO_OWNER.String():                    http.StatusBadRequest,
 	mpb.ErrCode_ERR_NFT_HASH_ID.String():                     http.StatusBadRequest,
+	mpb.ErrCode_ERR_EMAIL_MESSAGE_NOT_EXIST.String():         http.StatusBadRequest,
+	mpb.ErrCode_ERR_SMS_SEND.String():                        http.StatusBadRequest,
+	mpb.ErrCode_ERR_UPSTREAM_UNAVAILABLE.String():            http.StatusBadRequest,
+	mpb.ErrCode_ERR_UPSTREAM_TIMEOUT.String():                http.StatusBadRequest,
+	mpb.ErrCode_ERR_UPSTREAM_RATE_LIMITED.String():           http.StatusBadRequest,
+	mpb.ErrCode_ERR_UPSTREAM_NOT_FOUND.String():              http.StatusBadRequest,
+	mpb.ErrCode_ERR_UPSTREAM_BAD_RESPONSE.String():           http.StatusBadRequest,
 }
 
 func ErrMsg(fc gprotocol.FrameCoder, err error) []byte {
@@ -2,7 +2,6 @@ package nftservice
 
 import (
 	"context"
-	"fmt"
 	"time"
 
 	com "github.com/aureontu/MRWebServer/mr_services/common"
@@ -62,28 +61,39 @@ func (dao *nftDAO) setGraphiQLQueryStartIndex(ctx context.Context, addr string,
 	return nil
 }
 
-func (dao *nftDAO) updateNFTs(ctx context.Context, userId uint64, addNFTs map[string]*mpb.AptosNFTNodeV2,
+// updateNFTs apply the nft changes of one linked wallet to the user,
+// nfts which have been moved to another linked wallet of the user are kept
+func (dao *nftDAO) updateNFTs(ctx context.Context, userId uint64, addr string, addNFTs map[string]*mpb.AptosNFTNodeV2,
 	delNFTs map[string]bool) error {
 	key := com.NFTsKey(userId)
+	err := dao.filterNFTsOwnedByOthers(ctx, userId, addr, delNFTs)
+	if err != nil {
+		return err
+	}
 	anyMap := make(map[string]any)
 	anyList := make([]any, 0, len(addNFTs)*2)
 	batchAddKeys := make([]string, 0, len(addNFTs))
 	batchAddValues := make([]any, 0, len(addNFTs))
+	legacyUIDKeys := make([]string, 0, len(addNFTs)+len(delNFTs))
 	for k, v := range addNFTs {
 		nft := dao.svc.AptosNFTNodeV22DBAptosNFTNodeV2(v)
+		nft.OwnerAddr = addr
 		anyMap[k] = nft
-		batchAddKeys = append(batchAddKeys, k)
+		batchAddKeys = append(batchAddKeys, com.NFTUIDKey(k))
 		batchAddValues = append(batchAddValues, userId)
+		legacyUIDKeys = append(legacyUIDKeys, k)
 		anyList = append(anyList, uint64(nft.TokenId)<<32|uint64(nft.TransactionTimestampInt), nft.TokenDataId)
 	}
 	delFields := make([]string, 0, len(delNFTs))
 	delAnyList := make([]any, 0, len(delNFTs))
+	delUIDKeys := make([]string, 0, len(delNFTs))
 	for k := range delNFTs {
 		delFields = append(delFields, k)
 		delAnyList = append(delAnyList, k)
+		delUIDKeys = append(delUIDKeys, com.NFTUIDKey(k))
+		legacyUIDKeys = append(legacyUIDKeys, k)
 	}
-	fmt.Println(addNFTs)
-	err := dao.rMux.Safely(ctx, key, func() error {
+	err = dao.rMux.Safely(ctx, key, func() error {
 		var err error
 		if len(anyMap) > 0 {
 			_, err = dao.nftDB.ZAdd(ctx, com.NFTsListKey(userId), anyList...)
@@ -126,12 +136,179 @@ func (dao *nftDAO) updateNFTs(ctx context.Context, userId uint64, addNFTs map[st
 		dao.logger.Error("updateNFTs BatchSet failed", zap.Uint64("user_id", userId),
 			zap.Any("add_nfts", addNFTs), zap.Any("del_nfts", delNFTs), zap.Error(err))
 	}
-	err = dao.nftDB.BatchDel(ctx, delFields)
+	err = dao.nftDB.BatchDel(ctx, delUIDKeys)
 	if err != nil {
 		dao.logger.Error("updateNFTs BatchDel failed", zap.Uint64("user_id", userId),
 			zap.Any("add_nfts", addNFTs), zap.Any("del_nfts", delNFTs), zap.Error(err))
 	}
+	dao.delLegacyNFTUIDKeys(ctx, legacyUIDKeys)
+
+	return nil
+}
+
+func (dao *nftDAO) filterNFTsOwnedByOthers(ctx context.Context, userId uint64, addr string, nfts map[string]bool) error {
+	if len(nfts) == 0 {
+		return nil
+	}
+	fields := make([]string, 0, len(nfts))
+	for k := range nfts {
+		fields = append(fields, k)
+	}
+	dbNFTs := make([]*mpb.DBAptosNFTNodeV2, len(fields))
+	for i := range dbNFTs {
+		dbNFTs[i] = &mpb.DBAptosNFTNodeV2{}
+	}
+	err := dao.nftDB.HMGetObjects(ctx, com.NFTsKey(userId), fields, dbNFTs)
+	if err != nil {
+		dao.logger.Error("filterNFTsOwnedByOthers HMGetObjects failed", zap.Uint64("user_id", userId), zap.Error(err))
+		return mpberr.ErrDB
+	}
+	for i, nft := range dbNFTs {
+		if nft != nil && nft.OwnerAddr != "" && nft.OwnerAddr != addr {
+			delete(nfts, fields[i])
+		}
+	}
+	return nil
+}
+
+// removeWalletNFTs remove the nfts held by the wallet from the user and reset the query index of the wallet,
+// so that the nfts are rebuilt for whoever links it next
+func (dao *nftDAO) removeWalletNFTs(ctx context.Context, userId uint64, addr string, legacy bool) error {
+	return dao.removeNFTs(ctx, userId, []string{addr}, func(nft *mpb.DBAptosNFTNodeV2) bool {
+		return nft.OwnerAddr == addr || (legacy && nft.OwnerAddr == "")
+	})
+}
+
+// deleteUserNFTs remove all nfts of the user and reset the query index of the wallets
+func (dao *nftDAO) deleteUserNFTs(ctx context.Context, userId uint64, addrs []string) error {
+	err := dao.removeNFTs(ctx, userId, addrs, func(*mpb.DBAptosNFTNodeV2) bool {
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	keys := []string{com.NFTsKey(userId), com.NFTsListKey(userId)}
+	err = dao.nftDB.BatchDel(ctx, keys)
+	if err != nil {
+		dao.logger.Error("deleteUserNFTs BatchDel failed", zap.Strings("keys", keys), zap.Error(err))
+		return mpberr.ErrDB
+	}
+	return nil
+}
+
+// mergeUserNFTs move all nfts of the merged user to the user, it can be called again if it failed halfway
+func (dao *nftDAO) mergeUserNFTs(ctx context.Context, userId, mergedUserId uint64) error {
+	fromKey := com.NFTsKey(mergedUserId)
+	var fields []string
+	var nfts []*mpb.DBAptosNFTNodeV2
+	err := dao.nftDB.HGetAllObjects(ctx, fromKey, &fields, &nfts)
+	if err != nil && !dao.nftDB.IsErrNil(err) {
+		dao.logger.Error("mergeUserNFTs HGetAllObjects failed", zap.String("key", fromKey), zap.Error(err))
+		return mpberr.ErrDB
+	}
+	if len(fields) == 0 {
+		return nil
+	}
+
+	key := com.NFTsKey(userId)
+	anyMap := make(map[string]any, len(fields))
+	anyList := make([]any, 0, len(fields)*2)
+	uidKeys := make([]string, 0, len(fields))
+	uidValues := make([]any, 0, len(fields))
+	for i, nft := range nfts {
+		anyMap[fields[i]] = nft
+		anyList = append(anyList, uint64(nft.TokenId)<<32|uint64(nft.TransactionTimestampInt), fields[i])
+		uidKeys = append(uidKeys, com.NFTUIDKey(fields[i]))
+		uidValues = append(uidValues, userId)
+	}
+	err = dao.rMux.Safely(ctx, key, func() error {
+		_, err := dao.nftDB.ZAdd(ctx, com.NFTsListKey(userId), anyList...)
+		if err != nil {
+			return err
+		}
+		return dao.nftDB.HSetObjects(ctx, key, anyMap)
+	})
+	if err != nil {
+		dao.logger.Error("mergeUserNFTs safely failed", zap.Uint64("user_id", userId),
+			zap.Uint64("merged_user_id", mergedUserId), zap.Error(err))
+		return mpberr.ErrDB
+	}
+	err = dao.nftDB.BatchSet(ctx, uidKeys, uidValues, 0)
+	if err != nil {
+		dao.logger.Error("mergeUserNFTs BatchSet failed", zap.Uint64("user_id", userId),
+			zap.Uint64("merged_user_id", mergedUserId), zap.Error(err))
+		return mpberr.ErrDB
+	}
+	dao.delLegacyNFTUIDKeys(ctx, fields)
+
+	keys := []string{fromKey, com.NFTsListKey(mergedUserId)}
+	err = dao.nftDB.BatchDel(ctx, keys)
+	if err != nil {
+		dao.logger.Error("mergeUserNFTs BatchDel failed", zap.Strings("keys", keys), zap.Error(err))
+		return mpberr.ErrDB
+	}
+	return nil
+}
+
+// removeNFTs remove the matched nfts from the user and reset the query index of the wallets,
+// so that the nfts are rebuilt for whoever links the wallets next
+func (dao *nftDAO) removeNFTs(ctx context.Context, userId uint64, addrs []string,
+	match func(nft *mpb.DBAptosNFTNodeV2) bool) error {
+	key := com.NFTsKey(userId)
+	var delUIDKeys, legacyUIDKeys []string
+	err := dao.rMux.Safely(ctx, key, func() error {
+		var fields []string
+		var nfts []*mpb.DBAptosNFTNodeV2
+		err := dao.nftDB.HGetAllObjects(ctx, key, &fields, &nfts)
+		if err != nil && !dao.nftDB.IsErrNil(err) {
+			return err
+		}
+		delFields := make([]string, 0, len(fields))
+		delAnyList := make([]any, 0, len(fields))
+		for i, nft := range nfts {
+			if match(nft) {
+				delFields = append(delFields, fields[i])
+				delAnyList = append(delAnyList, fields[i])
+				delUIDKeys = append(delUIDKeys, com.NFTUIDKey(fields[i]))
+				legacyUIDKeys = append(legacyUIDKeys, fields[i])
+			}
+		}
+		if len(delFields) == 0 {
+			return nil
+		}
+		_, err = dao.nftDB.ZRem(ctx, com.NFTsListKey(userId), delAnyList...)
+		if err != nil {
+			return err
+		}
+		_, err = dao.nftDB.HDel(ctx, key, delFields...)
+		return err
+	})
+	if err != nil {
+		dao.logger.Error("removeNFTs safely failed", zap.Uint64("user_id", userId), zap.Strings("addrs", addrs),
+			zap.Error(err))
+		return mpberr.ErrDB
+	}
+	if len(delUIDKeys) > 0 {
+		err = dao.nftDB.BatchDel(ctx, delUIDKeys)
+		if err != nil {
+			dao.logger.Error("removeNFTs BatchDel failed", zap.Uint64("user_id", userId),
+				zap.Strings("addrs", addrs), zap.Error(err))
+		}
+		dao.delLegacyNFTUIDKeys(ctx, legacyUIDKeys)
+	}
 
+	for _, addr := range addrs {
+		_, err = dao.nftDB.Del(ctx, com.NFTGraphiStartIndexKey(addr))
+		if err != nil {
+			dao.logger.Error("removeNFTs Del start index failed", zap.String("addr", addr), zap.Error(err))
+			return mpberr.ErrDB
+		}
+		_, err = dao.tmpDB.Del(ctx, com.NFTGraphiLimitKey(addr))
+		if err != nil {
+			dao.logger.Error("removeNFTs Del limit failed", zap.String("addr", addr), zap.Error(err))
+			return mpberr.ErrDB
+		}
+	}
 	return nil
 }
 
@@ -160,7 +337,7 @@ func (dao *nftDAO) getAptosNFTOwnerUserId(ctx context.Context, tokenId string) (
 	key := com.NFTUIDKey(tokenId)
 	userId, err := gdb.ToUint64(dao.nftDB.Get(ctx, com.NFTUIDKey(tokenId)))
 	if dao.nftDB.IsErrNil(err) {
-		return 0, mpberr.ErrNFTNoOwner
+		return dao.migrateNFTOwner(ctx, tokenId)
 	}
 	if err != nil {
 		dao.logger.Error("getAptosNFTOwnerUserId Get failed", zap.String("key", key), zap.Error(err))
@@ -169,6 +346,39 @@ func (dao *nftDAO) getAptosNFTOwnerUserId(ctx context.Context, tokenId string) (
 	return userId, nil
 }
 
+// migrateNFTOwner move the owner of the nft from the legacy index keyed by the raw token id to com.NFTUIDKey.
+// The legacy keys are moved when they are read and dropped when the nft is written, so they fade out without a
+// full scan.
+func (dao *nftDAO) migrateNFTOwner(ctx context.Context, tokenId string) (uint64, error) {
+	userId, err := gdb.ToUint64(dao.nftDB.Get(ctx, tokenId))
+	if dao.nftDB.IsErrNil(err) {
+		return 0, mpberr.ErrNFTNoOwner
+	}
+	if err != nil {
+		dao.logger.Error("migrateNFTOwner Get failed", zap.String("key", tokenId), zap.Error(err))
+		return 0, mpberr.ErrDB
+	}
+	key := com.NFTUIDKey(tokenId)
+	_, err = dao.nftDB.SetNX(ctx, key, userId)
+	if err != nil {
+		dao.logger.Error("migrateNFTOwner SetNX failed", zap.String("key", key), zap.Error(err))
+		return userId, nil
+	}
+	dao.delLegacyNFTUIDKeys(ctx, []string{tokenId})
+	return userId, nil
+}
+
+// delLegacyNFTUIDKeys drop the legacy owner index of the nfts, see migrateNFTOwner
+func (dao *nftDAO) delLegacyNFTUIDKeys(ctx context.Context, tokenIds []string) {
+	if len(tokenIds) == 0 {
+		return
+	}
+	err := dao.nftDB.BatchDel(ctx, tokenIds)
+	if err != nil {
+		dao.logger.Error("delLegacyNFTUIDKeys BatchDel failed", zap.Strings("keys", tokenIds), zap.Error(err))
+	}
+}
+
 func (dao *nftDAO) getCollectionGraphiQLStartIndex(ctx context.Context, addr string) (uint64, error) {
 	index, err := gdb.ToUint64(dao.tranDB.Get(ctx, com.CollectionGraphiStartIndex(addr)))
 	if err != nil && !dao.tranDB.IsErrNil(err) {
@@ -49,6 +49,7 @@ func (svc *NFTService) AptosNFTNodeV22DBAptosNFTNodeV2(in *mpb.AptosNFTNodeV2) *
 		TokenStandard:        in.TokenStandard,
 		TokenUri:             in.TokenUri,
 		TransactionTimestamp: in.TransactionTimestamp,
+		OwnerAddr:            in.OwnerAddr,
 	}
 	if in.TokenProperties != nil {
 		out.TokenProperties.Prop1 = in.TokenProperties.Prop1
@@ -75,6 +76,7 @@ func (svc *NFTService) DBAptosNFTNodeV22AptosNFTNodeV2(in *mpb.DBAptosNFTNodeV2)
 		TokenStandard:        in.TokenStandard,
 		TokenUri:             in.TokenUri,
 		TransactionTimestamp: in.TransactionTimestamp,
+		OwnerAddr:            in.OwnerAddr,
 	}
 	if in.TokenProperties != nil {
 		out.TokenProperties.Prop1 = in.TokenProperties.Prop1
@@ -211,20 +211,32 @@ func (svc *NFTService) GetAptosNFTMetadatas(ctx context.Context, req *mpb.ReqGet
 }
 
 func (svc *NFTService) GetAptosNFTsV2(ctx context.Context, req *mpb.ReqGetAptosNFTsV2) (*mpb.ResGetAptosNFTsV2, error) {
-	// check query limit
-	ok, err := svc.dao.checkGraphiLimit(ctx, req.WalletAddr)
+	addrs, err := svc.getLinkedWalletAddrs(ctx, req.UserId, req.WalletAddr)
 	if err != nil {
 		return nil, err
 	}
-	if ok {
+	for _, addr := range addrs {
+		// check query limit
+		ok, err := svc.dao.checkGraphiLimit(ctx, addr)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			continue
+		}
 		// try update by graphiql
-		err = svc.updateByGraphiQL(ctx, req.UserId, req.WalletAddr)
+		err = svc.updateByGraphiQL(ctx, req.UserId, addr)
 		if err != nil {
 			return nil, err
 		}
 	}
 
 	// get from db
+	return svc.GetStoredNFTs(ctx, &mpb.ReqUserId{UserId: req.UserId})
+}
+
+// GetStoredNFTs return the nfts stored for the user without querying the indexer
+func (svc *NFTService) GetStoredNFTs(ctx context.Context, req *mpb.ReqUserId) (*mpb.ResGetAptosNFTsV2, error) {
 	res := &mpb.ResGetAptosNFTsV2{}
 	dbNFTs, err := svc.dao.getNFTs(ctx, req.UserId)
 	if err != nil {
@@ -241,6 +253,30 @@ func (svc *NFTService) GetAptosNFTsV2(ctx context.Context, req *mpb.ReqGetAptosN
 	return res, nil
 }
 
+// getLinkedWalletAddrs return all wallets linked to the user, fall back to the wallet in token if the
+// account service is not available
+func (svc *NFTService) getLinkedWalletAddrs(ctx context.Context, userId uint64, walletAddr string) ([]string, error) {
+	client, err := com.GetAccountServiceClient(ctx, svc)
+	if err == nil {
+		var res *mpb.ResListWallets
+		res, err = client.ListWallets(ctx, &mpb.ReqUserId{UserId: userId})
+		if err == nil && len(res.Wallets) > 0 {
+			addrs := make([]string, 0, len(res.Wallets))
+			for _, w := range res.Wallets {
+				addrs = append(addrs, w.WalletAddr)
+			}
+			return addrs, nil
+		}
+	}
+	if err != nil {
+		svc.logger.Error("getLinkedWalletAddrs ListWallets failed", zap.Uint64("user_id", userId), zap.Error(err))
+	}
+	if walletAddr == "" {
+		return nil, nil
+	}
+	return []string{walletAddr}, nil
+}
+
 func (svc *NFTService) updateByGraphiQL(ctx context.Context, userId uint64, addr string) error {
 	pageNum := svc.rm.getGraphiQLQueryPageNum()
 	if pageNum == 0 {
@@ -272,7 +308,7 @@ func (svc *NFTService) updateByGraphiQL(ctx context.Context, userId uint64, addr
 
 	addNFTs, delNFTs := svc.parseNFTTransactions(addr, rpcRes.Transactions.Data.AccountTransactions)
 	if len(addNFTs) > 0 || len(delNFTs) > 0 {
-		err = svc.dao.updateNFTs(ctx, userId, addNFTs, delNFTs)
+		err = svc.dao.updateNFTs(ctx, userId, addr, addNFTs, delNFTs)
 		if err != nil {
 			return err
 		}
@@ -373,3 +409,36 @@ func (svc *NFTService) getAccountInfo(ctx context.Context, userId uint64) (*mpb.
 
 	return res, nil
 }
+
+func (svc *NFTService) RemoveWalletNFTs(ctx context.Context, req *mpb.ReqRemoveWalletNFTs) (*mpb.Empty, error) {
+	if req.UserId == 0 || req.WalletAddr == "" {
+		return nil, mpberr.ErrParam
+	}
+	err := svc.dao.removeWalletNFTs(ctx, req.UserId, req.WalletAddr, req.Legacy)
+	if err != nil {
+		return nil, err
+	}
+	return &mpb.Empty{}, nil
+}
+
+func (svc *NFTService) DeleteUserNFTs(ctx context.Context, req *mpb.ReqDeleteUserNFTs) (*mpb.Empty, error) {
+	if req.UserId == 0 {
+		return nil, mpberr.ErrParam
+	}
+	err := svc.dao.deleteUserNFTs(ctx, req.UserId, req.WalletAddrs)
+	if err != nil {
+		return nil, err
+	}
+	return &mpb.Empty{}, nil
+}
+
+func (svc *NFTService) MergeUserNFTs(ctx context.Context, req *mpb.ReqMergeUserNFTs) (*mpb.Empty, error) {
+	if req.UserId == 0 || req.MergedUserId == 0 || req.UserId == req.MergedUserId {
+		return nil, mpberr.ErrParam
+	}
+	err := svc.dao.mergeUserNFTs(ctx, req.UserId, req.MergedUserId)
+	if err != nil {
+		return nil, err
+	}
+	return &mpb.Empty{}, nil
+}
@@ -0,0 +1,139 @@
+package util
+
+import (
+	"crypto/ed25519"
+	"math/bits"
+
+	"github.com/aureontu/MRWebServer/mr_services/mpberr"
+	"golang.org/x/crypto/sha3"
+)
+
+// AptosVerifier verify the signatures of one aptos account authentication scheme, new schemes such as keyless
+// accounts are supported by implementing it and calling RegisterAptosVerifier
+type AptosVerifier interface {
+	// Scheme return the scheme byte appended to the public key when deriving the authentication key
+	Scheme() byte
+	// Match report whether the serialized public key belongs to this scheme
+	Match(pubKey []byte) bool
+	// Verify check the signature of msg
+	Verify(pubKey, msg, signature []byte) bool
+}
+
+var aptosVerifiers []AptosVerifier
+
+func init() {
+	RegisterAptosVerifier(ed25519Verifier{})
+	RegisterAptosVerifier(multiEd25519Verifier{})
+}
+
+// RegisterAptosVerifier add a verifier, verifiers registered earlier are matched first
+func RegisterAptosVerifier(v AptosVerifier) {
+	aptosVerifiers = append(aptosVerifiers, v)
+}
+
+func getAptosVerifier(pubKey []byte) (AptosVerifier, error) {
+	for _, v := range aptosVerifiers {
+		if v.Match(pubKey) {
+			return v, nil
+		}
+	}
+	return nil, mpberr.ErrAptosPublicKey
+}
+
+// AptosAuthKey derive the authentication key of the public key, sha3-256(public key | scheme)
+func AptosAuthKey(pubKey []byte) ([]byte, error) {
+	v, err := getAptosVerifier(pubKey)
+	if err != nil {
+		return nil, err
+	}
+	h := sha3.New256()
+	h.Write(pubKey)
+	h.Write([]byte{v.Scheme()})
+	return h.Sum(nil), nil
+}
+
+// VerifyAptosSignature check the hex encoded signature of msg with the verifier of the public key scheme
+func VerifyAptosSignature(pubKey []byte, msg, signatureHex string) error {
+	v, err := getAptosVerifier(pubKey)
+	if err != nil {
+		return err
+	}
+	signature, err := decodeAptosHex(signatureHex)
+	if err != nil || !v.Verify(pubKey, []byte(msg), signature) {
+		return mpberr.ErrAptosVerifySignature
+	}
+	return nil
+}
+
+type ed25519Verifier struct{}
+
+func (ed25519Verifier) Scheme() byte {
+	return 0x00
+}
+
+func (ed25519Verifier) Match(pubKey []byte) bool {
+	return len(pubKey) == ed25519.PublicKeySize
+}
+
+func (ed25519Verifier) Verify(pubKey, msg, signature []byte) bool {
+	return len(signature) == ed25519.SignatureSize && ed25519.Verify(pubKey, msg, signature)
+}
+
+// multiEd25519Verifier verify k of n accounts
+//
+//	public key: n ed25519 public keys | threshold byte
+//	signature:  signatures ordered by key index | 4 bytes bitmap of the signing key indexes, high bit first
+type multiEd25519Verifier struct{}
+
+const (
+	multiEd25519MaxKeys   = 32
+	multiEd25519BitmapLen = 4
+)
+
+func (multiEd25519Verifier) Scheme() byte {
+	return 0x01
+}
+
+func (multiEd25519Verifier) Match(pubKey []byte) bool {
+	if len(pubKey) <= ed25519.PublicKeySize || len(pubKey)%ed25519.PublicKeySize != 1 {
+		return false
+	}
+	n := len(pubKey) / ed25519.PublicKeySize
+	threshold := int(pubKey[len(pubKey)-1])
+	return n <= multiEd25519MaxKeys && threshold > 0 && threshold <= n
+}
+
+func (multiEd25519Verifier) Verify(pubKey, msg, signature []byte) bool {
+	n := len(pubKey) / ed25519.PublicKeySize
+	threshold := int(pubKey[len(pubKey)-1])
+	if len(signature) < multiEd25519BitmapLen ||
+		(len(signature)-multiEd25519BitmapLen)%ed25519.SignatureSize != 0 {
+		return false
+	}
+	sigNum := (len(signature) - multiEd25519BitmapLen) / ed25519.SignatureSize
+	bitmap := signature[len(signature)-multiEd25519BitmapLen:]
+	setNum := 0
+	for _, b := range bitmap {
+		setNum += bits.OnesCount8(b)
+	}
+	if sigNum != setNum || sigNum < threshold {
+		return false
+	}
+
+	j := 0
+	for i := 0; i < multiEd25519MaxKeys; i++ {
+		if bitmap[i/8]&(0x80>>(i%8)) == 0 {
+			continue
+		}
+		if i >= n {
+			return false
+		}
+		key := pubKey[i*ed25519.PublicKeySize : (i+1)*ed25519.PublicKeySize]
+		sig := signature[j*ed25519.SignatureSize : (j+1)*ed25519.SignatureSize]
+		if !ed25519.Verify(key, msg, sig) {
+			return false
+		}
+		j++
+	}
+	return true
+}
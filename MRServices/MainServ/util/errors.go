@@ -18,6 +18,8 @@ var (
 	errRandomPoolTooSmall    = errors.New("random pool to small")
 	errRandomTotalWeightZero = errors.New("random total weight zero")
 	errRandomWeightFuncNil   = errors.New("random weight func nil")
+
+	errPasswordHashFormat = errors.New("password hash format invalid")
 )
 
 // AppError logic error for current app
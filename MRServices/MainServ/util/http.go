@@ -1,17 +1,15 @@
 package util
 
 import (
-	"bytes"
 	"context"
-	"crypto/tls"
 	"encoding/json"
 	"errors"
 	"fmt"
-	"io"
 	"io/ioutil"
 	"net/http"
 	"net/url"
 	"strings"
+	"time"
 
 	"github.com/aureontu/MRWebServer/mr_services/mpb"
 	"github.com/aureontu/MRWebServer/mr_services/mpberr"
@@ -356,99 +354,11 @@ func handleDecryption(body []byte, key AESEncryptionKeyPair) ([]byte, error) {
 	return decryptedBody, nil
 }
 
-func HttpGet(ctx context.Context, reqUrl string) ([]byte, error) {
-	var err error
-	req := &http.Request{}
-	req.URL, err = url.Parse(reqUrl)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-
-	client := &http.Client{}
-	res, err := client.Do(req)
-	if err != nil {
-		return nil, err
-	}
-
-	defer func() {
-		_ = res.Body.Close()
-	}()
-	return io.ReadAll(res.Body)
-}
-
-func HttpGetWithHeader(ctx context.Context, reqUrl string, headers map[string]string) ([]byte, error) {
-	var err error
-	req := &http.Request{}
-	req.URL, err = url.Parse(reqUrl)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	req.Header = http.Header{}
-	for k, v := range headers {
-		req.Header.Set(k, v)
-	}
-
-	client := &http.Client{}
-	res, err := client.Do(req)
-	if err != nil {
-		return nil, err
-	}
-
-	defer func() {
-		_ = res.Body.Close()
-	}()
-	return io.ReadAll(res.Body)
-}
-
-func HttpsGet(ctx context.Context, reqUrl string) ([]byte, error) {
-	var err error
-	req := &http.Request{}
-	req.URL, err = url.Parse(reqUrl)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-
-	tr := &http.Transport{
-		TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
-	}
-	client := &http.Client{Transport: tr}
-	res, err := client.Do(req)
-	if err != nil {
-		return nil, err
-	}
-
-	defer func() {
-		_ = res.Body.Close()
-	}()
-	return io.ReadAll(res.Body)
-}
-
-func HttpPost(ctx context.Context, reqUrl string, headers map[string]string, body []byte) ([]byte, error) {
-	var err error
-	//req ,err:= http.NewRequest("POST", reqUrl,strings.NewReader(string(body)))
-	req := &http.Request{}
-	req.Method = "POST"
-	req.URL, err = url.Parse(reqUrl)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	req.Header = http.Header{}
-	for k, v := range headers {
-		req.Header.Set(k, v)
-	}
-	req.Body = io.NopCloser(bytes.NewBuffer(body))
-	client := &http.Client{}
-	res, err := client.Do(req)
-	if err != nil {
-		return nil, err
-	}
-
-	defer func() {
-		_ = res.Body.Close()
-	}()
-	return io.ReadAll(res.Body)
+// NewPooledHTTPClient create the http client for the external apis, the connections are pooled per host
+func NewPooledHTTPClient() *http.Client {
+	tr := http.DefaultTransport.(*http.Transport).Clone()
+	tr.MaxIdleConns = 100
+	tr.MaxIdleConnsPerHost = 20
+	tr.IdleConnTimeout = 90 * time.Second
+	return &http.Client{Transport: tr}
 }
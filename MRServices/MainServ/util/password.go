@@ -0,0 +1,106 @@
+package util
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+)
+
+// Stored password format:
+//
+//	$mr1$argon2id$v=19$m=65536,t=3,p=2$<salt>$<hash>
+//
+// The first segment is our own scheme version so the algorithm or parameters can
+// change later without guessing. Anything without the "$mr" prefix is a legacy
+// record holding the raw client md5 digest.
+const (
+	passwordHashScheme = "mr1"
+	passwordHashAlgo   = "argon2id"
+
+	argon2Time    = 3
+	argon2Memory  = 64 * 1024
+	argon2Threads = 2
+	argon2KeyLen  = 32
+	argon2SaltLen = 16
+)
+
+type passwordHash struct {
+	scheme  string
+	algo    string
+	version int
+	memory  uint32
+	time    uint32
+	threads uint8
+	salt    []byte
+	key     []byte
+}
+
+// HashPassword hash the client password digest with argon2id and a random salt
+func HashPassword(digest string) (string, error) {
+	salt := make([]byte, argon2SaltLen)
+	if _, err := rand.Read(salt); err != nil {
+		return "", err
+	}
+	key := argon2.IDKey([]byte(digest), salt, argon2Time, argon2Memory, argon2Threads, argon2KeyLen)
+	return fmt.Sprintf("$%s$%s$v=%d$m=%d,t=%d,p=%d$%s$%s", passwordHashScheme, passwordHashAlgo, argon2.Version,
+		argon2Memory, argon2Time, argon2Threads,
+		base64.RawStdEncoding.EncodeToString(salt), base64.RawStdEncoding.EncodeToString(key)), nil
+}
+
+// IsPasswordHashed check whether the stored password is in hashed format
+func IsPasswordHashed(stored string) bool {
+	return strings.HasPrefix(stored, "$mr")
+}
+
+// VerifyPassword compare the client password digest with the stored value,
+// needRehash is true if the stored value is legacy or uses outdated parameters
+func VerifyPassword(stored, digest string) (ok bool, needRehash bool) {
+	if stored == "" {
+		return false, false
+	}
+	if !IsPasswordHashed(stored) {
+		ok = subtle.ConstantTimeCompare([]byte(stored), []byte(digest)) == 1
+		return ok, ok
+	}
+
+	ph, err := parsePasswordHash(stored)
+	if err != nil {
+		return false, false
+	}
+	key := argon2.IDKey([]byte(digest), ph.salt, ph.time, ph.memory, ph.threads, uint32(len(ph.key)))
+	if subtle.ConstantTimeCompare(key, ph.key) != 1 {
+		return false, false
+	}
+	needRehash = ph.scheme != passwordHashScheme || ph.version != argon2.Version || ph.memory != argon2Memory ||
+		ph.time != argon2Time || ph.threads != argon2Threads || len(ph.key) != argon2KeyLen
+	return true, needRehash
+}
+
+func parsePasswordHash(stored string) (*passwordHash, error) {
+	parts := strings.Split(stored, "$")
+	if len(parts) != 7 || parts[2] != passwordHashAlgo {
+		return nil, errPasswordHashFormat
+	}
+	ph := &passwordHash{scheme: parts[1], algo: parts[2]}
+	_, err := fmt.Sscanf(parts[3], "v=%d", &ph.version)
+	if err != nil {
+		return nil, errPasswordHashFormat
+	}
+	_, err = fmt.Sscanf(parts[4], "m=%d,t=%d,p=%d", &ph.memory, &ph.time, &ph.threads)
+	if err != nil {
+		return nil, errPasswordHashFormat
+	}
+	ph.salt, err = base64.RawStdEncoding.DecodeString(parts[5])
+	if err != nil {
+		return nil, errPasswordHashFormat
+	}
+	ph.key, err = base64.RawStdEncoding.DecodeString(parts[6])
+	if err != nil || len(ph.key) == 0 {
+		return nil, errPasswordHashFormat
+	}
+	return ph, nil
+}
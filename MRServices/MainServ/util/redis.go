@@ -0,0 +1,48 @@
+package util
+
+import (
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/oldjon/gutil/env"
+	gmarshaller "github.com/oldjon/gutil/marshaller"
+)
+
+// NewRedisUniversalClient create a go-redis client for what gdb.RedisClient does not cover, like scripts and
+// streams. It reads the same config as gdb.NewRedisClientByConfig, so both clients of a config behave the same.
+func NewRedisUniversalClient(cfg env.ModuleConfig) redis.UniversalClient {
+	readTimeout := time.Duration(cfg.GetInt("readtimeout")) * time.Second
+	if cfg.GetString("mode") == "cluster" {
+		return redis.NewClusterClient(&redis.ClusterOptions{
+			Addrs:        cfg.GetStringSlice("addrs"),
+			Password:     cfg.GetString("password"),
+			PoolSize:     cfg.GetInt("pool_size"),
+			MaxRedirects: cfg.GetInt("maxredirects"),
+			ReadOnly:     cfg.GetBool("readonly"),
+			ReadTimeout:  readTimeout,
+		})
+	}
+	return redis.NewClient(&redis.Options{
+		Addr:        cfg.GetString("addr"),
+		DB:          cfg.GetInt("db"),
+		Password:    cfg.GetString("password"),
+		PoolSize:    cfg.GetInt("pool_size"),
+		ReadTimeout: readTimeout,
+	})
+}
+
+// NewRedisMarshaller return the object marshaller gdb.NewRedisClientByConfig picks for the config, for the objects
+// written by NewRedisUniversalClient
+func NewRedisMarshaller(cfg env.ModuleConfig, marshaller string) gmarshaller.Marshaller {
+	if cfg.GetString("db_marshaller") != "" {
+		marshaller = cfg.GetString("db_marshaller")
+	}
+	switch marshaller {
+	case gmarshaller.MarshallerTypeProtoBuf:
+		return &gmarshaller.ProtoMarshaller{}
+	case gmarshaller.MarshallerTypeProtoBufComp:
+		return &gmarshaller.ProtoCompressMarshaller{}
+	default:
+		return &gmarshaller.JsonMarshaller{}
+	}
+}
@@ -0,0 +1,142 @@
+package util
+
+import (
+	"encoding/hex"
+	"strconv"
+	"strings"
+	"time"
+
+	com "github.com/aureontu/MRWebServer/mr_services/common"
+	"github.com/aureontu/MRWebServer/mr_services/mpberr"
+)
+
+// Sign-In-With-Aptos message, the fullMessage a wallet builds for signMessage:
+//
+//	APTOS
+//	address: 0x...
+//	application: mirrorrealms.io
+//	chainId: 1
+//	message: <statement>
+//	Issued At: 2023-06-01T08:00:00Z
+//	Expiration Time: 2023-06-01T08:10:00Z
+//	nonce: 3f2a...(the 32 hex chars of GenerateNonce)
+//
+// address, application and chainId are the optional fields of the wallet standard, they are required here.
+// Issued At and Expiration Time are the trailing lines of the message the client asked the wallet to sign.
+const (
+	siwaPrefix              = "APTOS"
+	siwaAddressField        = "address: "
+	siwaApplicationField    = "application: "
+	siwaChainIdField        = "chainId: "
+	siwaMessageField        = "message: "
+	siwaNonceField          = "nonce: "
+	siwaIssuedAtField       = "Issued At: "
+	siwaExpirationTimeField = "Expiration Time: "
+)
+
+type SIWAMessage struct {
+	Address        string
+	Application    string
+	ChainId        int64
+	Statement      string
+	IssuedAt       time.Time
+	ExpirationTime time.Time // zero if not set
+	Nonce          string
+}
+
+// ParseSIWAMessage parse the full message signed by the wallet
+func ParseSIWAMessage(fullMsg string) (*SIWAMessage, error) {
+	lines := strings.Split(strings.ReplaceAll(fullMsg, "\r\n", "\n"), "\n")
+	if len(lines) < 6 || lines[0] != siwaPrefix {
+		return nil, mpberr.ErrAptosMessage
+	}
+
+	msg := &SIWAMessage{}
+	var err error
+	i := 1
+	if msg.Address, i = readSIWAField(lines, i, siwaAddressField); msg.Address == "" {
+		return nil, mpberr.ErrAptosMessage
+	}
+	msg.Address, err = FixHashId(msg.Address)
+	if err != nil {
+		return nil, mpberr.ErrAptosMessage
+	}
+	if msg.Application, i = readSIWAField(lines, i, siwaApplicationField); msg.Application == "" {
+		return nil, mpberr.ErrAptosMessage
+	}
+	var chainId string
+	if chainId, i = readSIWAField(lines, i, siwaChainIdField); chainId == "" {
+		return nil, mpberr.ErrAptosMessage
+	}
+	msg.ChainId, err = strconv.ParseInt(chainId, 10, 64)
+	if err != nil {
+		return nil, mpberr.ErrAptosMessage
+	}
+
+	// the message may be multi lines, it ends at the last line which is the nonce
+	last := len(lines) - 1
+	if i >= last || !strings.HasPrefix(lines[i], siwaMessageField) || !strings.HasPrefix(lines[last], siwaNonceField) {
+		return nil, mpberr.ErrAptosMessage
+	}
+	msgLines := append([]string{strings.TrimPrefix(lines[i], siwaMessageField)}, lines[i+1:last]...)
+	msg.Nonce = strings.TrimPrefix(lines[last], siwaNonceField)
+	if len(msg.Nonce) != 2*com.WalletNonceLen {
+		return nil, mpberr.ErrAptosMessage
+	}
+	if _, err = hex.DecodeString(msg.Nonce); err != nil {
+		return nil, mpberr.ErrAptosMessage
+	}
+
+	// trailing time lines of the message
+	n := len(msgLines)
+	if n > 1 && strings.HasPrefix(msgLines[n-1], siwaExpirationTimeField) {
+		msg.ExpirationTime, err = time.Parse(time.RFC3339, strings.TrimPrefix(msgLines[n-1], siwaExpirationTimeField))
+		if err != nil {
+			return nil, mpberr.ErrAptosMessage
+		}
+		n--
+	}
+	if n < 2 || !strings.HasPrefix(msgLines[n-1], siwaIssuedAtField) {
+		return nil, mpberr.ErrAptosMessage
+	}
+	msg.IssuedAt, err = time.Parse(time.RFC3339, strings.TrimPrefix(msgLines[n-1], siwaIssuedAtField))
+	if err != nil {
+		return nil, mpberr.ErrAptosMessage
+	}
+	msg.Statement = strings.Join(msgLines[:n-1], "\n")
+	return msg, nil
+}
+
+func readSIWAField(lines []string, i int, field string) (string, int) {
+	if i >= len(lines) || !strings.HasPrefix(lines[i], field) {
+		return "", i
+	}
+	return strings.TrimSpace(strings.TrimPrefix(lines[i], field)), i + 1
+}
+
+// Validate check the message is issued for the wallet on the expected application and network and is still valid
+func (msg *SIWAMessage) Validate(walletAddr string, domains []string, chainId int64, now time.Time) error {
+	if msg.Address != walletAddr {
+		return mpberr.ErrAptosMessage
+	}
+	found := false
+	for _, d := range domains {
+		if strings.EqualFold(d, msg.Application) {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return mpberr.ErrAptosMessage
+	}
+	if msg.ChainId != chainId {
+		return mpberr.ErrAptosMessage
+	}
+	if msg.IssuedAt.After(now.Add(com.SIWAClockSkew)) || msg.IssuedAt.Before(now.Add(-com.NonceExpireDuration-com.SIWAClockSkew)) {
+		return mpberr.ErrAptosMessageExpired
+	}
+	if !msg.ExpirationTime.IsZero() && !msg.ExpirationTime.After(now) {
+		return mpberr.ErrAptosMessageExpired
+	}
+	return nil
+}
@@ -0,0 +1,160 @@
+package util
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aureontu/MRWebServer/mr_services/mpberr"
+)
+
+const (
+	siwaTestAddr  = "0x000000000000000000000000000000000000000000000000000000000000abcd"
+	siwaTestNonce = "0123456789abcdef0123456789abcdef"
+)
+
+func siwaTestMessage(lines ...string) string {
+	return strings.Join(lines, "\n")
+}
+
+func TestParseSIWAMessage(t *testing.T) {
+	issuedAt := time.Date(2023, 6, 1, 8, 0, 0, 0, time.UTC)
+	cases := []struct {
+		name      string
+		msg       string
+		err       error
+		statement string
+		expire    bool
+	}{
+		{
+			name: "full",
+			msg: siwaTestMessage("APTOS", "address: 0xABCD", "application: mirrorrealms.io", "chainId: 1",
+				"message: Sign in", "Issued At: 2023-06-01T08:00:00Z", "Expiration Time: 2023-06-01T08:10:00Z",
+				"nonce: "+siwaTestNonce),
+			statement: "Sign in",
+			expire:    true,
+		},
+		{
+			name: "multi line statement without expiration",
+			msg: siwaTestMessage("APTOS", "address: 0xabcd", "application: mirrorrealms.io", "chainId: 1",
+				"message: Sign in", "to Mirror Realms", "Issued At: 2023-06-01T08:00:00Z", "nonce: "+siwaTestNonce),
+			statement: "Sign in\nto Mirror Realms",
+		},
+		{
+			name: "crlf",
+			msg: strings.ReplaceAll(siwaTestMessage("APTOS", "address: 0xabcd", "application: mirrorrealms.io",
+				"chainId: 1", "message: Sign in", "Issued At: 2023-06-01T08:00:00Z", "nonce: "+siwaTestNonce),
+				"\n", "\r\n"),
+			statement: "Sign in",
+		},
+		{
+			name: "wrong prefix",
+			msg: siwaTestMessage("SOLANA", "address: 0xabcd", "application: mirrorrealms.io", "chainId: 1",
+				"message: Sign in", "Issued At: 2023-06-01T08:00:00Z", "nonce: "+siwaTestNonce),
+			err: mpberr.ErrAptosMessage,
+		},
+		{
+			name: "missing application",
+			msg: siwaTestMessage("APTOS", "address: 0xabcd", "chainId: 1", "message: Sign in",
+				"Issued At: 2023-06-01T08:00:00Z", "nonce: "+siwaTestNonce),
+			err: mpberr.ErrAptosMessage,
+		},
+		{
+			name: "bad chain id",
+			msg: siwaTestMessage("APTOS", "address: 0xabcd", "application: mirrorrealms.io", "chainId: main",
+				"message: Sign in", "Issued At: 2023-06-01T08:00:00Z", "nonce: "+siwaTestNonce),
+			err: mpberr.ErrAptosMessage,
+		},
+		{
+			name: "short nonce",
+			msg: siwaTestMessage("APTOS", "address: 0xabcd", "application: mirrorrealms.io", "chainId: 1",
+				"message: Sign in", "Issued At: 2023-06-01T08:00:00Z", "nonce: 0123"),
+			err: mpberr.ErrAptosMessage,
+		},
+		{
+			name: "nonce not hex",
+			msg: siwaTestMessage("APTOS", "address: 0xabcd", "application: mirrorrealms.io", "chainId: 1",
+				"message: Sign in", "Issued At: 2023-06-01T08:00:00Z", "nonce: "+strings.Repeat("z", 32)),
+			err: mpberr.ErrAptosMessage,
+		},
+		{
+			name: "missing issued at",
+			msg: siwaTestMessage("APTOS", "address: 0xabcd", "application: mirrorrealms.io", "chainId: 1",
+				"message: Sign in", "Expiration Time: 2023-06-01T08:10:00Z", "nonce: "+siwaTestNonce),
+			err: mpberr.ErrAptosMessage,
+		},
+		{
+			name: "bad issued at",
+			msg: siwaTestMessage("APTOS", "address: 0xabcd", "application: mirrorrealms.io", "chainId: 1",
+				"message: Sign in", "Issued At: yesterday", "nonce: "+siwaTestNonce),
+			err: mpberr.ErrAptosMessage,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			msg, err := ParseSIWAMessage(c.msg)
+			if err != c.err {
+				t.Fatalf("err = %v, want %v", err, c.err)
+			}
+			if err != nil {
+				return
+			}
+			if msg.Address != siwaTestAddr || msg.Application != "mirrorrealms.io" || msg.ChainId != 1 ||
+				msg.Nonce != siwaTestNonce {
+				t.Fatalf("fields = %+v", msg)
+			}
+			if msg.Statement != c.statement {
+				t.Fatalf("statement = %q, want %q", msg.Statement, c.statement)
+			}
+			if !msg.IssuedAt.Equal(issuedAt) {
+				t.Fatalf("issued at = %v, want %v", msg.IssuedAt, issuedAt)
+			}
+			if msg.ExpirationTime.IsZero() == c.expire {
+				t.Fatalf("expiration time = %v", msg.ExpirationTime)
+			}
+		})
+	}
+}
+
+func TestSIWAMessageValidate(t *testing.T) {
+	now := time.Date(2023, 6, 1, 8, 5, 0, 0, time.UTC)
+	valid := func() *SIWAMessage {
+		return &SIWAMessage{
+			Address:        siwaTestAddr,
+			Application:    "MirrorRealms.io",
+			ChainId:        1,
+			IssuedAt:       now.Add(-time.Minute),
+			ExpirationTime: now.Add(time.Minute),
+			Nonce:          siwaTestNonce,
+		}
+	}
+	cases := []struct {
+		name   string
+		modify func(msg *SIWAMessage)
+		err    error
+	}{
+		{name: "valid", modify: func(msg *SIWAMessage) {}},
+		{name: "no expiration", modify: func(msg *SIWAMessage) { msg.ExpirationTime = time.Time{} }},
+		{name: "issued within skew", modify: func(msg *SIWAMessage) { msg.IssuedAt = now.Add(30 * time.Second) }},
+		{name: "other wallet", modify: func(msg *SIWAMessage) { msg.Address = "0x1" }, err: mpberr.ErrAptosMessage},
+		{name: "other application", modify: func(msg *SIWAMessage) { msg.Application = "evil.io" },
+			err: mpberr.ErrAptosMessage},
+		{name: "other chain", modify: func(msg *SIWAMessage) { msg.ChainId = 2 }, err: mpberr.ErrAptosMessage},
+		{name: "issued in future", modify: func(msg *SIWAMessage) { msg.IssuedAt = now.Add(2 * time.Minute) },
+			err: mpberr.ErrAptosMessageExpired},
+		{name: "issued too long ago", modify: func(msg *SIWAMessage) { msg.IssuedAt = now.Add(-time.Hour) },
+			err: mpberr.ErrAptosMessageExpired},
+		{name: "expired", modify: func(msg *SIWAMessage) { msg.ExpirationTime = now },
+			err: mpberr.ErrAptosMessageExpired},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			msg := valid()
+			c.modify(msg)
+			err := msg.Validate(siwaTestAddr, []string{"localhost", "mirrorrealms.io"}, 1, now)
+			if err != c.err {
+				t.Fatalf("err = %v, want %v", err, c.err)
+			}
+		})
+	}
+}
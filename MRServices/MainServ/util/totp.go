@@ -0,0 +1,112 @@
+package util
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	com "github.com/aureontu/MRWebServer/mr_services/common"
+)
+
+// RFC 6238 TOTP with the parameters every authenticator app supports: HMAC-SHA1, 6 digits, 30 seconds step
+const (
+	totpSecretLen = 20
+	totpPeriod    = 30
+	totpSkew      = 1 // accept codes of one step before and after to tolerate clock drift
+
+	recoveryCodeAlphabet = "abcdefghjkmnpqrstuvwxyz23456789"
+)
+
+var totpEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// GenerateTOTPSecret generate a random base32 encoded totp secret
+func GenerateTOTPSecret() (string, error) {
+	b := make([]byte, totpSecretLen)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return totpEncoding.EncodeToString(b), nil
+}
+
+// TOTPURI build the otpauth uri for authenticator apps
+func TOTPURI(issuer, account, secret string) string {
+	v := url.Values{}
+	v.Set("secret", secret)
+	v.Set("issuer", issuer)
+	v.Set("algorithm", "SHA1")
+	v.Set("digits", fmt.Sprint(com.TOTPCodeLen))
+	v.Set("period", fmt.Sprint(totpPeriod))
+	label := url.PathEscape(issuer + ":" + account)
+	return "otpauth://totp/" + label + "?" + v.Encode()
+}
+
+// ValidateTOTP check the code against the secret at time t, the matched time step counter is returned
+// so that the caller can reject replays of the same code
+func ValidateTOTP(secret, code string, t time.Time) (uint64, bool) {
+	if len(code) != com.TOTPCodeLen {
+		return 0, false
+	}
+	key, err := totpEncoding.DecodeString(strings.ToUpper(secret))
+	if err != nil {
+		return 0, false
+	}
+	counter := uint64(t.Unix() / totpPeriod)
+	for i := -totpSkew; i <= totpSkew; i++ {
+		c := counter + uint64(i)
+		if subtle.ConstantTimeCompare([]byte(hotp(key, c)), []byte(code)) == 1 {
+			return c, true
+		}
+	}
+	return 0, false
+}
+
+func hotp(key []byte, counter uint64) string {
+	var msg [8]byte
+	binary.BigEndian.PutUint64(msg[:], counter)
+	mac := hmac.New(sha1.New, key)
+	mac.Write(msg[:])
+	sum := mac.Sum(nil)
+	offset := sum[len(sum)-1] & 0x0f
+	bin := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+	mod := uint32(1)
+	for i := 0; i < com.TOTPCodeLen; i++ {
+		mod *= 10
+	}
+	return fmt.Sprintf("%0*d", com.TOTPCodeLen, bin%mod)
+}
+
+// GenerateRecoveryCodes generate n one-time recovery codes in the form of xxxxx-xxxxx
+func GenerateRecoveryCodes(n int) ([]string, error) {
+	codes := make([]string, 0, n)
+	b := make([]byte, com.TOTPRecoveryCodeLen)
+	for i := 0; i < n; i++ {
+		if _, err := rand.Read(b); err != nil {
+			return nil, err
+		}
+		var sb strings.Builder
+		for j, c := range b {
+			if j == com.TOTPRecoveryCodeLen/2 {
+				sb.WriteByte('-')
+			}
+			sb.WriteByte(recoveryCodeAlphabet[int(c)%len(recoveryCodeAlphabet)])
+		}
+		codes = append(codes, sb.String())
+	}
+	return codes, nil
+}
+
+// HashRecoveryCode normalize the recovery code user typed and hash it for storage
+func HashRecoveryCode(code string) string {
+	code = strings.ToLower(strings.NewReplacer("-", "", " ", "").Replace(code))
+	sum := sha256.Sum256([]byte(code))
+	return hex.EncodeToString(sum[:])
+}
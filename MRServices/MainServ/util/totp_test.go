@@ -0,0 +1,112 @@
+package util
+
+import (
+	"encoding/base32"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	com "github.com/aureontu/MRWebServer/mr_services/common"
+)
+
+// the secret of the RFC 6238 test vectors, "12345678901234567890"
+var totpTestSecret = base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString([]byte("12345678901234567890"))
+
+func TestValidateTOTP(t *testing.T) {
+	cases := []struct {
+		name    string
+		secret  string
+		code    string
+		time    int64
+		counter uint64
+		ok      bool
+	}{
+		// the last 6 digits of the SHA1 vectors of RFC 6238 appendix B
+		{name: "rfc 59", secret: totpTestSecret, code: "287082", time: 59, counter: 1, ok: true},
+		{name: "rfc 1111111109", secret: totpTestSecret, code: "081804", time: 1111111109, counter: 37037036, ok: true},
+		{name: "rfc 1234567890", secret: totpTestSecret, code: "005924", time: 1234567890, counter: 41152263, ok: true},
+		{name: "rfc 2000000000", secret: totpTestSecret, code: "279037", time: 2000000000, counter: 66666666, ok: true},
+		{name: "lower case secret", secret: strings.ToLower(totpTestSecret), code: "287082", time: 59, counter: 1,
+			ok: true},
+		{name: "previous step", secret: totpTestSecret, code: "287082", time: 89, counter: 1, ok: true},
+		{name: "next step", secret: totpTestSecret, code: "287082", time: 29, counter: 1, ok: true},
+		{name: "out of skew", secret: totpTestSecret, code: "287082", time: 120},
+		{name: "wrong code", secret: totpTestSecret, code: "287083", time: 59},
+		{name: "short code", secret: totpTestSecret, code: "28708", time: 59},
+		{name: "bad secret", secret: "not base32!", code: "287082", time: 59},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			counter, ok := ValidateTOTP(c.secret, c.code, time.Unix(c.time, 0))
+			if ok != c.ok || counter != c.counter {
+				t.Fatalf("ValidateTOTP = %d, %v, want %d, %v", counter, ok, c.counter, c.ok)
+			}
+		})
+	}
+}
+
+func TestGenerateTOTPSecret(t *testing.T) {
+	secret, err := GenerateTOTPSecret()
+	if err != nil {
+		t.Fatal(err)
+	}
+	key, err := totpEncoding.DecodeString(secret)
+	if err != nil || len(key) != totpSecretLen {
+		t.Fatalf("secret %q decodes to %d bytes, %v", secret, len(key), err)
+	}
+	counter := uint64(time.Now().Unix() / totpPeriod)
+	if _, ok := ValidateTOTP(secret, hotp(key, counter), time.Now()); !ok {
+		t.Fatal("code of the generated secret rejected")
+	}
+}
+
+func TestTOTPURI(t *testing.T) {
+	u, err := url.Parse(TOTPURI("Mirror Realms", "a@b.io", "SECRET"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if u.Scheme != "otpauth" || u.Host != "totp" || u.Path != "/Mirror Realms:a@b.io" {
+		t.Fatalf("uri = %s", u)
+	}
+	q := u.Query()
+	if q.Get("secret") != "SECRET" || q.Get("issuer") != "Mirror Realms" || q.Get("digits") != "6" ||
+		q.Get("period") != "30" {
+		t.Fatalf("query = %v", q)
+	}
+}
+
+func TestRecoveryCodes(t *testing.T) {
+	codes, err := GenerateRecoveryCodes(8)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(codes) != 8 {
+		t.Fatalf("got %d codes", len(codes))
+	}
+	for _, code := range codes {
+		if len(code) != com.TOTPRecoveryCodeLen+1 || code[com.TOTPRecoveryCodeLen/2] != '-' {
+			t.Fatalf("code %q", code)
+		}
+	}
+
+	cases := []struct {
+		name  string
+		typed string
+		same  bool
+	}{
+		{name: "as shown", typed: "abcde-fghjk", same: true},
+		{name: "upper case", typed: "ABCDE-FGHJK", same: true},
+		{name: "no dash", typed: "abcdefghjk", same: true},
+		{name: "spaces", typed: " abcde fghjk ", same: true},
+		{name: "other code", typed: "abcde-fghjm"},
+	}
+	want := HashRecoveryCode("abcde-fghjk")
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := HashRecoveryCode(c.typed); (got == want) != c.same {
+				t.Fatalf("HashRecoveryCode(%q) = %s, want same %v", c.typed, got, c.same)
+			}
+		})
+	}
+}
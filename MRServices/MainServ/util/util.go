@@ -1,8 +1,11 @@
 package util
 
 import (
+	crand "crypto/rand"
+	"encoding/hex"
 	"math"
 	"math/rand"
+	"net"
 	"regexp"
 	"strconv"
 
@@ -14,6 +17,12 @@ func CheckEmailAddr(emailAddr string) bool {
 	return regex.MatchString(emailAddr)
 }
 
+// CheckTelNumber check the phone number is in E.164 format, e.g. +6591234567
+func CheckTelNumber(tel string) bool {
+	regex := regexp.MustCompile(`^\+[1-9][0-9]{6,14}$`)
+	return regex.MatchString(tel)
+}
+
 func GenerateRandomCode(length int) string {
 	codeInt := rand.Intn(int(math.Pow(float64(10), float64(length))))
 	code := strconv.Itoa(codeInt)
@@ -22,3 +31,38 @@ func GenerateRandomCode(length int) string {
 	}
 	return code
 }
+
+const inviteCodeChars = "23456789ABCDEFGHJKLMNPQRSTUVWXYZ" // no 0, 1, I and O which are easy to mix up
+
+// GenerateInviteCode generate a random invite code which is easy to read and type
+func GenerateInviteCode(length int) (string, error) {
+	b := make([]byte, length)
+	if _, err := crand.Read(b); err != nil {
+		return "", err
+	}
+	for i := range b {
+		b[i] = inviteCodeChars[int(b[i])%len(inviteCodeChars)]
+	}
+	return string(b), nil
+}
+
+// IPSubnet return the /24 subnet of an ipv4 address or the /64 subnet of an ipv6 address, empty if ip is invalid
+func IPSubnet(ip string) string {
+	addr := net.ParseIP(ip)
+	if addr == nil {
+		return ""
+	}
+	if v4 := addr.To4(); v4 != nil {
+		return v4.Mask(net.CIDRMask(24, 32)).String()
+	}
+	return addr.Mask(net.CIDRMask(64, 128)).String()
+}
+
+// GenerateRandomToken generate a hex token from crypto random bytes
+func GenerateRandomToken(byteLen int) (string, error) {
+	b := make([]byte, byteLen)
+	if _, err := crand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
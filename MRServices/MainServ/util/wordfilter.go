@@ -0,0 +1,184 @@
+package util
+
+import (
+	"bufio"
+	"os"
+	fp "path/filepath"
+	"strings"
+	"sync"
+	"unicode"
+
+	gdm "github.com/oldjon/gutil/dirmonitor"
+	"go.uber.org/zap"
+)
+
+const (
+	wordFilterPath   = "./resources/wordfilter/"
+	wordFilterSuffix = ".txt"
+)
+
+// MultiLangWordFilter check texts against the sensitive word dictionaries of every language.
+// Dictionaries are the <lang>.txt files in resources/wordfilter, one word per line, lines starting with # are ignored.
+// A dictionary is reloaded when its file is written.
+type MultiLangWordFilter struct {
+	logger *zap.Logger
+	dm     *gdm.DirMonitor
+
+	mu    sync.RWMutex
+	tries map[string]*wordTrie // lang -> trie
+}
+
+var (
+	multiLangWordFilter     *MultiLangWordFilter
+	multiLangWordFilterErr  error
+	multiLangWordFilterOnce sync.Once
+)
+
+// GetMultiLangWordFilter return the word filter shared in the process, dictionaries are loaded on the first call
+func GetMultiLangWordFilter(logger *zap.Logger) (*MultiLangWordFilter, error) {
+	multiLangWordFilterOnce.Do(func() {
+		multiLangWordFilter, multiLangWordFilterErr = newMultiLangWordFilter(logger)
+	})
+	return multiLangWordFilter, multiLangWordFilterErr
+}
+
+func newMultiLangWordFilter(logger *zap.Logger) (*MultiLangWordFilter, error) {
+	wf := &MultiLangWordFilter{
+		logger: logger,
+		tries:  make(map[string]*wordTrie),
+	}
+
+	var err error
+	wf.dm, err = gdm.NewDirMonitor(wordFilterPath)
+	if err != nil {
+		return nil, err
+	}
+
+	files, err := os.ReadDir(wordFilterPath)
+	if err != nil {
+		return nil, err
+	}
+	for _, f := range files {
+		if f.IsDir() || !strings.HasSuffix(f.Name(), wordFilterSuffix) {
+			continue
+		}
+		err = wf.loadDict(f.Name())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// new dictionaries are picked up as well
+	wf.dm.BindAny(func(filename string) error {
+		if !strings.HasSuffix(filename, wordFilterSuffix) {
+			return nil
+		}
+		return wf.loadDict(filename)
+	})
+	err = wf.dm.StartWatch()
+	if err != nil {
+		return nil, err
+	}
+	return wf, nil
+}
+
+func (wf *MultiLangWordFilter) loadDict(filename string) error {
+	f, err := os.Open(fp.Join(wordFilterPath, filename))
+	if err != nil {
+		wf.logger.Error("loadDict open failed", zap.String("file", filename), zap.Error(err))
+		return err
+	}
+	defer f.Close()
+
+	trie := newWordTrie()
+	cnt := 0
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if trie.add(line) {
+			cnt++
+		}
+	}
+	if err = scanner.Err(); err != nil {
+		wf.logger.Error("loadDict scan failed", zap.String("file", filename), zap.Error(err))
+		return err
+	}
+
+	lang := strings.TrimSuffix(filename, wordFilterSuffix)
+	wf.mu.Lock()
+	wf.tries[lang] = trie
+	wf.mu.Unlock()
+	wf.logger.Info("word filter dictionary loaded", zap.String("lang", lang), zap.Int("words", cnt))
+	return nil
+}
+
+// Contains return true if the text contains a sensitive word of any language
+func (wf *MultiLangWordFilter) Contains(text string) bool {
+	runes := normalizeFilterText(text)
+	wf.mu.RLock()
+	defer wf.mu.RUnlock()
+	for _, trie := range wf.tries {
+		if trie.match(runes) {
+			return true
+		}
+	}
+	return false
+}
+
+// normalizeFilterText lower the text and drop everything but letters and digits,
+// so words split by spaces or symbols are still matched
+func normalizeFilterText(text string) []rune {
+	runes := make([]rune, 0, len(text))
+	for _, r := range text {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			runes = append(runes, unicode.ToLower(r))
+		}
+	}
+	return runes
+}
+
+type wordTrie struct {
+	children map[rune]*wordTrie
+	end      bool
+}
+
+func newWordTrie() *wordTrie {
+	return &wordTrie{children: make(map[rune]*wordTrie)}
+}
+
+func (t *wordTrie) add(word string) bool {
+	runes := normalizeFilterText(word)
+	if len(runes) == 0 {
+		return false
+	}
+	node := t
+	for _, r := range runes {
+		child, ok := node.children[r]
+		if !ok {
+			child = newWordTrie()
+			node.children[r] = child
+		}
+		node = child
+	}
+	node.end = true
+	return true
+}
+
+func (t *wordTrie) match(runes []rune) bool {
+	for i := range runes {
+		node := t
+		for _, r := range runes[i:] {
+			node = node.children[r]
+			if node == nil {
+				break
+			}
+			if node.end {
+				return true
+			}
+		}
+	}
+	return false
+}